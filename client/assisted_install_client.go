@@ -14,12 +14,15 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/openshift/assisted-service/client/assisted_service_iso"
+	"github.com/openshift/assisted-service/client/audit"
+	"github.com/openshift/assisted-service/client/cluster_templates"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
 	"github.com/openshift/assisted-service/client/operators"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/client/webhooks"
 )
 
 const (
@@ -65,23 +68,29 @@ func New(c Config) *AssistedInstall {
 	cli := new(AssistedInstall)
 	cli.Transport = transport
 	cli.AssistedServiceIso = assisted_service_iso.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Audit = audit.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterTemplates = cluster_templates.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Webhooks = webhooks.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
 	AssistedServiceIso *assisted_service_iso.Client
+	Audit              *audit.Client
+	ClusterTemplates   *cluster_templates.Client
 	Events             *events.Client
 	Installer          *installer.Client
 	ManagedDomains     *managed_domains.Client
 	Manifests          *manifests.Client
 	Operators          *operators.Client
 	Versions           *versions.Client
+	Webhooks           *webhooks.Client
 	Transport          runtime.ClientTransport
 }
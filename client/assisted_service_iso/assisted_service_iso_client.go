@@ -23,7 +23,7 @@ type API interface {
 	CreateISOAndUploadToS3(ctx context.Context, params *CreateISOAndUploadToS3Params) (*CreateISOAndUploadToS3Created, error)
 	/*
 	   DownloadISO Downloads the Assisted Service ISO.*/
-	DownloadISO(ctx context.Context, params *DownloadISOParams, writer io.Writer) (*DownloadISOOK, error)
+	DownloadISO(ctx context.Context, params *DownloadISOParams, writer io.Writer) (*DownloadISOOK, *DownloadISOPartialContent, error)
 	/*
 	   GetPresignedForAssistedServiceISO Retrieves a pre-signed S3 URL for downloading assisted-service ISO.*/
 	GetPresignedForAssistedServiceISO(ctx context.Context, params *GetPresignedForAssistedServiceISOParams) (*GetPresignedForAssistedServiceISOOK, error)
@@ -75,7 +75,7 @@ func (a *Client) CreateISOAndUploadToS3(ctx context.Context, params *CreateISOAn
 /*
 DownloadISO Downloads the Assisted Service ISO.
 */
-func (a *Client) DownloadISO(ctx context.Context, params *DownloadISOParams, writer io.Writer) (*DownloadISOOK, error) {
+func (a *Client) DownloadISO(ctx context.Context, params *DownloadISOParams, writer io.Writer) (*DownloadISOOK, *DownloadISOPartialContent, error) {
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
 		ID:                 "DownloadISO",
@@ -91,9 +91,15 @@ func (a *Client) DownloadISO(ctx context.Context, params *DownloadISOParams, wri
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *DownloadISOOK:
+		return value, nil, nil
+	case *DownloadISOPartialContent:
+		return nil, value, nil
 	}
-	return result.(*DownloadISOOK), nil
+	return nil, nil, nil
 
 }
 
@@ -19,7 +19,7 @@ import (
 // NewDownloadISOParams creates a new DownloadISOParams object
 // with the default values initialized.
 func NewDownloadISOParams() *DownloadISOParams {
-
+	var ()
 	return &DownloadISOParams{
 
 		timeout: cr.DefaultTimeout,
@@ -29,7 +29,7 @@ func NewDownloadISOParams() *DownloadISOParams {
 // NewDownloadISOParamsWithTimeout creates a new DownloadISOParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewDownloadISOParamsWithTimeout(timeout time.Duration) *DownloadISOParams {
-
+	var ()
 	return &DownloadISOParams{
 
 		timeout: timeout,
@@ -39,7 +39,7 @@ func NewDownloadISOParamsWithTimeout(timeout time.Duration) *DownloadISOParams {
 // NewDownloadISOParamsWithContext creates a new DownloadISOParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewDownloadISOParamsWithContext(ctx context.Context) *DownloadISOParams {
-
+	var ()
 	return &DownloadISOParams{
 
 		Context: ctx,
@@ -49,7 +49,7 @@ func NewDownloadISOParamsWithContext(ctx context.Context) *DownloadISOParams {
 // NewDownloadISOParamsWithHTTPClient creates a new DownloadISOParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewDownloadISOParamsWithHTTPClient(client *http.Client) *DownloadISOParams {
-
+	var ()
 	return &DownloadISOParams{
 		HTTPClient: client,
 	}
@@ -59,6 +59,18 @@ func NewDownloadISOParamsWithHTTPClient(client *http.Client) *DownloadISOParams
 for the download i s o operation typically these are written to a http.Request
 */
 type DownloadISOParams struct {
+
+	/*IfRange
+	  Applies the Range header only if the ETag of the file still matches the given one.
+
+	*/
+	IfRange *string
+	/*Range
+	  Requests a single range of bytes of the file (e.g. "bytes=1024-"), for resuming interrupted downloads.
+
+	*/
+	Range *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -97,6 +109,28 @@ func (o *DownloadISOParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfRange adds the ifRange to the download i s o params
+func (o *DownloadISOParams) WithIfRange(ifRange *string) *DownloadISOParams {
+	o.SetIfRange(ifRange)
+	return o
+}
+
+// SetIfRange adds the ifRange to the download i s o params
+func (o *DownloadISOParams) SetIfRange(ifRange *string) {
+	o.IfRange = ifRange
+}
+
+// WithRange adds the rangeVar to the download i s o params
+func (o *DownloadISOParams) WithRange(rangeVar *string) *DownloadISOParams {
+	o.SetRange(rangeVar)
+	return o
+}
+
+// SetRange adds the range to the download i s o params
+func (o *DownloadISOParams) SetRange(rangeVar *string) {
+	o.Range = rangeVar
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *DownloadISOParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -105,6 +139,24 @@ func (o *DownloadISOParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.IfRange != nil {
+
+		// header param If-Range
+		if err := r.SetHeaderParam("If-Range", *o.IfRange); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Range != nil {
+
+		// header param Range
+		if err := r.SetHeaderParam("Range", *o.Range); err != nil {
+			return err
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -30,6 +30,12 @@ func (o *DownloadISOReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return result, nil
+	case 206:
+		result := NewDownloadISOPartialContent(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 401:
 		result := NewDownloadISOUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -48,6 +54,12 @@ func (o *DownloadISOReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 416:
+		result := NewDownloadISORequestedRangeNotSatisfiable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewDownloadISOInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -72,6 +84,13 @@ func NewDownloadISOOK(writer io.Writer) *DownloadISOOK {
 Success.
 */
 type DownloadISOOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
 	Payload io.Writer
 }
 
@@ -85,6 +104,64 @@ func (o *DownloadISOOK) GetPayload() io.Writer {
 
 func (o *DownloadISOOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDownloadISOPartialContent creates a DownloadISOPartialContent with default headers values
+func NewDownloadISOPartialContent(writer io.Writer) *DownloadISOPartialContent {
+	return &DownloadISOPartialContent{
+		Payload: writer,
+	}
+}
+
+/*DownloadISOPartialContent handles this case with default header values.
+
+Partial content, the requested range of the file.
+*/
+type DownloadISOPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The range of bytes of the file that is sent, and its size.
+	 */
+	ContentRange string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
+	Payload io.Writer
+}
+
+func (o *DownloadISOPartialContent) Error() string {
+	return fmt.Sprintf("[GET /assisted-service-iso/data][%d] downloadISOPartialContent  %+v", 206, o.Payload)
+}
+
+func (o *DownloadISOPartialContent) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *DownloadISOPartialContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
@@ -192,6 +269,46 @@ func (o *DownloadISONotFound) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewDownloadISORequestedRangeNotSatisfiable creates a DownloadISORequestedRangeNotSatisfiable with default headers values
+func NewDownloadISORequestedRangeNotSatisfiable() *DownloadISORequestedRangeNotSatisfiable {
+	return &DownloadISORequestedRangeNotSatisfiable{}
+}
+
+/*DownloadISORequestedRangeNotSatisfiable handles this case with default header values.
+
+The requested range is not satisfiable.
+*/
+type DownloadISORequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+	 */
+	ContentRange string
+
+	Payload *models.Error
+}
+
+func (o *DownloadISORequestedRangeNotSatisfiable) Error() string {
+	return fmt.Sprintf("[GET /assisted-service-iso/data][%d] downloadISORequestedRangeNotSatisfiable  %+v", 416, o.Payload)
+}
+
+func (o *DownloadISORequestedRangeNotSatisfiable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadISORequestedRangeNotSatisfiable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadISOInternalServerError creates a DownloadISOInternalServerError with default headers values
 func NewDownloadISOInternalServerError() *DownloadISOInternalServerError {
 	return &DownloadISOInternalServerError{}
@@ -7,6 +7,7 @@ package events
 
 import (
 	"context"
+	"io"
 
 	"github.com/go-openapi/runtime"
 
@@ -20,6 +21,9 @@ type API interface {
 	/*
 	   ListEvents Lists events for a cluster.*/
 	ListEvents(ctx context.Context, params *ListEventsParams) (*ListEventsOK, error)
+	/*
+	   StreamEvents Streams events for a cluster as server-sent events, ordered by their time. Each event is sent with an ID made of its time and its sequence number, so that reconnecting clients can resume from the last event they received. Events saved late may follow events with later times, so on resume the events of the last few seconds before the last received event are sent again, with the same IDs.*/
+	StreamEvents(ctx context.Context, params *StreamEventsParams, writer io.Writer) (*StreamEventsOK, error)
 }
 
 // New creates a new events API client.
@@ -64,3 +68,28 @@ func (a *Client) ListEvents(ctx context.Context, params *ListEventsParams) (*Lis
 	return result.(*ListEventsOK), nil
 
 }
+
+/*
+StreamEvents Streams events for a cluster as server-sent events, ordered by their time. Each event is sent with an ID made of its time and its sequence number, so that reconnecting clients can resume from the last event they received. Events saved late may follow events with later times, so on resume the events of the last few seconds before the last received event are sent again, with the same IDs.
+*/
+func (a *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, writer io.Writer) (*StreamEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "StreamEvents",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/events/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &StreamEventsReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*StreamEventsOK), nil
+
+}
@@ -30,6 +30,12 @@ func (o *DownloadClusterFilesReader) ReadResponse(response runtime.ClientRespons
 			return nil, err
 		}
 		return result, nil
+	case 206:
+		result := NewDownloadClusterFilesPartialContent(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 401:
 		result := NewDownloadClusterFilesUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -60,6 +66,12 @@ func (o *DownloadClusterFilesReader) ReadResponse(response runtime.ClientRespons
 			return nil, err
 		}
 		return nil, result
+	case 416:
+		result := NewDownloadClusterFilesRequestedRangeNotSatisfiable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewDownloadClusterFilesInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -90,6 +102,13 @@ func NewDownloadClusterFilesOK(writer io.Writer) *DownloadClusterFilesOK {
 Success.
 */
 type DownloadClusterFilesOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
 	Payload io.Writer
 }
 
@@ -103,6 +122,64 @@ func (o *DownloadClusterFilesOK) GetPayload() io.Writer {
 
 func (o *DownloadClusterFilesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDownloadClusterFilesPartialContent creates a DownloadClusterFilesPartialContent with default headers values
+func NewDownloadClusterFilesPartialContent(writer io.Writer) *DownloadClusterFilesPartialContent {
+	return &DownloadClusterFilesPartialContent{
+		Payload: writer,
+	}
+}
+
+/*DownloadClusterFilesPartialContent handles this case with default header values.
+
+Partial content, the requested range of the file.
+*/
+type DownloadClusterFilesPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The range of bytes of the file that is sent, and its size.
+	 */
+	ContentRange string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
+	Payload io.Writer
+}
+
+func (o *DownloadClusterFilesPartialContent) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/files][%d] downloadClusterFilesPartialContent  %+v", 206, o.Payload)
+}
+
+func (o *DownloadClusterFilesPartialContent) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *DownloadClusterFilesPartialContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
@@ -276,6 +353,46 @@ func (o *DownloadClusterFilesConflict) readResponse(response runtime.ClientRespo
 	return nil
 }
 
+// NewDownloadClusterFilesRequestedRangeNotSatisfiable creates a DownloadClusterFilesRequestedRangeNotSatisfiable with default headers values
+func NewDownloadClusterFilesRequestedRangeNotSatisfiable() *DownloadClusterFilesRequestedRangeNotSatisfiable {
+	return &DownloadClusterFilesRequestedRangeNotSatisfiable{}
+}
+
+/*DownloadClusterFilesRequestedRangeNotSatisfiable handles this case with default header values.
+
+The requested range is not satisfiable.
+*/
+type DownloadClusterFilesRequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+	 */
+	ContentRange string
+
+	Payload *models.Error
+}
+
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/files][%d] downloadClusterFilesRequestedRangeNotSatisfiable  %+v", 416, o.Payload)
+}
+
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadClusterFilesInternalServerError creates a DownloadClusterFilesInternalServerError with default headers values
 func NewDownloadClusterFilesInternalServerError() *DownloadClusterFilesInternalServerError {
 	return &DownloadClusterFilesInternalServerError{}
@@ -92,6 +92,9 @@ type DownloadClusterISOHeadersOK struct {
 	/*Size of the ISO in bytes
 	 */
 	ContentLength int64
+	/*The hex-encoded SHA-256 digest of the ISO, when it is known.
+	 */
+	XChecksumSha256 string
 }
 
 func (o *DownloadClusterISOHeadersOK) Error() string {
@@ -107,6 +110,9 @@ func (o *DownloadClusterISOHeadersOK) readResponse(response runtime.ClientRespon
 	}
 	o.ContentLength = contentLength
 
+	// response header X-Checksum-Sha256
+	o.XChecksumSha256 = response.GetHeader("X-Checksum-Sha256")
+
 	return nil
 }
 
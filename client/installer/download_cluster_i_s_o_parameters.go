@@ -60,6 +60,16 @@ for the download cluster i s o operation typically these are written to a http.R
 */
 type DownloadClusterISOParams struct {
 
+	/*IfRange
+	  Applies the Range header only if the ETag of the file still matches the given one.
+
+	*/
+	IfRange *string
+	/*Range
+	  Requests a single range of bytes of the file (e.g. "bytes=1024-"), for resuming interrupted downloads.
+
+	*/
+	Range *string
 	/*ClusterID
 	  The cluster whose ISO should be downloaded.
 
@@ -104,6 +114,28 @@ func (o *DownloadClusterISOParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfRange adds the ifRange to the download cluster i s o params
+func (o *DownloadClusterISOParams) WithIfRange(ifRange *string) *DownloadClusterISOParams {
+	o.SetIfRange(ifRange)
+	return o
+}
+
+// SetIfRange adds the ifRange to the download cluster i s o params
+func (o *DownloadClusterISOParams) SetIfRange(ifRange *string) {
+	o.IfRange = ifRange
+}
+
+// WithRange adds the rangeVar to the download cluster i s o params
+func (o *DownloadClusterISOParams) WithRange(rangeVar *string) *DownloadClusterISOParams {
+	o.SetRange(rangeVar)
+	return o
+}
+
+// SetRange adds the range to the download cluster i s o params
+func (o *DownloadClusterISOParams) SetRange(rangeVar *string) {
+	o.Range = rangeVar
+}
+
 // WithClusterID adds the clusterID to the download cluster i s o params
 func (o *DownloadClusterISOParams) WithClusterID(clusterID strfmt.UUID) *DownloadClusterISOParams {
 	o.SetClusterID(clusterID)
@@ -123,6 +155,24 @@ func (o *DownloadClusterISOParams) WriteToRequest(r runtime.ClientRequest, reg s
 	}
 	var res []error
 
+	if o.IfRange != nil {
+
+		// header param If-Range
+		if err := r.SetHeaderParam("If-Range", *o.IfRange); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Range != nil {
+
+		// header param Range
+		if err := r.SetHeaderParam("Range", *o.Range); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
@@ -30,6 +30,12 @@ func (o *DownloadClusterISOReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return result, nil
+	case 206:
+		result := NewDownloadClusterISOPartialContent(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 400:
 		result := NewDownloadClusterISOBadRequest()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -66,6 +72,12 @@ func (o *DownloadClusterISOReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 416:
+		result := NewDownloadClusterISORequestedRangeNotSatisfiable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewDownloadClusterISOInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -90,6 +102,13 @@ func NewDownloadClusterISOOK(writer io.Writer) *DownloadClusterISOOK {
 Success.
 */
 type DownloadClusterISOOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
 	Payload io.Writer
 }
 
@@ -103,6 +122,64 @@ func (o *DownloadClusterISOOK) GetPayload() io.Writer {
 
 func (o *DownloadClusterISOOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDownloadClusterISOPartialContent creates a DownloadClusterISOPartialContent with default headers values
+func NewDownloadClusterISOPartialContent(writer io.Writer) *DownloadClusterISOPartialContent {
+	return &DownloadClusterISOPartialContent{
+		Payload: writer,
+	}
+}
+
+/*DownloadClusterISOPartialContent handles this case with default header values.
+
+Partial content, the requested range of the file.
+*/
+type DownloadClusterISOPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The range of bytes of the file that is sent, and its size.
+	 */
+	ContentRange string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
+	Payload io.Writer
+}
+
+func (o *DownloadClusterISOPartialContent) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/image][%d] downloadClusterISOPartialContent  %+v", 206, o.Payload)
+}
+
+func (o *DownloadClusterISOPartialContent) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *DownloadClusterISOPartialContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
@@ -297,6 +374,46 @@ func (o *DownloadClusterISOConflict) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewDownloadClusterISORequestedRangeNotSatisfiable creates a DownloadClusterISORequestedRangeNotSatisfiable with default headers values
+func NewDownloadClusterISORequestedRangeNotSatisfiable() *DownloadClusterISORequestedRangeNotSatisfiable {
+	return &DownloadClusterISORequestedRangeNotSatisfiable{}
+}
+
+/*DownloadClusterISORequestedRangeNotSatisfiable handles this case with default header values.
+
+The requested range is not satisfiable.
+*/
+type DownloadClusterISORequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+	 */
+	ContentRange string
+
+	Payload *models.Error
+}
+
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/image][%d] downloadClusterISORequestedRangeNotSatisfiable  %+v", 416, o.Payload)
+}
+
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadClusterISOInternalServerError creates a DownloadClusterISOInternalServerError with default headers values
 func NewDownloadClusterISOInternalServerError() *DownloadClusterISOInternalServerError {
 	return &DownloadClusterISOInternalServerError{}
@@ -60,6 +60,16 @@ for the download cluster logs operation typically these are written to a http.Re
 */
 type DownloadClusterLogsParams struct {
 
+	/*IfRange
+	  Applies the Range header only if the ETag of the file still matches the given one.
+
+	*/
+	IfRange *string
+	/*Range
+	  Requests a single range of bytes of the file (e.g. "bytes=1024-"), for resuming interrupted downloads.
+
+	*/
+	Range *string
 	/*ClusterID
 	  The cluster whose logs should be downloaded.
 
@@ -114,6 +124,28 @@ func (o *DownloadClusterLogsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfRange adds the ifRange to the download cluster logs params
+func (o *DownloadClusterLogsParams) WithIfRange(ifRange *string) *DownloadClusterLogsParams {
+	o.SetIfRange(ifRange)
+	return o
+}
+
+// SetIfRange adds the ifRange to the download cluster logs params
+func (o *DownloadClusterLogsParams) SetIfRange(ifRange *string) {
+	o.IfRange = ifRange
+}
+
+// WithRange adds the rangeVar to the download cluster logs params
+func (o *DownloadClusterLogsParams) WithRange(rangeVar *string) *DownloadClusterLogsParams {
+	o.SetRange(rangeVar)
+	return o
+}
+
+// SetRange adds the range to the download cluster logs params
+func (o *DownloadClusterLogsParams) SetRange(rangeVar *string) {
+	o.Range = rangeVar
+}
+
 // WithClusterID adds the clusterID to the download cluster logs params
 func (o *DownloadClusterLogsParams) WithClusterID(clusterID strfmt.UUID) *DownloadClusterLogsParams {
 	o.SetClusterID(clusterID)
@@ -155,6 +187,24 @@ func (o *DownloadClusterLogsParams) WriteToRequest(r runtime.ClientRequest, reg
 	}
 	var res []error
 
+	if o.IfRange != nil {
+
+		// header param If-Range
+		if err := r.SetHeaderParam("If-Range", *o.IfRange); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Range != nil {
+
+		// header param Range
+		if err := r.SetHeaderParam("Range", *o.Range); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
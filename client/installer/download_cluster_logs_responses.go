@@ -30,6 +30,12 @@ func (o *DownloadClusterLogsReader) ReadResponse(response runtime.ClientResponse
 			return nil, err
 		}
 		return result, nil
+	case 206:
+		result := NewDownloadClusterLogsPartialContent(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 401:
 		result := NewDownloadClusterLogsUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -60,6 +66,12 @@ func (o *DownloadClusterLogsReader) ReadResponse(response runtime.ClientResponse
 			return nil, err
 		}
 		return nil, result
+	case 416:
+		result := NewDownloadClusterLogsRequestedRangeNotSatisfiable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewDownloadClusterLogsInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -84,6 +96,13 @@ func NewDownloadClusterLogsOK(writer io.Writer) *DownloadClusterLogsOK {
 Success.
 */
 type DownloadClusterLogsOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
 	Payload io.Writer
 }
 
@@ -97,6 +116,64 @@ func (o *DownloadClusterLogsOK) GetPayload() io.Writer {
 
 func (o *DownloadClusterLogsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDownloadClusterLogsPartialContent creates a DownloadClusterLogsPartialContent with default headers values
+func NewDownloadClusterLogsPartialContent(writer io.Writer) *DownloadClusterLogsPartialContent {
+	return &DownloadClusterLogsPartialContent{
+		Payload: writer,
+	}
+}
+
+/*DownloadClusterLogsPartialContent handles this case with default header values.
+
+Partial content, the requested range of the file.
+*/
+type DownloadClusterLogsPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+	 */
+	AcceptRanges string
+	/*The range of bytes of the file that is sent, and its size.
+	 */
+	ContentRange string
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+	 */
+	ETag string
+
+	Payload io.Writer
+}
+
+func (o *DownloadClusterLogsPartialContent) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs][%d] downloadClusterLogsPartialContent  %+v", 206, o.Payload)
+}
+
+func (o *DownloadClusterLogsPartialContent) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *DownloadClusterLogsPartialContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Accept-Ranges
+	o.AcceptRanges = response.GetHeader("Accept-Ranges")
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
@@ -270,6 +347,46 @@ func (o *DownloadClusterLogsConflict) readResponse(response runtime.ClientRespon
 	return nil
 }
 
+// NewDownloadClusterLogsRequestedRangeNotSatisfiable creates a DownloadClusterLogsRequestedRangeNotSatisfiable with default headers values
+func NewDownloadClusterLogsRequestedRangeNotSatisfiable() *DownloadClusterLogsRequestedRangeNotSatisfiable {
+	return &DownloadClusterLogsRequestedRangeNotSatisfiable{}
+}
+
+/*DownloadClusterLogsRequestedRangeNotSatisfiable handles this case with default header values.
+
+The requested range is not satisfiable.
+*/
+type DownloadClusterLogsRequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+	 */
+	ContentRange string
+
+	Payload *models.Error
+}
+
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/logs][%d] downloadClusterLogsRequestedRangeNotSatisfiable  %+v", 416, o.Payload)
+}
+
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header Content-Range
+	o.ContentRange = response.GetHeader("Content-Range")
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadClusterLogsInternalServerError creates a DownloadClusterLogsInternalServerError with default headers values
 func NewDownloadClusterLogsInternalServerError() *DownloadClusterLogsInternalServerError {
 	return &DownloadClusterLogsInternalServerError{}
@@ -35,10 +35,10 @@ type API interface {
 	DisableHost(ctx context.Context, params *DisableHostParams) (*DisableHostOK, error)
 	/*
 	   DownloadClusterFiles Downloads files relating to the installed/installing cluster.*/
-	DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, error)
+	DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, *DownloadClusterFilesPartialContent, error)
 	/*
 	   DownloadClusterISO Downloads the OpenShift per-cluster Discovery ISO.*/
-	DownloadClusterISO(ctx context.Context, params *DownloadClusterISOParams, writer io.Writer) (*DownloadClusterISOOK, error)
+	DownloadClusterISO(ctx context.Context, params *DownloadClusterISOParams, writer io.Writer) (*DownloadClusterISOOK, *DownloadClusterISOPartialContent, error)
 	/*
 	   DownloadClusterISOHeaders Downloads the OpenShift per-cluster Discovery ISO Headers only.*/
 	DownloadClusterISOHeaders(ctx context.Context, params *DownloadClusterISOHeadersParams) (*DownloadClusterISOHeadersOK, error)
@@ -47,7 +47,7 @@ type API interface {
 	DownloadClusterKubeconfig(ctx context.Context, params *DownloadClusterKubeconfigParams, writer io.Writer) (*DownloadClusterKubeconfigOK, error)
 	/*
 	   DownloadClusterLogs Download cluster logs.*/
-	DownloadClusterLogs(ctx context.Context, params *DownloadClusterLogsParams, writer io.Writer) (*DownloadClusterLogsOK, error)
+	DownloadClusterLogs(ctx context.Context, params *DownloadClusterLogsParams, writer io.Writer) (*DownloadClusterLogsOK, *DownloadClusterLogsPartialContent, error)
 	/*
 	   DownloadHostIgnition Downloads the customized ignition file for this host*/
 	DownloadHostIgnition(ctx context.Context, params *DownloadHostIgnitionParams, writer io.Writer) (*DownloadHostIgnitionOK, error)
@@ -57,12 +57,21 @@ type API interface {
 	/*
 	   EnableHost Enables a host for inclusion in the cluster.*/
 	EnableHost(ctx context.Context, params *EnableHostParams) (*EnableHostOK, error)
+	/*
+	   ExportClusterDefinition Exports the definition of the cluster, so that it can be imported to recreate the cluster.*/
+	ExportClusterDefinition(ctx context.Context, params *ExportClusterDefinitionParams) (*ExportClusterDefinitionOK, error)
 	/*
 	   GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO.*/
 	GenerateClusterISO(ctx context.Context, params *GenerateClusterISOParams) (*GenerateClusterISOCreated, error)
+	/*
+	   GetChecksumsSigningKey Retrieves the public key that verifies the signatures of the cluster checksums.*/
+	GetChecksumsSigningKey(ctx context.Context, params *GetChecksumsSigningKeyParams) (*GetChecksumsSigningKeyOK, error)
 	/*
 	   GetCluster Retrieves the details of the OpenShift cluster.*/
 	GetCluster(ctx context.Context, params *GetClusterParams) (*GetClusterOK, error)
+	/*
+	   GetClusterChecksums Retrieves the SHA-256 digests of the discovery ISO and the files of the cluster, optionally signed by the service.*/
+	GetClusterChecksums(ctx context.Context, params *GetClusterChecksumsParams) (*GetClusterChecksumsOK, error)
 	/*
 	   GetClusterDefaultConfig Get the default values for various cluster properties.*/
 	GetClusterDefaultConfig(ctx context.Context, params *GetClusterDefaultConfigParams) (*GetClusterDefaultConfigOK, error)
@@ -72,6 +81,18 @@ type API interface {
 	/*
 	   GetClusterInstallConfig Get the cluster's install config YAML.*/
 	GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error)
+	/*
+	   GetClusterInstallRetryPolicy Get the policy that the failed host installations of the cluster are automatically retried by.*/
+	GetClusterInstallRetryPolicy(ctx context.Context, params *GetClusterInstallRetryPolicyParams) (*GetClusterInstallRetryPolicyOK, error)
+	/*
+	   GetClusterStageTimeouts Get the installation stage timeouts of the cluster, which override the timeouts of the service.*/
+	GetClusterStageTimeouts(ctx context.Context, params *GetClusterStageTimeoutsParams) (*GetClusterStageTimeoutsOK, error)
+	/*
+	   GetClusterValidationPolicy Get the validation policy of the cluster.*/
+	GetClusterValidationPolicy(ctx context.Context, params *GetClusterValidationPolicyParams) (*GetClusterValidationPolicyOK, error)
+	/*
+	   GetClusterWorkerSuccessThreshold Get how many of the workers of the cluster must be installed for its installation to succeed.*/
+	GetClusterWorkerSuccessThreshold(ctx context.Context, params *GetClusterWorkerSuccessThresholdParams) (*GetClusterWorkerSuccessThresholdOK, error)
 	/*
 	   GetCredentials Get the cluster admin credentials.*/
 	GetCredentials(ctx context.Context, params *GetCredentialsParams) (*GetCredentialsOK, error)
@@ -102,6 +123,9 @@ type API interface {
 	/*
 	   GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files.*/
 	GetPresignedForClusterFiles(ctx context.Context, params *GetPresignedForClusterFilesParams) (*GetPresignedForClusterFilesOK, error)
+	/*
+	   ImportClusterDefinition Creates a new OpenShift cluster from a cluster definition that was exported from another cluster.*/
+	ImportClusterDefinition(ctx context.Context, params *ImportClusterDefinitionParams) (*ImportClusterDefinitionCreated, error)
 	/*
 	   InstallCluster Installs the OpenShift cluster.*/
 	InstallCluster(ctx context.Context, params *InstallClusterParams) (*InstallClusterAccepted, error)
@@ -111,12 +135,21 @@ type API interface {
 	/*
 	   InstallHosts Installs the OpenShift cluster.*/
 	InstallHosts(ctx context.Context, params *InstallHostsParams) (*InstallHostsAccepted, error)
+	/*
+	   ListClusterLogArtifacts Lists the uploaded host and controller log archives of the cluster and when they expire.*/
+	ListClusterLogArtifacts(ctx context.Context, params *ListClusterLogArtifactsParams) (*ListClusterLogArtifactsOK, error)
 	/*
 	   ListClusters Retrieves the list of OpenShift clusters.*/
 	ListClusters(ctx context.Context, params *ListClustersParams) (*ListClustersOK, error)
 	/*
 	   ListHosts Retrieves the list of OpenShift hosts.*/
 	ListHosts(ctx context.Context, params *ListHostsParams) (*ListHostsOK, error)
+	/*
+	   ListStateMachines Describes the state machines of the hosts and the clusters, with their transitions and diagrams.*/
+	ListStateMachines(ctx context.Context, params *ListStateMachinesParams) (*ListStateMachinesOK, error)
+	/*
+	   PauseInstallation Pauses an ongoing installation. The hosts that are installing are not given new installation steps and the timeouts of their installation stages are frozen until the installation is resumed.*/
+	PauseInstallation(ctx context.Context, params *PauseInstallationParams) (*PauseInstallationAccepted, error)
 	/*
 	   PostStepReply Posts the result of the operations from the host agent.*/
 	PostStepReply(ctx context.Context, params *PostStepReplyParams) (*PostStepReplyNoContent, error)
@@ -140,15 +173,33 @@ type API interface {
 
 	   Reset failed host validation.  It may be performed on any host validation with persistent validation result.*/
 	ResetHostValidation(ctx context.Context, params *ResetHostValidationParams) (*ResetHostValidationOK, error)
+	/*
+	   ResumeInstallation Resumes a paused installation.*/
+	ResumeInstallation(ctx context.Context, params *ResumeInstallationParams) (*ResumeInstallationAccepted, error)
+	/*
+	   SearchClusterLogs Searches the lines of the uploaded host and controller logs of the cluster.*/
+	SearchClusterLogs(ctx context.Context, params *SearchClusterLogsParams) (*SearchClusterLogsOK, error)
 	/*
 	   UpdateCluster Updates an OpenShift cluster definition.*/
 	UpdateCluster(ctx context.Context, params *UpdateClusterParams) (*UpdateClusterCreated, error)
 	/*
 	   UpdateClusterInstallConfig Override values in the install config.*/
 	UpdateClusterInstallConfig(ctx context.Context, params *UpdateClusterInstallConfigParams) (*UpdateClusterInstallConfigCreated, error)
+	/*
+	   UpdateClusterInstallRetryPolicy Replace the policy that the failed host installations of the cluster are automatically retried by. The policy can be changed during the installation.*/
+	UpdateClusterInstallRetryPolicy(ctx context.Context, params *UpdateClusterInstallRetryPolicyParams) (*UpdateClusterInstallRetryPolicyOK, error)
 	/*
 	   UpdateClusterLogsProgress Update log collection state and progress.*/
 	UpdateClusterLogsProgress(ctx context.Context, params *UpdateClusterLogsProgressParams) (*UpdateClusterLogsProgressNoContent, error)
+	/*
+	   UpdateClusterStageTimeouts Replace the installation stage timeouts of the cluster. The timeouts can be changed during the installation.*/
+	UpdateClusterStageTimeouts(ctx context.Context, params *UpdateClusterStageTimeoutsParams) (*UpdateClusterStageTimeoutsOK, error)
+	/*
+	   UpdateClusterValidationPolicy Replace the validation policy of the cluster.*/
+	UpdateClusterValidationPolicy(ctx context.Context, params *UpdateClusterValidationPolicyParams) (*UpdateClusterValidationPolicyOK, error)
+	/*
+	   UpdateClusterWorkerSuccessThreshold Replace how many of the workers of the cluster must be installed for its installation to succeed. The threshold can be changed during the installation.*/
+	UpdateClusterWorkerSuccessThreshold(ctx context.Context, params *UpdateClusterWorkerSuccessThresholdParams) (*UpdateClusterWorkerSuccessThresholdOK, error)
 	/*
 	   UpdateDiscoveryIgnition Override values in the discovery ignition config.*/
 	UpdateDiscoveryIgnition(ctx context.Context, params *UpdateDiscoveryIgnitionParams) (*UpdateDiscoveryIgnitionCreated, error)
@@ -173,6 +224,9 @@ type API interface {
 	/*
 	   UploadLogs Agent API to upload logs.*/
 	UploadLogs(ctx context.Context, params *UploadLogsParams) (*UploadLogsNoContent, error)
+	/*
+	   ValidateHypotheticalHost Runs the host validations on a hypothetical host, as if it was part of a cluster, without registering it.*/
+	ValidateHypotheticalHost(ctx context.Context, params *ValidateHypotheticalHostParams) (*ValidateHypotheticalHostOK, error)
 }
 
 // New creates a new installer API client.
@@ -321,7 +375,7 @@ func (a *Client) DisableHost(ctx context.Context, params *DisableHostParams) (*D
 /*
 DownloadClusterFiles Downloads files relating to the installed/installing cluster.
 */
-func (a *Client) DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, error) {
+func (a *Client) DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, *DownloadClusterFilesPartialContent, error) {
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
 		ID:                 "DownloadClusterFiles",
@@ -337,16 +391,22 @@ func (a *Client) DownloadClusterFiles(ctx context.Context, params *DownloadClust
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *DownloadClusterFilesOK:
+		return value, nil, nil
+	case *DownloadClusterFilesPartialContent:
+		return nil, value, nil
 	}
-	return result.(*DownloadClusterFilesOK), nil
+	return nil, nil, nil
 
 }
 
 /*
 DownloadClusterISO Downloads the OpenShift per-cluster Discovery ISO.
 */
-func (a *Client) DownloadClusterISO(ctx context.Context, params *DownloadClusterISOParams, writer io.Writer) (*DownloadClusterISOOK, error) {
+func (a *Client) DownloadClusterISO(ctx context.Context, params *DownloadClusterISOParams, writer io.Writer) (*DownloadClusterISOOK, *DownloadClusterISOPartialContent, error) {
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
 		ID:                 "DownloadClusterISO",
@@ -362,9 +422,15 @@ func (a *Client) DownloadClusterISO(ctx context.Context, params *DownloadCluster
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result.(*DownloadClusterISOOK), nil
+	switch value := result.(type) {
+	case *DownloadClusterISOOK:
+		return value, nil, nil
+	case *DownloadClusterISOPartialContent:
+		return nil, value, nil
+	}
+	return nil, nil, nil
 
 }
 
@@ -421,7 +487,7 @@ func (a *Client) DownloadClusterKubeconfig(ctx context.Context, params *Download
 /*
 DownloadClusterLogs Download cluster logs.
 */
-func (a *Client) DownloadClusterLogs(ctx context.Context, params *DownloadClusterLogsParams, writer io.Writer) (*DownloadClusterLogsOK, error) {
+func (a *Client) DownloadClusterLogs(ctx context.Context, params *DownloadClusterLogsParams, writer io.Writer) (*DownloadClusterLogsOK, *DownloadClusterLogsPartialContent, error) {
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
 		ID:                 "DownloadClusterLogs",
@@ -437,9 +503,15 @@ func (a *Client) DownloadClusterLogs(ctx context.Context, params *DownloadCluste
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result.(*DownloadClusterLogsOK), nil
+	switch value := result.(type) {
+	case *DownloadClusterLogsOK:
+		return value, nil, nil
+	case *DownloadClusterLogsPartialContent:
+		return nil, value, nil
+	}
+	return nil, nil, nil
 
 }
 
@@ -518,6 +590,31 @@ func (a *Client) EnableHost(ctx context.Context, params *EnableHostParams) (*Ena
 
 }
 
+/*
+ExportClusterDefinition Exports the definition of the cluster, so that it can be imported to recreate the cluster.
+*/
+func (a *Client) ExportClusterDefinition(ctx context.Context, params *ExportClusterDefinitionParams) (*ExportClusterDefinitionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ExportClusterDefinition",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/definition",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportClusterDefinitionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportClusterDefinitionOK), nil
+
+}
+
 /*
 GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO.
 */
@@ -543,6 +640,31 @@ func (a *Client) GenerateClusterISO(ctx context.Context, params *GenerateCluster
 
 }
 
+/*
+GetChecksumsSigningKey Retrieves the public key that verifies the signatures of the cluster checksums.
+*/
+func (a *Client) GetChecksumsSigningKey(ctx context.Context, params *GetChecksumsSigningKeyParams) (*GetChecksumsSigningKeyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetChecksumsSigningKey",
+		Method:             "GET",
+		PathPattern:        "/checksums/signing-key",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetChecksumsSigningKeyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetChecksumsSigningKeyOK), nil
+
+}
+
 /*
 GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -568,6 +690,31 @@ func (a *Client) GetCluster(ctx context.Context, params *GetClusterParams) (*Get
 
 }
 
+/*
+GetClusterChecksums Retrieves the SHA-256 digests of the discovery ISO and the files of the cluster, optionally signed by the service.
+*/
+func (a *Client) GetClusterChecksums(ctx context.Context, params *GetClusterChecksumsParams) (*GetClusterChecksumsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterChecksums",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/checksums",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterChecksumsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterChecksumsOK), nil
+
+}
+
 /*
 GetClusterDefaultConfig Get the default values for various cluster properties.
 */
@@ -643,6 +790,106 @@ func (a *Client) GetClusterInstallConfig(ctx context.Context, params *GetCluster
 
 }
 
+/*
+GetClusterInstallRetryPolicy Get the policy that the failed host installations of the cluster are automatically retried by.
+*/
+func (a *Client) GetClusterInstallRetryPolicy(ctx context.Context, params *GetClusterInstallRetryPolicyParams) (*GetClusterInstallRetryPolicyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterInstallRetryPolicy",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/install-retry-policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterInstallRetryPolicyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterInstallRetryPolicyOK), nil
+
+}
+
+/*
+GetClusterStageTimeouts Get the installation stage timeouts of the cluster, which override the timeouts of the service.
+*/
+func (a *Client) GetClusterStageTimeouts(ctx context.Context, params *GetClusterStageTimeoutsParams) (*GetClusterStageTimeoutsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterStageTimeouts",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/stage-timeouts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterStageTimeoutsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterStageTimeoutsOK), nil
+
+}
+
+/*
+GetClusterValidationPolicy Get the validation policy of the cluster.
+*/
+func (a *Client) GetClusterValidationPolicy(ctx context.Context, params *GetClusterValidationPolicyParams) (*GetClusterValidationPolicyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterValidationPolicy",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/validation-policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterValidationPolicyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterValidationPolicyOK), nil
+
+}
+
+/*
+GetClusterWorkerSuccessThreshold Get how many of the workers of the cluster must be installed for its installation to succeed.
+*/
+func (a *Client) GetClusterWorkerSuccessThreshold(ctx context.Context, params *GetClusterWorkerSuccessThresholdParams) (*GetClusterWorkerSuccessThresholdOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterWorkerSuccessThreshold",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/worker-success-threshold",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterWorkerSuccessThresholdReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterWorkerSuccessThresholdOK), nil
+
+}
+
 /*
 GetCredentials Get the cluster admin credentials.
 */
@@ -871,6 +1118,31 @@ func (a *Client) GetPresignedForClusterFiles(ctx context.Context, params *GetPre
 
 }
 
+/*
+ImportClusterDefinition Creates a new OpenShift cluster from a cluster definition that was exported from another cluster.
+*/
+func (a *Client) ImportClusterDefinition(ctx context.Context, params *ImportClusterDefinitionParams) (*ImportClusterDefinitionCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ImportClusterDefinition",
+		Method:             "POST",
+		PathPattern:        "/clusters/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ImportClusterDefinitionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ImportClusterDefinitionCreated), nil
+
+}
+
 /*
 InstallCluster Installs the OpenShift cluster.
 */
@@ -946,6 +1218,31 @@ func (a *Client) InstallHosts(ctx context.Context, params *InstallHostsParams) (
 
 }
 
+/*
+ListClusterLogArtifacts Lists the uploaded host and controller log archives of the cluster and when they expire.
+*/
+func (a *Client) ListClusterLogArtifacts(ctx context.Context, params *ListClusterLogArtifactsParams) (*ListClusterLogArtifactsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterLogArtifacts",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/artifacts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListClusterLogArtifactsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterLogArtifactsOK), nil
+
+}
+
 /*
 ListClusters Retrieves the list of OpenShift clusters.
 */
@@ -996,6 +1293,56 @@ func (a *Client) ListHosts(ctx context.Context, params *ListHostsParams) (*ListH
 
 }
 
+/*
+ListStateMachines Describes the state machines of the hosts and the clusters, with their transitions and diagrams.
+*/
+func (a *Client) ListStateMachines(ctx context.Context, params *ListStateMachinesParams) (*ListStateMachinesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListStateMachines",
+		Method:             "GET",
+		PathPattern:        "/state-machines",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListStateMachinesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListStateMachinesOK), nil
+
+}
+
+/*
+PauseInstallation Pauses an ongoing installation. The hosts that are installing are not given new installation steps and the timeouts of their installation stages are frozen until the installation is resumed.
+*/
+func (a *Client) PauseInstallation(ctx context.Context, params *PauseInstallationParams) (*PauseInstallationAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PauseInstallation",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/pause",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PauseInstallationReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PauseInstallationAccepted), nil
+
+}
+
 /*
 PostStepReply Posts the result of the operations from the host agent.
 */
@@ -1173,6 +1520,56 @@ func (a *Client) ResetHostValidation(ctx context.Context, params *ResetHostValid
 
 }
 
+/*
+ResumeInstallation Resumes a paused installation.
+*/
+func (a *Client) ResumeInstallation(ctx context.Context, params *ResumeInstallationParams) (*ResumeInstallationAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ResumeInstallation",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/actions/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ResumeInstallationReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ResumeInstallationAccepted), nil
+
+}
+
+/*
+SearchClusterLogs Searches the lines of the uploaded host and controller logs of the cluster.
+*/
+func (a *Client) SearchClusterLogs(ctx context.Context, params *SearchClusterLogsParams) (*SearchClusterLogsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SearchClusterLogs",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/logs/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SearchClusterLogsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SearchClusterLogsOK), nil
+
+}
+
 /*
 UpdateCluster Updates an OpenShift cluster definition.
 */
@@ -1223,6 +1620,31 @@ func (a *Client) UpdateClusterInstallConfig(ctx context.Context, params *UpdateC
 
 }
 
+/*
+UpdateClusterInstallRetryPolicy Replace the policy that the failed host installations of the cluster are automatically retried by. The policy can be changed during the installation.
+*/
+func (a *Client) UpdateClusterInstallRetryPolicy(ctx context.Context, params *UpdateClusterInstallRetryPolicyParams) (*UpdateClusterInstallRetryPolicyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterInstallRetryPolicy",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/install-retry-policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateClusterInstallRetryPolicyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterInstallRetryPolicyOK), nil
+
+}
+
 /*
 UpdateClusterLogsProgress Update log collection state and progress.
 */
@@ -1248,6 +1670,81 @@ func (a *Client) UpdateClusterLogsProgress(ctx context.Context, params *UpdateCl
 
 }
 
+/*
+UpdateClusterStageTimeouts Replace the installation stage timeouts of the cluster. The timeouts can be changed during the installation.
+*/
+func (a *Client) UpdateClusterStageTimeouts(ctx context.Context, params *UpdateClusterStageTimeoutsParams) (*UpdateClusterStageTimeoutsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterStageTimeouts",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/stage-timeouts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateClusterStageTimeoutsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterStageTimeoutsOK), nil
+
+}
+
+/*
+UpdateClusterValidationPolicy Replace the validation policy of the cluster.
+*/
+func (a *Client) UpdateClusterValidationPolicy(ctx context.Context, params *UpdateClusterValidationPolicyParams) (*UpdateClusterValidationPolicyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterValidationPolicy",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/validation-policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateClusterValidationPolicyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterValidationPolicyOK), nil
+
+}
+
+/*
+UpdateClusterWorkerSuccessThreshold Replace how many of the workers of the cluster must be installed for its installation to succeed. The threshold can be changed during the installation.
+*/
+func (a *Client) UpdateClusterWorkerSuccessThreshold(ctx context.Context, params *UpdateClusterWorkerSuccessThresholdParams) (*UpdateClusterWorkerSuccessThresholdOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterWorkerSuccessThreshold",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/worker-success-threshold",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateClusterWorkerSuccessThresholdReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterWorkerSuccessThresholdOK), nil
+
+}
+
 /*
 UpdateDiscoveryIgnition Override values in the discovery ignition config.
 */
@@ -1447,3 +1944,28 @@ func (a *Client) UploadLogs(ctx context.Context, params *UploadLogsParams) (*Upl
 	return result.(*UploadLogsNoContent), nil
 
 }
+
+/*
+ValidateHypotheticalHost Runs the host validations on a hypothetical host, as if it was part of a cluster, without registering it.
+*/
+func (a *Client) ValidateHypotheticalHost(ctx context.Context, params *ValidateHypotheticalHostParams) (*ValidateHypotheticalHostOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ValidateHypotheticalHost",
+		Method:             "POST",
+		PathPattern:        "/host-validations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ValidateHypotheticalHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ValidateHypotheticalHostOK), nil
+
+}
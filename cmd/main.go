@@ -18,9 +18,11 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-service/internal/assistedserviceiso"
+	"github.com/openshift/assisted-service/internal/audit"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	hiveext "github.com/openshift/assisted-service/internal/controller/api/hiveextension/v1beta1"
@@ -37,6 +39,7 @@ import (
 	"github.com/openshift/assisted-service/internal/imgexpirer"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/logartifacts"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/migrations"
@@ -47,6 +50,7 @@ import (
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/internal/webhooks"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/app"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -91,6 +95,8 @@ const (
 	deployment_type_ocp    = "ocp"
 	storage_filesystem     = "filesystem"
 	storage_s3             = "s3"
+	storage_azure          = "azure"
+	storage_gcs            = "gcs"
 )
 
 var Options struct {
@@ -102,8 +108,12 @@ var Options struct {
 	InstructionConfig           hostcommands.InstructionConfig
 	OperatorsConfig             operators.Options
 	GCConfig                    garbagecollector.Config
+	LogArtifactsConfig          logartifacts.Config
 	ClusterStateMonitorInterval time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	S3Config                    s3wrapper.Config
+	AzureConfig                 s3wrapper.AzureConfig
+	GCSConfig                   s3wrapper.GCSConfig
+	EncryptionConfig            s3wrapper.EncryptionConfig
 	HostStateMonitorInterval    time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
 	Versions                    versions.Versions
 	OpenshiftVersions           string        `envconfig:"OPENSHIFT_VERSIONS"`
@@ -123,10 +133,14 @@ var Options struct {
 	EnableKubeAPI               bool `envconfig:"ENABLE_KUBE_API" default:"false"`
 	EnableKubeAPIDay2Cluster    bool `envconfig:"ENABLE_KUBE_API_DAY2" default:"false"`
 	InfraEnvConfig              controllers.InfraEnvConfig
+	WebhooksConfig              webhooks.Config
+	EventsRetentionConfig       events.RetentionConfig
 	ISOEditorConfig             isoeditor.Config
 	CheckClusterVersion         bool          `envconfig:"CHECK_CLUSTER_VERSION" default:"false"`
 	DeletionWorkerInterval      time.Duration `envconfig:"DELETION_WORKER_INTERVAL" default:"1h"`
 	DeregisterWorkerInterval    time.Duration `envconfig:"DEREGISTER_WORKER_INTERVAL" default:"1h"`
+	LogsRetentionWorkerInterval time.Duration `envconfig:"LOGS_RETENTION_WORKER_INTERVAL" default:"1h"`
+	LogsProcessingInterval      time.Duration `envconfig:"LOGS_PROCESSING_INTERVAL" default:"10s"`
 	EnableDeletedUnregisteredGC bool          `envconfig:"ENABLE_DELETE_UNREGISTER_GC" default:"true"`
 	EnableDeregisterInactiveGC  bool          `envconfig:"ENABLE_DEREGISTER_INACTIVE_GC" default:"true"`
 	ServeHTTPS                  bool          `envconfig:"SERVE_HTTPS" default:"false"`
@@ -136,6 +150,7 @@ var Options struct {
 	MaxOpenConns                int           `envconfig:"DB_MAX_OPEN_CONNECTIONS" default:"100"`
 	ConnMaxLifetime             time.Duration `envconfig:"DB_CONNECTIONS_MAX_LIFETIME" default:"30m"`
 	FileSystemUsageThreshold    int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
+	FileSystemQuotaBytes        int64         `envconfig:"FILESYSTEM_QUOTA_BYTES" default:"0"`
 	EnableElasticAPM            bool          `envconfig:"ENABLE_ELASTIC_APM" default:"false"`
 	WorkDir                     string        `envconfig:"WORK_DIR" default:"/data/"`
 }
@@ -185,6 +200,7 @@ func main() {
 	}
 
 	port := flag.String("port", "8090", "define port that the service will listen to")
+	rotateEncryptionKeys := flag.Bool("rotate-encryption-keys", false, "re-encrypt the stored objects with the current encryption key and exit")
 	flag.Parse()
 
 	log.Println("Starting bm service")
@@ -242,9 +258,16 @@ func main() {
 	isoEditorFactory := isoeditor.NewFactory(Options.ISOEditorConfig, staticNetworkConfig)
 
 	var objectHandler = createStorageClient(Options.DeployTarget, Options.Storage, &Options.S3Config,
-		Options.WorkDir, log, versionHandler, isoEditorFactory, metricsManager, Options.FileSystemUsageThreshold)
+		&Options.AzureConfig, &Options.GCSConfig, Options.WorkDir, log, versionHandler, isoEditorFactory, metricsManager, Options.FileSystemUsageThreshold, Options.FileSystemQuotaBytes)
+	objectHandler = createEncryptedStorageClient(objectHandler, &Options.EncryptionConfig, log)
+	if *rotateEncryptionKeys {
+		rotateStorageEncryptionKeys(objectHandler, log)
+		return
+	}
+	objectHandler = s3wrapper.NewChecksumClient(objectHandler, log)
 	createS3Bucket(objectHandler, log)
 
+	clusterTemplatesApi := clustertemplates.NewClusterTemplatesAPI(db, log.WithField("pkg", "cluster-templates"))
 	manifestsApi := manifests.NewManifestsAPI(db, log.WithField("pkg", "manifests"), objectHandler)
 	operatorsManager := operators.NewManager(log, manifestsApi, Options.OperatorsConfig, objectHandler)
 	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, operatorsManager)
@@ -339,7 +362,11 @@ func main() {
 		crdUtils = controllers.NewDummyCRDUtils()
 	}
 
-	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC {
+	failOnError(Options.LogArtifactsConfig.Validate(), "Invalid log artifacts configuration")
+	Options.BMConfig.LogArtifacts = Options.LogArtifactsConfig
+	Options.GCConfig.LogArtifacts = Options.LogArtifactsConfig
+	logsRetentionEnabled := len(Options.LogArtifactsConfig.RetentionRules) > 0
+	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC || logsRetentionEnabled {
 		gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"), hostApi, clusterApi, objectHandler, lead)
 
 		// In operator-deployment, ClusterDeployment is responsible for managing the lifetime of the cluster resource.
@@ -364,24 +391,56 @@ func main() {
 			deletionWorker.Start()
 			defer deletionWorker.Stop()
 		}
+
+		if logsRetentionEnabled {
+			logsRetentionWorker := thread.New(
+				log.WithField("garbagecollector", "Logs Retention Worker"),
+				"Logs Retention Worker",
+				Options.LogsRetentionWorkerInterval,
+				gc.DeleteExpiredLogs)
+
+			logsRetentionWorker.Start()
+			defer logsRetentionWorker.Stop()
+		}
 	}
 
 	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, ocpClient, ocmClient,
-		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig)
-
-	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
-	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
+		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
+		manifestsApi, clusterTemplatesApi)
+	logsProcessor := thread.New(
+		log.WithField("pkg", "logs-processor"), "Logs Processor", Options.LogsProcessingInterval, bm.ProcessUploadedLogs)
+	logsProcessor.Start()
+	defer logsProcessor.Stop()
+
+	failOnError(Options.EventsRetentionConfig.Validate(), "Invalid events retention configuration")
+	eventsArchive := events.NewArchive(Options.EventsRetentionConfig, db, log.WithField("pkg", "events-archive"), objectHandler, lead)
+	eventsArchiver := thread.New(
+		log.WithField("pkg", "events-archiver"), "Events Archiver", Options.EventsRetentionConfig.ArchiveInterval, eventsArchive.ArchiveEvents)
+	eventsArchiver.Start()
+	defer eventsArchiver.Stop()
+	events := events.NewApi(eventsHandler, eventsArchive, logrus.WithField("pkg", "eventsApi"))
+	expirer := imgexpirer.NewManager(db, objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
 	imageExpirationMonitor := thread.New(
 		log.WithField("pkg", "image-expiration-monitor"), "Image Expiration Monitor", Options.ImageExpirationInterval, expirer.ExpirationTask)
 	imageExpirationMonitor.Start()
 	defer imageExpirationMonitor.Stop()
+	webhookDispatcher := webhooks.NewDispatcher(Options.WebhooksConfig, db, log.WithField("pkg", "webhooks"), lead)
+	webhookDeliveryWorker := thread.New(
+		log.WithField("pkg", "webhook-delivery-worker"), "Webhook Delivery Worker", Options.WebhooksConfig.DispatchInterval, webhookDispatcher.DispatchDeliveries)
+	webhookDeliveryWorker.Start()
+	defer webhookDeliveryWorker.Stop()
+	webhooksApi := webhooks.NewApi(db, logrus.WithField("pkg", "webhooksApi"))
 	assistedServiceISO := assistedserviceiso.NewAssistedServiceISOApi(objectHandler, authHandler, logrus.WithField("pkg", "assistedserviceiso"), pullSecretValidator, Options.AssistedServiceISOConfig)
 
+	auditor := audit.NewAuditor(db, log.WithField("pkg", "audit"))
+	auditApi := audit.NewApi(db, logrus.WithField("pkg", "auditApi"))
+
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
-			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
+			wrapped := auditor.Middleware(h)
+			wrapped = metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(wrapped)
 
 			if Options.EnableElasticAPM {
 				// For APM metrics, we only want to trace openapi (internal) requests.
@@ -403,9 +462,11 @@ func main() {
 		AuthUserAuth:          authHandler.AuthUserAuth,
 		AuthURLAuth:           authHandler.AuthURLAuth,
 		APIKeyAuthenticator:   authHandler.CreateAuthenticator(),
-		Authorizer:            authzHandler.CreateAuthorizer(),
+		Authorizer:            auditor.Authorizer(authzHandler.CreateAuthorizer()),
 		InstallerAPI:          bm,
 		AssistedServiceIsoAPI: assistedServiceISO,
+		AuditAPI:              auditApi,
+		ClusterTemplatesAPI:   clusterTemplatesApi,
 		EventsAPI:             events,
 		Logger:                log.Printf,
 		VersionsAPI:           versionHandler,
@@ -413,6 +474,7 @@ func main() {
 		InnerMiddleware:       innerHandler(),
 		ManifestsAPI:          manifestsApi,
 		OperatorsAPI:          operatorsHandler,
+		WebhooksAPI:           webhooksApi,
 	})
 	failOnError(err, "Failed to init rest handler")
 
@@ -486,6 +548,12 @@ func main() {
 				Scheme: ctrlMgr.GetScheme(),
 			}).SetupWithManager(ctrlMgr), "unable to create controller BMH")
 
+			failOnError((&controllers.ClusterTemplateReconciler{
+				Client:    ctrlMgr.GetClient(),
+				Log:       log,
+				Templates: clusterTemplatesApi,
+			}).SetupWithManager(ctrlMgr), "unable to create controller ClusterTemplate")
+
 			failOnError(ctrlMgr.Start(ctrl.SetupSignalHandler()), "failed to run manager")
 		}
 	}()
@@ -585,8 +653,9 @@ func createS3Bucket(objectHandler s3wrapper.API, log logrus.FieldLogger) {
 	}
 }
 
-func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config, fsWorkDir string,
-	log logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int) s3wrapper.API {
+func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config,
+	azureCfg *s3wrapper.AzureConfig, gcsCfg *s3wrapper.GCSConfig, fsWorkDir string,
+	log logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int, fsQuotaBytes int64) s3wrapper.API {
 	var storageClient s3wrapper.API
 	if storage != "" {
 		switch storage {
@@ -596,10 +665,22 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 				log.Fatal("failed to create S3 client")
 			}
 		case storage_filesystem:
-			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold)
+			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold, fsQuotaBytes)
 			if storageClient == nil {
 				log.Fatal("failed to create filesystem client")
 			}
+		case storage_azure:
+			azureClient := s3wrapper.NewAzureClient(azureCfg, log, versionsHandler, isoEditorFactory)
+			if azureClient == nil {
+				log.Fatal("failed to create Azure Blob Storage client")
+			}
+			storageClient = azureClient
+		case storage_gcs:
+			gcsClient := s3wrapper.NewGCSClient(gcsCfg, log, versionsHandler, isoEditorFactory)
+			if gcsClient == nil {
+				log.Fatal("failed to create Google Cloud Storage client")
+			}
+			storageClient = gcsClient
 		default:
 			log.Fatalf("unsupported storage client: %s", storage)
 		}
@@ -612,7 +693,7 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 				log.Fatal("failed to create S3 client")
 			}
 		case deployment_type_onprem, deployment_type_ocp:
-			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold)
+			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, versionsHandler, isoEditorFactory, metricsAPI, fsThreshold, fsQuotaBytes)
 			if storageClient == nil {
 				log.Fatal("failed to create S3 filesystem client")
 			}
@@ -623,6 +704,31 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 	return storageClient
 }
 
+// createEncryptedStorageClient encrypts the sensitive cluster artifacts at rest if an encryption key file is configured
+func createEncryptedStorageClient(storageClient s3wrapper.API, cfg *s3wrapper.EncryptionConfig, log logrus.FieldLogger) s3wrapper.API {
+	if cfg.KeyFile == "" {
+		return storageClient
+	}
+	keyProvider, err := s3wrapper.NewLocalKeyProvider(cfg.KeyFile)
+	if err != nil {
+		log.WithError(err).Fatal("failed to create encryption key provider")
+	}
+	log.Infof("Encrypting objects with prefixes %v with encryption key %s", cfg.ObjectPrefixes, keyProvider.CurrentKeyID())
+	return s3wrapper.NewEncryptedClient(storageClient, log, keyProvider, cfg.ObjectPrefixes)
+}
+
+func rotateStorageEncryptionKeys(objectHandler s3wrapper.API, log logrus.FieldLogger) {
+	encryptedClient, ok := objectHandler.(*s3wrapper.EncryptedClient)
+	if !ok {
+		log.Fatal("Encryption keys can't be rotated without an encryption key file")
+	}
+	count, err := encryptedClient.Reencrypt(context.Background())
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to rotate encryption keys after re-encrypting %d objects", count)
+	}
+	log.Infof("Re-encrypted %d objects with the current encryption key", count)
+}
+
 func NewApiEnabler(h http.Handler, log logrus.FieldLogger) *ApiEnabler {
 	return &ApiEnabler{
 		log:       log,
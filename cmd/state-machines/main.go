@@ -0,0 +1,76 @@
+// Command state-machines prints the state machines of the hosts and the clusters as JSON, Graphviz or Mermaid
+// diagrams, or as the markdown document in docs/dev/state-machines.md:
+//
+//	go run ./cmd/state-machines -format markdown -output docs/dev/state-machines.md
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/statemachine"
+	"github.com/openshift/assisted-service/models"
+)
+
+func main() {
+	format := flag.String("format", "json", "The output format: json, graphviz, mermaid or markdown")
+	machine := flag.String("machine", "", "Only print this state machine: host or cluster")
+	output := flag.String("output", "", "The file to write to instead of the standard output")
+	flag.Parse()
+
+	var machines []*models.StateMachine
+	for _, m := range []*models.StateMachine{host.DescribeStateMachine(), cluster.DescribeStateMachine()} {
+		if *machine == "" || swag.StringValue(m.Name) == *machine {
+			machines = append(machines, m)
+		}
+	}
+	if len(machines) == 0 {
+		exit(fmt.Errorf("unknown state machine %s", *machine))
+	}
+
+	var out string
+	switch *format {
+	case "json":
+		b, err := json.MarshalIndent(machines, "", "  ")
+		if err != nil {
+			exit(err)
+		}
+		out = string(b) + "\n"
+	case "graphviz":
+		out = render(machines, statemachine.Graphviz)
+	case "mermaid":
+		out = render(machines, statemachine.Mermaid)
+	case "markdown":
+		out = statemachine.Markdown(machines...)
+	default:
+		exit(fmt.Errorf("unknown format %s", *format))
+	}
+
+	if *output == "" {
+		fmt.Print(out)
+		return
+	}
+	if err := ioutil.WriteFile(*output, []byte(out), 0600); err != nil {
+		exit(err)
+	}
+}
+
+func render(machines []*models.StateMachine, diagram func(*models.StateMachine) string) string {
+	diagrams := make([]string, 0, len(machines))
+	for _, m := range machines {
+		diagrams = append(diagrams, diagram(m))
+	}
+	return strings.Join(diagrams, "\n")
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
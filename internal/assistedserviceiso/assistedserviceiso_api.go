@@ -114,19 +114,30 @@ func (a *assistedServiceISOApi) DownloadISO(ctx context.Context, params assisted
 	username := ocm.UserNameFromContext(ctx)
 	isoName := fmt.Sprintf("%s%s.iso", imgexpirer.AssistedServiceLiveISOPrefix, username)
 
-	reader, contentLength, err := a.objectHandler.Download(ctx, isoName)
+	download, err := filemiddleware.OpenObject(ctx, a.objectHandler, isoName, params.Range, params.IfRange)
 	if err != nil {
+		if rangeErr, ok := err.(*filemiddleware.RangeNotSatisfiableError); ok {
+			return assisted_service_iso.NewDownloadISORequestedRangeNotSatisfiable().WithContentRange(rangeErr.ContentRange()).
+				WithPayload(common.GenerateError(http.StatusRequestedRangeNotSatisfiable, err))
+		}
 		log.WithError(err).Errorf("Failed to get Assisted Service ISO for user: %s", username)
-		if strings.Contains(err.Error(), "NotFound") {
+		if _, ok := err.(common.NotFound); ok || strings.Contains(err.Error(), "NotFound") {
 			return common.NewApiError(http.StatusNotFound, err)
 		} else {
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	}
 
-	return filemiddleware.NewResponder(assisted_service_iso.NewDownloadISOOK().WithPayload(reader),
+	if download.IsPartial() {
+		return filemiddleware.NewResponder(assisted_service_iso.NewDownloadISOPartialContent().
+			WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).WithContentRange(download.ContentRange()).
+			WithPayload(download.Reader), isoName, download.Length())
+	}
+	return filemiddleware.NewResponder(assisted_service_iso.NewDownloadISOOK().
+		WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).
+		WithPayload(download.Reader),
 		isoName,
-		contentLength)
+		download.Length())
 }
 
 func (a *assistedServiceISOApi) GetPresignedForAssistedServiceISO(ctx context.Context, params assisted_service_iso.GetPresignedForAssistedServiceISOParams) middleware.Responder {
@@ -143,7 +154,7 @@ func (a *assistedServiceISOApi) GetPresignedForAssistedServiceISO(ctx context.Co
 	url, err := a.objectHandler.GeneratePresignedDownloadURL(ctx, isoName, isoNameWithExtension, a.config.ImageExpirationTime)
 	if err != nil {
 		log.WithError(err).Errorf("failed to generate presigned URL for file: %s", isoNameWithExtension)
-		if strings.Contains(err.Error(), "NotFound") {
+		if _, ok := err.(common.NotFound); ok || strings.Contains(err.Error(), "NotFound") {
 			return common.NewApiError(http.StatusNotFound, err)
 		} else {
 			return common.NewApiError(http.StatusInternalServerError, err)
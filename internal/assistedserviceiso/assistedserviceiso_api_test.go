@@ -3,9 +3,12 @@ package assistedserviceiso
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 	. "github.com/onsi/ginkgo"
@@ -129,22 +132,48 @@ var _ = Describe("AssistedServiceISO", func() {
 	})
 
 	Context("DownloadISO", func() {
+		const etag = `"abc"`
+
 		It("success", func() {
-			mockS3Client.EXPECT().IsAwsS3().Return(false)
-			mockS3Client.EXPECT().Download(ctx, isoNameWithExtension)
+			reader := ioutil.NopCloser(strings.NewReader("0123456789"))
+			mockS3Client.EXPECT().GetObjectInfo(ctx, isoNameWithExtension).Return(&s3wrapper.ObjectInfo{Size: 10, ETag: etag}, nil)
+			mockS3Client.EXPECT().DownloadRange(ctx, isoNameWithExtension, int64(0), int64(10)).Return(reader, nil)
 			generateReply := api.DownloadISO(ctx, assisted_service_iso.DownloadISOParams{})
-			Expect(generateReply).Should(Equal(filemiddleware.NewResponder(assisted_service_iso.NewDownloadISOOK().WithPayload(nil), isoNameWithExtension, 0)))
+			Expect(generateReply).Should(Equal(filemiddleware.NewResponder(assisted_service_iso.NewDownloadISOOK().
+				WithAcceptRanges("bytes").WithETag(etag).WithPayload(reader), isoNameWithExtension, 10)))
+		})
+
+		It("downloads the requested range", func() {
+			reader := ioutil.NopCloser(strings.NewReader("56789"))
+			mockS3Client.EXPECT().GetObjectInfo(ctx, isoNameWithExtension).Return(&s3wrapper.ObjectInfo{Size: 10, ETag: etag}, nil)
+			mockS3Client.EXPECT().DownloadRange(ctx, isoNameWithExtension, int64(5), int64(5)).Return(reader, nil)
+			generateReply := api.DownloadISO(ctx, assisted_service_iso.DownloadISOParams{Range: swag.String("bytes=5-"), IfRange: swag.String(etag)})
+			Expect(generateReply).Should(Equal(filemiddleware.NewResponder(assisted_service_iso.NewDownloadISOPartialContent().
+				WithAcceptRanges("bytes").WithETag(etag).WithContentRange("bytes 5-9/10").WithPayload(reader), isoNameWithExtension, 5)))
+		})
+
+		It("downloads the whole ISO when it changed since the download started", func() {
+			mockS3Client.EXPECT().GetObjectInfo(ctx, isoNameWithExtension).Return(&s3wrapper.ObjectInfo{Size: 10, ETag: etag}, nil)
+			mockS3Client.EXPECT().DownloadRange(ctx, isoNameWithExtension, int64(0), int64(10)).Return(ioutil.NopCloser(strings.NewReader("0123456789")), nil)
+			generateReply := api.DownloadISO(ctx, assisted_service_iso.DownloadISOParams{Range: swag.String("bytes=5-"), IfRange: swag.String(`"old"`)})
+			Expect(generateReply).Should(BeAssignableToTypeOf(filemiddleware.NewResponder(nil, "", 0)))
+		})
+
+		It("rejects ranges past the end of the ISO", func() {
+			mockS3Client.EXPECT().GetObjectInfo(ctx, isoNameWithExtension).Return(&s3wrapper.ObjectInfo{Size: 10, ETag: etag}, nil)
+			generateReply := api.DownloadISO(ctx, assisted_service_iso.DownloadISOParams{Range: swag.String("bytes=10-")})
+			Expect(generateReply).Should(BeAssignableToTypeOf(assisted_service_iso.NewDownloadISORequestedRangeNotSatisfiable()))
+			Expect(generateReply.(*assisted_service_iso.DownloadISORequestedRangeNotSatisfiable).ContentRange).To(Equal("bytes */10"))
 		})
 
 		It("download from s3 failed", func() {
-			mockS3Client.EXPECT().IsAwsS3().Return(false)
 			// internal system error
-			mockS3Client.EXPECT().Download(ctx, isoNameWithExtension).Return(nil, int64(0), errors.Errorf("internal system error"))
+			mockS3Client.EXPECT().GetObjectInfo(ctx, isoNameWithExtension).Return(nil, errors.Errorf("internal system error"))
 			generateReply := api.DownloadISO(ctx, assisted_service_iso.DownloadISOParams{})
 			Expect(generateReply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusInternalServerError)))
 
 			// ISO hasn't been generated and not found in object store
-			mockS3Client.EXPECT().Download(ctx, isoNameWithExtension).Return(nil, int64(0), errors.Errorf("NotFound 404"))
+			mockS3Client.EXPECT().GetObjectInfo(ctx, isoNameWithExtension).Return(nil, common.NotFound(isoNameWithExtension))
 			generateReply = api.DownloadISO(ctx, assisted_service_iso.DownloadISOParams{})
 			Expect(generateReply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
 		})
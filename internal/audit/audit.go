@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// the security scheme of the calls made by the agents, which are not audited
+	agentAuthScheme = "agentAuth"
+
+	clusterIDParam = "cluster_id"
+	hostIDParam    = "host_id"
+)
+
+type contextKey struct{}
+
+// caller is filled by the authorizer of the call, since the principal is only known after the
+// audit middleware has passed the call on
+type caller struct {
+	payload *ocm.AuthPayload
+	agent   bool
+}
+
+// Auditor records the calls that change clusters and hosts, along with the fields they changed
+type Auditor struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewAuditor(db *gorm.DB, log logrus.FieldLogger) *Auditor {
+	return &Auditor{
+		db:  db,
+		log: log,
+	}
+}
+
+// Middleware records the mutating calls. It should be added as an inner middleware, since it relies on
+// the MatchedRoute to find the operation and the cluster and host it targets
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if route == nil || !isMutating(r.Method) || agentOnly(route) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		clusterID, hostID := routeParam(route, clusterIDParam), routeParam(route, hostIDParam)
+		before := a.snapshot(clusterID, hostID)
+
+		c := &caller{}
+		r = r.WithContext(context.WithValue(r.Context(), contextKey{}, c))
+		rec := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		// calls that were not authorized never reached the API, and the calls of agents are not audited
+		if c.payload == nil || c.agent {
+			return
+		}
+		after := a.snapshot(clusterID, hostID)
+		changes := append(diff(models.AuditChangeResourceCluster, before.cluster, after.cluster),
+			diff(models.AuditChangeResourceHost, before.host, after.host)...)
+
+		id := strfmt.UUID(uuid.New().String())
+		now := strfmt.DateTime(time.Now())
+		record := &common.AuditRecord{
+			AuditRecord: models.AuditRecord{
+				ID:          &id,
+				CreatedAt:   &now,
+				UserName:    c.payload.Username,
+				OrgID:       c.payload.Organization,
+				RequestID:   requestid.FromContext(r.Context()),
+				OperationID: route.Operation.ID,
+				Method:      swag.String(r.Method),
+				Endpoint:    swag.String(r.URL.Path),
+				ClusterID:   strfmt.UUID(clusterID),
+				HostID:      strfmt.UUID(hostID),
+				StatusCode:  int64(rec.statusCode),
+				Changes:     changes,
+			},
+		}
+		a.save(r.Context(), record)
+	})
+}
+
+// Authorizer wraps the authorizer of the API, to let the middleware know who made the call
+func (a *Auditor) Authorizer(inner func(*http.Request) error) func(*http.Request) error {
+	return func(r *http.Request) error {
+		if c, ok := r.Context().Value(contextKey{}).(*caller); ok {
+			c.payload = ocm.PayloadFromContext(r.Context())
+			if route := middleware.MatchedRouteFrom(r); route != nil && route.Authenticator != nil {
+				c.agent = funk.ContainsString(route.Authenticator.Schemes, agentAuthScheme)
+			}
+		}
+		if inner == nil {
+			return nil
+		}
+		return inner(r)
+	}
+}
+
+func (a *Auditor) save(ctx context.Context, record *common.AuditRecord) {
+	log := logutil.FromContext(ctx, a.log)
+	if len(record.Changes) > 0 {
+		data, err := json.Marshal(record.Changes)
+		if err != nil {
+			log.WithError(err).Errorf("failed to serialize the changes of %s call", record.OperationID)
+			return
+		}
+		record.SerializedChanges = string(data)
+	}
+	if err := a.db.Create(record).Error; err != nil {
+		log.WithError(err).Errorf("failed to save audit record of %s call by %s", record.OperationID, record.UserName)
+	}
+}
+
+type snapshot struct {
+	cluster *models.Cluster
+	host    *models.Host
+}
+
+// snapshot reads the cluster and the host that a call targets. Objects that don't exist, before they
+// are created or after they are deleted, are left nil
+func (a *Auditor) snapshot(clusterID, hostID string) snapshot {
+	var ret snapshot
+	if clusterID != "" {
+		var cluster common.Cluster
+		if err := a.db.Take(&cluster, "id = ?", clusterID).Error; err == nil {
+			ret.cluster = &cluster.Cluster
+		} else if !gorm.IsRecordNotFoundError(err) {
+			a.log.WithError(err).Warnf("failed to get cluster %s for audit", clusterID)
+		}
+	}
+	if clusterID != "" && hostID != "" {
+		if host, err := common.GetHostFromDB(a.db, clusterID, hostID); err == nil {
+			ret.host = &host.Host
+		}
+	}
+	return ret
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// agentOnly returns whether the route can only be called by agents
+func agentOnly(route *middleware.MatchedRoute) bool {
+	if len(route.Authenticators) == 0 {
+		return false
+	}
+	for _, authenticator := range route.Authenticators {
+		for _, scheme := range authenticator.Schemes {
+			if scheme != agentAuthScheme {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func routeParam(route *middleware.MatchedRoute, name string) string {
+	for _, p := range route.Params {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
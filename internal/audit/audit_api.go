@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.AuditAPI = &Api{}
+
+type Api struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) ListAuditRecords(ctx context.Context, params audit.ListAuditRecordsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	query := a.db.Model(&common.AuditRecord{})
+	if params.ClusterID != nil {
+		query = query.Where("cluster_id = ?", params.ClusterID.String())
+	}
+	if params.HostID != nil {
+		query = query.Where("host_id = ?", params.HostID.String())
+	}
+	if params.UserName != nil {
+		query = query.Where("user_name = ?", *params.UserName)
+	}
+	if params.OrgID != nil {
+		query = query.Where("org_id = ?", *params.OrgID)
+	}
+	if params.OperationID != nil {
+		query = query.Where("operation_id = ?", *params.OperationID)
+	}
+	if params.Field != nil {
+		query = query.Where("serialized_changes LIKE ?", "%"+common.EscapeLikePattern(fieldPattern(*params.Field))+"%")
+	}
+	if params.Since != nil {
+		query = query.Where("created_at >= ?", *params.Since)
+	}
+	if params.Until != nil {
+		query = query.Where("created_at < ?", *params.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.WithError(err).Error("failed to count audit records")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	query = query.Order("created_at DESC").Order("id")
+	if params.Limit != nil {
+		query = query.Limit(*params.Limit)
+	}
+	if params.Offset != nil {
+		query = query.Offset(*params.Offset)
+	}
+	var records []*common.AuditRecord
+	if err := query.Find(&records).Error; err != nil {
+		log.WithError(err).Error("failed to list audit records")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	ret := make(models.AuditRecordList, len(records))
+	for i, record := range records {
+		if record.SerializedChanges != "" {
+			if err := json.Unmarshal([]byte(record.SerializedChanges), &record.Changes); err != nil {
+				log.WithError(err).Errorf("failed to parse the changes of audit record %s", *record.ID)
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
+		}
+		ret[i] = &record.AuditRecord
+	}
+	return audit.NewListAuditRecordsOK().WithPayload(ret).WithAuditRecordCount(total)
+}
+
+// fieldPattern is the way a change of the field appears in the serialized changes
+func fieldPattern(field string) string {
+	data, _ := json.Marshal(field)
+	return `"field":` + string(data)
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/secretdump"
+)
+
+// Fields that change on every update, or that are derived from the other fields, only add noise
+var ignoredFields = map[string]bool{
+	"UpdatedAt":       true,
+	"CheckedInAt":     true,
+	"ValidationsInfo": true,
+}
+
+// diff returns the changes between two versions of a cluster or a host. Secret fields are redacted
+// the same way secretdump redacts them, and nested objects and lists are not compared. Nothing is
+// returned when either version doesn't exist
+func diff(resource string, before, after interface{}) []*models.AuditChange {
+	bv, av := reflect.ValueOf(before), reflect.ValueOf(after)
+	if bv.Kind() != reflect.Ptr || av.Kind() != reflect.Ptr || bv.IsNil() || av.IsNil() {
+		return nil
+	}
+	bv, av = bv.Elem(), av.Elem()
+
+	var changes []*models.AuditChange
+	for i := 0; i < bv.NumField(); i++ {
+		field := bv.Type().Field(i)
+		if ignoredFields[field.Name] {
+			continue
+		}
+		beforeValue, ok := fieldValue(bv.Field(i))
+		if !ok {
+			continue
+		}
+		afterValue, _ := fieldValue(av.Field(i))
+		if beforeValue == afterValue {
+			continue
+		}
+		if secretdump.IsSecret(field) {
+			beforeValue, afterValue = redact(beforeValue), redact(afterValue)
+		}
+		changes = append(changes, &models.AuditChange{
+			Resource: swag.String(resource),
+			Field:    swag.String(jsonName(field)),
+			Before:   beforeValue,
+			After:    afterValue,
+		})
+	}
+	return changes
+}
+
+// fieldValue formats the values of scalar fields, and pointers to them. It returns false for the
+// fields that are not compared
+func fieldValue(v reflect.Value) (string, bool) {
+	if !v.CanInterface() {
+		return "", false
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", isScalar(v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	if !isScalar(v.Type()) {
+		return "", false
+	}
+	if stringer, ok := v.Interface().(fmt.Stringer); ok {
+		return stringer.String(), true
+	}
+	return fmt.Sprint(v.Interface()), true
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Struct:
+		// dates
+		return t.Implements(stringerType)
+	}
+	return false
+}
+
+// an empty value is kept, so that setting and clearing a secret can still be told apart
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return secretdump.Redacted
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
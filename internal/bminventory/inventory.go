@@ -5,19 +5,27 @@ import (
 
 	// #nosec
 	"crypto/md5"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -27,8 +35,10 @@ import (
 	"github.com/kennygrant/sanitize"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/discoveryimage"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/gencrypto"
@@ -40,10 +50,13 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/logartifacts"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/statemachine"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -60,7 +73,9 @@ import (
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/transaction"
+	templatesops "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -76,6 +91,10 @@ const WindowBetweenRequestsInSeconds = 10 * time.Second
 
 type Config struct {
 	ignition.IgnitionConfig
+	logsearch.Config
+	// Set from the log artifacts configuration of the service, which the garbage collector shares
+	LogArtifacts logartifacts.Config `ignored:"true"`
+
 	AgentDockerImg                  string            `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/ocpmetal/assisted-installer-agent:latest"`
 	ServiceBaseURL                  string            `envconfig:"SERVICE_BASE_URL"`
 	ServiceCACertPath               string            `envconfig:"SERVICE_CA_CERT_PATH" default:""`
@@ -97,6 +116,11 @@ type Config struct {
 	DefaultServiceNetworkCidr       string            `envconfig:"SERVICE_NETWORK_CIDR" default:"172.30.0.0/16"`
 	ISOImageType                    string            `envconfig:"ISO_IMAGE_TYPE" default:"full-iso"`
 	IPv6Support                     bool              `envconfig:"IPV6_SUPPORT" default:"true"`
+	// StreamDiscoveryImages creates the discovery images on the fly, while they are downloaded, out of the base ISOs
+	// rather than storing an image per cluster
+	StreamDiscoveryImages bool `envconfig:"STREAM_DISCOVERY_IMAGES" default:"false"`
+	// ChecksumsSigningKey is the PEM of an EC private key that signs the checksums of the cluster files
+	ChecksumsSigningKey string `envconfig:"CHECKSUMS_SIGNING_KEY_PEM" default:""`
 }
 
 const minimalOpenShiftVersionForSingleNode = "4.8.0-0.0"
@@ -125,6 +149,10 @@ type InstallerInternals interface {
 	RegisterAddHostsClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, params installer.RegisterAddHostsClusterParams) (*common.Cluster, error)
 	InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, hostId strfmt.UUID) error
 	UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error)
+	UpdateClusterValidationPolicyInternal(ctx context.Context, params installer.UpdateClusterValidationPolicyParams) (*common.Cluster, error)
+	UpdateClusterStageTimeoutsInternal(ctx context.Context, params installer.UpdateClusterStageTimeoutsParams) (*common.Cluster, error)
+	UpdateClusterInstallRetryPolicyInternal(ctx context.Context, params installer.UpdateClusterInstallRetryPolicyParams) (*common.Cluster, error)
+	UpdateClusterWorkerSuccessThresholdInternal(ctx context.Context, params installer.UpdateClusterWorkerSuccessThresholdParams) (*common.Cluster, error)
 	CancelInstallationInternal(ctx context.Context, params installer.CancelInstallationParams) (*common.Cluster, error)
 	AddOpenshiftVersion(ctx context.Context, ocpReleaseImage, pullSecret string) (*models.OpenshiftVersion, error)
 	GetHostById(hostId string) (*common.Host, error)
@@ -159,6 +187,10 @@ type bareMetalInventory struct {
 	hwValidator          hardware.Validator
 	installConfigBuilder installcfg.InstallConfigBuilder
 	staticNetworkConfig  staticnetworkconfig.StaticNetworkConfig
+	manifestsApi         manifests.ClusterManifestsInternals
+	clusterTemplatesApi  clustertemplates.ClusterTemplatesInternals
+	logIndexer           *logsearch.Indexer
+	uploadedLogs         uploadedLogs
 }
 
 func NewBareMetalInventory(
@@ -186,6 +218,8 @@ func NewBareMetalInventory(
 	dnsApi dns.DNSApi,
 	installConfigBuilder installcfg.InstallConfigBuilder,
 	staticNetworkConfig staticnetworkconfig.StaticNetworkConfig,
+	manifestsApi manifests.ClusterManifestsInternals,
+	clusterTemplatesApi clustertemplates.ClusterTemplatesInternals,
 ) *bareMetalInventory {
 	return &bareMetalInventory{
 		db:                   db,
@@ -212,6 +246,9 @@ func NewBareMetalInventory(
 		hwValidator:          hwValidator,
 		installConfigBuilder: installConfigBuilder,
 		staticNetworkConfig:  staticNetworkConfig,
+		manifestsApi:         manifestsApi,
+		clusterTemplatesApi:  clusterTemplatesApi,
+		logIndexer:           logsearch.NewIndexer(cfg.Config, log, objectHandler),
 	}
 }
 
@@ -275,7 +312,15 @@ func (b *bareMetalInventory) UpdateDiscoveryIgnitionInternal(ctx context.Context
 	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom discovery ignition config was applied to the cluster", time.Now())
 	log.Infof("Custom discovery ignition config was applied to cluster %s", params.ClusterID)
 
-	existed, err := b.objectHandler.DeleteObject(ctx, getImageName(*c.ID))
+	imgName := discoveryimage.ObjectName(c)
+	if c.ImageObjectName != "" {
+		// The image may be shared with other clusters, so the cluster stops pointing at it rather than deleting it
+		err = b.db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("image_object_name", "").Error
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	existed, err := discoveryimage.DeleteIfUnreferenced(ctx, b.db, b.objectHandler, imgName, c.ID)
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -345,6 +390,13 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		}
 	}()
 
+	var template *models.ClusterTemplate
+	if params.NewClusterParams.TemplateID != nil {
+		if template, err = b.applyClusterTemplate(ctx, params.NewClusterParams); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = validations.ValidateIPAddressFamily(b.IPv6Support, params.NewClusterParams.ClusterNetworkCidr, params.NewClusterParams.ServiceNetworkCidr,
 		&params.NewClusterParams.IngressVip); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
@@ -487,6 +539,18 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if template != nil {
+		cluster.TemplateID = *template.ID
+		cluster.TemplateVersion = swag.Int64Value(template.Version)
+		if template.InstallConfigOverrides != "" {
+			cluster.InstallConfigOverrides = clustertemplates.InstallConfigOverrides(template, params.NewClusterParams.TemplateParams)
+			if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, cluster.InstallConfigOverrides); err != nil {
+				err = errors.Wrapf(err, "invalid install config overrides of cluster template %s", *template.ID)
+				return nil, common.NewApiError(http.StatusBadRequest, err)
+			}
+		}
+	}
+
 	b.setDefaultUsage(&cluster.Cluster)
 
 	err = b.clusterApi.RegisterCluster(ctx, &cluster)
@@ -494,6 +558,12 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
+	if template != nil {
+		if err = b.createTemplateManifests(ctx, *cluster.ID, template, params.NewClusterParams.TemplateParams); err != nil {
+			return nil, err
+		}
+	}
+
 	if b.ocmClient != nil && b.ocmClient.Config.WithAMSSubscriptions {
 		if err = b.integrateWithAMSClusterRegistration(ctx, &cluster); err != nil {
 			err = errors.Wrapf(err, "cluster %s failed to integrate with AMS on cluster registration", id)
@@ -506,6 +576,61 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 	return b.GetClusterInternal(ctx, installer.GetClusterParams{ClusterID: *cluster.ID})
 }
 
+// applyClusterTemplate sets the parameters of the new cluster that aren't set to the defaults of its template and
+// returns the template
+func (b *bareMetalInventory) applyClusterTemplate(ctx context.Context, params *models.ClusterCreateParams) (*models.ClusterTemplate, error) {
+	template, err := b.clusterTemplatesApi.GetClusterTemplateInternal(ctx, templatesops.GetClusterTemplateParams{
+		ClusterTemplateID: *params.TemplateID,
+		Version:           params.TemplateVersion,
+	})
+	if err != nil {
+		if apiErr, ok := err.(*common.ApiErrorResponse); ok && apiErr.StatusCode() == http.StatusNotFound {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		return nil, err
+	}
+
+	clustertemplates.ApplyClusterParams(template, params)
+	if err = params.Validate(strfmt.Default); err != nil {
+		err = errors.Wrapf(err, "invalid parameters after applying cluster template %s", *template.ID)
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	return template, nil
+}
+
+// createTemplateManifests adds the manifests of the template to the new cluster. The cluster is deregistered if any
+// of the manifests can't be added.
+func (b *bareMetalInventory) createTemplateManifests(ctx context.Context, clusterID strfmt.UUID, template *models.ClusterTemplate,
+	templateParams map[string]string) error {
+	log := logutil.FromContext(ctx, b.log)
+
+	templateManifests, err := clustertemplates.Manifests(template, templateParams)
+	if err != nil {
+		err = common.NewApiError(http.StatusBadRequest, err)
+	} else {
+		for _, manifest := range templateManifests {
+			if _, err = b.manifestsApi.CreateClusterManifestInternal(ctx, operations.CreateClusterManifestParams{
+				ClusterID:            clusterID,
+				CreateManifestParams: manifest,
+			}); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to add the manifests of cluster template %s to cluster %s", *template.ID, clusterID)
+		if deregisterErr := b.DeregisterClusterInternal(ctx, installer.DeregisterClusterParams{ClusterID: clusterID}); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s", clusterID)
+		}
+		return err
+	}
+
+	msg := fmt.Sprintf("Cluster was registered from version %d of cluster template %s with %d custom manifests",
+		swag.Int64Value(template.Version), *template.ID, len(templateManifests))
+	b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo, msg, time.Now())
+	return nil
+}
+
 func updateSSHPublicKey(cluster *common.Cluster) error {
 	sshPublicKey := swag.StringValue(&cluster.SSHPublicKey)
 	if sshPublicKey == "" {
@@ -721,36 +846,279 @@ func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params inst
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	imgName := getImageName(*cluster.ID)
-	exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
+	if b.Config.StreamDiscoveryImages {
+		return b.downloadStreamedClusterISO(ctx, log, &cluster, params)
+	}
+
+	imgName := discoveryimage.ObjectName(&cluster)
+	var iso *clusterISO
+	var download *filemiddleware.Download
+	var err error
+	if discoveryimage.IsShared(imgName) {
+		if iso, err = b.getSharedClusterISO(ctx, &cluster, imgName); err == nil {
+			download, err = iso.Open(params.Range, params.IfRange)
+		}
+	} else {
+		download, err = filemiddleware.OpenObject(ctx, b.objectHandler, imgName, params.Range, params.IfRange)
+	}
 	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
+				"Failed to download image: the image was not found (perhaps it expired) - please generate the image and try again", time.Now())
+			return installer.NewDownloadClusterISONotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The image was not found "+
+					"(perhaps it expired) - please generate the image and try again")))
+		}
+		if rangeErr, ok := err.(*filemiddleware.RangeNotSatisfiableError); ok {
+			return installer.NewDownloadClusterISORequestedRangeNotSatisfiable().WithContentRange(rangeErr.ContentRange()).
+				WithPayload(common.GenerateError(http.StatusRequestedRangeNotSatisfiable, err))
+		}
 		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
 		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
 			"Failed to download image: error fetching from storage backend", time.Now())
 		return installer.NewDownloadClusterISOInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
-	if !exists {
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
+	return b.clusterISOResponder(ctx, &cluster, iso, download)
+}
+
+// clusterISOResponder returns the response that sends the discovery image of the cluster, or the requested range of
+// it. The digest of an image that embeds the ignition config of the cluster while it is downloaded, which is given
+// unless the image is stored as a whole, is computed while the whole image is sent.
+func (b *bareMetalInventory) clusterISOResponder(ctx context.Context, cluster *common.Cluster, iso *clusterISO, download *filemiddleware.Download) middleware.Responder {
+	fileName := fmt.Sprintf("cluster-%s-discovery.iso", cluster.ID.String())
+	if download.IsPartial() {
+		// Resumed downloads would add an event for every range that is requested
+		return filemiddleware.NewResponder(installer.NewDownloadClusterISOPartialContent().
+			WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).WithContentRange(download.ContentRange()).
+			WithPayload(download.Reader), fileName, download.Length())
+	}
+	if iso != nil {
+		download.Reader = &checksumReader{ReadCloser: download.Reader, hash: sha256.New(), remaining: download.Length(),
+			store: func(checksum string) { b.storeClusterISOChecksum(ctx, cluster, iso, checksum) }}
+	}
+	b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityInfo,
+		fmt.Sprintf(`Started image download (image type is "%s")`, cluster.ImageInfo.Type), time.Now())
+	return filemiddleware.NewResponder(installer.NewDownloadClusterISOOK().
+		WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).
+		WithPayload(download.Reader), fileName, download.Length())
+}
+
+func (b *bareMetalInventory) downloadStreamedClusterISO(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
+	params installer.DownloadClusterISOParams) middleware.Responder {
+	notFound := func() middleware.Responder {
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError,
 			"Failed to download image: the image was not found (perhaps it expired) - please generate the image and try again", time.Now())
 		return installer.NewDownloadClusterISONotFound().
 			WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The image was not found "+
 				"(perhaps it expired) - please generate the image and try again")))
 	}
-	reader, contentLength, err := b.objectHandler.Download(ctx, imgName)
+	if !isStreamedImageAvailable(cluster) {
+		return notFound()
+	}
+	iso, err := b.getStreamedClusterISO(ctx, cluster)
+	var download *filemiddleware.Download
+	if err == nil {
+		download, err = iso.Open(params.Range, params.IfRange)
+	}
 	if err != nil {
-		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
-			"Failed to download image: error fetching from storage backend", time.Now())
+		// The saved ignition config may have been deleted along with the files of the cluster
+		if _, ok := err.(common.NotFound); ok {
+			return notFound()
+		}
+		if rangeErr, ok := err.(*filemiddleware.RangeNotSatisfiableError); ok {
+			return installer.NewDownloadClusterISORequestedRangeNotSatisfiable().WithContentRange(rangeErr.ContentRange()).
+				WithPayload(common.GenerateError(http.StatusRequestedRangeNotSatisfiable, err))
+		}
+		log.WithError(err).Errorf("Failed to stream ISO for cluster %s", cluster.ID.String())
+		b.eventsHandler.AddEvent(ctx, *cluster.ID, nil, models.EventSeverityError,
+			"Failed to download image: error creating the image from the base image", time.Now())
 		return installer.NewDownloadClusterISOInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo,
-		fmt.Sprintf(`Started image download (image type is "%s")`, cluster.ImageInfo.Type), time.Now())
+	return b.clusterISOResponder(ctx, cluster, iso, download)
+}
+
+// isStreamedImageAvailable returns whether the cluster generated an image that didn't expire yet, when the images
+// are created while they are downloaded
+func isStreamedImageAvailable(cluster *common.Cluster) bool {
+	return cluster.ImageGenerated && cluster.ImageInfo != nil && time.Now().Before(time.Time(cluster.ImageInfo.ExpiresAt))
+}
+
+// getStreamedClusterISO returns the discovery image of the cluster, which embeds the ignition config that was saved
+// when the image was generated in the base ISO while it is read. The ignition config holds a signed token when using
+// local auth, so it isn't formatted again, and every request of the image gets the same bytes and entity tag.
+func (b *bareMetalInventory) getStreamedClusterISO(ctx context.Context, cluster *common.Cluster) (*clusterISO, error) {
+	baseISOName, err := b.getBaseISOObjectName(cluster.OpenshiftVersion, cluster.ImageInfo.Type)
+	if err != nil {
+		return nil, err
+	}
+	ignitionConfig, err := b.getSavedDiscoveryIgnition(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	var ramDisk []byte
+	clusterProxyInfo := isoeditor.ClusterProxyInfo{
+		HTTPProxy:  cluster.HTTPProxy,
+		HTTPSProxy: cluster.HTTPSProxy,
+		NoProxy:    cluster.NoProxy,
+	}
+	if cluster.ImageInfo.Type == models.ImageTypeMinimalIso && isoeditor.NeedsCustomRAMDisk(cluster.ImageInfo.StaticNetworkConfig, &clusterProxyInfo) {
+		ramDisk, err = isoeditor.CustomRAMDiskArchive(b.staticNetworkConfig, cluster.ImageInfo.StaticNetworkConfig, &clusterProxyInfo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create the custom RAM disk")
+		}
+	}
+
+	baseISOInfo, err := b.objectHandler.GetPublicObjectInfo(ctx, baseISOName)
+	if err != nil {
+		return nil, err
+	}
+	return newClusterISO(baseISOInfo, ignitionConfig, ramDisk, func(offset, length int64) (io.ReadCloser, error) {
+		return b.objectHandler.DownloadPublicRange(ctx, baseISOName, offset, length)
+	})
+}
+
+// getSharedClusterISO returns the discovery image of the cluster, which embeds the ignition config that was saved
+// when the image was generated in the shared image object while it is read
+func (b *bareMetalInventory) getSharedClusterISO(ctx context.Context, cluster *common.Cluster, imgName string) (*clusterISO, error) {
+	ignitionConfig, err := b.getSavedDiscoveryIgnition(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	imgInfo, err := b.objectHandler.GetObjectInfo(ctx, imgName)
+	if err != nil {
+		return nil, err
+	}
+	// The custom RAM disk of minimal ISOs doesn't depend on the cluster, so it's already in the shared image
+	return newClusterISO(imgInfo, ignitionConfig, nil, func(offset, length int64) (io.ReadCloser, error) {
+		return b.objectHandler.DownloadRange(ctx, imgName, offset, length)
+	})
+}
+
+// getSplicedClusterISO returns the discovery image of the cluster when it embeds the ignition config of the cluster
+// while it is downloaded, or nil when the image is stored as a whole
+func (b *bareMetalInventory) getSplicedClusterISO(ctx context.Context, cluster *common.Cluster) (*clusterISO, error) {
+	if b.Config.StreamDiscoveryImages {
+		return b.getStreamedClusterISO(ctx, cluster)
+	}
+	if imgName := discoveryimage.ObjectName(cluster); discoveryimage.IsShared(imgName) {
+		return b.getSharedClusterISO(ctx, cluster, imgName)
+	}
+	return nil, nil
+}
+
+// getSavedDiscoveryIgnition returns the ignition config that was saved when the discovery image of the cluster was
+// generated
+func (b *bareMetalInventory) getSavedDiscoveryIgnition(ctx context.Context, cluster *common.Cluster) (string, error) {
+	reader, _, err := b.objectHandler.Download(ctx, discoveryimage.IgnitionObjectName(*cluster.ID))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	ignitionConfig, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the ignition config of the image")
+	}
+	return string(ignitionConfig), nil
+}
+
+// clusterISO is the discovery image of a cluster, which embeds the ignition config and the custom RAM disk of the
+// cluster in another ISO while it is read
+type clusterISO struct {
+	size int64
+	etag string
+	iso  *isoeditor.ClusterISO
+	// openRange opens the given number of bytes of the other ISO, starting at the given offset
+	openRange func(offset, length int64) (io.ReadCloser, error)
+}
+
+// newClusterISO returns the discovery image of a cluster, which embeds the ignition config and the custom RAM disk in
+// the ISO that is read by openRange
+func newClusterISO(isoInfo *s3wrapper.ObjectInfo, ignitionConfig string, ramDisk []byte,
+	openRange func(offset, length int64) (io.ReadCloser, error)) (*clusterISO, error) {
+	systemAreaReader, err := openRange(0, isoeditor.SystemAreaSize)
+	if err != nil {
+		return nil, err
+	}
+	defer systemAreaReader.Close()
+	systemArea, err := ioutil.ReadAll(systemAreaReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the system area of the ISO")
+	}
+	iso, err := isoeditor.NewClusterISO(systemArea, ignitionConfig, ramDisk)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterISO{size: isoInfo.Size, etag: iso.ETag(isoInfo.ETag), iso: iso, openRange: openRange}, nil
+}
+
+// Open opens the part of the image that was requested by the Range and If-Range headers, or the whole image
+func (c *clusterISO) Open(rangeHeader, ifRange *string) (*filemiddleware.Download, error) {
+	return filemiddleware.Open(c.size, c.etag, rangeHeader, ifRange, func(offset, length int64) (io.ReadCloser, error) {
+		reader, err := c.openRange(offset, length)
+		if err != nil {
+			return nil, err
+		}
+		return c.iso.Reader(reader, offset, length), nil
+	})
+}
+
+// discoveryISOChecksumMetadataKey is the key of the digest of the discovery image of a cluster in the metadata of the
+// saved ignition config that the image embeds while it is downloaded. Such images don't pass through the service as a
+// whole before they are downloaded, so the digest is computed by the first complete download of the image. It is kept
+// along with the entity tag of the image, which changes with the ISO that the image is created from.
+const discoveryISOChecksumMetadataKey = "discovery_iso_sha256"
+
+// getClusterISOChecksum returns the digest of the discovery image of the cluster, or an empty string when the image
+// wasn't downloaded completely since it last changed
+func (b *bareMetalInventory) getClusterISOChecksum(ctx context.Context, cluster *common.Cluster, iso *clusterISO) (string, error) {
+	info, err := b.objectHandler.GetObjectInfo(ctx, discoveryimage.IgnitionObjectName(*cluster.ID))
+	if err != nil {
+		return "", err
+	}
+	parts := strings.SplitN(info.Metadata[discoveryISOChecksumMetadataKey], ":", 2)
+	if len(parts) != 2 || parts[0] != strings.Trim(iso.etag, `"`) {
+		return "", nil
+	}
+	return parts[1], nil
+}
+
+// storeClusterISOChecksum keeps the digest of the discovery image of the cluster, which is optional, so failures are
+// only logged
+func (b *bareMetalInventory) storeClusterISOChecksum(ctx context.Context, cluster *common.Cluster, iso *clusterISO, checksum string) {
+	value := fmt.Sprintf("%s:%s", strings.Trim(iso.etag, `"`), checksum)
+	if err := b.objectHandler.SetObjectMetadata(ctx, discoveryimage.IgnitionObjectName(*cluster.ID), discoveryISOChecksumMetadataKey, value); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("Failed to store the ISO checksum of cluster %s", cluster.ID.String())
+	}
+}
+
+// checksumReader computes the digest of a download while it is read, and stores it once all of its bytes were read
+type checksumReader struct {
+	io.ReadCloser
+	hash      hash.Hash
+	remaining int64
+	store     func(checksum string)
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 && r.remaining > 0 {
+		r.hash.Write(p[:n])
+		if r.remaining -= int64(n); r.remaining == 0 {
+			r.store(hex.EncodeToString(r.hash.Sum(nil)))
+		}
+	}
+	return n, err
+}
 
-	return filemiddleware.NewResponder(installer.NewDownloadClusterISOOK().WithPayload(reader),
-		fmt.Sprintf("cluster-%s-discovery.iso", params.ClusterID.String()),
-		contentLength)
+// getBaseISOObjectName returns the name of the public object that the discovery images of the given type are
+// created from
+func (b *bareMetalInventory) getBaseISOObjectName(openshiftVersion string, imageType models.ImageType) (string, error) {
+	if imageType == models.ImageTypeMinimalIso {
+		return b.objectHandler.GetMinimalIsoObjectName(openshiftVersion)
+	}
+	return b.objectHandler.GetBaseIsoObject(openshiftVersion)
 }
 
 func (b *bareMetalInventory) DownloadClusterISOHeaders(ctx context.Context, params installer.DownloadClusterISOHeadersParams) middleware.Responder {
@@ -762,41 +1130,202 @@ func (b *bareMetalInventory) DownloadClusterISOHeaders(ctx context.Context, para
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
-	imgName := getImageName(*cluster.ID)
-	exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
-	if err != nil {
-		log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
-		b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
-			"Failed to download image: error fetching from storage backend", time.Now())
-		return installer.NewDownloadClusterISOHeadersInternalServerError().
-			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
-	}
-	if !exists {
+	notFound := func() middleware.Responder {
 		return installer.NewDownloadClusterISOHeadersNotFound().
 			WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The image was not found")))
 	}
+	imgName := discoveryimage.ObjectName(&cluster)
+	if b.Config.StreamDiscoveryImages {
+		if !isStreamedImageAvailable(&cluster) {
+			return notFound()
+		}
+	} else {
+		exists, err := b.objectHandler.DoesObjectExist(ctx, imgName)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to get ISO for cluster %s", cluster.ID.String())
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError,
+				"Failed to download image: error fetching from storage backend", time.Now())
+			return installer.NewDownloadClusterISOHeadersInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+		if !exists {
+			return notFound()
+		}
+	}
+
+	iso, err := b.getSplicedClusterISO(ctx, &cluster)
+	if err != nil {
+		// The saved ignition config may have been deleted along with the files of the cluster
+		if _, ok := err.(common.NotFound); ok {
+			return notFound()
+		}
+		log.WithError(err).Errorf("Failed to get ISO size for cluster %s", cluster.ID.String())
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if iso != nil {
+		headers := installer.NewDownloadClusterISOHeadersOK().WithContentLength(iso.size)
+		checksum, err := b.getClusterISOChecksum(ctx, &cluster, iso)
+		if err != nil {
+			// The digest is optional, so the headers are returned without it
+			log.WithError(err).Warnf("Failed to get ISO checksum for cluster %s", cluster.ID.String())
+		}
+		if checksum != "" {
+			headers = headers.WithXChecksumSha256(checksum)
+		}
+		return headers
+	}
+
 	imgSize, err := b.objectHandler.GetObjectSizeBytes(ctx, imgName)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ISO size for cluster %s", cluster.ID.String())
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
-	return installer.NewDownloadClusterISOHeadersOK().WithContentLength(imgSize)
+	info, err := b.objectHandler.GetObjectInfo(ctx, imgName)
+	if err != nil {
+		// The digest is optional, so the headers are returned without it
+		log.WithError(err).Warnf("Failed to get ISO checksum for cluster %s", cluster.ID.String())
+		return installer.NewDownloadClusterISOHeadersOK().WithContentLength(imgSize)
+	}
+	return installer.NewDownloadClusterISOHeadersOK().WithContentLength(imgSize).WithXChecksumSha256(info.SHA256)
+}
+
+// discoveryISOChecksumName is the name of the discovery ISO in the checksums of a cluster
+const discoveryISOChecksumName = "discovery.iso"
+
+func (b *bareMetalInventory) GetClusterChecksums(ctx context.Context, params installer.GetClusterChecksumsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	createdAt := strfmt.DateTime(time.Now())
+	checksums := &models.ClusterChecksums{
+		ClusterID: cluster.ID,
+		CreatedAt: &createdAt,
+		Files:     []*models.FileChecksum{},
+	}
+	appendChecksum := func(checksum *models.FileChecksum, err error) error {
+		if err != nil {
+			if _, ok := err.(common.NotFound); ok {
+				return nil
+			}
+			return err
+		}
+		if checksum != nil {
+			checksums.Files = append(checksums.Files, checksum)
+		}
+		return nil
+	}
+
+	if cluster.ImageGenerated && (!b.Config.StreamDiscoveryImages || isStreamedImageAvailable(cluster)) {
+		if err = appendChecksum(b.getClusterISOFileChecksum(ctx, cluster)); err != nil {
+			log.WithError(err).Errorf("failed to get the checksum of the discovery ISO of cluster %s", cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	for _, fileName := range clusterPkg.S3FileNames {
+		if err = appendChecksum(b.getObjectFileChecksum(ctx, fileName, fmt.Sprintf("%s/%s", cluster.ID, fileName))); err != nil {
+			log.WithError(err).Errorf("failed to get the checksum of file %s of cluster %s", fileName, cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	if b.Config.ChecksumsSigningKey != "" {
+		checksums.Signature, err = gencrypto.SignedJWTForKey(jwt.MapClaims{
+			"cluster_id": checksums.ClusterID,
+			"created_at": checksums.CreatedAt,
+			"files":      checksums.Files,
+		}, b.Config.ChecksumsSigningKey)
+		if err != nil {
+			log.WithError(err).Errorf("failed to sign the checksums of cluster %s", cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, errors.New("Failed to sign the checksums"))
+		}
+	}
+	return installer.NewGetClusterChecksumsOK().WithPayload(checksums)
+}
+
+// getClusterISOFileChecksum returns the digest of the discovery image of the cluster, or nil when it isn't known
+func (b *bareMetalInventory) getClusterISOFileChecksum(ctx context.Context, cluster *common.Cluster) (*models.FileChecksum, error) {
+	iso, err := b.getSplicedClusterISO(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if iso == nil {
+		return b.getObjectFileChecksum(ctx, discoveryISOChecksumName, discoveryimage.ObjectName(cluster))
+	}
+	checksum, err := b.getClusterISOChecksum(ctx, cluster, iso)
+	if err != nil {
+		return nil, err
+	}
+	return newFileChecksum(discoveryISOChecksumName, iso.size, checksum), nil
+}
+
+// getObjectFileChecksum returns the digest of a stored object, or nil when the object was stored before the digests
+// were kept
+func (b *bareMetalInventory) getObjectFileChecksum(ctx context.Context, name, objectName string) (*models.FileChecksum, error) {
+	info, err := b.objectHandler.GetObjectInfo(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return newFileChecksum(name, info.Size, info.SHA256), nil
+}
+
+func newFileChecksum(name string, size int64, checksum string) *models.FileChecksum {
+	if checksum == "" {
+		return nil
+	}
+	return &models.FileChecksum{Name: swag.String(name), Size: swag.Int64(size), Sha256: swag.String(checksum)}
+}
+
+func (b *bareMetalInventory) GetChecksumsSigningKey(ctx context.Context, params installer.GetChecksumsSigningKeyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if b.Config.ChecksumsSigningKey == "" {
+		return installer.NewGetChecksumsSigningKeyNotFound().
+			WithPayload(common.GenerateError(http.StatusNotFound, errors.New("The checksums aren't signed")))
+	}
+	publicKey, err := gencrypto.ECPublicKeyPEM(b.Config.ChecksumsSigningKey)
+	if err != nil {
+		log.WithError(err).Error("failed to get the public key of the checksums signing key")
+		return common.NewApiError(http.StatusInternalServerError, errors.New("Failed to get the checksums signing key"))
+	}
+	return installer.NewGetChecksumsSigningKeyOK().WithPayload(&models.ChecksumsSigningKey{PublicKey: swag.String(publicKey)})
+}
+
+// getStreamedImageSize returns the size of the streamed discovery image of the cluster, which is the size of the
+// base ISO that it is created from
+func (b *bareMetalInventory) getStreamedImageSize(ctx context.Context, cluster *common.Cluster, imageType models.ImageType) (int64, error) {
+	baseISOName, err := b.getBaseISOObjectName(cluster.OpenshiftVersion, imageType)
+	if err != nil {
+		return 0, err
+	}
+	return b.objectHandler.GetPublicObjectSizeBytes(ctx, baseISOName)
 }
 
 func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, cluster *common.Cluster, clusterProxyHash string, imageType models.ImageType, generated bool) error {
 	updates := map[string]interface{}{}
-	imgName := getImageName(*cluster.ID)
-	imgSize, err := b.objectHandler.GetObjectSizeBytes(ctx, imgName)
+	imgName := discoveryimage.ObjectName(cluster)
+	var imgSize int64
+	var err error
+	if b.Config.StreamDiscoveryImages {
+		imgSize, err = b.getStreamedImageSize(ctx, cluster, imageType)
+	} else {
+		imgSize, err = b.objectHandler.GetObjectSizeBytes(ctx, imgName)
+	}
 	if err != nil {
 		return errors.New("Failed to generate image: error fetching size")
 	}
 	updates["image_size_bytes"] = imgSize
 	cluster.ImageInfo.SizeBytes = &imgSize
 
-	// Presigned URL only works with AWS S3 because Scality is not exposed
+	// Presigned URL only works with AWS S3 because Scality is not exposed. Only the images that were stored for a single
+	// cluster before images were shared are presigned: streamed and shared images get the ignition config of the
+	// cluster, which identifies the cluster, embedded by the service while they are downloaded, so the stored object
+	// alone can't register hosts. Storing a copy of the image of every cluster to presign it would undo the sharing, so
+	// the images are downloaded through the service on AWS S3 as well.
 	downloadURL := ""
-	if b.objectHandler.IsAwsS3() {
-		downloadURL, err = b.objectHandler.GeneratePresignedDownloadURL(ctx, imgName, imgName, b.Config.ImageExpirationTime)
+	if !b.Config.StreamDiscoveryImages && b.objectHandler.IsAwsS3() && !discoveryimage.IsShared(imgName) {
+		downloadURL, err = b.objectHandler.GeneratePresignedDownloadURL(ctx, imgName, discoveryimage.LegacyObjectName(*cluster.ID), b.Config.ImageExpirationTime)
 		if err != nil {
 			return errors.New("Failed to generate image: error generating URL")
 		}
@@ -926,12 +1455,13 @@ func (b *bareMetalInventory) GenerateClusterISOInternal(ctx context.Context, par
 	staticNetworkConfig := b.staticNetworkConfig.FormatStaticNetworkConfigForDB(params.ImageCreateParams.StaticNetworkConfig)
 
 	var imageExists bool
-	if cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
+	if !b.Config.StreamDiscoveryImages &&
+		cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
 		cluster.ProxyHash == clusterProxyHash &&
 		cluster.ImageInfo.StaticNetworkConfig == staticNetworkConfig &&
 		cluster.ImageGenerated &&
 		cluster.ImageInfo.Type == params.ImageCreateParams.ImageType {
-		imgName := getImageName(params.ClusterID)
+		imgName := discoveryimage.ObjectName(cluster)
 		imageExists, err = b.objectHandler.UpdateObjectTimestamp(ctx, imgName)
 		if err != nil {
 			log.WithError(err).Errorf("failed to contact storage backend")
@@ -1008,31 +1538,34 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	if err = b.objectHandler.Upload(ctx, []byte(ignitionConfig), fmt.Sprintf("%s/discovery.ign", cluster.ID)); err != nil {
+	if err = b.objectHandler.Upload(ctx, []byte(ignitionConfig), discoveryimage.IgnitionObjectName(*cluster.ID)); err != nil {
 		log.WithError(err).Errorf("Upload discovery ignition failed for cluster %s", cluster.ID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	objectPrefix := fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, cluster.ID.String())
-
+	var baseISOName string
 	if params.ImageCreateParams.ImageType == models.ImageTypeMinimalIso {
-		if err := b.generateClusterMinimalISO(ctx, log, cluster, ignitionConfig, objectPrefix); err != nil {
-			log.WithError(err).Errorf("Failed to generate minimal ISO for cluster %s", cluster.ID)
+		if baseISOName, err = b.objectHandler.GetMinimalIsoObjectName(cluster.OpenshiftVersion); err != nil {
+			log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
 			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to generate minimal ISO", time.Now())
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 	} else {
-		baseISOName, err := b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion)
-		if err != nil {
+		if baseISOName, err = b.objectHandler.GetBaseIsoObject(cluster.OpenshiftVersion); err != nil {
 			log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
+	}
 
-		if err := b.objectHandler.UploadISO(ctx, ignitionConfig, baseISOName, objectPrefix); err != nil {
-			log.WithError(err).Errorf("Upload ISO failed for cluster %s", cluster.ID)
-			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to upload image", time.Now())
-			return common.NewApiError(http.StatusInternalServerError, err)
+	if b.Config.StreamDiscoveryImages {
+		// Streamed images are created out of the base ISO while they are downloaded, so there is no image object
+		cluster.ImageObjectName = ""
+		if err = b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("image_object_name", "").Error; err != nil {
+			log.WithError(err).Errorf("failed to update the image object of cluster %s", cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, errors.New("Failed to generate image: error updating image record"))
 		}
+	} else if err = b.uploadNewImage(ctx, log, cluster, params, baseISOName); err != nil {
+		return err
 	}
 
 	if err := b.updateImageInfoPostUpload(ctx, cluster, clusterProxyHash, params.ImageCreateParams.ImageType, true); err != nil {
@@ -1044,18 +1577,74 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 	return nil
 }
 
-func (b *bareMetalInventory) getIgnitionConfigForLogging(cluster *common.Cluster, params installer.GenerateClusterISOParams, log logrus.FieldLogger) string {
-	ignitionConfigForLogging, _ := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(cluster, b.IgnitionConfig, true, b.authHandler.AuthType())
-	log.Infof("Generated cluster <%s> image with ignition config %s", params.ClusterID, ignitionConfigForLogging)
-	msg := "Generated image"
-	var msgExtras []string
-
-	if cluster.HTTPProxy != "" {
-		msgExtras = append(msgExtras, fmt.Sprintf(`proxy URL is "%s"`, cluster.HTTPProxy))
+// uploadNewImage creates the discovery image of the cluster out of the base ISO and uploads it, unless a cluster
+// already generated an identical image
+func (b *bareMetalInventory) uploadNewImage(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
+	params installer.GenerateClusterISOParams, baseISOName string) error {
+	/* Images are content-addressed, so clusters that generate identical images share a single object.
+	The image embeds a placeholder instead of the ignition config of the cluster, which identifies the cluster, and
+	the saved ignition config of the cluster is embedded in the image while it is downloaded.
+	The cluster points at the object before it is uploaded, so that the object isn't expired while it is uploaded.
+	*/
+	imageContent := discoveryimage.Content{
+		BaseISOObject:       baseISOName,
+		StaticNetworkConfig: cluster.ImageInfo.StaticNetworkConfig,
+		HTTPProxy:           cluster.HTTPProxy,
+		HTTPSProxy:          cluster.HTTPSProxy,
+		NoProxy:             cluster.NoProxy,
 	}
-
-	msgExtras = append(msgExtras, fmt.Sprintf(`Image type is "%s"`, string(params.ImageCreateParams.ImageType)))
-
+	cluster.ImageObjectName = imageContent.ObjectName()
+	if err := b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("image_object_name", cluster.ImageObjectName).Error; err != nil {
+		log.WithError(err).Errorf("failed to update the image object of cluster %s", cluster.ID)
+		return common.NewApiError(http.StatusInternalServerError, errors.New("Failed to generate image: error updating image record"))
+	}
+	generated, err := discoveryimage.IsGenerated(b.db, cluster.ImageObjectName, cluster.ID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find clusters sharing image %s", cluster.ImageObjectName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	if generated {
+		// The image may have been evicted from a storage that is limited by a quota
+		exists, err := b.objectHandler.DoesObjectExist(ctx, cluster.ImageObjectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to find image %s", cluster.ImageObjectName)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		generated = exists
+	}
+
+	if generated {
+		log.Infof("Re-using image %s of identical parameters for cluster %s", cluster.ImageObjectName, cluster.ID)
+	} else if params.ImageCreateParams.ImageType == models.ImageTypeMinimalIso {
+		if err := b.generateClusterMinimalISO(ctx, log, cluster, discoveryimage.PlaceholderIgnitionConfig, baseISOName, imageContent.ObjectPrefix()); err != nil {
+			log.WithError(err).Errorf("Failed to generate minimal ISO for cluster %s", cluster.ID)
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to generate minimal ISO", time.Now())
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	} else {
+		if err := b.objectHandler.UploadISO(ctx, discoveryimage.PlaceholderIgnitionConfig, baseISOName, imageContent.ObjectPrefix()); err != nil {
+			log.WithError(err).Errorf("Upload ISO failed for cluster %s", cluster.ID)
+			b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityError, "Failed to upload image", time.Now())
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *bareMetalInventory) getIgnitionConfigForLogging(cluster *common.Cluster, params installer.GenerateClusterISOParams, log logrus.FieldLogger) string {
+	ignitionConfigForLogging, _ := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(cluster, b.IgnitionConfig, true, b.authHandler.AuthType())
+	log.Infof("Generated cluster <%s> image with ignition config %s", params.ClusterID, ignitionConfigForLogging)
+	msg := "Generated image"
+	var msgExtras []string
+
+	if cluster.HTTPProxy != "" {
+		msgExtras = append(msgExtras, fmt.Sprintf(`proxy URL is "%s"`, cluster.HTTPProxy))
+	}
+
+	msgExtras = append(msgExtras, fmt.Sprintf(`Image type is "%s"`, string(params.ImageCreateParams.ImageType)))
+
 	sshExtra := "SSH public key is not set"
 	if params.ImageCreateParams.SSHPublicKey != "" {
 		sshExtra = "SSH public key is set"
@@ -1068,13 +1657,7 @@ func (b *bareMetalInventory) getIgnitionConfigForLogging(cluster *common.Cluster
 }
 
 func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log logrus.FieldLogger,
-	cluster *common.Cluster, ignitionConfig, objectPrefix string) error {
-
-	baseISOName, err := b.objectHandler.GetMinimalIsoObjectName(cluster.OpenshiftVersion)
-	if err != nil {
-		log.WithError(err).Errorf("Failed to get source object name for cluster %s with ocp version %s", cluster.ID, cluster.OpenshiftVersion)
-		return err
-	}
+	cluster *common.Cluster, ignitionConfig, baseISOName, objectPrefix string) error {
 
 	isoPath, err := s3wrapper.GetFile(ctx, b.objectHandler, baseISOName, b.ISOCacheDir, true)
 	if err != nil {
@@ -1109,10 +1692,6 @@ func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log
 	return os.Remove(clusterISOPath)
 }
 
-func getImageName(clusterID strfmt.UUID) string {
-	return fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()))
-}
-
 func (b *bareMetalInventory) refreshAllHosts(ctx context.Context, cluster *common.Cluster) error {
 	err := b.setMajorityGroupForCluster(cluster.ID, b.db)
 	if err != nil {
@@ -1463,65 +2042,816 @@ func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster commo
 			}
 		}
 	}
-	return nil
+	return nil
+}
+
+func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
+	c, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	cfg, err := b.installConfigBuilder.GetInstallConfig(c, false, "")
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	return installer.NewGetClusterInstallConfigOK().WithPayload(string(cfg))
+}
+
+func (b *bareMetalInventory) GetClusterDefaultConfig(_ context.Context, _ installer.GetClusterDefaultConfigParams) middleware.Responder {
+	body := models.ClusterDefaultConfig{}
+
+	body.NtpSource = b.Config.DefaultNTPSource
+	body.ClusterNetworkCidr = b.Config.DefaultClusterNetworkCidr
+	body.ServiceNetworkCidr = b.Config.DefaultServiceNetworkCidr
+	body.ClusterNetworkHostPrefix = b.Config.DefaultClusterNetworkHostPrefix
+
+	return installer.NewGetClusterDefaultConfigOK().WithPayload(&body)
+}
+
+func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder {
+	_, err := b.UpdateClusterInstallConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterInstallConfigCreated()
+}
+
+func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	query := "id = ?"
+
+	err := b.db.First(&cluster, query, params.ClusterID).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		return nil, err
+	}
+
+	if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, params.InstallConfigParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
+	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
+	return &cluster, nil
+}
+
+// clusterSetting is a JSON-formatted setting of the clusters that the API gets and replaces as a whole
+type clusterSetting struct {
+	// name is how the events and the logs refer to the setting, and was the verb that agrees with it
+	name, was string
+	column    string
+	field     func(c *common.Cluster) *string
+	// updatableInAnyStatus settings can be changed during the installation too
+	updatableInAnyStatus bool
+}
+
+var (
+	validationPolicySetting = clusterSetting{
+		name: "Validation policy", was: "was", column: "validation_policy",
+		field: func(c *common.Cluster) *string { return &c.ValidationPolicy },
+	}
+	stageTimeoutsSetting = clusterSetting{
+		name: "Installation stage timeouts", was: "were", column: "stage_timeouts",
+		field:                func(c *common.Cluster) *string { return &c.StageTimeouts },
+		updatableInAnyStatus: true,
+	}
+	installRetryPolicySetting = clusterSetting{
+		name: "Install retry policy", was: "was", column: "install_retry_policy",
+		field:                func(c *common.Cluster) *string { return &c.InstallRetryPolicy },
+		updatableInAnyStatus: true,
+	}
+	workerSuccessThresholdSetting = clusterSetting{
+		name: "Worker success threshold", was: "was", column: "worker_success_threshold",
+		field:                func(c *common.Cluster) *string { return &c.WorkerSuccessThreshold },
+		updatableInAnyStatus: true,
+	}
+)
+
+// getClusterSetting reads the setting of the cluster into value, which is left empty when the setting wasn't set
+func (b *bareMetalInventory) getClusterSetting(ctx context.Context, clusterID strfmt.UUID, setting clusterSetting, value interface{}) error {
+	c, err := b.getCluster(ctx, clusterID.String())
+	if err != nil {
+		return err
+	}
+	return readClusterSetting(c, setting, value)
+}
+
+func readClusterSetting(c *common.Cluster, setting clusterSetting, value interface{}) error {
+	if err := common.UnmarshalJSONColumn(*setting.field(c), value); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+// updateClusterSetting replaces the setting of the cluster with value, once validate accepts it
+func (b *bareMetalInventory) updateClusterSetting(ctx context.Context, clusterID strfmt.UUID, setting clusterSetting,
+	value interface{}, validate func() error) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	query := "id = ?"
+
+	err := b.db.First(&cluster, query, clusterID).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", clusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	if !setting.updatableInAnyStatus {
+		if err = b.clusterApi.VerifyClusterUpdatability(&cluster); err != nil {
+			log.WithError(err).Errorf("%s of cluster %s can't be updated in current state", setting.name, clusterID)
+			return nil, common.NewApiError(http.StatusConflict, err)
+		}
+	}
+
+	if err = validate(); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	stored, err := common.MarshalJSONColumn(value)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	err = b.db.Model(&common.Cluster{}).Where(query, clusterID).Update(setting.column, stored).Error
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	*setting.field(&cluster) = stored
+
+	msg := fmt.Sprintf("%s of the cluster %s updated to %s", setting.name, setting.was, stored)
+	b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo, msg, time.Now())
+	log.Infof("%s of cluster %s %s updated to %s", setting.name, clusterID, setting.was, stored)
+	return &cluster, nil
+}
+
+func (b *bareMetalInventory) GetClusterValidationPolicy(ctx context.Context, params installer.GetClusterValidationPolicyParams) middleware.Responder {
+	policy := &models.ValidationPolicy{}
+	if err := b.getClusterSetting(ctx, params.ClusterID, validationPolicySetting, policy); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewGetClusterValidationPolicyOK().WithPayload(policy)
+}
+
+func (b *bareMetalInventory) UpdateClusterValidationPolicy(ctx context.Context, params installer.UpdateClusterValidationPolicyParams) middleware.Responder {
+	c, err := b.UpdateClusterValidationPolicyInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	policy := &models.ValidationPolicy{}
+	if err = readClusterSetting(c, validationPolicySetting, policy); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterValidationPolicyOK().WithPayload(policy)
+}
+
+func (b *bareMetalInventory) UpdateClusterValidationPolicyInternal(ctx context.Context, params installer.UpdateClusterValidationPolicyParams) (*common.Cluster, error) {
+	if params.ValidationPolicy == nil {
+		params.ValidationPolicy = &models.ValidationPolicy{}
+	}
+	return b.updateClusterSetting(ctx, params.ClusterID, validationPolicySetting, params.ValidationPolicy,
+		func() error { return params.ValidationPolicy.Validate(strfmt.Default) })
+}
+
+func (b *bareMetalInventory) GetClusterStageTimeouts(ctx context.Context, params installer.GetClusterStageTimeoutsParams) middleware.Responder {
+	stageTimeouts := &models.StageTimeouts{}
+	if err := b.getClusterSetting(ctx, params.ClusterID, stageTimeoutsSetting, stageTimeouts); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewGetClusterStageTimeoutsOK().WithPayload(stageTimeouts)
+}
+
+func (b *bareMetalInventory) UpdateClusterStageTimeouts(ctx context.Context, params installer.UpdateClusterStageTimeoutsParams) middleware.Responder {
+	c, err := b.UpdateClusterStageTimeoutsInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	stageTimeouts := &models.StageTimeouts{}
+	if err = readClusterSetting(c, stageTimeoutsSetting, stageTimeouts); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterStageTimeoutsOK().WithPayload(stageTimeouts)
+}
+
+// UpdateClusterStageTimeoutsInternal replaces the stage timeouts of the cluster. Unlike most of the cluster
+// configuration, they can be changed during the installation, and apply to the hosts from their next refresh.
+func (b *bareMetalInventory) UpdateClusterStageTimeoutsInternal(ctx context.Context, params installer.UpdateClusterStageTimeoutsParams) (*common.Cluster, error) {
+	if params.StageTimeouts == nil {
+		params.StageTimeouts = &models.StageTimeouts{}
+	}
+	return b.updateClusterSetting(ctx, params.ClusterID, stageTimeoutsSetting, params.StageTimeouts,
+		func() error { return validateStageTimeouts(params.StageTimeouts) })
+}
+
+func validateStageTimeouts(stageTimeouts *models.StageTimeouts) error {
+	if err := stageTimeouts.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	stages := make(map[models.HostStage]bool)
+	for _, timeout := range stageTimeouts.Timeouts {
+		if timeout == nil {
+			return errors.New("stage timeouts must not be null")
+		}
+		if stages[timeout.Stage] {
+			return errors.Errorf("stage %s has more than one timeout", timeout.Stage)
+		}
+		stages[timeout.Stage] = true
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) GetClusterInstallRetryPolicy(ctx context.Context, params installer.GetClusterInstallRetryPolicyParams) middleware.Responder {
+	installRetryPolicy := &models.InstallRetryPolicy{}
+	if err := b.getClusterSetting(ctx, params.ClusterID, installRetryPolicySetting, installRetryPolicy); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewGetClusterInstallRetryPolicyOK().WithPayload(installRetryPolicy)
+}
+
+func (b *bareMetalInventory) UpdateClusterInstallRetryPolicy(ctx context.Context, params installer.UpdateClusterInstallRetryPolicyParams) middleware.Responder {
+	c, err := b.UpdateClusterInstallRetryPolicyInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	installRetryPolicy := &models.InstallRetryPolicy{}
+	if err = readClusterSetting(c, installRetryPolicySetting, installRetryPolicy); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterInstallRetryPolicyOK().WithPayload(installRetryPolicy)
+}
+
+// UpdateClusterInstallRetryPolicyInternal replaces the install retry policy of the cluster. It can be changed during
+// the installation, and applies to the host installations that fail after the change.
+func (b *bareMetalInventory) UpdateClusterInstallRetryPolicyInternal(ctx context.Context, params installer.UpdateClusterInstallRetryPolicyParams) (*common.Cluster, error) {
+	if params.InstallRetryPolicy == nil {
+		params.InstallRetryPolicy = &models.InstallRetryPolicy{}
+	}
+	return b.updateClusterSetting(ctx, params.ClusterID, installRetryPolicySetting, params.InstallRetryPolicy,
+		func() error { return validateInstallRetryPolicy(params.InstallRetryPolicy) })
+}
+
+func validateInstallRetryPolicy(installRetryPolicy *models.InstallRetryPolicy) error {
+	if err := installRetryPolicy.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	for _, stage := range installRetryPolicy.Stages {
+		if !host.IsRetryableStage(stage) {
+			return errors.Errorf("failures in stage %s can't be retried since the host is no longer running the discovery image", stage)
+		}
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) GetClusterWorkerSuccessThreshold(ctx context.Context, params installer.GetClusterWorkerSuccessThresholdParams) middleware.Responder {
+	workerSuccessThreshold := &models.WorkerSuccessThreshold{}
+	if err := b.getClusterSetting(ctx, params.ClusterID, workerSuccessThresholdSetting, workerSuccessThreshold); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewGetClusterWorkerSuccessThresholdOK().WithPayload(workerSuccessThreshold)
+}
+
+func (b *bareMetalInventory) UpdateClusterWorkerSuccessThreshold(ctx context.Context, params installer.UpdateClusterWorkerSuccessThresholdParams) middleware.Responder {
+	c, err := b.UpdateClusterWorkerSuccessThresholdInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	workerSuccessThreshold := &models.WorkerSuccessThreshold{}
+	if err = readClusterSetting(c, workerSuccessThresholdSetting, workerSuccessThreshold); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewUpdateClusterWorkerSuccessThresholdOK().WithPayload(workerSuccessThreshold)
+}
+
+// UpdateClusterWorkerSuccessThresholdInternal replaces the worker success threshold of the cluster. It can be changed
+// during the installation, and applies from the next refresh of the cluster.
+func (b *bareMetalInventory) UpdateClusterWorkerSuccessThresholdInternal(ctx context.Context, params installer.UpdateClusterWorkerSuccessThresholdParams) (*common.Cluster, error) {
+	if params.WorkerSuccessThreshold == nil {
+		params.WorkerSuccessThreshold = &models.WorkerSuccessThreshold{}
+	}
+	return b.updateClusterSetting(ctx, params.ClusterID, workerSuccessThresholdSetting, params.WorkerSuccessThreshold,
+		func() error { return params.WorkerSuccessThreshold.Validate(strfmt.Default) })
+}
+
+// ListStateMachines describes the state machines of the hosts and the clusters, as they are defined in the code
+func (b *bareMetalInventory) ListStateMachines(ctx context.Context, params installer.ListStateMachinesParams) middleware.Responder {
+	return installer.NewListStateMachinesOK().WithPayload(models.StateMachines{
+		statemachine.WithDiagrams(host.DescribeStateMachine()),
+		statemachine.WithDiagrams(clusterPkg.DescribeStateMachine()),
+	})
+}
+
+func (b *bareMetalInventory) ValidateHypotheticalHost(ctx context.Context, params installer.ValidateHypotheticalHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getHypotheticalHostCluster(ctx, params.HypotheticalHost)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	host, err := newHypotheticalHost(cluster, params.HypotheticalHost)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	results, err := b.hostApi.ValidateHypotheticalHost(ctx, host, cluster)
+	if err != nil {
+		log.WithError(err).Errorf("failed to validate hypothetical host for cluster %s", cluster.ID.String())
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+
+	return installer.NewValidateHypotheticalHostOK().WithPayload(results)
+}
+
+// getHypotheticalHostCluster returns the cluster that a hypothetical host is validated against, either an existing
+// cluster or a cluster that only has the requested OpenShift version and availability mode
+func (b *bareMetalInventory) getHypotheticalHostCluster(ctx context.Context, params *models.HypotheticalHost) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	if params.ClusterID != nil {
+		db := common.LoadTableFromDB(b.db, common.HostsTable, "status <> ?", models.HostStatusDisabled)
+		db = common.LoadTableFromDB(db, common.MonitoredOperatorsTable)
+		cluster, err := common.GetClusterFromDBWhere(db, common.SkipEagerLoading, common.SkipDeletedRecords,
+			identity.AddUserFilter(ctx, "id = ?"), params.ClusterID.String())
+		if err != nil {
+			log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID.String())
+			if gorm.IsRecordNotFoundError(errors.Cause(err)) {
+				return nil, common.NewApiError(http.StatusNotFound, err)
+			}
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return cluster, nil
+	}
+
+	if params.OpenshiftVersion == "" {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Either the cluster or the OpenShift version must be set"))
+	}
+	openshiftVersion, err := b.versionsHandler.GetVersion(params.OpenshiftVersion)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("Openshift version %s is not supported", params.OpenshiftVersion))
+	}
+	highAvailabilityMode := models.ClusterHighAvailabilityModeFull
+	if params.HighAvailabilityMode != nil {
+		highAvailabilityMode = *params.HighAvailabilityMode
+	}
+	id := strfmt.UUID(uuid.New().String())
+	return &common.Cluster{
+		Cluster: models.Cluster{
+			ID:                   &id,
+			Kind:                 swag.String(models.ClusterKindCluster),
+			OpenshiftVersion:     *openshiftVersion.ReleaseVersion,
+			HighAvailabilityMode: swag.String(highAvailabilityMode),
+			Status:               swag.String(models.ClusterStatusPendingForInput),
+		},
+	}, nil
+}
+
+func newHypotheticalHost(cluster *common.Cluster, params *models.HypotheticalHost) (*models.Host, error) {
+	inventory := params.Inventory
+	if inventory == nil || inventory.CPU == nil || inventory.Memory == nil || len(inventory.Disks) == 0 {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("The inventory must include the CPU, the memory and the disks of the host"))
+	}
+	role := params.Role
+	switch role {
+	case "":
+		role = models.HostRoleAutoAssign
+	case models.HostRoleMaster, models.HostRoleWorker, models.HostRoleAutoAssign:
+	default:
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("Role %s is not supported for a hypothetical host", role))
+	}
+
+	inventoryBytes, err := json.Marshal(inventory)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	var connectivity string
+	if params.Connectivity != nil {
+		connectivityBytes, err := json.Marshal(params.Connectivity)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		connectivity = string(connectivityBytes)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	kind := models.HostKindHost
+	if swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
+		kind = models.HostKindAddToExistingClusterHost
+	}
+	return &models.Host{
+		ID:           &id,
+		ClusterID:    *cluster.ID,
+		Kind:         swag.String(kind),
+		Status:       swag.String(models.HostStatusDiscovering),
+		Role:         role,
+		Inventory:    string(inventoryBytes),
+		Connectivity: connectivity,
+		CheckedInAt:  strfmt.DateTime(time.Now()),
+		Progress:     &models.HostProgressInfo{},
+	}, nil
+}
+
+func (b *bareMetalInventory) ExportClusterDefinition(ctx context.Context, params installer.ExportClusterDefinitionParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	definition, err := b.exportClusterDefinition(ctx, cluster)
+	if err != nil {
+		log.WithError(err).Errorf("failed to export the definition of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	return installer.NewExportClusterDefinitionOK().WithPayload(definition)
+}
+
+// proxyWithoutCredentials removes the user and the password from a proxy URL. A URL that can't be parsed is dropped,
+// since it might hold credentials that can't be told apart
+func proxyWithoutCredentials(proxyURL string) string {
+	if proxyURL == "" {
+		return ""
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	return u.String()
+}
+
+func (b *bareMetalInventory) exportClusterDefinition(ctx context.Context, cluster *common.Cluster) (*models.ClusterDefinition, error) {
+	olmOperators := make([]*models.OperatorCreateParams, 0)
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm {
+			olmOperators = append(olmOperators, &models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties})
+		}
+	}
+
+	definition := &models.ClusterDefinition{
+		Version: swag.String(models.ClusterDefinitionVersionV1),
+		ClusterParams: &models.ClusterCreateParams{
+			Name:                     swag.String(cluster.Name),
+			HighAvailabilityMode:     cluster.HighAvailabilityMode,
+			OpenshiftVersion:         swag.String(cluster.OpenshiftVersion),
+			BaseDNSDomain:            cluster.BaseDNSDomain,
+			ClusterNetworkCidr:       swag.String(cluster.ClusterNetworkCidr),
+			ClusterNetworkHostPrefix: cluster.ClusterNetworkHostPrefix,
+			ServiceNetworkCidr:       swag.String(cluster.ServiceNetworkCidr),
+			// The pull secret belongs to the user of the source cluster and is set on import
+			PullSecret:        swag.String(""),
+			SSHPublicKey:      cluster.SSHPublicKey,
+			VipDhcpAllocation: cluster.VipDhcpAllocation,
+			// The credentials of the proxies are secret as well, and are set after import
+			HTTPProxy:             swag.String(proxyWithoutCredentials(cluster.HTTPProxy)),
+			HTTPSProxy:            swag.String(proxyWithoutCredentials(cluster.HTTPSProxy)),
+			NoProxy:               swag.String(cluster.NoProxy),
+			UserManagedNetworking: cluster.UserManagedNetworking,
+			AdditionalNtpSource:   swag.String(cluster.AdditionalNtpSource),
+			OlmOperators:          olmOperators,
+			Hyperthreading:        swag.String(cluster.Hyperthreading),
+		},
+		InstallConfigOverrides: cluster.InstallConfigOverrides,
+		Manifests:              make([]*models.CreateManifestParams, 0),
+		Hosts:                  make([]*models.ClusterDefinitionHost, 0),
+	}
+
+	// VIPs that were allocated by DHCP are allocated again on the target
+	if !swag.BoolValue(cluster.VipDhcpAllocation) && !swag.BoolValue(cluster.UserManagedNetworking) {
+		definition.ClusterParams.IngressVip = cluster.IngressVip
+		definition.APIVip = cluster.APIVip
+	}
+
+	staticNetworkConfig, err := b.staticNetworkConfig.ParseStaticNetworkConfigFromDB(cluster.ImageInfo.StaticNetworkConfig)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	definition.StaticNetworkConfig = staticNetworkConfig
+
+	clusterManifests, err := b.manifestsApi.ListClusterManifestsInternal(ctx, operations.ListClusterManifestsParams{ClusterID: *cluster.ID})
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range clusterManifests {
+		content, err := b.downloadClusterManifest(ctx, *cluster.ID, manifest)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		definition.Manifests = append(definition.Manifests, &models.CreateManifestParams{
+			Folder:   swag.String(manifest.Folder),
+			FileName: swag.String(manifest.FileName),
+			Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
+		})
+	}
+
+	for _, h := range cluster.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := hostutil.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		macAddresses := make([]string, 0, len(inventory.Interfaces))
+		for _, intf := range inventory.Interfaces {
+			if intf.MacAddress != "" {
+				macAddresses = append(macAddresses, strings.ToLower(intf.MacAddress))
+			}
+		}
+		if len(macAddresses) == 0 {
+			continue
+		}
+		hostDefinition := &models.ClusterDefinitionHost{
+			MacAddresses:       macAddresses,
+			RequestedHostname:  h.RequestedHostname,
+			InstallationDiskID: h.InstallationDiskID,
+		}
+		if h.Role != models.HostRoleAutoAssign {
+			hostDefinition.Role = models.HostRoleUpdateParams(h.Role)
+		}
+		definition.Hosts = append(definition.Hosts, hostDefinition)
+	}
+
+	return definition, nil
+}
+
+func (b *bareMetalInventory) downloadClusterManifest(ctx context.Context, clusterID strfmt.UUID, manifest *models.Manifest) ([]byte, error) {
+	objectName := manifests.GetManifestObjectName(clusterID, filepath.Join(manifest.Folder, manifest.FileName))
+	reader, _, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download manifest %s", objectName)
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest %s", objectName)
+	}
+	return content, nil
+}
+
+func (b *bareMetalInventory) ImportClusterDefinition(ctx context.Context, params installer.ImportClusterDefinitionParams) middleware.Responder {
+	c, err := b.importClusterDefinition(ctx, params.ImportParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewImportClusterDefinitionCreated().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) importClusterDefinition(ctx context.Context, params *models.ClusterDefinitionImportParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	if err := params.Validate(strfmt.Default); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	definition := params.Definition
+
+	// The cluster is registered with the release of the target that matches the major and minor version of the
+	// source cluster
+	sourceVersion := swag.StringValue(definition.ClusterParams.OpenshiftVersion)
+	targetVersion, err := b.versionsHandler.GetVersion(sourceVersion)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Openshift version %s of the cluster definition is not supported", sourceVersion))
+	}
+
+	hostAssignments, err := normalizeHostAssignments(definition.Hosts)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if len(definition.StaticNetworkConfig) > 0 {
+		if err = b.staticNetworkConfig.ValidateStaticConfigParams(definition.StaticNetworkConfig); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	clusterParams := *definition.ClusterParams
+	clusterParams.PullSecret = params.PullSecret
+	cluster, err := b.RegisterClusterInternal(ctx, nil, installer.RegisterClusterParams{NewClusterParams: &clusterParams})
+	if err != nil {
+		return nil, err
+	}
+	clusterID := *cluster.ID
+
+	success := false
+	defer func() {
+		if success {
+			return
+		}
+		log.Infof("Deregistering cluster %s after a failed import", clusterID)
+		if deregisterErr := b.DeregisterClusterInternal(ctx, installer.DeregisterClusterParams{ClusterID: clusterID}); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s after a failed import", clusterID)
+		}
+	}()
+
+	if definition.InstallConfigOverrides != "" {
+		if _, err = b.UpdateClusterInstallConfigInternal(ctx, installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: definition.InstallConfigOverrides,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, manifest := range definition.Manifests {
+		if _, err = b.manifestsApi.CreateClusterManifestInternal(ctx, operations.CreateClusterManifestParams{
+			ClusterID:            clusterID,
+			CreateManifestParams: manifest,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	updates := map[string]interface{}{}
+	if definition.APIVip != "" {
+		if swag.BoolValue(cluster.VipDhcpAllocation) || swag.BoolValue(cluster.UserManagedNetworking) {
+			err = errors.New("API VIP cannot be set when it is allocated by DHCP or with User Managed Networking")
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		if err = verifyParsableVIPs(definition.APIVip, cluster.IngressVip); err != nil {
+			return nil, err
+		}
+		if err = network.VerifyDifferentVipAddresses(definition.APIVip, cluster.IngressVip); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["api_vip"] = definition.APIVip
+	}
+	if len(definition.StaticNetworkConfig) > 0 {
+		updates["image_static_network_config"] = b.staticNetworkConfig.FormatStaticNetworkConfigForDB(definition.StaticNetworkConfig)
+	}
+	if len(hostAssignments) > 0 {
+		var assignments []byte
+		if assignments, err = json.Marshal(hostAssignments); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		updates["imported_host_assignments"] = string(assignments)
+	}
+	if len(updates) > 0 {
+		if err = b.db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(updates).Error; err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	if cluster, err = common.GetClusterFromDB(b.db, clusterID, common.UseEagerLoading); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	success = true
+
+	msg := fmt.Sprintf("Cluster was imported from a cluster definition with %d custom manifests and %d host assignments",
+		len(definition.Manifests), len(hostAssignments))
+	b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityInfo, msg, time.Now())
+	sourceRelease, sourceErr := version.NewVersion(sourceVersion)
+	targetRelease, targetErr := version.NewVersion(swag.StringValue(targetVersion.ReleaseVersion))
+	if sourceErr == nil && targetErr == nil && !sourceRelease.Equal(targetRelease) {
+		msg = fmt.Sprintf("The cluster definition was exported with OpenShift %s, the cluster was imported with OpenShift %s",
+			sourceVersion, swag.StringValue(targetVersion.ReleaseVersion))
+		b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityWarning, msg, time.Now())
+	}
+	log.Infof("Cluster %s was imported from a cluster definition", clusterID)
+	return cluster, nil
+}
+
+// normalizeHostAssignments validates the host assignments of a cluster definition and returns them with their MAC
+// addresses in lower case, so that they can be matched against the inventories of the hosts
+func normalizeHostAssignments(hosts []*models.ClusterDefinitionHost) ([]*models.ClusterDefinitionHost, error) {
+	assignments := make([]*models.ClusterDefinitionHost, 0, len(hosts))
+	seen := map[string]bool{}
+	for i, h := range hosts {
+		if h.RequestedHostname != "" {
+			if err := hostutil.ValidateHostname(h.RequestedHostname); err != nil {
+				return nil, errors.Wrapf(err, "invalid hostname of host %d", i)
+			}
+		}
+		assignment := *h
+		assignment.MacAddresses = make([]string, len(h.MacAddresses))
+		for j, mac := range h.MacAddresses {
+			hwAddr, err := net.ParseMAC(mac)
+			if err != nil {
+				return nil, errors.Errorf("Invalid MAC address %s of host %d", mac, i)
+			}
+			normalized := hwAddr.String()
+			if seen[normalized] {
+				return nil, errors.Errorf("MAC address %s is used by more than one host", mac)
+			}
+			seen[normalized] = true
+			assignment.MacAddresses[j] = normalized
+		}
+		assignments = append(assignments, &assignment)
+	}
+	return assignments, nil
 }
 
-func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
-	c, err := b.getCluster(ctx, params.ClusterID.String())
-	if err != nil {
-		return common.GenerateErrorResponder(err)
-	}
+// applyImportedHostAssignment applies the host assignment of an imported cluster definition that matches the MAC
+// addresses of the host. Each assignment is applied once, so later changes of the user are kept
+func (b *bareMetalInventory) applyImportedHostAssignment(ctx context.Context, h *models.Host) {
+	log := logutil.FromContext(ctx, b.log)
 
-	cfg, err := b.installConfigBuilder.GetInstallConfig(c, false, "")
-	if err != nil {
-		return common.GenerateErrorResponder(err)
+	var cluster common.Cluster
+	if err := b.db.Select("imported_host_assignments").Take(&cluster, "id = ?", h.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Warnf("failed to get the imported host assignments of cluster %s", h.ClusterID)
+		return
+	}
+	if cluster.ImportedHostAssignments == "" {
+		return
 	}
 
-	return installer.NewGetClusterInstallConfigOK().WithPayload(string(cfg))
-}
-
-func (b *bareMetalInventory) GetClusterDefaultConfig(_ context.Context, _ installer.GetClusterDefaultConfigParams) middleware.Responder {
-	body := models.ClusterDefaultConfig{}
-
-	body.NtpSource = b.Config.DefaultNTPSource
-	body.ClusterNetworkCidr = b.Config.DefaultClusterNetworkCidr
-	body.ServiceNetworkCidr = b.Config.DefaultServiceNetworkCidr
-	body.ClusterNetworkHostPrefix = b.Config.DefaultClusterNetworkHostPrefix
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		// in case another host consumes an assignment at the same time we need to use FOR UPDATE option
+		c, err := common.GetClusterFromDB(transaction.AddForUpdateQueryOption(tx), h.ClusterID, common.SkipEagerLoading)
+		if err != nil {
+			return err
+		}
+		if c.ImportedHostAssignments == "" {
+			return nil
+		}
+		var assignments []*models.ClusterDefinitionHost
+		if err = json.Unmarshal([]byte(c.ImportedHostAssignments), &assignments); err != nil {
+			return err
+		}
+		host, err := common.GetHostFromDB(tx, h.ClusterID.String(), h.ID.String())
+		if err != nil {
+			return err
+		}
+		inventory, err := hostutil.UnmarshalInventory(host.Inventory)
+		if err != nil {
+			return err
+		}
+		index := findHostAssignment(assignments, inventory)
+		if index < 0 {
+			return nil
+		}
 
-	return installer.NewGetClusterDefaultConfigOK().WithPayload(&body)
-}
+		if err = b.applyHostAssignment(ctx, tx, &host.Host, assignments[index]); err != nil {
+			log.WithError(err).Warnf("failed to apply the imported assignment of host %s", h.ID)
+			msg := fmt.Sprintf("Failed to apply the assignment of the imported cluster definition to host %s: %s",
+				hostutil.GetHostnameForMsg(&host.Host), err.Error())
+			b.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, msg, time.Now())
+		} else {
+			msg := fmt.Sprintf("Host %s: applied the assignment of the imported cluster definition",
+				hostutil.GetHostnameForMsg(&host.Host))
+			b.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo, msg, time.Now())
+		}
 
-func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder {
-	_, err := b.UpdateClusterInstallConfigInternal(ctx, params)
+		remaining := ""
+		assignments = append(assignments[:index], assignments[index+1:]...)
+		if len(assignments) > 0 {
+			var data []byte
+			if data, err = json.Marshal(assignments); err != nil {
+				return err
+			}
+			remaining = string(data)
+		}
+		return tx.Model(&common.Cluster{}).Where("id = ?", h.ClusterID.String()).
+			Update("imported_host_assignments", remaining).Error
+	})
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		log.WithError(err).Warnf("failed to apply the imported host assignments of cluster %s to host %s", h.ClusterID, h.ID)
 	}
-	return installer.NewUpdateClusterInstallConfigCreated()
 }
 
-func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.UpdateClusterInstallConfigParams) (*common.Cluster, error) {
-	log := logutil.FromContext(ctx, b.log)
-	var cluster common.Cluster
-	query := "id = ?"
-
-	err := b.db.First(&cluster, query, params.ClusterID).Error
-	if err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
-		return nil, err
+func findHostAssignment(assignments []*models.ClusterDefinitionHost, inventory *models.Inventory) int {
+	for _, intf := range inventory.Interfaces {
+		hwAddr, err := net.ParseMAC(intf.MacAddress)
+		if err != nil {
+			continue
+		}
+		for i, assignment := range assignments {
+			if funk.ContainsString(assignment.MacAddresses, hwAddr.String()) {
+				return i
+			}
+		}
 	}
+	return -1
+}
 
-	if err = b.installConfigBuilder.ValidateInstallConfigPatch(&cluster, params.InstallConfigParams); err != nil {
-		return nil, common.NewApiError(http.StatusBadRequest, err)
+func (b *bareMetalInventory) applyHostAssignment(ctx context.Context, db *gorm.DB, h *models.Host, assignment *models.ClusterDefinitionHost) error {
+	if assignment.Role != "" && models.HostRole(assignment.Role) != h.Role {
+		if err := b.hostApi.UpdateRole(ctx, h, models.HostRole(assignment.Role), db); err != nil {
+			return errors.Wrapf(err, "failed to set role %s", assignment.Role)
+		}
 	}
-
-	err = b.db.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
-	if err != nil {
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	if assignment.RequestedHostname != "" && assignment.RequestedHostname != h.RequestedHostname {
+		if err := b.hostApi.UpdateHostname(ctx, h, assignment.RequestedHostname, db); err != nil {
+			return errors.Wrapf(err, "failed to set hostname %s", assignment.RequestedHostname)
+		}
 	}
-
-	b.eventsHandler.AddEvent(ctx, params.ClusterID, nil, models.EventSeverityInfo, "Custom install config was applied to the cluster", time.Now())
-	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
-	return &cluster, nil
+	if assignment.InstallationDiskID != "" && assignment.InstallationDiskID != h.InstallationDiskID {
+		if err := b.hostApi.UpdateInstallationDisk(ctx, db, h, assignment.InstallationDiskID); err != nil {
+			return errors.Wrapf(err, "failed to set installation disk %s", assignment.InstallationDiskID)
+		}
+	}
+	return nil
 }
 
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
@@ -3020,6 +4350,9 @@ func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInvento
 	switch params.Reply.StepType {
 	case models.StepTypeInventory:
 		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
+		if err == nil {
+			b.applyImportedHostAssignment(ctx, &host)
+		}
 	case models.StepTypeConnectivityCheck:
 		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
 	case models.StepTypeAPIVipConnectivityCheck:
@@ -3030,6 +4363,8 @@ func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInvento
 		err = b.processDhcpAllocationResponse(ctx, &host, stepReply)
 	case models.StepTypeNtpSynchronizer:
 		err = b.processNtpSynchronizerResponse(ctx, &host, stepReply)
+	case models.StepTypeDomainResolution:
+		err = b.hostApi.UpdateDomainResolution(ctx, &host, stepReply, b.db)
 	case models.StepTypeContainerImageAvailability:
 		err = b.processImageAvailabilityResponse(ctx, &host, stepReply)
 	case models.StepTypeInstallationDiskSpeedCheck:
@@ -3073,6 +4408,8 @@ func filterReplyByType(params installer.PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.DhcpAllocationResponse{}, params.Reply.Output)
 	case models.StepTypeNtpSynchronizer:
 		stepReply, err = filterReply(&models.NtpSynchronizationResponse{}, params.Reply.Output)
+	case models.StepTypeDomainResolution:
+		stepReply, err = filterReply(&models.DomainResolutionResponse{}, params.Reply.Output)
 	case models.StepTypeContainerImageAvailability:
 		stepReply, err = filterReply(&models.ContainerImageAvailabilityResponse{}, params.Reply.Output)
 	case models.StepTypeInstallationDiskSpeedCheck:
@@ -3329,11 +4666,20 @@ func (b *bareMetalInventory) GetPresignedForClusterFiles(ctx context.Context, pa
 
 	duration, _ := time.ParseDuration("10m")
 	url, err := b.objectHandler.GeneratePresignedDownloadURL(ctx, fullFileName, downloadFilename, duration)
+	if errors.Is(err, s3wrapper.ErrEncryptedObject) {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
 	if err != nil {
 		log.WithError(err).Errorf("failed to generate presigned URL: %s from cluster: %s", params.FileName, params.ClusterID.String())
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	return installer.NewGetPresignedForClusterFilesOK().WithPayload(&models.Presigned{URL: &url})
+	presigned := &models.Presigned{URL: &url}
+	if info, infoErr := b.objectHandler.GetObjectInfo(ctx, fullFileName); infoErr == nil {
+		presigned.Sha256 = info.SHA256
+	} else {
+		log.WithError(infoErr).Warnf("failed to get the checksum of file %s of cluster %s", params.FileName, params.ClusterID.String())
+	}
+	return installer.NewGetPresignedForClusterFilesOK().WithPayload(presigned)
 }
 
 func (b *bareMetalInventory) DownloadClusterFiles(ctx context.Context, params installer.DownloadClusterFilesParams) middleware.Responder {
@@ -3342,13 +4688,24 @@ func (b *bareMetalInventory) DownloadClusterFiles(ctx context.Context, params in
 		return common.GenerateErrorResponder(err)
 	}
 
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fmt.Sprintf("%s/%s", params.ClusterID, params.FileName))
+	download, err := filemiddleware.OpenObject(ctx, b.objectHandler, fmt.Sprintf("%s/%s", params.ClusterID, params.FileName), params.Range, params.IfRange)
 	if err != nil {
+		if rangeErr, ok := err.(*filemiddleware.RangeNotSatisfiableError); ok {
+			return installer.NewDownloadClusterFilesRequestedRangeNotSatisfiable().WithContentRange(rangeErr.ContentRange()).
+				WithPayload(common.GenerateError(http.StatusRequestedRangeNotSatisfiable, err))
+		}
 		log.WithError(err).Errorf("failed to download file %s from cluster: %s", params.FileName, params.ClusterID.String())
 		return common.GenerateErrorResponder(err)
 	}
 
-	return filemiddleware.NewResponder(installer.NewDownloadClusterFilesOK().WithPayload(respBody), params.FileName, contentLength)
+	if download.IsPartial() {
+		return filemiddleware.NewResponder(installer.NewDownloadClusterFilesPartialContent().
+			WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).WithContentRange(download.ContentRange()).
+			WithPayload(download.Reader), params.FileName, download.Length())
+	}
+	return filemiddleware.NewResponder(installer.NewDownloadClusterFilesOK().
+		WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).
+		WithPayload(download.Reader), params.FileName, download.Length())
 }
 
 func (b *bareMetalInventory) DownloadClusterKubeconfig(ctx context.Context, params installer.DownloadClusterKubeconfigParams) middleware.Responder {
@@ -3802,6 +5159,96 @@ func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, par
 	return cluster, nil
 }
 
+func (b *bareMetalInventory) PauseInstallation(ctx context.Context, params installer.PauseInstallationParams) middleware.Responder {
+	c, err := b.updateInstallationPause(ctx, params.ClusterID, true)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewPauseInstallationAccepted().WithPayload(&c.Cluster)
+}
+
+func (b *bareMetalInventory) ResumeInstallation(ctx context.Context, params installer.ResumeInstallationParams) middleware.Responder {
+	c, err := b.updateInstallationPause(ctx, params.ClusterID, false)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewResumeInstallationAccepted().WithPayload(&c.Cluster)
+}
+
+// updateInstallationPause pauses or resumes the installation of the cluster and of its hosts, in one transaction
+func (b *bareMetalInventory) updateInstallationPause(ctx context.Context, clusterID strfmt.UUID, pause bool) (*common.Cluster, error) {
+	action := "resume"
+	if pause {
+		action = "pause"
+	}
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("%s installation for cluster %s", action, clusterID)
+
+	txSuccess := false
+	tx := b.db.Begin()
+	tx = transaction.AddForUpdateQueryOption(tx)
+	defer func() {
+		if !txSuccess {
+			log.Errorf("%s installation failed", action)
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Errorf("%s installation failed", action)
+			tx.Rollback()
+		}
+	}()
+
+	if tx.Error != nil {
+		msg := fmt.Sprintf("Failed to %s installation: error starting DB transaction", action)
+		log.WithError(tx.Error).Errorf(msg)
+		b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityError, msg, time.Now())
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New(msg))
+	}
+
+	cluster, err := common.GetClusterFromDB(tx, clusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to %s installation: could not find cluster %s", action, clusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	if pause {
+		if err := b.clusterApi.PauseInstallation(ctx, cluster, tx); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := b.clusterApi.ResumeInstallation(ctx, cluster, tx); err != nil {
+			return nil, err
+		}
+	}
+	for _, h := range cluster.Hosts {
+		if pause {
+			if err := b.hostApi.PauseInstallation(ctx, h, tx); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := b.hostApi.ResumeInstallation(ctx, h, tx); err != nil {
+				return nil, err
+			}
+		}
+		if err := b.customizeHost(h); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Errorf("Failed to %s installation: error committing DB transaction (%s)", action, err)
+		msg := fmt.Sprintf("Failed to %s installation: error committing DB transaction", action)
+		b.eventsHandler.AddEvent(ctx, clusterID, nil, models.EventSeverityError, msg, time.Now())
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction"))
+	}
+	txSuccess = true
+
+	return cluster, nil
+}
+
 func (b *bareMetalInventory) ResetCluster(ctx context.Context, params installer.ResetClusterParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("resetting cluster %s", params.ClusterID)
@@ -4163,6 +5610,7 @@ func (b *bareMetalInventory) uploadLogs(ctx context.Context, params installer.Up
 		log.WithError(err).Errorf("Failed to upload %s to s3", fileName)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	b.uploadedLogs.add(params.ClusterID, fileName)
 	if params.LogsType == string(models.LogsTypeController) {
 		err = b.clusterApi.SetUploadControllerLogsAt(ctx, currentCluster, b.db)
 		if err != nil {
@@ -4195,6 +5643,7 @@ func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, clusterId strin
 		log.WithError(err).Errorf("Failed to upload %s to s3 for host %s", fileName, hostId)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	b.uploadedLogs.add(currentHost.ClusterID, fileName)
 
 	err = b.hostApi.SetUploadLogsAt(ctx, &currentHost.Host, b.db)
 	if err != nil {
@@ -4209,6 +5658,263 @@ func (b *bareMetalInventory) uploadHostLogs(ctx context.Context, clusterId strin
 	return nil
 }
 
+// recompressLogs recompresses the uploaded log archive when a log compression is configured, and returns the name of
+// the archive the logs are stored in. The gzipped archive is kept when it can't be recompressed.
+func (b *bareMetalInventory) recompressLogs(ctx context.Context, fileName string) string {
+	if b.LogArtifacts.Compression != models.LogArtifactCompressionZstd {
+		return fileName
+	}
+	recompressed, err := logartifacts.Recompress(ctx, b.log, b.objectHandler, fileName)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("Failed to recompress log archive %s", fileName)
+		return fileName
+	}
+	return recompressed
+}
+
+// uploadedLogs are the log archives that were uploaded since they were last processed, and the clusters they belong to
+type uploadedLogs struct {
+	lock       sync.Mutex
+	archives   []string
+	clusterIDs []strfmt.UUID
+}
+
+func (u *uploadedLogs) add(clusterID strfmt.UUID, archive string) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.archives = append(u.archives, archive)
+	if !funk.Contains(u.clusterIDs, clusterID) {
+		u.clusterIDs = append(u.clusterIDs, clusterID)
+	}
+}
+
+// take returns the uploaded log archives and their clusters and forgets them, so that each archive is processed once
+func (u *uploadedLogs) take() ([]string, []strfmt.UUID) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	archives, clusterIDs := u.archives, u.clusterIDs
+	u.archives, u.clusterIDs = nil, nil
+	return archives, clusterIDs
+}
+
+// ProcessUploadedLogs recompresses and indexes the log archives that were uploaded since it last ran, and classifies
+// the failures of the failed clusters they belong to again, once per cluster however many of its hosts uploaded logs.
+// The uploads don't wait for the archives to be processed, so it runs periodically in the background.
+func (b *bareMetalInventory) ProcessUploadedLogs() {
+	archives, clusterIDs := b.uploadedLogs.take()
+	if len(archives) == 0 {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	for _, archive := range archives {
+		b.indexLogs(ctx, b.recompressLogs(ctx, archive))
+	}
+	for _, clusterID := range clusterIDs {
+		b.classifyFailureWithLogs(ctx, clusterID)
+	}
+}
+
+// indexLogs indexes the uploaded log archive for searching. The upload doesn't fail without an index, since the
+// archive is indexed again when it is searched.
+func (b *bareMetalInventory) indexLogs(ctx context.Context, fileName string) {
+	if err := b.logIndexer.Index(ctx, fileName); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("Failed to index log archive %s", fileName)
+	}
+}
+
+// classifyFailureWithLogs classifies the failure of a cluster in error again, since the logs that the hosts and the
+// controller upload after the failure have more evidence than the cluster had when it failed
+func (b *bareMetalInventory) classifyFailureWithLogs(ctx context.Context, clusterID strfmt.UUID) {
+	cluster, err := common.GetClusterFromDB(b.db, clusterID, common.SkipEagerLoading)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("Failed to get cluster %s to classify its failure", clusterID)
+		return
+	}
+	if swag.StringValue(cluster.Status) != models.ClusterStatusError {
+		return
+	}
+	if _, err = b.clusterApi.ClassifyFailure(ctx, cluster, b.db); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("Failed to classify the failure of cluster %s", cluster.ID)
+	}
+}
+
+func (b *bareMetalInventory) SearchClusterLogs(ctx context.Context, params installer.SearchClusterLogsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	filter := &logsearch.Filter{Levels: params.Levels, File: swag.StringValue(params.File)}
+	if err = filter.SetPattern(swag.StringValue(params.Pattern)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if params.Since != nil {
+		since := time.Time(*params.Since)
+		filter.Since = &since
+	}
+	if params.Until != nil {
+		until := time.Time(*params.Until)
+		filter.Until = &until
+	}
+
+	offset := swag.Int64Value(params.Offset)
+	matches := models.LogSearchMatchList{}
+	var total int64
+	for _, archive := range b.logArchivesToSearch(cluster, params) {
+		archive := archive
+		// The archive is stored under one of its names, depending on whether it was recompressed
+		for _, objectName := range logartifacts.ArchiveObjectNames(cluster.ID.String(), archive.logID) {
+			archive.match.Archive = swag.String(strings.TrimPrefix(objectName, cluster.ID.String()+"/"))
+			var found bool
+			found, err = b.logIndexer.Search(ctx, objectName, filter, func(entry *logsearch.Entry) {
+				total++
+				if total <= offset || (params.Limit != nil && int64(len(matches)) >= *params.Limit) {
+					return
+				}
+				match := archive.match
+				match.File = swag.String(entry.File)
+				match.Line = swag.Int64(entry.Line)
+				match.Level = entry.Level
+				match.Message = swag.String(entry.Message)
+				if entry.Time != nil {
+					t := strfmt.DateTime(*entry.Time)
+					match.Time = &t
+				}
+				matches = append(matches, &match)
+			})
+			if err != nil {
+				log.WithError(err).Errorf("failed to search the logs of cluster %s", params.ClusterID)
+				return common.NewApiError(http.StatusInternalServerError, err)
+			}
+			if found {
+				break
+			}
+		}
+	}
+	return installer.NewSearchClusterLogsOK().WithPayload(matches).WithMatchCount(total)
+}
+
+// logArchive is a log archive to search, along with the details of the archive that each match includes
+type logArchive struct {
+	logID string
+	match models.LogSearchMatch
+}
+
+// logArchivesToSearch returns the log archives of the cluster that the search parameters select
+func (b *bareMetalInventory) logArchivesToSearch(cluster *common.Cluster, params installer.SearchClusterLogsParams) []logArchive {
+	searchTypes := params.LogsTypes
+	if len(searchTypes) == 0 {
+		searchTypes = []string{string(models.LogsTypeHost), string(models.LogsTypeController)}
+	}
+	hostsFiltered := params.HostID != nil || len(params.Roles) > 0
+
+	var archives []logArchive
+	if funk.ContainsString(searchTypes, string(models.LogsTypeHost)) {
+		for _, h := range cluster.Hosts {
+			role := h.Role
+			if h.Bootstrap {
+				role = models.HostRoleBootstrap
+			}
+			if params.HostID != nil && *h.ID != *params.HostID {
+				continue
+			}
+			if len(params.Roles) > 0 && !funk.ContainsString(params.Roles, string(role)) {
+				continue
+			}
+			archives = append(archives, b.logArchive(cluster, models.LogSearchMatch{
+				LogsType: models.LogsTypeHost,
+				HostID:   *h.ID,
+				HostRole: role,
+				Hostname: hostutil.GetHostnameForMsg(h),
+			}, h.ID.String(), h.ID))
+		}
+	}
+	// The controller logs belong to no host
+	if funk.ContainsString(searchTypes, string(models.LogsTypeController)) && !hostsFiltered {
+		archives = append(archives, b.logArchive(cluster, models.LogSearchMatch{LogsType: models.LogsTypeController},
+			string(models.LogsTypeController), nil))
+	}
+	return archives
+}
+
+// logArchive fills the download URL of the archive of the match
+func (b *bareMetalInventory) logArchive(cluster *common.Cluster, match models.LogSearchMatch, logID string, hostID *strfmt.UUID) logArchive {
+	match.ArchiveHref = logsDownloadHref(cluster, match.LogsType, hostID)
+	return logArchive{logID: logID, match: match}
+}
+
+// logsDownloadHref returns the URL that downloads the logs of the type, or an empty URL if it can't be built
+func logsDownloadHref(cluster *common.Cluster, logsType models.LogsType, hostID *strfmt.UUID) string {
+	typeParam := string(logsType)
+	href, err := (&installer.DownloadClusterLogsURL{ClusterID: *cluster.ID, LogsType: &typeParam, HostID: hostID}).Build()
+	if err != nil {
+		return ""
+	}
+	return href.String()
+}
+
+func (b *bareMetalInventory) ListClusterLogArtifacts(ctx context.Context, params installer.ListClusterLogArtifactsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	artifacts := models.LogArtifactList{}
+	for _, h := range cluster.Hosts {
+		if h.LogsCollectedAt == strfmt.DateTime(time.Time{}) {
+			continue
+		}
+		role := h.Role
+		if h.Bootstrap {
+			role = models.HostRoleBootstrap
+		}
+		artifact := models.LogArtifact{
+			LogsType:    models.LogsTypeHost,
+			HostID:      *h.ID,
+			HostRole:    role,
+			Hostname:    hostutil.GetHostnameForMsg(h),
+			CollectedAt: h.LogsCollectedAt,
+		}
+		if err = b.addLogArtifact(ctx, cluster, &artifacts, artifact, h.ID.String(), h.ID); err != nil {
+			log.WithError(err).Errorf("failed to find the logs of host %s in cluster %s", h.ID, params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	if cluster.ControllerLogsCollectedAt != strfmt.DateTime(time.Time{}) {
+		artifact := models.LogArtifact{LogsType: models.LogsTypeController, CollectedAt: cluster.ControllerLogsCollectedAt}
+		if err = b.addLogArtifact(ctx, cluster, &artifacts, artifact, string(models.LogsTypeController), nil); err != nil {
+			log.WithError(err).Errorf("failed to find the controller logs of cluster %s", params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+	return installer.NewListClusterLogArtifactsOK().WithPayload(artifacts)
+}
+
+// addLogArtifact adds the stored log archive to the artifacts along with when it expires. Archives that were deleted
+// since they were uploaded are skipped.
+func (b *bareMetalInventory) addLogArtifact(ctx context.Context, cluster *common.Cluster, artifacts *models.LogArtifactList,
+	artifact models.LogArtifact, logID string, hostID *strfmt.UUID) error {
+	objectName, info, err := logartifacts.FindArchive(ctx, b.objectHandler, cluster.ID.String(), logID)
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			return nil
+		}
+		return err
+	}
+	artifact.Archive = swag.String(strings.TrimPrefix(objectName, cluster.ID.String()+"/"))
+	artifact.ArchiveHref = logsDownloadHref(cluster, artifact.LogsType, hostID)
+	artifact.Compression = swag.String(logartifacts.Compression(objectName))
+	artifact.SizeBytes = swag.Int64(info.Size)
+	if expiresAt := b.LogArtifacts.RetentionRules.Expiration(artifact.LogsType, cluster); expiresAt != nil {
+		t := strfmt.DateTime(*expiresAt)
+		artifact.ExpiresAt = &t
+	}
+	*artifacts = append(*artifacts, &artifact)
+	return nil
+}
+
 func (b *bareMetalInventory) DownloadClusterLogs(ctx context.Context, params installer.DownloadClusterLogsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Downloading logs from cluster %s", params.ClusterID)
@@ -4216,17 +5922,32 @@ func (b *bareMetalInventory) DownloadClusterLogs(ctx context.Context, params ins
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	respBody, contentLength, err := b.objectHandler.Download(ctx, fileName)
+	download, err := filemiddleware.OpenObject(ctx, b.objectHandler, fileName, params.Range, params.IfRange)
+	if _, ok := err.(common.NotFound); ok && logartifacts.IsGzipped(fileName) {
+		fileName, downloadFileName = recompressedLogsFile(fileName, downloadFileName)
+		download, err = filemiddleware.OpenObject(ctx, b.objectHandler, fileName, params.Range, params.IfRange)
+	}
 	if err != nil {
 		if _, ok := err.(common.NotFound); ok {
 			log.WithError(err).Warnf("File not found %s", fileName)
 			return common.NewApiError(http.StatusNotFound, errors.Errorf("Logs of type %s for cluster %s "+
 				"were not found", swag.StringValue(params.LogsType), params.ClusterID))
 		}
+		if rangeErr, ok := err.(*filemiddleware.RangeNotSatisfiableError); ok {
+			return installer.NewDownloadClusterLogsRequestedRangeNotSatisfiable().WithContentRange(rangeErr.ContentRange()).
+				WithPayload(common.GenerateError(http.StatusRequestedRangeNotSatisfiable, err))
+		}
 		log.WithError(err).Errorf("failed to download file %s", fileName)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	return filemiddleware.NewResponder(installer.NewDownloadClusterLogsOK().WithPayload(respBody), downloadFileName, contentLength)
+	if download.IsPartial() {
+		return filemiddleware.NewResponder(installer.NewDownloadClusterLogsPartialContent().
+			WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).WithContentRange(download.ContentRange()).
+			WithPayload(download.Reader), downloadFileName, download.Length())
+	}
+	return filemiddleware.NewResponder(installer.NewDownloadClusterLogsOK().
+		WithAcceptRanges(filemiddleware.AcceptRanges).WithETag(download.ETag).
+		WithPayload(download.Reader), downloadFileName, download.Length())
 }
 
 func (b *bareMetalInventory) UploadHostLogs(ctx context.Context, params installer.UploadHostLogsParams) middleware.Responder {
@@ -4247,6 +5968,10 @@ func (b *bareMetalInventory) DownloadHostLogs(ctx context.Context, params instal
 	}
 
 	respBody, contentLength, err := b.objectHandler.Download(ctx, fileName)
+	if _, ok := err.(common.NotFound); ok {
+		fileName, downloadFileName = recompressedLogsFile(fileName, downloadFileName)
+		respBody, contentLength, err = b.objectHandler.Download(ctx, fileName)
+	}
 	if err != nil {
 		if _, ok := err.(common.NotFound); ok {
 			log.WithError(err).Warnf("File not found %s", fileName)
@@ -4268,8 +5993,13 @@ func (b *bareMetalInventory) prepareClusterLogs(ctx context.Context, cluster *co
 	return fileName, nil
 }
 
+// recompressedLogsFile returns the names of the zstd archive that replaced a gzipped log archive and of its download
+func recompressedLogsFile(fileName, downloadFileName string) (string, string) {
+	return logartifacts.RecompressedObjectName(fileName), strings.TrimSuffix(downloadFileName, ".tar.gz") + ".tar.zst"
+}
+
 func (b *bareMetalInventory) getLogsFullName(clusterId string, logId string) string {
-	return fmt.Sprintf("%s/logs/%s/logs.tar.gz", clusterId, logId)
+	return logsearch.ArchiveObjectName(clusterId, logId)
 }
 
 func (b *bareMetalInventory) getHost(ctx context.Context, clusterId string, hostId string) (*common.Host, error) {
@@ -277,6 +277,21 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateClusterInstallConfigInternal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInstallConfigInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterInstallConfigInternal), arg0, arg1)
 }
 
+// UpdateClusterInstallRetryPolicyInternal mocks base method
+func (m *MockInstallerInternals) UpdateClusterInstallRetryPolicyInternal(arg0 context.Context, arg1 installer.UpdateClusterInstallRetryPolicyParams) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterInstallRetryPolicyInternal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterInstallRetryPolicyInternal indicates an expected call of UpdateClusterInstallRetryPolicyInternal
+func (mr *MockInstallerInternalsMockRecorder) UpdateClusterInstallRetryPolicyInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInstallRetryPolicyInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterInstallRetryPolicyInternal), arg0, arg1)
+}
+
 // UpdateClusterInternal mocks base method
 func (m *MockInstallerInternals) UpdateClusterInternal(arg0 context.Context, arg1 installer.UpdateClusterParams) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +307,51 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateClusterInternal(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterInternal), arg0, arg1)
 }
 
+// UpdateClusterStageTimeoutsInternal mocks base method
+func (m *MockInstallerInternals) UpdateClusterStageTimeoutsInternal(arg0 context.Context, arg1 installer.UpdateClusterStageTimeoutsParams) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterStageTimeoutsInternal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterStageTimeoutsInternal indicates an expected call of UpdateClusterStageTimeoutsInternal
+func (mr *MockInstallerInternalsMockRecorder) UpdateClusterStageTimeoutsInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterStageTimeoutsInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterStageTimeoutsInternal), arg0, arg1)
+}
+
+// UpdateClusterValidationPolicyInternal mocks base method
+func (m *MockInstallerInternals) UpdateClusterValidationPolicyInternal(arg0 context.Context, arg1 installer.UpdateClusterValidationPolicyParams) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterValidationPolicyInternal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterValidationPolicyInternal indicates an expected call of UpdateClusterValidationPolicyInternal
+func (mr *MockInstallerInternalsMockRecorder) UpdateClusterValidationPolicyInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterValidationPolicyInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterValidationPolicyInternal), arg0, arg1)
+}
+
+// UpdateClusterWorkerSuccessThresholdInternal mocks base method
+func (m *MockInstallerInternals) UpdateClusterWorkerSuccessThresholdInternal(arg0 context.Context, arg1 installer.UpdateClusterWorkerSuccessThresholdParams) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterWorkerSuccessThresholdInternal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterWorkerSuccessThresholdInternal indicates an expected call of UpdateClusterWorkerSuccessThresholdInternal
+func (mr *MockInstallerInternalsMockRecorder) UpdateClusterWorkerSuccessThresholdInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterWorkerSuccessThresholdInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateClusterWorkerSuccessThresholdInternal), arg0, arg1)
+}
+
 // UpdateDiscoveryIgnitionInternal mocks base method
 func (m *MockInstallerInternals) UpdateDiscoveryIgnitionInternal(arg0 context.Context, arg1 installer.UpdateDiscoveryIgnitionParams) error {
 	m.ctrl.T.Helper()
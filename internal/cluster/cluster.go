@@ -20,10 +20,14 @@ import (
 	"github.com/kennygrant/sanitize"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/discoveryimage"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/failureanalysis"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/logartifacts"
+	"github.com/openshift/assisted-service/internal/logsearch"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -87,6 +91,8 @@ type API interface {
 	AcceptRegistration(c *common.Cluster) (err error)
 	CancelInstallation(ctx context.Context, c *common.Cluster, reason string, db *gorm.DB) *common.ApiErrorResponse
 	ResetCluster(ctx context.Context, c *common.Cluster, reason string, db *gorm.DB) *common.ApiErrorResponse
+	PauseInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse
+	ResumeInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse
 	PrepareForInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) error
 	HandlePreInstallError(ctx context.Context, c *common.Cluster, err error)
 	HandlePreInstallSuccess(ctx context.Context, c *common.Cluster)
@@ -104,6 +110,8 @@ type API interface {
 	CompleteInstallation(ctx context.Context, db *gorm.DB, cluster *common.Cluster, successfullyFinished bool, reason string) (*common.Cluster, error)
 	PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API) error
 	DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime) error
+	// Classify the root cause of the failure of a cluster in error and store it as its failure reason
+	ClassifyFailure(ctx context.Context, c *common.Cluster, db *gorm.DB) (*common.Cluster, error)
 }
 
 type LogTimeoutConfig struct {
@@ -119,6 +127,8 @@ type Config struct {
 	PrepareConfig           PrepareConfig
 	MonitorBatchSize        int  `envconfig:"CLUSTER_MONITOR_BATCH_SIZE" default:"100"`
 	EnableSingleNodeDnsmasq bool `envconfig:"ENABLE_SINGLE_NODE_DNSMASQ" default:"false"`
+	// The limits of the log indexes that are created when the logs are searched for failure signatures
+	LogSearch logsearch.Config
 }
 
 type Manager struct {
@@ -139,6 +149,7 @@ type Manager struct {
 	objectHandler         s3wrapper.API
 	dnsApi                dns.DNSApi
 	monitorQueryGenerator *common.MonitorQueryGenerator
+	failureClassifier     *failureanalysis.Classifier
 }
 
 func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler,
@@ -149,6 +160,10 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		db:            db,
 		prepareConfig: cfg.PrepareConfig,
 	}
+	var logIndexer *logsearch.Indexer
+	if objectHandler != nil {
+		logIndexer = logsearch.NewIndexer(cfg.LogSearch, log, objectHandler)
+	}
 	return &Manager{
 		Config:                cfg,
 		log:                   log,
@@ -166,6 +181,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		ocmClient:             ocmClient,
 		objectHandler:         objectHandler,
 		dnsApi:                dnsApi,
+		failureClassifier:     failureanalysis.NewClassifier(log, eventsHandler, logIndexer),
 	}
 }
 
@@ -214,15 +230,15 @@ func (m *Manager) DeregisterCluster(ctx context.Context, c *common.Cluster) erro
 		return metricsErr
 	}
 
-	// Delete discovery image for deregistered cluster
-	discoveryImage := fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, c.ID.String()))
+	// Delete discovery image for deregistered cluster, unless it is shared with other clusters
+	discoveryImage := discoveryimage.ObjectName(c)
 	exists, err := m.objectHandler.DoesObjectExist(ctx, discoveryImage)
 	if err != nil {
 		m.log.WithError(err).Errorf("Failed to find cluster discovery image %s", discoveryImage)
 		return err
 	}
 	if exists {
-		_, err = m.objectHandler.DeleteObject(ctx, discoveryImage)
+		_, err = discoveryimage.DeleteIfUnreferenced(ctx, m.db, m.objectHandler, discoveryImage, c.ID)
 		if err != nil {
 			m.log.WithError(err).Errorf("Failed to delete cluster discovery image %s", discoveryImage)
 			return err
@@ -271,7 +287,11 @@ func (m *Manager) reportValidationStatusChanged(ctx context.Context, c *common.C
 					eventMsg := fmt.Sprintf("Cluster validation '%s' that used to succeed is now failing", v.ID)
 					m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityWarning, eventMsg, time.Now())
 				}
-				if v.Status == ValidationSuccess && currentStatus == ValidationFailure {
+				if v.Status == ValidationWarning && currentStatus == ValidationSuccess {
+					eventMsg := fmt.Sprintf("Cluster validation '%s' that used to succeed is now failing, ignored by the validation policy of the cluster", v.ID)
+					m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityWarning, eventMsg, time.Now())
+				}
+				if v.Status == ValidationSuccess && (currentStatus == ValidationFailure || currentStatus == ValidationWarning) {
 					eventMsg := fmt.Sprintf("Cluster validation '%s' is now fixed", v.ID)
 					m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo, eventMsg, time.Now())
 				}
@@ -383,6 +403,51 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, c *common.Cluster,
 	return ret, err
 }
 
+func (m *Manager) ClassifyFailure(ctx context.Context, c *common.Cluster, db *gorm.DB) (*common.Cluster, error) {
+	if db == nil {
+		db = m.db
+	}
+	cluster, err := common.GetClusterFromDB(db, *c.ID, common.UseEagerLoading)
+	if err != nil {
+		return nil, err
+	}
+	if swag.StringValue(cluster.Status) != models.ClusterStatusError {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("cluster %s is in %s status, only the failures of clusters in error are classified",
+				cluster.ID, swag.StringValue(cluster.Status)))
+	}
+	return m.classifyFailure(ctx, db, cluster), nil
+}
+
+// classifyFailure stores the failure reason of the cluster. A cluster whose failure can't be stored keeps its
+// previous failure reason, since the classification doesn't change the status of the cluster.
+func (m *Manager) classifyFailure(ctx context.Context, db *gorm.DB, c *common.Cluster) *common.Cluster {
+	log := logutil.FromContext(ctx, m.log)
+	reason := m.failureClassifier.Classify(ctx, c)
+	value, err := common.MarshalJSONColumn(reason)
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal the failure reason of cluster %s", c.ID)
+		return c
+	}
+	if err = db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Update("failure_reason", value).Error; err != nil {
+		log.WithError(err).Errorf("failed to update the failure reason of cluster %s", c.ID)
+		return c
+	}
+	log.Infof("Classified the failure of cluster %s as %s: %s", c.ID, reason.Category, swag.StringValue(reason.Summary))
+	c.FailureReason = value
+	return c
+}
+
+// classifyNewFailure classifies the failure of a cluster that entered error since it was last monitored. The
+// classification searches the events and the logs of the cluster, so it runs once per failure and outside of the
+// refresh of the cluster status.
+func (m *Manager) classifyNewFailure(ctx context.Context, c *common.Cluster) {
+	if swag.StringValue(c.Status) != models.ClusterStatusError || c.FailureReason != "" {
+		return
+	}
+	m.classifyFailure(ctx, m.db, c)
+}
+
 func (m *Manager) SetUploadControllerLogsAt(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
 	err := db.Model(c).Update("controller_logs_collected_at", strfmt.DateTime(time.Now())).Error
 	if err != nil {
@@ -483,6 +548,7 @@ func (m *Manager) initMonitorQueryGenerator() {
 	if m.monitorQueryGenerator == nil {
 		noNeedToMonitorInStates := []string{
 			models.ClusterStatusInstalled,
+			models.ClusterStatusInstallingPaused, // until the installation is resumed
 		}
 
 		dbWithCondition := m.db.Preload("Hosts", "status <> ?", models.HostStatusDisabled).Preload(common.MonitoredOperatorsTable).
@@ -555,6 +621,9 @@ func (m *Manager) ClusterMonitoring() {
 				if m.shouldTriggerLeaseTimeoutEvent(cluster, curMonitorInvokedAt) {
 					m.triggerLeaseTimeoutEvent(ctx, cluster)
 				}
+				m.classifyNewFailure(ctx, clusterAfterRefresh)
+			} else {
+				m.classifyNewFailure(ctx, cluster)
 			}
 		}
 		offset += limit
@@ -662,6 +731,44 @@ func (m *Manager) CancelInstallation(ctx context.Context, c *common.Cluster, rea
 	return nil
 }
 
+func (m *Manager) PauseInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse {
+	eventSeverity := models.EventSeverityInfo
+	eventInfo := "Paused cluster installation"
+	defer func() {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, eventSeverity, eventInfo, time.Now())
+	}()
+
+	err := m.sm.Run(TransitionTypePauseInstallation, newStateCluster(c), &TransitionArgsPauseInstallation{
+		ctx: ctx,
+		db:  db,
+	})
+	if err != nil {
+		eventSeverity = models.EventSeverityError
+		eventInfo = fmt.Sprintf("Failed to pause installation: %s", err.Error())
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
+func (m *Manager) ResumeInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse {
+	eventSeverity := models.EventSeverityInfo
+	eventInfo := "Resumed cluster installation"
+	defer func() {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, eventSeverity, eventInfo, time.Now())
+	}()
+
+	err := m.sm.Run(TransitionTypeResumeInstallation, newStateCluster(c), &TransitionArgsResumeInstallation{
+		ctx: ctx,
+		db:  db,
+	})
+	if err != nil {
+		eventSeverity = models.EventSeverityError
+		eventInfo = fmt.Sprintf("Failed to resume installation: %s", err.Error())
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
 func (m *Manager) UpdateLogsProgress(ctx context.Context, c *common.Cluster, progress string) error {
 	err := updateLogsProgress(logutil.FromContext(ctx, m.log), m.db, c, swag.StringValue(c.Status), progress)
 	return err
@@ -794,7 +901,7 @@ func (m *Manager) CreateTarredClusterLogs(ctx context.Context, c *common.Cluster
 		return "", common.NewApiError(http.StatusNotFound, err)
 	}
 	files = funk.Filter(files, func(x string) bool {
-		return x != fileName
+		return x != fileName && !logsearch.IsIndexObject(x)
 	}).([]string)
 
 	var tarredFilenames []string
@@ -813,7 +920,11 @@ func (m *Manager) CreateTarredClusterLogs(ctx context.Context, c *common.Cluster
 					if hostObject.Bootstrap {
 						role = string(models.HostRoleBootstrap)
 					}
-					tarredFilename = fmt.Sprintf("%s_%s_%s.tar.gz", sanitize.Name(c.Name), role, sanitize.Name(hostutil.GetHostnameForMsg(hostObject)))
+					extension := ".tar.gz"
+					if logartifacts.IsRecompressed(file) {
+						extension = ".tar.zst"
+					}
+					tarredFilename = fmt.Sprintf("%s_%s_%s%s", sanitize.Name(c.Name), role, sanitize.Name(hostutil.GetHostnameForMsg(hostObject)), extension)
 				}
 			} else {
 				tarredFilename = fmt.Sprintf("%s_%s", fileNameSplit[len(fileNameSplit)-2], fileNameSplit[len(fileNameSplit)-1])
@@ -921,10 +1032,12 @@ func (m *Manager) deleteClusterFiles(ctx context.Context, c *common.Cluster, obj
 			errors.Errorf(msg))
 	}
 
+	eventArchivesPath := filepath.Join(string(*c.ID), events.ArchivePrefix) + "/"
 	var failedToDelete []string
 	for _, file := range files {
-		//skip log and manifests deletion when deleting cluster files
-		if folder == "" && (strings.Contains(file, "logs") || strings.Contains(file, "manifests")) {
+		//skip log, manifests and archived events deletion when deleting cluster files
+		if folder == "" && (strings.Contains(file, "logs") || strings.Contains(file, "manifests") ||
+			strings.HasPrefix(file, eventArchivesPath)) {
 			continue
 		}
 		log.Debugf("Deleting cluster %s S3 file: %s", c.ID.String(), file)
@@ -947,6 +1060,10 @@ func (m *Manager) deleteClusterManifests(ctx context.Context, c *common.Cluster,
 	return m.deleteClusterFiles(ctx, c, objectHandler, "manifests")
 }
 
+func (m *Manager) deleteClusterEventArchives(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
+	return m.deleteClusterFiles(ctx, c, objectHandler, events.ArchivePrefix)
+}
+
 func (m *Manager) DeleteClusterLogs(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
 	return m.deleteClusterFiles(ctx, c, objectHandler, "logs")
 }
@@ -955,6 +1072,18 @@ func (m *Manager) DeleteClusterFiles(ctx context.Context, c *common.Cluster, obj
 	return m.deleteClusterFiles(ctx, c, objectHandler, "")
 }
 
+// deleteClusterDiscoveryImage deletes the shared discovery image of a deleted cluster, unless other clusters still
+// point at it. Legacy images are deleted when the cluster is deregistered.
+func (m *Manager) deleteClusterDiscoveryImage(ctx context.Context, c *common.Cluster, objectHandler s3wrapper.API) error {
+	if !discoveryimage.IsShared(c.ImageObjectName) {
+		return nil
+	}
+	if _, err := discoveryimage.DeleteIfUnreferenced(ctx, m.db, objectHandler, c.ImageObjectName, c.ID); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
 func (m Manager) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime) error {
 	log := logutil.FromContext(ctx, m.log)
 
@@ -998,6 +1127,14 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 			deleteFromDB = false
 			m.log.WithError(err).Warnf("Failed deleting s3 manifests of cluster %s", c.ID.String())
 		}
+		if err := m.deleteClusterEventArchives(ctx, c, objectHandler); err != nil {
+			deleteFromDB = false
+			m.log.WithError(err).Warnf("Failed deleting s3 archived events of cluster %s", c.ID.String())
+		}
+		if err := m.deleteClusterDiscoveryImage(ctx, c, objectHandler); err != nil {
+			deleteFromDB = false
+			m.log.WithError(err).Warnf("Failed deleting discovery image of cluster %s", c.ID.String())
+		}
 		if !deleteFromDB {
 			continue
 		}
@@ -1013,13 +1150,34 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 			m.log.WithError(err).Warnf("Failed deleting events from db for cluster %s", c.ID.String())
 		}
 
+		if err := common.DeleteRecordsByClusterID(db, *c.ID, common.EventArchive{}); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting archived events from db for cluster %s", c.ID.String())
+		}
+
 		if err := common.DeleteRecordsByClusterID(db, *c.ID, models.MonitoredOperator{}); err != nil {
 			m.log.WithError(err).Warnf("Failed deleting operators from db for cluster %s", c.ID.String())
 		}
+
+		if err := deleteClusterWebhooks(db, *c.ID); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting webhooks from db for cluster %s", c.ID.String())
+		}
 	}
 	return nil
 }
 
+// deleteClusterWebhooks deletes the webhooks of the cluster along with their deliveries, and the deliveries of the
+// notifications about the cluster to any other webhook
+func deleteClusterWebhooks(db *gorm.DB, clusterID strfmt.UUID) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("cluster_id = ? OR webhook_id IN ?", clusterID,
+			tx.Model(&common.Webhook{}).Select("id").Where("cluster_id = ?", clusterID).SubQuery()).
+			Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return common.DeleteRecordsByClusterID(tx, clusterID, common.Webhook{})
+	})
+}
+
 func (m *Manager) GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster, error) {
 	c, err := common.GetClusterFromDBWhere(m.db, common.UseEagerLoading, common.SkipDeletedRecords, "kube_key_name = ? and kube_key_namespace = ?", key.Name, key.Namespace)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/discoveryimage"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -167,6 +168,9 @@ var _ = Describe("TestClusterMonitoring", func() {
 				c = createCluster(&id, "installing", statusInfoInstalling)
 				mockMetric.EXPECT().ClusterInstallationFinished(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 				mockHostAPI.EXPECT().IsValidMasterCandidate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+				// The failure of the clusters that end in error is classified
+				mockEvents.EXPECT().QueryEvents(gomock.Any()).Return(nil, int64(0), nil).AnyTimes()
+				mockS3Client.EXPECT().GetObjectInfo(gomock.Any(), gomock.Any()).Return(nil, common.NotFound("logs")).AnyTimes()
 			})
 
 			It("installing -> installing", func() {
@@ -511,6 +515,9 @@ var _ = Describe("TestClusterMonitoring", func() {
 			after := time.Now().Truncate(10 * time.Millisecond)
 			c = getClusterFromDB(id, db)
 			Expect(swag.StringValue(c.Status)).Should(Equal(expectedState))
+			if expectedState == models.ClusterStatusError {
+				Expect(c.FailureReason).ShouldNot(BeEmpty())
+			}
 			if shouldHaveUpdated {
 				Expect(c.StatusInfo).ShouldNot(BeNil())
 				updateTime := time.Time(c.StatusUpdatedAt).Truncate(10 * time.Millisecond)
@@ -599,6 +606,7 @@ var _ = Describe("TestClusterMonitoring", func() {
 					MachineNetworkCidr: "1.1.0.0/16",
 					BaseDNSDomain:      "test.com",
 					PullSecretSet:      true,
+					FailureReason:      `{"category":"unknown"}`,
 				}}
 
 				Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
@@ -622,6 +630,17 @@ var _ = Describe("TestClusterMonitoring", func() {
 				Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusError))
 				Expect(c.LogsInfo).Should(Equal(progress))
 			})
+			It("classifies the failure once", func() {
+				Expect(db.Model(&c).Update("failure_reason", "").Error).ShouldNot(HaveOccurred())
+				mockEvents.EXPECT().QueryEvents(gomock.Any()).Return(nil, int64(0), nil).Times(1)
+				mockS3Client.EXPECT().GetObjectInfo(gomock.Any(), gomock.Any()).Return(nil, common.NotFound("logs")).AnyTimes()
+				clusterApi.ClusterMonitoring()
+				clusterApi.ClusterMonitoring()
+				c = getClusterFromDB(id, db)
+				reason, err := common.GetFailureReason(&c)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(reason).ShouldNot(BeNil())
+			})
 		})
 
 	})
@@ -1294,6 +1313,77 @@ var _ = Describe("CancelInstallation", func() {
 	})
 })
 
+var _ = Describe("PauseInstallation and ResumeInstallation", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		state         API
+		c             common.Cluster
+		eventsHandler events.Handler
+		ctrl          *gomock.Controller
+		dbName        string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		eventsHandler = events.New(db, logrus.New())
+		ctrl = gomock.NewController(GinkgoT())
+		mockOperators := operators.NewMockAPI(ctrl)
+		dummy := &leader.DummyElector{}
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, dummy, mockOperators, nil, nil, nil)
+		id := strfmt.UUID(uuid.New().String())
+		c = common.Cluster{Cluster: models.Cluster{
+			ID:         &id,
+			Status:     swag.String(models.ClusterStatusInstalling),
+			StatusInfo: swag.String(statusInfoInstalling)}}
+	})
+
+	lastEvent := func() *common.Event {
+		events, err := eventsHandler.GetEvents(*c.ID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(len(events)).ShouldNot(Equal(0))
+		return events[len(events)-1]
+	}
+
+	It("pauses and resumes an installing cluster", func() {
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+
+		Expect(state.PauseInstallation(ctx, &c, db)).ShouldNot(HaveOccurred())
+		Expect(*lastEvent().Message).Should(Equal("Paused cluster installation"))
+		Expect(db.First(&c, "id = ?", c.ID).Error).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusInstallingPaused))
+		Expect(swag.StringValue(c.StatusInfo)).Should(Equal(statusInfoInstallingPaused))
+
+		Expect(state.ResumeInstallation(ctx, &c, db)).ShouldNot(HaveOccurred())
+		Expect(*lastEvent().Message).Should(Equal("Resumed cluster installation"))
+		Expect(db.First(&c, "id = ?", c.ID).Error).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(c.Status)).Should(Equal(models.ClusterStatusInstalling))
+		Expect(swag.StringValue(c.StatusInfo)).Should(Equal(statusInfoInstalling))
+	})
+
+	It("can't pause a cluster that isn't installing", func() {
+		c.Status = swag.String(models.ClusterStatusFinalizing)
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		err := state.PauseInstallation(ctx, &c, db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.StatusCode()).Should(Equal(int32(http.StatusConflict)))
+		Expect(*lastEvent().Severity).Should(Equal(models.EventSeverityError))
+	})
+
+	It("can't resume a cluster that isn't paused", func() {
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		err := state.ResumeInstallation(ctx, &c, db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.StatusCode()).Should(Equal(int32(http.StatusConflict)))
+		Expect(*lastEvent().Severity).Should(Equal(models.EventSeverityError))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+})
+
 var _ = Describe("ResetCluster", func() {
 	var (
 		ctx           = context.Background()
@@ -2240,6 +2330,16 @@ var _ = Describe("Cluster tarred files", func() {
 		_, err := capi.CreateTarredClusterLogs(ctx, &cl, mockS3Client)
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("keeps the archived events when deleting the cluster files", func() {
+		archive := fmt.Sprintf("%s/events/cluster/1-2.jsonl.gz", clusterId)
+		others := []string{fmt.Sprintf("%s/kubeconfig", clusterId), fmt.Sprintf("%s/install-events.json", clusterId)}
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, fmt.Sprintf("%s/", clusterId)).Return(append(others, archive), nil)
+		for _, file := range others {
+			mockS3Client.EXPECT().DeleteObject(ctx, file).Return(true, nil)
+		}
+		Expect(capi.DeleteClusterFiles(ctx, &cl, mockS3Client)).ShouldNot(HaveOccurred())
+	})
 })
 
 var _ = Describe("GenerateAdditionalManifests", func() {
@@ -2576,11 +2676,34 @@ var _ = Describe("Permanently delete clusters", func() {
 		Expect(db.First(&common.Cluster{}, "id = ?", c2.ID).RowsAffected).Should(Equal(int64(0)))
 		Expect(db.Unscoped().First(&common.Cluster{}, "id = ?", c2.ID).RowsAffected).Should(Equal(int64(1)))
 
+		Expect(db.Create(&common.EventArchive{ObjectName: "archive", ClusterID: *c1.ID}).Error).ShouldNot(HaveOccurred())
+
+		createWebhook := func(clusterID strfmt.UUID) strfmt.UUID {
+			id := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Webhook{Webhook: models.Webhook{ID: &id, ClusterID: clusterID}}).Error).ShouldNot(HaveOccurred())
+			return id
+		}
+		createDelivery := func(webhookID, clusterID strfmt.UUID) {
+			id := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.WebhookDelivery{ID: &id, WebhookID: &webhookID, ClusterID: clusterID}).Error).ShouldNot(HaveOccurred())
+		}
+		c1Webhook := createWebhook(*c1.ID)
+		c3Webhook := createWebhook(*c3.ID)
+		globalWebhook := createWebhook("")
+		createDelivery(c1Webhook, *c1.ID)
+		createDelivery(globalWebhook, *c1.ID)
+		createDelivery(globalWebhook, *c3.ID)
+		createDelivery(c3Webhook, *c3.ID)
+
 		mockS3Api.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
 		mockS3Api.EXPECT().ListObjectsByPrefix(gomock.Any(), gomock.Any()).Return([]string{}, nil).AnyTimes()
 
 		Expect(state.PermanentClustersDeletion(ctx, strfmt.DateTime(time.Now()), mockS3Api)).ShouldNot(HaveOccurred())
 
+		var archives []*common.EventArchive
+		Expect(db.Find(&archives, "cluster_id = ?", *c1.ID).Error).ShouldNot(HaveOccurred())
+		Expect(archives).Should(HaveLen(0))
+
 		Expect(db.Unscoped().Where("id = ?", c1.ID).Find(&common.Cluster{}).RowsAffected).Should(Equal(int64(0)))
 		Expect(db.Unscoped().Where("id = ?", c2.ID).Find(&common.Cluster{}).RowsAffected).Should(Equal(int64(0)))
 		Expect(db.Unscoped().Where("id = ?", c3.ID).Find(&common.Cluster{}).RowsAffected).Should(Equal(int64(1)))
@@ -2598,6 +2721,17 @@ var _ = Describe("Permanently delete clusters", func() {
 		var operators []*models.MonitoredOperator
 		Expect(db.Find(&operators, "cluster_id = ?", *c1.ID).Error).ShouldNot(HaveOccurred())
 		Expect(operators).Should(HaveLen(0))
+
+		var webhooks []*common.Webhook
+		Expect(db.Order("id").Find(&webhooks).Error).ShouldNot(HaveOccurred())
+		Expect(webhooks).Should(HaveLen(2))
+		Expect(db.Where("id = ?", c1Webhook.String()).Find(&common.Webhook{}).RowsAffected).Should(Equal(int64(0)))
+		var deliveries []*models.WebhookDelivery
+		Expect(db.Find(&deliveries).Error).ShouldNot(HaveOccurred())
+		Expect(deliveries).Should(HaveLen(2))
+		for _, d := range deliveries {
+			Expect(d.ClusterID).Should(Equal(*c3.ID))
+		}
 	})
 
 	It("permanently delete clusters - nothing to delete", func() {
@@ -2819,6 +2953,27 @@ var _ = Describe("Validation metrics and events", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 	})
 
+	It("Test DeregisterCluster keeps a shared discovery image that is still used", func() {
+		imageName := discoveryimage.Content{BaseISOObject: "rhcos.iso"}.ObjectName()
+		expiresAt := strfmt.DateTime(time.Now().Add(time.Hour))
+		c.ImageObjectName = imageName
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Updates(map[string]interface{}{
+			"image_object_name": imageName, "image_expires_at": expiresAt}).Error).ShouldNot(HaveOccurred())
+		otherID := strfmt.UUID(uuid.New().String())
+		other := common.Cluster{Cluster: models.Cluster{ID: &otherID, ImageInfo: &models.ImageInfo{ExpiresAt: expiresAt}},
+			ImageObjectName: imageName}
+		Expect(db.Create(&other).Error).ShouldNot(HaveOccurred())
+
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), imageName).Return(true, nil).Times(1)
+		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Times(0)
+		mockHost.EXPECT().ReportValidationFailedMetrics(ctx, gomock.Any(), openshiftVersion, emailDomain)
+		mockMetric.EXPECT().ClusterValidationFailed(openshiftVersion, emailDomain, models.ClusterValidationIDSufficientMastersCount)
+		mockEvents.EXPECT().AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
+
+		err := m.DeregisterCluster(ctx, c)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
 	It("Test reportValidationStatusChanged", func() {
 		mockEvents.EXPECT().AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo, gomock.Any(), gomock.Any())
 
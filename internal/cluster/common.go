@@ -10,6 +10,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/internal/webhooks"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/pkg/errors"
@@ -33,8 +34,10 @@ const (
 	statusInfoPreparingForInstallationTimeout = "Preparing cluster for installation timeout"
 	statusInfoPendingForInput                 = "User input required"
 	statusInfoError                           = "cluster has hosts in error"
+	statusInfoNotEnoughWorkers                = "cluster has too few workers that can be installed: $WORKERS_SUMMARY"
 	statusInfoAddingHosts                     = "cluster is adding hosts to existing OCP cluster"
 	statusInfoInstallingPendingUserAction     = "Cluster has hosts with wrong boot order"
+	statusInfoInstallingPaused                = "Installation was paused by user"
 	statusInfoUnpreparingHostExists           = "At least one host has stopped preparing for installation"
 	statusInfoClusterFailedToPrepare          = "Cluster failed to prepare for installation"
 )
@@ -63,6 +66,7 @@ func updateClusterStatus(log logrus.FieldLogger, db *gorm.DB, clusterId strfmt.U
 
 	if newStatus != srcStatus {
 		log.Infof("cluster %s has been updated with the following updates %+v", clusterId, extra)
+		webhooks.NotifyClusterStatus(log, db, cluster)
 	}
 
 	return cluster, nil
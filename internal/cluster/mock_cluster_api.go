@@ -365,6 +365,34 @@ func (mr *MockAPIMockRecorder) ResetCluster(ctx, c, reason, db interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCluster", reflect.TypeOf((*MockAPI)(nil).ResetCluster), ctx, c, reason, db)
 }
 
+// PauseInstallation mocks base method
+func (m *MockAPI) PauseInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseInstallation", ctx, c, db)
+	ret0, _ := ret[0].(*common.ApiErrorResponse)
+	return ret0
+}
+
+// PauseInstallation indicates an expected call of PauseInstallation
+func (mr *MockAPIMockRecorder) PauseInstallation(ctx, c, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseInstallation", reflect.TypeOf((*MockAPI)(nil).PauseInstallation), ctx, c, db)
+}
+
+// ResumeInstallation mocks base method
+func (m *MockAPI) ResumeInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) *common.ApiErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeInstallation", ctx, c, db)
+	ret0, _ := ret[0].(*common.ApiErrorResponse)
+	return ret0
+}
+
+// ResumeInstallation indicates an expected call of ResumeInstallation
+func (mr *MockAPIMockRecorder) ResumeInstallation(ctx, c, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeInstallation", reflect.TypeOf((*MockAPI)(nil).ResumeInstallation), ctx, c, db)
+}
+
 // PrepareForInstallation mocks base method
 func (m *MockAPI) PrepareForInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -602,3 +630,18 @@ func (mr *MockAPIMockRecorder) DeregisterInactiveCluster(ctx, maxDeregisterPerIn
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInactiveCluster", reflect.TypeOf((*MockAPI)(nil).DeregisterInactiveCluster), ctx, maxDeregisterPerInterval, inactiveSince)
 }
+
+// ClassifyFailure mocks base method
+func (m *MockAPI) ClassifyFailure(ctx context.Context, c *common.Cluster, db *gorm.DB) (*common.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClassifyFailure", ctx, c, db)
+	ret0, _ := ret[0].(*common.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClassifyFailure indicates an expected call of ClassifyFailure
+func (mr *MockAPIMockRecorder) ClassifyFailure(ctx, c, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClassifyFailure", reflect.TypeOf((*MockAPI)(nil).ClassifyFailure), ctx, c, db)
+}
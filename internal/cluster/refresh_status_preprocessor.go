@@ -6,10 +6,11 @@ import (
 	"strings"
 
 	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/operators"
-	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 )
@@ -57,10 +58,22 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	if !funk.ContainsString(checkValidationsInStatuses, swag.StringValue(c.cluster.Status)) {
 		return stateMachineInput, validationsOutput, nil
 	}
+	policy, err := common.GetValidationPolicy(c.cluster)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse the validation policy of cluster %s", c.clusterId.String())
+	}
 	for _, v := range r.validations {
-		st := v.condition(c)
-		stateMachineInput[v.id.String()] = st == ValidationSuccess
-		message := v.formatter(c, st)
+		var st ValidationStatus
+		var message string
+		if common.IsClusterValidationDisabledByPolicy(policy, v.id.String()) {
+			st = ValidationDisabled
+			message = validationDisabledByPolicy
+		} else {
+			st = v.condition(c)
+			message = v.formatter(c, st)
+			st = applyWarningPolicy(policy, v.id, st)
+		}
+		stateMachineInput[v.id.String()] = isValidationPassing(st)
 		category, err := v.id.Category()
 		if err != nil {
 			logrus.WithError(err).Warn("id.category()")
@@ -78,7 +91,6 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 		return nil, nil, err
 	}
 	for _, result := range results {
-		stateMachineInput[result.ValidationId] = result.Status == api.Success
 		id := ValidationID(result.ValidationId)
 		category, err := id.Category()
 		if err != nil {
@@ -87,10 +99,18 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 		}
 
 		status := ValidationStatus(result.Status)
+		message := strings.Join(result.Reasons, "\n")
+		if common.IsClusterValidationDisabledByPolicy(policy, id.String()) {
+			status = ValidationDisabled
+			message = validationDisabledByPolicy
+		} else {
+			status = applyWarningPolicy(policy, id, status)
+		}
+		stateMachineInput[result.ValidationId] = isValidationPassing(status)
 		validationsOutput[category] = append(validationsOutput[category], ValidationResult{
 			ID:      id,
 			Status:  status,
-			Message: strings.Join(result.Reasons, "\n"),
+			Message: message,
 		})
 	}
 
@@ -103,6 +123,22 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	return stateMachineInput, validationsOutput, nil
 }
 
+const validationDisabledByPolicy = "Validation disabled by the validation policy of the cluster"
+
+// applyWarningPolicy downgrades the failure of a validation to a warning when the validation policy of the
+// cluster says so
+func applyWarningPolicy(policy *models.ValidationPolicy, id ValidationID, status ValidationStatus) ValidationStatus {
+	if status == ValidationFailure && common.IsClusterValidationWarningByPolicy(policy, id.String()) {
+		return ValidationWarning
+	}
+	return status
+}
+
+// isValidationPassing returns whether the validation doesn't prevent the installation
+func isValidationPassing(status ValidationStatus) bool {
+	return status == ValidationSuccess || status == ValidationWarning || status == ValidationDisabled
+}
+
 // sortByValidationResultID sorts results by models.ClusterValidationID
 func sortByValidationResultID(validationResults []ValidationResult) {
 	sort.SliceStable(validationResults, func(i, j int) bool {
@@ -187,6 +223,11 @@ func newValidations(v *clusterValidator) []validation {
 			condition: v.isNtpServerConfigured,
 			formatter: v.printNtpServerConfigured,
 		},
+		{
+			id:        AreDomainNamesResolvedConsistently,
+			condition: v.areDomainNamesResolvedConsistently,
+			formatter: v.printDomainNamesResolvedConsistently,
+		},
 	}
 	return ret
 }
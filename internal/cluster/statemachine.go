@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"github.com/filanov/stateswitch"
+	"github.com/openshift/assisted-service/internal/statemachine"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -11,10 +12,12 @@ const (
 	TransitionTypePrepareForInstallation     = "PrepareForInstallation"
 	TransitionTypeHandlePreInstallationError = "Handle pre-installation-error"
 	TransitionTypeRefreshStatus              = "RefreshStatus"
+	TransitionTypePauseInstallation          = "PauseInstallation"
+	TransitionTypeResumeInstallation         = "ResumeInstallation"
 )
 
-func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
-	sm := stateswitch.NewStateMachine()
+func NewClusterStateMachine(th *transitionHandler) *statemachine.Recorder {
+	sm := statemachine.NewRecorder("cluster")
 
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeCancelInstallation,
@@ -23,6 +26,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
 			stateswitch.State(models.ClusterStatusError),
 			stateswitch.State(models.ClusterStatusFinalizing),
+			stateswitch.State(models.ClusterStatusInstallingPaused),
 		},
 		DestinationState: stateswitch.State(models.ClusterStatusCancelled),
 		PostTransition:   th.PostCancelInstallation,
@@ -46,11 +50,32 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.ClusterStatusError),
 			stateswitch.State(models.ClusterStatusCancelled),
 			stateswitch.State(models.ClusterStatusFinalizing),
+			stateswitch.State(models.ClusterStatusInstallingPaused),
 		},
 		DestinationState: stateswitch.State(models.ClusterStatusInsufficient),
 		PostTransition:   th.PostResetCluster,
 	})
 
+	// Pause the installation, the hosts that are installing are paused as well
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypePauseInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.ClusterStatusInstalling),
+		},
+		DestinationState: stateswitch.State(models.ClusterStatusInstallingPaused),
+		PostTransition:   th.PostPauseInstallation,
+	})
+
+	// Resume a paused installation, the next refresh of the cluster moves it on according to its hosts
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeResumeInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.ClusterStatusInstallingPaused),
+		},
+		DestinationState: stateswitch.State(models.ClusterStatusInstalling),
+		PostTransition:   th.PostResumeInstallation,
+	})
+
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypePrepareForInstallation,
 		SourceStates: []stateswitch.State{
@@ -60,18 +85,19 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		PostTransition:   th.PostPrepareForInstallation,
 	})
 
-	var pendingConditions = stateswitch.And(If(IsMachineCidrDefined), If(isClusterCidrDefined), If(isServiceCidrDefined), If(IsDNSDomainDefined), If(IsPullSecretSet))
-	var vipsDefinedConditions = stateswitch.And(If(IsApiVipDefined), If(IsIngressVipDefined))
-	var requiredForInstall = stateswitch.And(If(IsMachineCidrEqualsToCalculatedCidr), If(IsApiVipValid), If(IsIngressVipValid), If(AllHostsAreReadyToInstall),
-		If(SufficientMastersCount), If(networkPrefixValid), If(noCidrOverlapping), If(IsNtpServerConfigured), If(IsOcsRequirementsSatisfied), If(IsLsoRequirementsSatisfied), If(IsCnvRequirementsSatisfied))
+	var pendingConditions = statemachine.And(If(IsMachineCidrDefined), If(isClusterCidrDefined), If(isServiceCidrDefined), If(IsDNSDomainDefined), If(IsPullSecretSet))
+	var vipsDefinedConditions = statemachine.And(If(IsApiVipDefined), If(IsIngressVipDefined))
+	var requiredForInstall = statemachine.And(If(IsMachineCidrEqualsToCalculatedCidr), If(IsApiVipValid), If(IsIngressVipValid), If(AllHostsAreReadyToInstall),
+		If(SufficientMastersCount), If(networkPrefixValid), If(noCidrOverlapping), If(IsNtpServerConfigured), If(IsOcsRequirementsSatisfied), If(IsLsoRequirementsSatisfied), If(IsCnvRequirementsSatisfied),
+		If(AreDomainNamesResolvedConsistently))
 
 	// Refresh cluster status conditions - Non DHCP
-	var requiredInputFieldsExistNonDhcp = stateswitch.And(vipsDefinedConditions, pendingConditions)
+	var requiredInputFieldsExistNonDhcp = statemachine.And(vipsDefinedConditions, pendingConditions)
 
 	// Refresh cluster status conditions - DHCP
-	var isSufficientForInstallDhcp = stateswitch.And(requiredForInstall, vipsDefinedConditions)
+	var isSufficientForInstallDhcp = statemachine.And(requiredForInstall, vipsDefinedConditions)
 
-	var allRefreshStatusConditions = stateswitch.And(pendingConditions, vipsDefinedConditions, requiredForInstall)
+	var allRefreshStatusConditions = statemachine.And(pendingConditions, vipsDefinedConditions, requiredForInstall)
 
 	// Non DHCP transitions
 
@@ -84,7 +110,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.ClusterStatusReady),
 			stateswitch.State(models.ClusterStatusInsufficient),
 		},
-		Condition:        stateswitch.And(stateswitch.Not(If(VipDhcpAllocationSet)), stateswitch.Not(requiredInputFieldsExistNonDhcp)),
+		Condition:        statemachine.And(statemachine.Not(If(VipDhcpAllocationSet)), statemachine.Not(requiredInputFieldsExistNonDhcp)),
 		DestinationState: stateswitch.State(models.ClusterStatusPendingForInput),
 		PostTransition:   th.PostRefreshCluster(statusInfoPendingForInput),
 	})
@@ -99,7 +125,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.ClusterStatusReady),
 			stateswitch.State(models.ClusterStatusInsufficient),
 		},
-		Condition:        stateswitch.And(stateswitch.Not(If(VipDhcpAllocationSet)), requiredInputFieldsExistNonDhcp, stateswitch.Not(requiredForInstall)),
+		Condition:        statemachine.And(statemachine.Not(If(VipDhcpAllocationSet)), requiredInputFieldsExistNonDhcp, statemachine.Not(requiredForInstall)),
 		DestinationState: stateswitch.State(models.ClusterStatusInsufficient),
 		PostTransition:   th.PostRefreshCluster(StatusInfoInsufficient),
 	})
@@ -115,7 +141,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.ClusterStatusReady),
 			stateswitch.State(models.ClusterStatusInsufficient),
 		},
-		Condition:        stateswitch.And(If(VipDhcpAllocationSet), stateswitch.Not(pendingConditions)),
+		Condition:        statemachine.And(If(VipDhcpAllocationSet), statemachine.Not(pendingConditions)),
 		DestinationState: stateswitch.State(models.ClusterStatusPendingForInput),
 		PostTransition:   th.PostRefreshCluster(statusInfoPendingForInput),
 	})
@@ -130,7 +156,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.ClusterStatusReady),
 			stateswitch.State(models.ClusterStatusInsufficient),
 		},
-		Condition:        stateswitch.And(If(VipDhcpAllocationSet), pendingConditions, stateswitch.Not(isSufficientForInstallDhcp)),
+		Condition:        statemachine.And(If(VipDhcpAllocationSet), pendingConditions, statemachine.Not(isSufficientForInstallDhcp)),
 		DestinationState: stateswitch.State(models.ClusterStatusInsufficient),
 		PostTransition:   th.PostRefreshCluster(StatusInfoInsufficient),
 	})
@@ -160,7 +186,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType:   TransitionTypeRefreshStatus,
 		SourceStates:     []stateswitch.State{stateswitch.State(models.ClusterStatusPreparingForInstallation)},
-		Condition:        stateswitch.And(If(AllHostsPreparedSuccessfully), If(ClusterPreparationSucceeded)),
+		Condition:        statemachine.And(If(AllHostsPreparedSuccessfully), If(ClusterPreparationSucceeded)),
 		DestinationState: stateswitch.State(models.ClusterStatusInstalling),
 		Transition:       th.InstallCluster,
 		PostTransition:   th.PostRefreshCluster(statusInfoInstalling),
@@ -177,17 +203,30 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType:   TransitionTypeRefreshStatus,
 		SourceStates:     []stateswitch.State{stateswitch.State(models.ClusterStatusPreparingForInstallation)},
-		Condition:        stateswitch.And(stateswitch.Not(If(UnPreparingtHostsExist)), If(ClusterPreparationFailed)),
+		Condition:        statemachine.And(statemachine.Not(If(UnPreparingtHostsExist)), If(ClusterPreparationFailed)),
 		DestinationState: stateswitch.State(models.ClusterStatusReady),
 		PostTransition:   th.PostRefreshCluster(statusInfoClusterFailedToPrepare),
 	})
 
+	// This transition is fired when the installation of the cluster can no longer succeed since too few of its workers
+	// can be installed
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefreshStatus,
 		SourceStates: []stateswitch.State{
+			stateswitch.State(models.ClusterStatusInstalling),
 			stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
 		},
-		Condition:        stateswitch.Not(th.IsInstalling),
+		Condition:        th.IsMissingWorkers,
+		DestinationState: stateswitch.State(models.ClusterStatusError),
+		PostTransition:   th.PostRefreshCluster(statusInfoNotEnoughWorkers),
+	})
+
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefreshStatus,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
+		},
+		Condition:        statemachine.Not(th.IsInstalling),
 		DestinationState: stateswitch.State(models.ClusterStatusError),
 		PostTransition:   th.PostRefreshCluster(statusInfoError),
 	})
@@ -197,7 +236,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
 		},
-		Condition: stateswitch.And(
+		Condition: statemachine.And(
 			th.IsInstallingPendingUserAction,
 			th.IsInstalling),
 		DestinationState: stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
@@ -208,8 +247,8 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
 		},
-		Condition: stateswitch.And(
-			stateswitch.Not(th.IsInstallingPendingUserAction),
+		Condition: statemachine.And(
+			statemachine.Not(th.IsInstallingPendingUserAction),
 			th.IsInstalling),
 		DestinationState: stateswitch.State(models.ClusterStatusInstalling),
 		PostTransition:   th.PostRefreshCluster(statusInfoInstalling),
@@ -220,7 +259,7 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.ClusterStatusInstalling),
 		},
-		Condition: stateswitch.And(
+		Condition: statemachine.And(
 			th.IsInstalling,
 			th.IsInstallingPendingUserAction),
 		DestinationState: stateswitch.State(models.ClusterStatusInstallingPendingUserAction),
@@ -233,9 +272,9 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.ClusterStatusInstalling),
 		},
-		Condition: stateswitch.And(
+		Condition: statemachine.And(
 			th.IsFinalizing,
-			stateswitch.Not(th.IsInstallingPendingUserAction)),
+			statemachine.Not(th.IsInstallingPendingUserAction)),
 		DestinationState: stateswitch.State(models.ClusterStatusFinalizing),
 		PostTransition:   th.PostRefreshCluster(statusInfoFinalizing),
 	})
@@ -246,9 +285,9 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.ClusterStatusInstalling),
 		},
-		Condition: stateswitch.And(
-			stateswitch.Not(th.IsFinalizing),
-			stateswitch.Not(th.IsInstallingPendingUserAction),
+		Condition: statemachine.And(
+			statemachine.Not(th.IsFinalizing),
+			statemachine.Not(th.IsInstallingPendingUserAction),
 			th.IsInstalling),
 		DestinationState: stateswitch.State(models.ClusterStatusInstalling),
 		PostTransition:   th.PostRefreshCluster(statusInfoInstalling),
@@ -279,9 +318,9 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.ClusterStatusInstalling),
 		},
-		Condition: stateswitch.And(
-			stateswitch.Not(th.IsFinalizing),
-			stateswitch.Not(th.IsInstalling)),
+		Condition: statemachine.And(
+			statemachine.Not(th.IsFinalizing),
+			statemachine.Not(th.IsInstalling)),
 		DestinationState: stateswitch.State(models.ClusterStatusError),
 		PostTransition:   th.PostRefreshCluster(statusInfoError),
 	})
@@ -316,3 +355,8 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 
 	return sm
 }
+
+// DescribeStateMachine returns the transitions of the state machine of the clusters
+func DescribeStateMachine() *models.StateMachine {
+	return NewClusterStateMachine(&transitionHandler{}).Describe()
+}
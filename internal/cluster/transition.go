@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/statemachine"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/ocm"
@@ -59,6 +60,52 @@ func (th *transitionHandler) PostCancelInstallation(sw stateswitch.StateSwitch,
 		params.reason)
 }
 
+////////////////////////////////////////////////////////////////////////////
+// PauseInstallation
+////////////////////////////////////////////////////////////////////////////
+
+type TransitionArgsPauseInstallation struct {
+	ctx context.Context
+	db  *gorm.DB
+}
+
+func (th *transitionHandler) PostPauseInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sCluster, ok := sw.(*stateCluster)
+	if !ok {
+		return errors.New("PostPauseInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsPauseInstallation)
+	if !ok {
+		return errors.New("PostPauseInstallation invalid argument")
+	}
+
+	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), params.db, sCluster,
+		statusInfoInstallingPaused)
+}
+
+////////////////////////////////////////////////////////////////////////////
+// ResumeInstallation
+////////////////////////////////////////////////////////////////////////////
+
+type TransitionArgsResumeInstallation struct {
+	ctx context.Context
+	db  *gorm.DB
+}
+
+func (th *transitionHandler) PostResumeInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sCluster, ok := sw.(*stateCluster)
+	if !ok {
+		return errors.New("PostResumeInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsResumeInstallation)
+	if !ok {
+		return errors.New("PostResumeInstallation invalid argument")
+	}
+
+	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), params.db, sCluster,
+		statusInfoInstalling)
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // ResetCluster
 ////////////////////////////////////////////////////////////////////////////
@@ -79,8 +126,8 @@ func (th *transitionHandler) PostResetCluster(sw stateswitch.StateSwitch, args s
 		return errors.New("PostResetCluster invalid argument")
 	}
 
-	//reset log fields and Openshift ClusterID when resetting the cluster
-	extra := append(append(make([]interface{}, 0), "OpenshiftClusterID", ""), resetLogsField...)
+	//reset log fields, Openshift ClusterID and the failure reason when resetting the cluster
+	extra := append(append(make([]interface{}, 0), "OpenshiftClusterID", "", "failure_reason", ""), resetLogsField...)
 	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), params.db, sCluster, params.reason, extra...)
 }
 
@@ -146,14 +193,26 @@ func createClusterCompletionStatusInfo(log logrus.FieldLogger, cluster *common.C
 	_, statuses := getClusterMonitoringOperatorsStatus(cluster)
 	log.Infof("Cluster %s Monitoring status: %s", *cluster.ID, statuses)
 
-	// Cluster status info is installed if no failed OLM operators
-	if countOperatorsInAllStatuses(statuses[models.OperatorTypeOlm]) == 0 ||
-		len(statuses[models.OperatorTypeOlm][models.OperatorStatusFailed]) == 0 {
+	failedOLMOperators := countOperatorsInAllStatuses(statuses[models.OperatorTypeOlm]) != 0 &&
+		len(statuses[models.OperatorTypeOlm][models.OperatorStatusFailed]) != 0
+	// Failed workers are reported only when a worker success threshold let the installation complete without them
+	var failedWorkerHosts []string
+	if hasWorkerSuccessThreshold(cluster) {
+		failedWorkerHosts = failedWorkers(cluster)
+	}
+
+	// Cluster status info is installed if no failed OLM operators and workers
+	if !failedOLMOperators && len(failedWorkerHosts) == 0 {
 		return statusInfoInstalled
 	}
 
 	statusInfo := StatusInfoDegraded
-	statusInfo += ". Failed OLM operators: " + strings.Join(statuses[models.OperatorTypeOlm][models.OperatorStatusFailed], ", ")
+	if failedOLMOperators {
+		statusInfo += ". Failed OLM operators: " + strings.Join(statuses[models.OperatorTypeOlm][models.OperatorStatusFailed], ", ")
+	}
+	if len(failedWorkerHosts) > 0 {
+		statusInfo += ". Failed workers: " + strings.Join(failedWorkerHosts, ", ")
+	}
 
 	return statusInfo
 }
@@ -288,7 +347,7 @@ func If(id stringer) stateswitch.Condition {
 		}
 		return b, nil
 	}
-	return ret
+	return statemachine.Named(fmt.Sprintf("If(%s)", id.String()), ret)
 }
 
 //check if we should move to finalizing state
@@ -308,9 +367,17 @@ func (th *transitionHandler) IsFinalizing(sw stateswitch.StateSwitch, args state
 //check if we should stay in installing state
 func (th *transitionHandler) IsInstalling(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
 	sCluster, _ := sw.(*stateCluster)
-	installingStatuses := []string{models.HostStatusInstalling, models.HostStatusInstallingInProgress,
-		models.HostStatusInstalled, models.HostStatusInstallingPendingUserAction, models.HostStatusPreparingSuccessful}
-	return th.enoughMastersAndWorkers(sCluster, installingStatuses), nil
+	return th.enoughMastersAndWorkers(sCluster, hostInstallingStatuses), nil
+}
+
+// IsMissingWorkers returns whether the installation of the cluster can no longer succeed only because fewer of its
+// workers can be installed than its worker success threshold requires. Clusters without a threshold fail as usual.
+func (th *transitionHandler) IsMissingWorkers(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+	sCluster, ok := sw.(*stateCluster)
+	if !ok {
+		return false, errors.New("IsMissingWorkers incompatible type of StateSwitch")
+	}
+	return hasWorkerSuccessThreshold(sCluster.cluster) && th.enoughMasters(sCluster, hostInstallingStatuses) && !th.enoughWorkers(sCluster, hostInstallingStatuses), nil
 }
 
 //check if we should move to installing-pending-user-action state
@@ -373,29 +440,37 @@ func (th *transitionHandler) PostUpdateFinalizingAMSConsoleUrl(sw stateswitch.St
 	return nil
 }
 
+// to be installed cluster need 3 masters and the workers that its worker success threshold requires
 func (th *transitionHandler) enoughMastersAndWorkers(sCluster *stateCluster, statuses []string) bool {
+	return th.enoughMasters(sCluster, statuses) && th.enoughWorkers(sCluster, statuses)
+}
+
+func (th *transitionHandler) enoughMasters(sCluster *stateCluster, statuses []string) bool {
 	mappedMastersByRole := MapMasterHostsByStatus(sCluster.cluster)
-	mappedWorkersByRole := MapWorkersHostsByStatus(sCluster.cluster)
 	mastersInSomeInstallingStatus := 0
-	workersInSomeInstallingStatus := 0
-
 	for _, status := range statuses {
 		mastersInSomeInstallingStatus += len(mappedMastersByRole[status])
-		workersInSomeInstallingStatus += len(mappedWorkersByRole[status])
 	}
 
-	numberOfExpectedWorkers := NumberOfWorkers(sCluster.cluster)
 	minRequiredMasterNodes := MinMastersNeededForInstallation
 	if swag.StringValue(sCluster.cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
 		minRequiredMasterNodes = 1
 	}
+	return mastersInSomeInstallingStatus >= minRequiredMasterNodes
+}
 
-	// to be installed cluster need 3 master and at least 2 worker (if workers were given)
-	if mastersInSomeInstallingStatus >= minRequiredMasterNodes &&
-		(numberOfExpectedWorkers == 0 || workersInSomeInstallingStatus >= MinWorkersNeededForInstallation) {
-		return true
+func (th *transitionHandler) enoughWorkers(sCluster *stateCluster, statuses []string) bool {
+	mappedWorkersByRole := MapWorkersHostsByStatus(sCluster.cluster)
+	workersInSomeInstallingStatus := 0
+	for _, status := range statuses {
+		workersInSomeInstallingStatus += len(mappedWorkersByRole[status])
 	}
-	return false
+
+	minRequiredWorkerNodes, err := requiredWorkers(sCluster.cluster)
+	if err != nil {
+		th.log.WithError(err).Warnf("Requiring %d workers of cluster %s", minRequiredWorkerNodes, sCluster.cluster.ID)
+	}
+	return workersInSomeInstallingStatus >= minRequiredWorkerNodes
 }
 
 //check if prepare for installation reach to timeout
@@ -472,9 +547,15 @@ func (th *transitionHandler) PostRefreshCluster(reason string) stateswitch.PostT
 			err            error
 			updatedCluster *common.Cluster
 		)
-		if sCluster.srcState != swag.StringValue(sCluster.cluster.Status) || reason != swag.StringValue(sCluster.cluster.StatusInfo) {
+		// Not using reason directly to avoid closures issue.
+		template := reason
+		if strings.Contains(template, "$WORKERS_SUMMARY") {
+			required, _ := requiredWorkers(sCluster.cluster)
+			template = strings.Replace(template, "$WORKERS_SUMMARY", workersSummary(sCluster.cluster, required), 1)
+		}
+		if sCluster.srcState != swag.StringValue(sCluster.cluster.Status) || template != swag.StringValue(sCluster.cluster.StatusInfo) {
 			updatedCluster, err = updateClusterStatus(logutil.FromContext(params.ctx, th.log), params.db, *sCluster.cluster.ID, sCluster.srcState, *sCluster.cluster.Status,
-				reason)
+				template)
 		}
 
 		//update hosts status to models.HostStatusResettingPendingUserAction if needed
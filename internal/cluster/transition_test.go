@@ -2866,6 +2866,7 @@ var _ = Describe("Refresh Cluster - Installing Cases", func() {
 			withOCMClient      bool
 			requiresAMSUpdate  bool
 			operators          []*models.MonitoredOperator
+			workersThreshold   string
 		}{
 			{
 				name:               "installing to installing",
@@ -3037,6 +3038,42 @@ var _ = Describe("Refresh Cluster - Installing Cases", func() {
 				},
 				statusInfoChecker: makeValueChecker(statusInfoError),
 			},
+			{
+				name:               "installing to installing until the worker success threshold is installed",
+				srcState:           models.ClusterStatusInstalling,
+				srcStatusInfo:      statusInfoInstalling,
+				dstState:           models.ClusterStatusInstalling,
+				machineNetworkCidr: "1.2.3.0/24",
+				apiVip:             "1.2.3.5",
+				ingressVip:         "1.2.3.6",
+				workersThreshold:   `{"min_workers":2}`,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid2, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid3, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid4, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleWorker},
+					{ID: &hid5, Status: swag.String(models.HostStatusInstallingInProgress), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleWorker},
+				},
+				statusInfoChecker: makeValueChecker(statusInfoInstalling),
+			},
+			{
+				name:               "installing to finalizing when the worker success threshold is installed",
+				srcState:           models.ClusterStatusInstalling,
+				srcStatusInfo:      statusInfoInstalling,
+				dstState:           models.ClusterStatusFinalizing,
+				machineNetworkCidr: "1.2.3.0/24",
+				apiVip:             "1.2.3.5",
+				ingressVip:         "1.2.3.6",
+				workersThreshold:   `{"min_workers_percentage":50}`,
+				hosts: []models.Host{
+					{ID: &hid1, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid2, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid3, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleMaster},
+					{ID: &hid4, Status: swag.String(models.HostStatusInstalled), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleWorker},
+					{ID: &hid5, Status: swag.String(models.HostStatusError), Inventory: common.GenerateTestDefaultInventory(), Role: models.HostRoleWorker},
+				},
+				statusInfoChecker: makeValueChecker(statusInfoFinalizing),
+			},
 			{
 				name:               "finalizing to finalizing",
 				srcState:           models.ClusterStatusFinalizing,
@@ -3110,6 +3147,7 @@ var _ = Describe("Refresh Cluster - Installing Cases", func() {
 						ClusterNetworkCidr:       "1.3.0.0/16",
 						ClusterNetworkHostPrefix: 24,
 						MonitoredOperators:       t.operators,
+						WorkerSuccessThreshold:   t.workersThreshold,
 					},
 				}
 				if t.withOCMClient {
@@ -3153,6 +3191,21 @@ var _ = Describe("Refresh Cluster - Installing Cases", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(clusterAfterRefresh.Status).To(Equal(&t.dstState))
 				t.statusInfoChecker.check(clusterAfterRefresh.StatusInfo)
+				// The failure is classified by the monitor, after the refresh
+				Expect(clusterAfterRefresh.FailureReason).To(BeEmpty())
+				if t.dstState == models.ClusterStatusError {
+					mockEvents.EXPECT().QueryEvents(gomock.Any()).Return(nil, int64(0), nil)
+					mockS3Api.EXPECT().GetObjectInfo(gomock.Any(), gomock.Any()).Return(nil, common.NotFound("logs")).AnyTimes()
+					_, err = clusterApi.ClassifyFailure(ctx, &cluster, db)
+					Expect(err).ToNot(HaveOccurred())
+					clusterInDB := getClusterFromDB(clusterId, db)
+					reason, err := common.GetFailureReason(&clusterInDB)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reason).ToNot(BeNil())
+					if t.srcState == models.ClusterStatusInstallingPendingUserAction {
+						Expect(reason.Category).To(Equal(models.FailureCategoryBootOrder))
+					}
+				}
 			})
 		}
 	})
@@ -4041,6 +4094,134 @@ var _ = Describe("NTP refresh cluster", func() {
 	})
 })
 
+var _ = Describe("Refresh cluster - validation policy", func() {
+	var (
+		ctx                         = context.Background()
+		db                          *gorm.DB
+		clusterId, hid1, hid2, hid3 strfmt.UUID
+		cluster                     common.Cluster
+		clusterApi                  *Manager
+		mockEvents                  *events.MockHandler
+		mockHostAPI                 *host.MockAPI
+		mockMetric                  *metrics.MockAPI
+		ctrl                        *gomock.Controller
+		dbName                      string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		mockHostAPI = host.NewMockAPI(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
+			mockEvents, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, nil)
+		hid1 = strfmt.UUID(uuid.New().String())
+		hid2 = strfmt.UUID(uuid.New().String())
+		hid3 = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		mockHostAPI.EXPECT().IsRequireUserActionReset(gomock.Any()).Return(false).AnyTimes()
+		mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	tests := []struct {
+		name             string
+		validationPolicy string
+		dstState         string
+		ntpStatus        ValidationStatus
+		ntpMessage       string
+	}{
+		{
+			name:       "failing validation keeps the cluster insufficient",
+			dstState:   models.ClusterStatusInsufficient,
+			ntpStatus:  ValidationFailure,
+			ntpMessage: "please configure an NTP server via DHCP",
+		},
+		{
+			name:             "validation disabled by the policy",
+			validationPolicy: `{"disabled_cluster_validations":["ntp-server-configured"]}`,
+			dstState:         models.ClusterStatusReady,
+			ntpStatus:        ValidationDisabled,
+			ntpMessage:       validationDisabledByPolicy,
+		},
+		{
+			name:             "failure downgraded to a warning by the policy",
+			validationPolicy: `{"warning_cluster_validations":["ntp-server-configured"]}`,
+			dstState:         models.ClusterStatusReady,
+			ntpStatus:        ValidationWarning,
+			ntpMessage:       "please configure an NTP server via DHCP",
+		},
+		{
+			name:             "host validations in the policy don't affect the cluster validations",
+			validationPolicy: `{"disabled_host_validations":["ntp-synced"]}`,
+			dstState:         models.ClusterStatusInsufficient,
+			ntpStatus:        ValidationFailure,
+			ntpMessage:       "please configure an NTP server via DHCP",
+		},
+	}
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			cluster = common.Cluster{
+				Cluster: models.Cluster{
+					APIVip:                   "1.2.3.5",
+					ID:                       &clusterId,
+					IngressVip:               "1.2.3.6",
+					MachineNetworkCidr:       "1.2.3.0/24",
+					Status:                   swag.String(models.ClusterStatusPendingForInput),
+					BaseDNSDomain:            "test.com",
+					PullSecretSet:            true,
+					ClusterNetworkCidr:       "1.3.0.0/16",
+					ServiceNetworkCidr:       "1.4.0.0/16",
+					ClusterNetworkHostPrefix: 24,
+					ValidationPolicy:         t.validationPolicy,
+				},
+			}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			hosts := []models.Host{
+				{ID: &hid1, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster},
+				{ID: &hid2, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239 - 400), Role: models.HostRoleMaster},
+				{ID: &hid3, Status: swag.String(models.HostStatusKnown), Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster},
+			}
+			for i := range hosts {
+				hosts[i].ClusterID = clusterId
+				Expect(db.Create(&hosts[i]).Error).ShouldNot(HaveOccurred())
+			}
+			cluster = getClusterFromDB(clusterId, db)
+
+			clusterAfterRefresh, err := clusterApi.RefreshStatus(ctx, &cluster, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clusterAfterRefresh.Status).To(Equal(&t.dstState))
+			makeJsonChecker(map[ValidationID]validationCheckResult{
+				AllHostsAreReadyToInstall: {status: ValidationSuccess, messagePattern: "All hosts in the cluster are ready to install"},
+				IsNtpServerConfigured:     {status: t.ntpStatus, messagePattern: t.ntpMessage},
+			}).check(clusterAfterRefresh.ValidationsInfo)
+		})
+	}
+
+	It("fails refreshing the cluster with an invalid policy", func() {
+		cluster = common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterId,
+				Status:           swag.String(models.ClusterStatusPendingForInput),
+				ValidationPolicy: "{",
+			},
+		}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		cluster = getClusterFromDB(clusterId, db)
+
+		_, err := clusterApi.RefreshStatus(ctx, &cluster, db)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 var _ = Describe("Single node", func() {
 	var (
 		ctx                         = context.Background()
@@ -4366,6 +4547,91 @@ var _ = Describe("ValidationResult sort", func() {
 	})
 })
 
+var _ = Describe("Worker success threshold", func() {
+	var (
+		th          *transitionHandler
+		cluster     *common.Cluster
+		failedId    strfmt.UUID
+		workerHosts = func(statuses ...string) []*models.Host {
+			hosts := make([]*models.Host, 0)
+			for i := 0; i < 3; i++ {
+				id := strfmt.UUID(uuid.New().String())
+				hosts = append(hosts, &models.Host{ID: &id, Status: swag.String(models.HostStatusInstalled), Role: models.HostRoleMaster})
+			}
+			for _, status := range statuses {
+				id := strfmt.UUID(uuid.New().String())
+				if status == models.HostStatusError {
+					failedId = id
+				}
+				hosts = append(hosts, &models.Host{ID: &id, Status: swag.String(status), Role: models.HostRoleWorker})
+			}
+			return hosts
+		}
+	)
+
+	BeforeEach(func() {
+		th = &transitionHandler{log: common.GetTestLog()}
+		clusterId := strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterId}}
+	})
+
+	It("requires one worker when no threshold was set", func() {
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusInstalled, models.HostStatusInstalled)
+		Expect(requiredWorkers(cluster)).To(Equal(1))
+		cluster.Hosts = workerHosts()
+		Expect(requiredWorkers(cluster)).To(Equal(0))
+	})
+
+	It("requires the stricter of the count and the rounded up percentage", func() {
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusInstalled, models.HostStatusInstalled,
+			models.HostStatusInstalled, models.HostStatusInstalled)
+		cluster.WorkerSuccessThreshold = `{"min_workers_percentage":50}`
+		Expect(requiredWorkers(cluster)).To(Equal(3))
+		cluster.WorkerSuccessThreshold = `{"min_workers":4,"min_workers_percentage":50}`
+		Expect(requiredWorkers(cluster)).To(Equal(4))
+		cluster.WorkerSuccessThreshold = `{"min_workers":10}`
+		Expect(requiredWorkers(cluster)).To(Equal(5))
+		cluster.WorkerSuccessThreshold = `{"min_workers":0}`
+		Expect(requiredWorkers(cluster)).To(Equal(0))
+	})
+
+	It("decides between finalizing, waiting and error", func() {
+		cluster.WorkerSuccessThreshold = `{"min_workers":2}`
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusInstallingInProgress, models.HostStatusError)
+		sCluster := &stateCluster{cluster: cluster}
+		Expect(th.IsFinalizing(sCluster, nil)).To(BeFalse())
+		Expect(th.IsInstalling(sCluster, nil)).To(BeTrue())
+		Expect(th.IsMissingWorkers(sCluster, nil)).To(BeFalse())
+
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusInstalled, models.HostStatusError)
+		Expect(th.IsFinalizing(sCluster, nil)).To(BeTrue())
+
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusError, models.HostStatusCancelled)
+		Expect(th.IsInstalling(sCluster, nil)).To(BeFalse())
+		Expect(th.IsMissingWorkers(sCluster, nil)).To(BeTrue())
+
+		// Clusters without a threshold fail as hosts in error
+		cluster.WorkerSuccessThreshold = ""
+		cluster.Hosts = workerHosts(models.HostStatusError, models.HostStatusError, models.HostStatusCancelled)
+		Expect(th.IsInstalling(sCluster, nil)).To(BeFalse())
+		Expect(th.IsMissingWorkers(sCluster, nil)).To(BeFalse())
+	})
+
+	It("summarizes the workers that fell below the threshold", func() {
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusError)
+		Expect(workersSummary(cluster, 2)).To(Equal(fmt.Sprintf(
+			"1 of the 2 workers are installed or installing while 2 must be installed. Failed workers: %s (error)", failedId)))
+		cluster.WorkerSuccessThreshold = `{"min_workers":1}`
+		Expect(createClusterCompletionStatusInfo(common.GetTestLog(), cluster)).To(Equal(
+			fmt.Sprintf("%s. Failed workers: %s (error)", StatusInfoDegraded, failedId)))
+	})
+
+	It("doesn't report failed workers without a threshold", func() {
+		cluster.Hosts = workerHosts(models.HostStatusInstalled, models.HostStatusInstalled, models.HostStatusError)
+		Expect(createClusterCompletionStatusInfo(common.GetTestLog(), cluster)).To(Equal(statusInfoInstalled))
+	})
+})
+
 func getClusterFromDB(clusterId strfmt.UUID, db *gorm.DB) common.Cluster {
 	c, err := common.GetClusterFromDB(db, clusterId, common.UseEagerLoading)
 	Expect(err).ShouldNot(HaveOccurred())
@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -18,10 +19,12 @@ import (
 type ValidationStatus string
 
 const (
-	ValidationSuccess ValidationStatus = "success"
-	ValidationFailure ValidationStatus = "failure"
-	ValidationPending ValidationStatus = "pending"
-	ValidationError   ValidationStatus = "error"
+	ValidationSuccess  ValidationStatus = "success"
+	ValidationFailure  ValidationStatus = "failure"
+	ValidationPending  ValidationStatus = "pending"
+	ValidationError    ValidationStatus = "error"
+	ValidationDisabled ValidationStatus = "disabled"
+	ValidationWarning  ValidationStatus = "warning"
 )
 
 const (
@@ -501,3 +504,34 @@ func (v *clusterValidator) printNtpServerConfigured(c *clusterPreprocessContext,
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+// With user managed networking the DNS records are provided by the user, and all the hosts should
+// resolve the domain names of the cluster to the same addresses
+func (v *clusterValidator) areDomainNamesResolvedConsistently(c *clusterPreprocessContext) ValidationStatus {
+	if !swag.BoolValue(c.cluster.UserManagedNetworking) {
+		return ValidationSuccess
+	}
+	names, err := network.GetInconsistentDomainNames(c.cluster)
+	if err != nil {
+		v.log.WithError(err).Warn("Parse domain resolutions")
+		return ValidationError
+	}
+	return boolValue(len(names) == 0)
+}
+
+func (v *clusterValidator) printDomainNamesResolvedConsistently(c *clusterPreprocessContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if !swag.BoolValue(c.cluster.UserManagedNetworking) {
+			return "Domain name resolution is not required (not User Managed Networking)"
+		}
+		return "The hosts resolve the domain names of the cluster consistently"
+	case ValidationFailure:
+		names, _ := network.GetInconsistentDomainNames(c.cluster)
+		return fmt.Sprintf("The hosts resolve %s to different addresses, please check the DNS records.", strings.Join(names, ", "))
+	case ValidationError:
+		return "Parse error for domain name resolutions"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
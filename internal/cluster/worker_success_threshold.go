@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// hostInstallingStatuses are the statuses of the hosts that are installed or may still be installed.
+// Hosts in resetting are hosts whose failed installation is being retried.
+var hostInstallingStatuses = []string{models.HostStatusInstalling, models.HostStatusInstallingInProgress,
+	models.HostStatusInstalled, models.HostStatusInstallingPendingUserAction, models.HostStatusPreparingSuccessful,
+	models.HostStatusResetting}
+
+// requiredWorkers returns how many of the workers of the cluster must be installed for its installation to succeed.
+// It is the stricter of the count and the percentage of the worker success threshold of the cluster, but never more
+// than the workers of the cluster. The built-in minimum is returned with the error when the threshold can't be read.
+func requiredWorkers(c *common.Cluster) (int, error) {
+	numberOfWorkers := NumberOfWorkers(c)
+	required := MinWorkersNeededForInstallation
+	if numberOfWorkers < required {
+		required = numberOfWorkers
+	}
+	threshold, err := common.GetWorkerSuccessThreshold(c)
+	if err != nil {
+		return required, errors.Wrapf(err, "failed to get the worker success threshold of cluster %s", c.ID)
+	}
+	if threshold.MinWorkers == nil && threshold.MinWorkersPercentage == nil {
+		return required, nil
+	}
+
+	required = int(swag.Int64Value(threshold.MinWorkers))
+	// the percentage is rounded up, so that 50% of 3 workers requires 2 of them
+	if byPercentage := int((swag.Int64Value(threshold.MinWorkersPercentage)*int64(numberOfWorkers) + 99) / 100); byPercentage > required {
+		required = byPercentage
+	}
+	if required > numberOfWorkers {
+		required = numberOfWorkers
+	}
+	return required, nil
+}
+
+// hasWorkerSuccessThreshold returns whether a worker success threshold that can be read was set for the cluster
+func hasWorkerSuccessThreshold(c *common.Cluster) bool {
+	threshold, err := common.GetWorkerSuccessThreshold(c)
+	return err == nil && (threshold.MinWorkers != nil || threshold.MinWorkersPercentage != nil)
+}
+
+// failedWorkers returns the names and statuses of the workers of the cluster that can no longer be installed
+func failedWorkers(c *common.Cluster) []string {
+	var failed []string
+	for _, h := range c.Hosts {
+		status := swag.StringValue(h.Status)
+		if h.Role != models.HostRoleWorker || status == models.HostStatusDisabled || funk.ContainsString(hostInstallingStatuses, status) {
+			continue
+		}
+		failed = append(failed, fmt.Sprintf("%s (%s)", hostutil.GetHostnameForMsg(h), status))
+	}
+	sort.Strings(failed)
+	return failed
+}
+
+// workersSummary describes how many of the workers of the cluster can still be installed compared to the number of
+// workers that must be installed, and which workers failed
+func workersSummary(c *common.Cluster, required int) string {
+	mappedWorkersByStatus := MapWorkersHostsByStatus(c)
+	installing := 0
+	for _, status := range hostInstallingStatuses {
+		installing += len(mappedWorkersByStatus[status])
+	}
+	summary := fmt.Sprintf("%d of the %d workers are installed or installing while %d must be installed",
+		installing, NumberOfWorkers(c), required)
+	if failed := failedWorkers(c); len(failed) > 0 {
+		summary += ". Failed workers: " + strings.Join(failed, ", ")
+	}
+	return summary
+}
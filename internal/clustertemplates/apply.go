@@ -0,0 +1,110 @@
+package clustertemplates
+
+import (
+	"encoding/base64"
+	"regexp"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+var placeholderRegex = regexp.MustCompile(`\$\{([A-Za-z0-9_.-]+)\}`)
+
+// Substitute replaces the ${key} placeholders of the value with the matching parameters. Placeholders without a
+// matching parameter are left as is.
+func Substitute(value string, params map[string]string) string {
+	if len(params) == 0 {
+		return value
+	}
+	return placeholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+		if param, ok := params[placeholderRegex.FindStringSubmatch(placeholder)[1]]; ok {
+			return param
+		}
+		return placeholder
+	})
+}
+
+// ApplyClusterParams sets the parameters of the cluster that aren't set to the defaults of the template, after
+// substituting the template parameters in them
+func ApplyClusterParams(template *models.ClusterTemplate, params *models.ClusterCreateParams) {
+	defaults := template.ClusterParams
+	if defaults == nil {
+		return
+	}
+	substitute := func(value string) string {
+		return Substitute(value, params.TemplateParams)
+	}
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return swag.String(substitute(value))
+	}
+
+	if params.HighAvailabilityMode == nil && defaults.HighAvailabilityMode != "" {
+		params.HighAvailabilityMode = swag.String(defaults.HighAvailabilityMode)
+	}
+	if params.BaseDNSDomain == "" {
+		params.BaseDNSDomain = substitute(defaults.BaseDNSDomain)
+	}
+	if params.ClusterNetworkCidr == nil {
+		params.ClusterNetworkCidr = optional(defaults.ClusterNetworkCidr)
+	}
+	if params.ClusterNetworkHostPrefix == 0 {
+		params.ClusterNetworkHostPrefix = defaults.ClusterNetworkHostPrefix
+	}
+	if params.ServiceNetworkCidr == nil {
+		params.ServiceNetworkCidr = optional(defaults.ServiceNetworkCidr)
+	}
+	if params.SSHPublicKey == "" {
+		params.SSHPublicKey = substitute(defaults.SSHPublicKey)
+	}
+	if params.VipDhcpAllocation == nil {
+		params.VipDhcpAllocation = defaults.VipDhcpAllocation
+	}
+	if params.UserManagedNetworking == nil {
+		params.UserManagedNetworking = defaults.UserManagedNetworking
+	}
+	if params.HTTPProxy == nil {
+		params.HTTPProxy = optional(defaults.HTTPProxy)
+	}
+	if params.HTTPSProxy == nil {
+		params.HTTPSProxy = optional(defaults.HTTPSProxy)
+	}
+	if params.NoProxy == nil {
+		params.NoProxy = optional(defaults.NoProxy)
+	}
+	if params.AdditionalNtpSource == nil {
+		params.AdditionalNtpSource = optional(defaults.AdditionalNtpSource)
+	}
+	if params.OlmOperators == nil {
+		params.OlmOperators = defaults.OlmOperators
+	}
+	if params.Hyperthreading == nil && defaults.Hyperthreading != "" {
+		params.Hyperthreading = swag.String(defaults.Hyperthreading)
+	}
+}
+
+// InstallConfigOverrides returns the install config overrides of the template with the template parameters
+// substituted in them
+func InstallConfigOverrides(template *models.ClusterTemplate, params map[string]string) string {
+	return Substitute(template.InstallConfigOverrides, params)
+}
+
+// Manifests returns the manifests of the template with the template parameters substituted in their content
+func Manifests(template *models.ClusterTemplate, params map[string]string) ([]*models.CreateManifestParams, error) {
+	manifests := make([]*models.CreateManifestParams, len(template.Manifests))
+	for i, manifest := range template.Manifests {
+		content, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content))
+		if err != nil {
+			return nil, errors.Errorf("Failed to base64-decode manifest %s of the template", swag.StringValue(manifest.FileName))
+		}
+		manifests[i] = &models.CreateManifestParams{
+			Folder:   manifest.Folder,
+			FileName: manifest.FileName,
+			Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(Substitute(string(content), params)))),
+		}
+	}
+	return manifests, nil
+}
@@ -0,0 +1,275 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.ClusterTemplatesAPI = &ClusterTemplates{}
+
+//go:generate mockgen -package clustertemplates -destination mock_clustertemplates_internal.go . ClusterTemplatesInternals
+type ClusterTemplatesInternals interface {
+	CreateClusterTemplateInternal(ctx context.Context, params operations.CreateClusterTemplateParams) (*models.ClusterTemplate, error)
+	UpdateClusterTemplateInternal(ctx context.Context, params operations.UpdateClusterTemplateParams) (*models.ClusterTemplate, error)
+	GetClusterTemplateInternal(ctx context.Context, params operations.GetClusterTemplateParams) (*models.ClusterTemplate, error)
+	DeleteClusterTemplateInternal(ctx context.Context, params operations.DeleteClusterTemplateParams) error
+}
+
+// NewClusterTemplatesAPI returns the cluster templates API
+func NewClusterTemplatesAPI(db *gorm.DB, log logrus.FieldLogger) *ClusterTemplates {
+	return &ClusterTemplates{
+		db:  db,
+		log: log,
+	}
+}
+
+// ClusterTemplates stores the versions of the cluster templates in the DB. Every update adds a version, so that
+// clusters keep referring to the version they were registered from.
+type ClusterTemplates struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func (t *ClusterTemplates) CreateClusterTemplate(ctx context.Context, params operations.CreateClusterTemplateParams) middleware.Responder {
+	template, err := t.CreateClusterTemplateInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewCreateClusterTemplateCreated().WithPayload(template)
+}
+
+func (t *ClusterTemplates) CreateClusterTemplateInternal(ctx context.Context, params operations.CreateClusterTemplateParams) (*models.ClusterTemplate, error) {
+	log := logutil.FromContext(ctx, t.log)
+
+	if err := validateTemplateParams(params.NewClusterTemplateParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	template, err := newTemplateVersion(id, 1, ocm.UserNameFromContext(ctx), ocm.OrgIDFromContext(ctx), params.NewClusterTemplateParams)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = t.db.Create(template).Error; err != nil {
+		log.WithError(err).Error("failed to create cluster template")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Created cluster template %s", id)
+	return &template.ClusterTemplate, nil
+}
+
+func (t *ClusterTemplates) UpdateClusterTemplate(ctx context.Context, params operations.UpdateClusterTemplateParams) middleware.Responder {
+	template, err := t.UpdateClusterTemplateInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewUpdateClusterTemplateOK().WithPayload(template)
+}
+
+func (t *ClusterTemplates) UpdateClusterTemplateInternal(ctx context.Context, params operations.UpdateClusterTemplateParams) (*models.ClusterTemplate, error) {
+	log := logutil.FromContext(ctx, t.log)
+
+	if err := validateTemplateParams(params.ClusterTemplateUpdateParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	var template *common.ClusterTemplate
+	err := t.db.Transaction(func(tx *gorm.DB) error {
+		latest, err := getTemplate(ctx, transaction.AddForUpdateQueryOption(tx), params.ClusterTemplateID, nil)
+		if err != nil {
+			return err
+		}
+		template, err = newTemplateVersion(params.ClusterTemplateID, swag.Int64Value(latest.Version)+1,
+			latest.UserName, latest.OrgID, params.ClusterTemplateUpdateParams)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return tx.Create(template).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to update cluster template %s", params.ClusterTemplateID)
+		return nil, err
+	}
+
+	log.Infof("Created version %d of cluster template %s", swag.Int64Value(template.Version), params.ClusterTemplateID)
+	return &template.ClusterTemplate, nil
+}
+
+func (t *ClusterTemplates) GetClusterTemplate(ctx context.Context, params operations.GetClusterTemplateParams) middleware.Responder {
+	template, err := t.GetClusterTemplateInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewGetClusterTemplateOK().WithPayload(template)
+}
+
+func (t *ClusterTemplates) GetClusterTemplateInternal(ctx context.Context, params operations.GetClusterTemplateParams) (*models.ClusterTemplate, error) {
+	template, err := getTemplate(ctx, t.db, params.ClusterTemplateID, params.Version)
+	if err != nil {
+		if _, ok := err.(*common.ApiErrorResponse); !ok {
+			logutil.FromContext(ctx, t.log).WithError(err).Errorf("failed to get cluster template %s", params.ClusterTemplateID)
+		}
+		return nil, err
+	}
+	return &template.ClusterTemplate, nil
+}
+
+func (t *ClusterTemplates) ListClusterTemplates(ctx context.Context, params operations.ListClusterTemplatesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, t.log)
+
+	var templates []*common.ClusterTemplate
+	err := t.db.Where(identity.AddUserFilter(ctx, latestVersionCondition)).Order("created_at").Find(&templates).Error
+	if err != nil {
+		log.WithError(err).Error("failed to list cluster templates")
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+
+	ret := make(models.ClusterTemplateList, len(templates))
+	for i, template := range templates {
+		if err = deserialize(template); err != nil {
+			log.WithError(err).Errorf("failed to parse cluster template %s", *template.ID)
+			return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+		}
+		ret[i] = &template.ClusterTemplate
+	}
+	return operations.NewListClusterTemplatesOK().WithPayload(ret)
+}
+
+func (t *ClusterTemplates) DeleteClusterTemplate(ctx context.Context, params operations.DeleteClusterTemplateParams) middleware.Responder {
+	if err := t.DeleteClusterTemplateInternal(ctx, params); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return operations.NewDeleteClusterTemplateNoContent()
+}
+
+func (t *ClusterTemplates) DeleteClusterTemplateInternal(ctx context.Context, params operations.DeleteClusterTemplateParams) error {
+	log := logutil.FromContext(ctx, t.log)
+
+	reply := t.db.Where(identity.AddUserFilter(ctx, "id = ?"), params.ClusterTemplateID.String()).Delete(&common.ClusterTemplate{})
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to delete cluster template %s", params.ClusterTemplateID)
+		return common.NewApiError(http.StatusInternalServerError, reply.Error)
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("cluster template %s not found", params.ClusterTemplateID))
+	}
+
+	log.Infof("Deleted cluster template %s", params.ClusterTemplateID)
+	return nil
+}
+
+const latestVersionCondition = "version = (SELECT MAX(version) FROM cluster_templates AS versions WHERE versions.id = cluster_templates.id)"
+
+// getTemplate returns a version of the template, or its latest version if the version isn't set
+func getTemplate(ctx context.Context, db *gorm.DB, id strfmt.UUID, version *int64) (*common.ClusterTemplate, error) {
+	var template common.ClusterTemplate
+	query := db.Where(identity.AddUserFilter(ctx, "id = ?"), id.String())
+	if version != nil {
+		query = query.Where("version = ?", *version)
+	} else {
+		query = query.Where(latestVersionCondition)
+	}
+	if err := query.Take(&template).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			if version != nil {
+				return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("version %d of cluster template %s not found", *version, id))
+			}
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster template %s not found", id))
+		}
+		return nil, err
+	}
+	if err := deserialize(&template); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func newTemplateVersion(id strfmt.UUID, version int64, userName, orgID string, params *models.ClusterTemplateCreateParams) (*common.ClusterTemplate, error) {
+	createdAt := strfmt.DateTime(time.Now())
+	template := &common.ClusterTemplate{
+		ClusterTemplate: models.ClusterTemplate{
+			ID:                     &id,
+			Version:                swag.Int64(version),
+			Name:                   params.Name,
+			Description:            params.Description,
+			UserName:               userName,
+			OrgID:                  orgID,
+			CreatedAt:              &createdAt,
+			ClusterParams:          params.ClusterParams,
+			InstallConfigOverrides: params.InstallConfigOverrides,
+			Manifests:              params.Manifests,
+		},
+	}
+	if template.ClusterParams != nil {
+		data, err := json.Marshal(template.ClusterParams)
+		if err != nil {
+			return nil, err
+		}
+		template.SerializedClusterParams = string(data)
+	}
+	if len(template.Manifests) > 0 {
+		data, err := json.Marshal(template.Manifests)
+		if err != nil {
+			return nil, err
+		}
+		template.SerializedManifests = string(data)
+	}
+	return template, nil
+}
+
+func deserialize(template *common.ClusterTemplate) error {
+	if template.SerializedClusterParams != "" {
+		if err := json.Unmarshal([]byte(template.SerializedClusterParams), &template.ClusterParams); err != nil {
+			return errors.Wrapf(err, "failed to parse the cluster params of cluster template %s", *template.ID)
+		}
+	}
+	if template.SerializedManifests != "" {
+		if err := json.Unmarshal([]byte(template.SerializedManifests), &template.Manifests); err != nil {
+			return errors.Wrapf(err, "failed to parse the manifests of cluster template %s", *template.ID)
+		}
+	}
+	return nil
+}
+
+// validateTemplateParams checks what can be checked before the placeholders of the template are substituted. The
+// complete validation happens when a cluster is registered from the template.
+func validateTemplateParams(params *models.ClusterTemplateCreateParams) error {
+	if err := params.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if params.InstallConfigOverrides != "" && !json.Valid([]byte(params.InstallConfigOverrides)) {
+		return errors.New("Install config overrides of the template have an illegal JSON format")
+	}
+	for _, manifest := range params.Manifests {
+		fileName := swag.StringValue(manifest.FileName)
+		if strings.ContainsRune(fileName, os.PathSeparator) {
+			return errors.Errorf("Manifest %s of the template should not include a directory in its name", fileName)
+		}
+		if _, err := base64.StdEncoding.DecodeString(swag.StringValue(manifest.Content)); err != nil {
+			return errors.Errorf("Failed to base64-decode manifest %s of the template", fileName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package clustertemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	"github.com/sirupsen/logrus"
+)
+
+func TestClusterTemplates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Cluster templates test Suite")
+}
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Role: ocm.UserRole, Username: userName, Organization: orgID}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+func encode(content string) *string {
+	return swag.String(base64.StdEncoding.EncodeToString([]byte(content)))
+}
+
+func expectApiError(err error, code int32) {
+	Expect(err).Should(HaveOccurred())
+	apiErr, ok := err.(*common.ApiErrorResponse)
+	Expect(ok).Should(BeTrue())
+	Expect(apiErr.StatusCode()).Should(Equal(code))
+}
+
+var _ = Describe("Substitute", func() {
+	It("replaces the placeholders that have parameters", func() {
+		params := map[string]string{"site": "edge-1", "domain": "example.com"}
+		Expect(Substitute("${site}.${domain}", params)).Should(Equal("edge-1.example.com"))
+	})
+
+	It("leaves the placeholders without parameters as is", func() {
+		Expect(Substitute("${site}.${zone}", map[string]string{"site": "edge-1"})).Should(Equal("edge-1.${zone}"))
+		Expect(Substitute("${site}", nil)).Should(Equal("${site}"))
+	})
+})
+
+var _ = Describe("ApplyClusterParams", func() {
+	var template *models.ClusterTemplate
+
+	BeforeEach(func() {
+		template = &models.ClusterTemplate{
+			ClusterParams: &models.ClusterTemplateParams{
+				BaseDNSDomain:         "${site}.example.com",
+				ClusterNetworkCidr:    "10.128.0.0/14",
+				HTTPProxy:             "http://proxy.${site}.example.com:3128",
+				AdditionalNtpSource:   "ntp.${site}.example.com",
+				UserManagedNetworking: swag.Bool(true),
+				VipDhcpAllocation:     swag.Bool(false),
+				HighAvailabilityMode:  models.ClusterHighAvailabilityModeNone,
+				OlmOperators:          []*models.OperatorCreateParams{{Name: "lso"}},
+			},
+		}
+	})
+
+	It("sets the parameters that aren't set to the substituted defaults", func() {
+		params := &models.ClusterCreateParams{TemplateParams: map[string]string{"site": "edge-1"}}
+		ApplyClusterParams(template, params)
+		Expect(params.BaseDNSDomain).Should(Equal("edge-1.example.com"))
+		Expect(swag.StringValue(params.ClusterNetworkCidr)).Should(Equal("10.128.0.0/14"))
+		Expect(swag.StringValue(params.HTTPProxy)).Should(Equal("http://proxy.edge-1.example.com:3128"))
+		Expect(swag.StringValue(params.AdditionalNtpSource)).Should(Equal("ntp.edge-1.example.com"))
+		Expect(swag.BoolValue(params.UserManagedNetworking)).Should(BeTrue())
+		Expect(params.VipDhcpAllocation).ShouldNot(BeNil())
+		Expect(*params.VipDhcpAllocation).Should(BeFalse())
+		Expect(swag.StringValue(params.HighAvailabilityMode)).Should(Equal(models.ClusterHighAvailabilityModeNone))
+		Expect(params.OlmOperators).Should(HaveLen(1))
+		Expect(params.ServiceNetworkCidr).Should(BeNil())
+		Expect(params.HTTPSProxy).Should(BeNil())
+		Expect(params.Hyperthreading).Should(BeNil())
+	})
+
+	It("keeps the parameters that are set", func() {
+		params := &models.ClusterCreateParams{
+			BaseDNSDomain:         "other.com",
+			HTTPProxy:             swag.String(""),
+			UserManagedNetworking: swag.Bool(false),
+			OlmOperators:          []*models.OperatorCreateParams{},
+		}
+		ApplyClusterParams(template, params)
+		Expect(params.BaseDNSDomain).Should(Equal("other.com"))
+		Expect(swag.StringValue(params.HTTPProxy)).Should(BeEmpty())
+		Expect(swag.BoolValue(params.UserManagedNetworking)).Should(BeFalse())
+		Expect(params.OlmOperators).Should(BeEmpty())
+	})
+})
+
+var _ = Describe("Manifests", func() {
+	It("substitutes the parameters in the content of the manifests", func() {
+		template := &models.ClusterTemplate{Manifests: []*models.CreateManifestParams{{
+			Folder:   swag.String(models.CreateManifestParamsFolderOpenshift),
+			FileName: swag.String("chrony.yaml"),
+			Content:  encode("server: ntp.${site}.example.com"),
+		}}}
+		manifests, err := Manifests(template, map[string]string{"site": "edge-1"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manifests).Should(HaveLen(1))
+		Expect(swag.StringValue(manifests[0].Folder)).Should(Equal(models.CreateManifestParamsFolderOpenshift))
+		Expect(swag.StringValue(manifests[0].FileName)).Should(Equal("chrony.yaml"))
+		Expect(manifests[0].Content).Should(Equal(encode("server: ntp.edge-1.example.com")))
+		Expect(template.Manifests[0].Content).Should(Equal(encode("server: ntp.${site}.example.com")))
+	})
+})
+
+var _ = Describe("Cluster templates API", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+		api    *ClusterTemplates
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = NewClusterTemplatesAPI(db, logrus.New())
+		ctx = userContext("user1", "org1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createParams := func(domain string) *models.ClusterTemplateCreateParams {
+		return &models.ClusterTemplateCreateParams{
+			Name:                   swag.String("edge"),
+			ClusterParams:          &models.ClusterTemplateParams{BaseDNSDomain: domain},
+			InstallConfigOverrides: `{"fips": true}`,
+			Manifests: []*models.CreateManifestParams{{
+				FileName: swag.String("site.yaml"),
+				Content:  encode("site: ${site}"),
+			}},
+		}
+	}
+
+	create := func() *models.ClusterTemplate {
+		template, err := api.CreateClusterTemplateInternal(ctx, operations.CreateClusterTemplateParams{
+			NewClusterTemplateParams: createParams("example.com"),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return template
+	}
+
+	It("creates the first version of a template", func() {
+		template := create()
+		Expect(swag.Int64Value(template.Version)).Should(Equal(int64(1)))
+		Expect(template.UserName).Should(Equal("user1"))
+		Expect(template.OrgID).Should(Equal("org1"))
+
+		stored, err := api.GetClusterTemplateInternal(ctx, operations.GetClusterTemplateParams{ClusterTemplateID: *template.ID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stored.ClusterParams.BaseDNSDomain).Should(Equal("example.com"))
+		Expect(stored.InstallConfigOverrides).Should(Equal(`{"fips": true}`))
+		Expect(stored.Manifests).Should(HaveLen(1))
+		Expect(swag.StringValue(stored.Manifests[0].FileName)).Should(Equal("site.yaml"))
+	})
+
+	It("rejects invalid templates", func() {
+		params := createParams("example.com")
+		params.InstallConfigOverrides = "{"
+		_, err := api.CreateClusterTemplateInternal(ctx, operations.CreateClusterTemplateParams{NewClusterTemplateParams: params})
+		expectApiError(err, http.StatusBadRequest)
+
+		params = createParams("example.com")
+		params.Manifests[0].Content = swag.String("not base64")
+		_, err = api.CreateClusterTemplateInternal(ctx, operations.CreateClusterTemplateParams{NewClusterTemplateParams: params})
+		expectApiError(err, http.StatusBadRequest)
+	})
+
+	It("adds a version on update and keeps the previous versions", func() {
+		template := create()
+		updated, err := api.UpdateClusterTemplateInternal(ctx, operations.UpdateClusterTemplateParams{
+			ClusterTemplateID:           *template.ID,
+			ClusterTemplateUpdateParams: createParams("other.com"),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.Int64Value(updated.Version)).Should(Equal(int64(2)))
+
+		latest, err := api.GetClusterTemplateInternal(ctx, operations.GetClusterTemplateParams{ClusterTemplateID: *template.ID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.Int64Value(latest.Version)).Should(Equal(int64(2)))
+		Expect(latest.ClusterParams.BaseDNSDomain).Should(Equal("other.com"))
+
+		first, err := api.GetClusterTemplateInternal(ctx, operations.GetClusterTemplateParams{
+			ClusterTemplateID: *template.ID,
+			Version:           swag.Int64(1),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(first.ClusterParams.BaseDNSDomain).Should(Equal("example.com"))
+
+		_, err = api.GetClusterTemplateInternal(ctx, operations.GetClusterTemplateParams{
+			ClusterTemplateID: *template.ID,
+			Version:           swag.Int64(3),
+		})
+		expectApiError(err, http.StatusNotFound)
+	})
+
+	It("lists the latest version of the templates of the user", func() {
+		template := create()
+		_, err := api.UpdateClusterTemplateInternal(ctx, operations.UpdateClusterTemplateParams{
+			ClusterTemplateID:           *template.ID,
+			ClusterTemplateUpdateParams: createParams("other.com"),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = api.CreateClusterTemplateInternal(userContext("user2", "org2"), operations.CreateClusterTemplateParams{
+			NewClusterTemplateParams: createParams("example.com"),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		reply := api.ListClusterTemplates(ctx, operations.ListClusterTemplatesParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewListClusterTemplatesOK()))
+		templates := reply.(*operations.ListClusterTemplatesOK).Payload
+		Expect(templates).Should(HaveLen(1))
+		Expect(*templates[0].ID).Should(Equal(*template.ID))
+		Expect(swag.Int64Value(templates[0].Version)).Should(Equal(int64(2)))
+		Expect(templates[0].ClusterParams.BaseDNSDomain).Should(Equal("other.com"))
+	})
+
+	It("hides the templates of other users", func() {
+		template := create()
+		otherCtx := userContext("user2", "org1")
+		_, err := api.GetClusterTemplateInternal(otherCtx, operations.GetClusterTemplateParams{ClusterTemplateID: *template.ID})
+		expectApiError(err, http.StatusNotFound)
+		_, err = api.UpdateClusterTemplateInternal(otherCtx, operations.UpdateClusterTemplateParams{
+			ClusterTemplateID:           *template.ID,
+			ClusterTemplateUpdateParams: createParams("other.com"),
+		})
+		expectApiError(err, http.StatusNotFound)
+		err = api.DeleteClusterTemplateInternal(otherCtx, operations.DeleteClusterTemplateParams{ClusterTemplateID: *template.ID})
+		expectApiError(err, http.StatusNotFound)
+	})
+
+	It("deletes all the versions of a template", func() {
+		template := create()
+		_, err := api.UpdateClusterTemplateInternal(ctx, operations.UpdateClusterTemplateParams{
+			ClusterTemplateID:           *template.ID,
+			ClusterTemplateUpdateParams: createParams("other.com"),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(api.DeleteClusterTemplateInternal(ctx, operations.DeleteClusterTemplateParams{ClusterTemplateID: *template.ID})).
+			ShouldNot(HaveOccurred())
+		var count int
+		Expect(db.Model(&common.ClusterTemplate{}).Where("id = ?", template.ID.String()).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).Should(BeZero())
+
+		err = api.DeleteClusterTemplateInternal(ctx, operations.DeleteClusterTemplateParams{
+			ClusterTemplateID: strfmt.UUID(uuid.New().String()),
+		})
+		expectApiError(err, http.StatusNotFound)
+	})
+})
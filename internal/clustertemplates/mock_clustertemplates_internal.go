@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/clustertemplates (interfaces: ClusterTemplatesInternals)
+
+// Package clustertemplates is a generated GoMock package.
+package clustertemplates
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+	cluster_templates "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	reflect "reflect"
+)
+
+// MockClusterTemplatesInternals is a mock of ClusterTemplatesInternals interface
+type MockClusterTemplatesInternals struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterTemplatesInternalsMockRecorder
+}
+
+// MockClusterTemplatesInternalsMockRecorder is the mock recorder for MockClusterTemplatesInternals
+type MockClusterTemplatesInternalsMockRecorder struct {
+	mock *MockClusterTemplatesInternals
+}
+
+// NewMockClusterTemplatesInternals creates a new mock instance
+func NewMockClusterTemplatesInternals(ctrl *gomock.Controller) *MockClusterTemplatesInternals {
+	mock := &MockClusterTemplatesInternals{ctrl: ctrl}
+	mock.recorder = &MockClusterTemplatesInternalsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockClusterTemplatesInternals) EXPECT() *MockClusterTemplatesInternalsMockRecorder {
+	return m.recorder
+}
+
+// CreateClusterTemplateInternal mocks base method
+func (m *MockClusterTemplatesInternals) CreateClusterTemplateInternal(arg0 context.Context, arg1 cluster_templates.CreateClusterTemplateParams) (*models.ClusterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterTemplateInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.ClusterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterTemplateInternal indicates an expected call of CreateClusterTemplateInternal
+func (mr *MockClusterTemplatesInternalsMockRecorder) CreateClusterTemplateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterTemplateInternal", reflect.TypeOf((*MockClusterTemplatesInternals)(nil).CreateClusterTemplateInternal), arg0, arg1)
+}
+
+// DeleteClusterTemplateInternal mocks base method
+func (m *MockClusterTemplatesInternals) DeleteClusterTemplateInternal(arg0 context.Context, arg1 cluster_templates.DeleteClusterTemplateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterTemplateInternal", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterTemplateInternal indicates an expected call of DeleteClusterTemplateInternal
+func (mr *MockClusterTemplatesInternalsMockRecorder) DeleteClusterTemplateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterTemplateInternal", reflect.TypeOf((*MockClusterTemplatesInternals)(nil).DeleteClusterTemplateInternal), arg0, arg1)
+}
+
+// GetClusterTemplateInternal mocks base method
+func (m *MockClusterTemplatesInternals) GetClusterTemplateInternal(arg0 context.Context, arg1 cluster_templates.GetClusterTemplateParams) (*models.ClusterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterTemplateInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.ClusterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterTemplateInternal indicates an expected call of GetClusterTemplateInternal
+func (mr *MockClusterTemplatesInternalsMockRecorder) GetClusterTemplateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterTemplateInternal", reflect.TypeOf((*MockClusterTemplatesInternals)(nil).GetClusterTemplateInternal), arg0, arg1)
+}
+
+// UpdateClusterTemplateInternal mocks base method
+func (m *MockClusterTemplatesInternals) UpdateClusterTemplateInternal(arg0 context.Context, arg1 cluster_templates.UpdateClusterTemplateParams) (*models.ClusterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterTemplateInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.ClusterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterTemplateInternal indicates an expected call of UpdateClusterTemplateInternal
+func (mr *MockClusterTemplatesInternalsMockRecorder) UpdateClusterTemplateInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterTemplateInternal", reflect.TypeOf((*MockClusterTemplatesInternals)(nil).UpdateClusterTemplateInternal), arg0, arg1)
+}
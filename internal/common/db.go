@@ -51,6 +51,15 @@ type Cluster struct {
 	// and the generation failed, the value of ImageGenerated will be set to 'false'. In that case, providing the
 	// same request with the same custom parameters will re-attempt to generate the image.
 	ImageGenerated bool `json:"image_generated"`
+
+	// The host assignments of an imported cluster definition that were not applied yet. They are applied to the
+	// hosts that register with matching MAC addresses, once their inventory is received.
+	ImportedHostAssignments string `json:"imported_host_assignments" gorm:"type:text"`
+
+	// ImageObjectName is the name of the object holding the discovery image of the cluster. Discovery images are
+	// content-addressed, so clusters that generated identical images point at the same object. Empty for images
+	// that were generated before, which are named after the cluster ID.
+	ImageObjectName string `json:"image_object_name"`
 }
 
 type Event struct {
@@ -58,8 +67,40 @@ type Event struct {
 	models.Event
 }
 
+// EventArchive records an object in which old events of a cluster were archived before they were
+// removed from the events table
+type EventArchive struct {
+	ObjectName string      `gorm:"primary_key"`
+	ClusterID  strfmt.UUID `gorm:"index"`
+	Category   string
+	// The times of the first and the last events in the object
+	FirstEventTime time.Time `gorm:"type:timestamp with time zone"`
+	LastEventTime  time.Time `gorm:"type:timestamp with time zone"`
+	EventCount     int
+}
+
+type AuditRecord struct {
+	models.AuditRecord
+	// The changes of the record, serialized as JSON
+	SerializedChanges string `gorm:"type:text"`
+}
+
+type Webhook struct {
+	models.Webhook
+	// The key used to sign the notifications sent to the webhook
+	Secret string `json:"-" gorm:"type:text"`
+}
+
+type ClusterTemplate struct {
+	models.ClusterTemplate
+	// The cluster params and the manifests of the template, serialized as JSON
+	SerializedClusterParams string `gorm:"type:text"`
+	SerializedManifests     string `gorm:"type:text"`
+}
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}).Error
+	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}, &EventArchive{}, &AuditRecord{}, &Webhook{}, &models.WebhookDelivery{},
+		&ClusterTemplate{}).Error
 }
 
 type Host struct {
@@ -168,6 +209,13 @@ func GetHostByIdFromDB(db *gorm.DB, hostId string) (*Host, error) {
 	return &host, nil
 }
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// EscapeLikePattern escapes the wildcards of LIKE patterns, so that the text is matched literally
+func EscapeLikePattern(text string) string {
+	return likeEscaper.Replace(text)
+}
+
 func DeleteRecordsByClusterID(db *gorm.DB, clusterID strfmt.UUID, value interface{}, where ...interface{}) error {
 	return db.Where("cluster_id = ?", clusterID).Delete(value, where...).Error
 }
@@ -0,0 +1,17 @@
+package common
+
+import (
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetFailureReason returns the classified reason of the failure of the cluster, nil when it wasn't classified
+func GetFailureReason(cluster *Cluster) (*models.FailureReason, error) {
+	if cluster.FailureReason == "" {
+		return nil, nil
+	}
+	var ret models.FailureReason
+	if err := UnmarshalJSONColumn(cluster.FailureReason, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
@@ -0,0 +1,14 @@
+package common
+
+import (
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetInstallRetryPolicy returns the policy that the failed host installations of the cluster are retried by, an empty policy that retries nothing when none was set
+func GetInstallRetryPolicy(cluster *Cluster) (*models.InstallRetryPolicy, error) {
+	var ret models.InstallRetryPolicy
+	if err := UnmarshalJSONColumn(cluster.InstallRetryPolicy, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
@@ -0,0 +1,23 @@
+package common
+
+import (
+	"encoding/json"
+)
+
+// UnmarshalJSONColumn unmarshals the JSON-formatted value of a cluster column into ret, which is left empty when the
+// column was never set
+func UnmarshalJSONColumn(value string, ret interface{}) error {
+	if value == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(value), ret)
+}
+
+// MarshalJSONColumn returns the JSON-formatted value that a cluster column stores v as
+func MarshalJSONColumn(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
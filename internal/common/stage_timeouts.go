@@ -0,0 +1,14 @@
+package common
+
+import (
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetStageTimeouts returns the installation stage timeouts of the cluster, empty timeouts when none were set
+func GetStageTimeouts(cluster *Cluster) (*models.StageTimeouts, error) {
+	var ret models.StageTimeouts
+	if err := UnmarshalJSONColumn(cluster.StageTimeouts, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
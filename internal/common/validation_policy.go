@@ -0,0 +1,48 @@
+package common
+
+import (
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+// GetValidationPolicy returns the validation policy of the cluster, an empty policy when none was set
+func GetValidationPolicy(cluster *Cluster) (*models.ValidationPolicy, error) {
+	var ret models.ValidationPolicy
+	if err := UnmarshalJSONColumn(cluster.ValidationPolicy, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func IsHostValidationDisabledByPolicy(policy *models.ValidationPolicy, id string) bool {
+	return policy != nil && funk.Contains(policy.DisabledHostValidations, models.HostValidationID(id))
+}
+
+func IsHostValidationWarningByPolicy(policy *models.ValidationPolicy, id string) bool {
+	return policy != nil && funk.Contains(policy.WarningHostValidations, models.HostValidationID(id))
+}
+
+func IsClusterValidationDisabledByPolicy(policy *models.ValidationPolicy, id string) bool {
+	return policy != nil && funk.Contains(policy.DisabledClusterValidations, models.ClusterValidationID(id))
+}
+
+func IsClusterValidationWarningByPolicy(policy *models.ValidationPolicy, id string) bool {
+	return policy != nil && funk.Contains(policy.WarningClusterValidations, models.ClusterValidationID(id))
+}
+
+// ApplyValidationPolicyThresholds overrides the thresholds of the host requirements with the ones set in
+// the validation policy of the cluster
+func ApplyValidationPolicyThresholds(policy *models.ValidationPolicy, requirements *models.ClusterHostRequirementsDetails) {
+	if policy == nil || requirements == nil {
+		return
+	}
+	if policy.InstallationDiskSpeedThresholdMs != nil {
+		requirements.InstallationDiskSpeedThresholdMs = *policy.InstallationDiskSpeedThresholdMs
+	}
+	if policy.NetworkLatencyThresholdMs != nil {
+		requirements.NetworkLatencyThresholdMs = policy.NetworkLatencyThresholdMs
+	}
+	if policy.PacketLossPercentage != nil {
+		requirements.PacketLossPercentage = policy.PacketLossPercentage
+	}
+}
@@ -0,0 +1,14 @@
+package common
+
+import (
+	"github.com/openshift/assisted-service/models"
+)
+
+// GetWorkerSuccessThreshold returns how many of the workers of the cluster must be installed, an empty threshold that requires the built-in minimum when none was set
+func GetWorkerSuccessThreshold(cluster *Cluster) (*models.WorkerSuccessThreshold, error) {
+	var ret models.WorkerSuccessThreshold
+	if err := UnmarshalJSONColumn(cluster.WorkerSuccessThreshold, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	TemplateSyncedCondition conditionsv1.ConditionType = "Synced"
+
+	TemplateSyncedReason = "TemplateSynced"
+	TemplateSyncedMsg    = "The template has been synced to the service"
+	TemplateSyncError    = "TemplateSyncError"
+	TemplateSyncErrorMsg = "Failed to sync the template to the service:"
+)
+
+// ClusterTemplateOperator is an OLM operator installed on the clusters of the template
+type ClusterTemplateOperator struct {
+	// Name of the operator.
+	Name string `json:"name"`
+	// Properties of the operator, in the JSON format of the REST API.
+	// +optional
+	Properties string `json:"properties,omitempty"`
+}
+
+// ClusterTemplateSpec holds the defaults of the clusters registered from the template. String values may contain
+// ${key} placeholders that are substituted with the template parameters of each cluster.
+type ClusterTemplateSpec struct {
+	// Description is a free-text description of the template.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// HighAvailabilityMode is the availability of the installed clusters, 'Full' or 'None'.
+	// +kubebuilder:validation:Enum=Full;None
+	// +optional
+	HighAvailabilityMode string `json:"highAvailabilityMode,omitempty"`
+
+	// BaseDomain is the base domain of the clusters.
+	// +optional
+	BaseDomain string `json:"baseDomain,omitempty"`
+
+	// ClusterNetworkCIDR is the IP address block from which Pod IPs are allocated.
+	// +optional
+	ClusterNetworkCIDR string `json:"clusterNetworkCIDR,omitempty"`
+
+	// ClusterNetworkHostPrefix is the subnet prefix length to assign to each node.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	ClusterNetworkHostPrefix int64 `json:"clusterNetworkHostPrefix,omitempty"`
+
+	// ServiceNetworkCIDR is the IP address pool to use for service IP addresses.
+	// +optional
+	ServiceNetworkCIDR string `json:"serviceNetworkCIDR,omitempty"`
+
+	// SSHPublicKey will be added to all the cluster hosts for use in debugging.
+	// +optional
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
+
+	// VIPDHCPAllocation indicates if the virtual IPs are allocated by DHCP.
+	// +optional
+	VIPDHCPAllocation *bool `json:"vipDHCPAllocation,omitempty"`
+
+	// UserManagedNetworking indicates if the networking is managed by the user.
+	// +optional
+	UserManagedNetworking *bool `json:"userManagedNetworking,omitempty"`
+
+	// Proxy defines the proxy settings of the clusters.
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+
+	// AdditionalNTPSources is a list of NTP sources (hostname or IP) to be added to all the cluster hosts.
+	// +optional
+	AdditionalNTPSources []string `json:"additionalNTPSources,omitempty"`
+
+	// Hyperthreading enables hyperthreading on the masters, the workers, all the nodes or none of them.
+	// +kubebuilder:validation:Enum=masters;workers;none;all
+	// +optional
+	Hyperthreading string `json:"hyperthreading,omitempty"`
+
+	// Operators lists the OLM operators to install on the clusters.
+	// +optional
+	Operators []ClusterTemplateOperator `json:"operators,omitempty"`
+
+	// InstallConfigOverrides is a JSON-formatted string containing the overrides for the install-config.yaml file
+	// of the clusters.
+	// +optional
+	InstallConfigOverrides string `json:"installConfigOverrides,omitempty"`
+
+	// ManifestsConfigMapRef is a reference to a ConfigMap whose entries are manifests added to the clusters,
+	// keyed by their file names.
+	// +optional
+	ManifestsConfigMapRef *corev1.LocalObjectReference `json:"manifestsConfigMapRef,omitempty"`
+}
+
+// ClusterTemplateStatus defines the observed state of ClusterTemplate
+type ClusterTemplateStatus struct {
+	// TemplateID is the ID of the template in the service.
+	// +optional
+	TemplateID string `json:"templateID,omitempty"`
+	// Version is the latest version of the template in the service. Clusters record the version that they were
+	// registered from.
+	// +optional
+	Version int64 `json:"version,omitempty"`
+	// ObservedGeneration is the generation of the spec that the latest version was created from.
+	// +optional
+	ObservedGeneration int64                    `json:"observedGeneration,omitempty"`
+	Conditions         []conditionsv1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ClusterTemplate holds defaults for registering similar clusters. AgentClusterInstalls refer to it with the
+// agent-install.openshift.io/cluster-template annotation.
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterTemplateSpec   `json:"spec,omitempty"`
+	Status ClusterTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplates
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+}
@@ -415,7 +415,7 @@ func installed(agent *aiv1beta1.Agent, status, statusInfo string) {
 		msg = InstallationNotStartedMsg
 	case models.HostStatusPreparingForInstallation, models.HostStatusPreparingSuccessful,
 		models.HostStatusInstalling, models.HostStatusInstallingInProgress,
-		models.HostStatusInstallingPendingUserAction:
+		models.HostStatusInstallingPendingUserAction, models.HostStatusInstallingPaused:
 		condStatus = corev1.ConditionFalse
 		reason = InstallationInProgressReason
 		msg = fmt.Sprintf("%s %s", InstallationInProgressMsg, statusInfo)
@@ -514,7 +514,7 @@ func readyForInstallation(agent *aiv1beta1.Agent, status string) {
 		reason = AgentNotReadyReason
 		msg = AgentNotReadyMsg
 	case models.HostStatusPreparingForInstallation, models.HostStatusPreparingSuccessful, models.HostStatusInstalling,
-		models.HostStatusInstallingInProgress, models.HostStatusInstallingPendingUserAction:
+		models.HostStatusInstallingInProgress, models.HostStatusInstallingPendingUserAction, models.HostStatusInstallingPaused:
 		condStatus = corev1.ConditionFalse
 		reason = AgentAlreadyInstallingReason
 		msg = AgentAlreadyInstallingMsg
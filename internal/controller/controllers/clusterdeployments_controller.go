@@ -19,8 +19,10 @@ package controllers
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"strings"
 	"time"
 
@@ -65,6 +67,12 @@ const (
 	adminPasswordSecretStringTemplate = "%s-admin-password"
 	adminKubeConfigStringTemplate     = "%s-admin-kubeconfig"
 	InstallConfigOverrides            = aiv1beta1.Group + "/install-config-overrides"
+	ValidationPolicy                  = aiv1beta1.Group + "/validation-policy"
+	StageTimeouts                     = aiv1beta1.Group + "/stage-timeouts"
+	InstallRetryPolicy                = aiv1beta1.Group + "/install-retry-policy"
+	WorkerSuccessThreshold            = aiv1beta1.Group + "/worker-success-threshold"
+	ClusterTemplate                   = aiv1beta1.Group + "/cluster-template"
+	ClusterTemplateParams             = aiv1beta1.Group + "/cluster-template-params"
 	ClusterDeploymentFinalizerName    = "clusterdeployments." + aiv1beta1.Group + "/ai-deprovision"
 	AgentClusterInstallFinalizerName  = "agentclusterinstall." + aiv1beta1.Group + "/ai-deprovision"
 )
@@ -202,6 +210,34 @@ func (r *ClusterDeploymentsReconciler) Reconcile(origCtx context.Context, req ct
 		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
 	}
 
+	// check for validation policy changes and update if needed
+	err = r.updateValidationPolicy(ctx, log, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to update validation policy")
+		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
+	}
+
+	// check for stage timeouts changes and update if needed, also during the installation
+	err = r.updateStageTimeouts(ctx, log, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to update stage timeouts")
+		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
+	}
+
+	// check for install retry policy changes and update if needed, also during the installation
+	err = r.updateInstallRetryPolicy(ctx, log, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to update install retry policy")
+		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
+	}
+
+	// check for worker success threshold changes and update if needed, also during the installation
+	err = r.updateWorkerSuccessThreshold(ctx, log, clusterInstall, cluster)
+	if err != nil {
+		log.WithError(err).Error("failed to update worker success threshold")
+		return r.updateStatus(ctx, log, clusterInstall, cluster, err)
+	}
+
 	// In case the Cluster is a Day 1 cluster and is installed, update the Metadata and create secrets for credentials
 	if *cluster.Status == models.ClusterStatusInstalled && swag.StringValue(cluster.Kind) == models.ClusterKindCluster {
 		if !isInstalled(clusterDeployment, clusterInstall) {
@@ -676,6 +712,105 @@ func (r *ClusterDeploymentsReconciler) updateInstallConfigOverrides(ctx context.
 	return nil
 }
 
+func (r *ClusterDeploymentsReconciler) updateValidationPolicy(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall,
+	cluster *common.Cluster) error {
+	// handle ValidationPolicy
+	policy := &models.ValidationPolicy{}
+	return updateClusterSetting(log, clusterInstall, ValidationPolicy, "validation policy", &cluster.ValidationPolicy,
+		policy, &models.ValidationPolicy{}, func() (string, error) {
+			updated, err := r.Installer.UpdateClusterValidationPolicyInternal(ctx, installer.UpdateClusterValidationPolicyParams{
+				ClusterID:        *cluster.ID,
+				ValidationPolicy: policy,
+			})
+			if err != nil {
+				return "", err
+			}
+			return updated.ValidationPolicy, nil
+		})
+}
+
+func (r *ClusterDeploymentsReconciler) updateStageTimeouts(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall,
+	cluster *common.Cluster) error {
+	// handle StageTimeouts
+	stageTimeouts := &models.StageTimeouts{}
+	return updateClusterSetting(log, clusterInstall, StageTimeouts, "stage timeouts", &cluster.StageTimeouts,
+		stageTimeouts, &models.StageTimeouts{}, func() (string, error) {
+			updated, err := r.Installer.UpdateClusterStageTimeoutsInternal(ctx, installer.UpdateClusterStageTimeoutsParams{
+				ClusterID:     *cluster.ID,
+				StageTimeouts: stageTimeouts,
+			})
+			if err != nil {
+				return "", err
+			}
+			return updated.StageTimeouts, nil
+		})
+}
+
+func (r *ClusterDeploymentsReconciler) updateInstallRetryPolicy(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall,
+	cluster *common.Cluster) error {
+	// handle InstallRetryPolicy
+	installRetryPolicy := &models.InstallRetryPolicy{}
+	return updateClusterSetting(log, clusterInstall, InstallRetryPolicy, "install retry policy", &cluster.InstallRetryPolicy,
+		installRetryPolicy, &models.InstallRetryPolicy{}, func() (string, error) {
+			updated, err := r.Installer.UpdateClusterInstallRetryPolicyInternal(ctx, installer.UpdateClusterInstallRetryPolicyParams{
+				ClusterID:          *cluster.ID,
+				InstallRetryPolicy: installRetryPolicy,
+			})
+			if err != nil {
+				return "", err
+			}
+			return updated.InstallRetryPolicy, nil
+		})
+}
+
+func (r *ClusterDeploymentsReconciler) updateWorkerSuccessThreshold(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall,
+	cluster *common.Cluster) error {
+	// handle WorkerSuccessThreshold
+	workerSuccessThreshold := &models.WorkerSuccessThreshold{}
+	return updateClusterSetting(log, clusterInstall, WorkerSuccessThreshold, "worker success threshold", &cluster.WorkerSuccessThreshold,
+		workerSuccessThreshold, &models.WorkerSuccessThreshold{}, func() (string, error) {
+			updated, err := r.Installer.UpdateClusterWorkerSuccessThresholdInternal(ctx, installer.UpdateClusterWorkerSuccessThresholdParams{
+				ClusterID:              *cluster.ID,
+				WorkerSuccessThreshold: workerSuccessThreshold,
+			})
+			if err != nil {
+				return "", err
+			}
+			return updated.WorkerSuccessThreshold, nil
+		})
+}
+
+// updateClusterSetting replaces a JSON-formatted setting of the cluster with the value of its annotation. The annotation
+// is read into requested and the setting into current, and they are compared in their canonical form since the
+// annotation is written by the user. update replaces the setting with requested and returns the stored setting.
+func updateClusterSetting(log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall, annotation, name string,
+	setting *string, requested, current interface{}, update func() (string, error)) error {
+	if err := common.UnmarshalJSONColumn(clusterInstall.ObjectMeta.GetAnnotations()[annotation], requested); err != nil {
+		return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "failed to parse the %s annotation", annotation))
+	}
+	requestedValue, err := common.MarshalJSONColumn(requested)
+	if err != nil {
+		return err
+	}
+	if err = common.UnmarshalJSONColumn(*setting, current); err != nil {
+		return err
+	}
+	currentValue, err := common.MarshalJSONColumn(current)
+	if err != nil {
+		return err
+	}
+	if requestedValue == currentValue {
+		return nil
+	}
+	updated, err := update()
+	if err != nil {
+		return err
+	}
+	*setting = updated
+	log.Infof("Updated %s on clusterInstall %s/%s", name, clusterInstall.Namespace, clusterInstall.Name)
+	return nil
+}
+
 func (r *ClusterDeploymentsReconciler) syncManifests(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
 	clusterInstall *hiveext.AgentClusterInstall, alreadyCreatedManifests models.ListManifests) error {
 
@@ -812,6 +947,11 @@ func (r *ClusterDeploymentsReconciler) createNewCluster(
 		clusterParams.Hyperthreading = getHyperthreading(clusterInstall)
 	}
 
+	if err = r.setClusterTemplate(ctx, clusterInstall, clusterParams); err != nil {
+		log.WithError(err).Error("failed to set the cluster template")
+		return r.updateStatus(ctx, log, clusterInstall, nil, err)
+	}
+
 	c, err := r.Installer.RegisterClusterInternal(ctx, &key, installer.RegisterClusterParams{
 		NewClusterParams: clusterParams,
 	})
@@ -819,6 +959,38 @@ func (r *ClusterDeploymentsReconciler) createNewCluster(
 	return r.updateStatus(ctx, log, clusterInstall, c, err)
 }
 
+// setClusterTemplate registers the cluster from the latest synced version of the ClusterTemplate that the
+// cluster-template annotation names, if any
+func (r *ClusterDeploymentsReconciler) setClusterTemplate(ctx context.Context, clusterInstall *hiveext.AgentClusterInstall,
+	clusterParams *models.ClusterCreateParams) error {
+	annotations := clusterInstall.ObjectMeta.GetAnnotations()
+	name := annotations[ClusterTemplate]
+	if name == "" {
+		return nil
+	}
+
+	var templateParams map[string]string
+	if value := annotations[ClusterTemplateParams]; value != "" {
+		if err := json.Unmarshal([]byte(value), &templateParams); err != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "failed to parse the %s annotation", ClusterTemplateParams))
+		}
+	}
+
+	template := &aiv1beta1.ClusterTemplate{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: clusterInstall.Namespace, Name: name}, template); err != nil {
+		return errors.Wrapf(err, "failed to get cluster template %s", name)
+	}
+	if template.Status.TemplateID == "" {
+		return errors.Errorf("cluster template %s has not been synced yet", name)
+	}
+
+	templateID := strfmt.UUID(template.Status.TemplateID)
+	clusterParams.TemplateID = &templateID
+	clusterParams.TemplateVersion = swag.Int64(template.Status.Version)
+	clusterParams.TemplateParams = templateParams
+	return nil
+}
+
 func (r *ClusterDeploymentsReconciler) createNewDay2Cluster(
 	ctx context.Context,
 	log logrus.FieldLogger,
@@ -1061,6 +1233,7 @@ func (r *ClusterDeploymentsReconciler) updateStatus(ctx context.Context, log log
 			clusterValidated(clusterInstall, status, c)
 			clusterCompleted(clusterInstall, status, swag.StringValue(c.StatusInfo))
 			clusterFailed(clusterInstall, status, swag.StringValue(c.StatusInfo))
+			clusterFailureReason(log, clusterInstall, status, c)
 			clusterStopped(clusterInstall, status)
 		}
 	} else {
@@ -1191,7 +1364,7 @@ func clusterRequirementsMet(clusterInstall *hiveext.AgentClusterInstall, status
 		reason = ClusterNotReadyReason
 		msg = ClusterNotReadyMsg
 	case models.ClusterStatusPreparingForInstallation,
-		models.ClusterStatusInstalling, models.ClusterStatusInstallingPendingUserAction,
+		models.ClusterStatusInstalling, models.ClusterStatusInstallingPendingUserAction, models.ClusterStatusInstallingPaused,
 		models.ClusterStatusAddingHosts, models.ClusterStatusFinalizing:
 		condStatus = corev1.ConditionTrue
 		reason = ClusterAlreadyInstallingReason
@@ -1231,7 +1404,7 @@ func clusterCompleted(clusterInstall *hiveext.AgentClusterInstall, status, statu
 		reason = InstallationNotStartedReason
 		msg = InstallationNotStartedMsg
 	case models.ClusterStatusPreparingForInstallation, models.ClusterStatusInstalling, models.ClusterStatusFinalizing,
-		models.ClusterStatusInstallingPendingUserAction:
+		models.ClusterStatusInstallingPendingUserAction, models.ClusterStatusInstallingPaused:
 		condStatus = corev1.ConditionFalse
 		reason = InstallationInProgressReason
 		msg = fmt.Sprintf("%s %s", InstallationInProgressMsg, statusInfo)
@@ -1270,6 +1443,44 @@ func clusterFailed(clusterInstall *hiveext.AgentClusterInstall, status, statusIn
 	})
 }
 
+// clusterFailureReason reports the classified root cause of a failed installation. The reason of the condition is the
+// category of the failure, such as DiskFailure or ImagePullFailure.
+func clusterFailureReason(log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall, status string, c *common.Cluster) {
+	condStatus := corev1.ConditionFalse
+	reason := ClusterFailureNotClassifiedReason
+	msg := ClusterFailureNotClassifiedMsg
+	if status == models.ClusterStatusError {
+		failureReason, err := common.GetFailureReason(c)
+		if err != nil {
+			log.WithError(err).Errorf("failed to parse the failure reason of cluster %s", c.ID)
+		}
+		if failureReason != nil {
+			condStatus = corev1.ConditionTrue
+			reason = failureCategoryReason(failureReason.Category)
+			msg = fmt.Sprintf("%s %s", ClusterFailureClassifiedMsg, swag.StringValue(failureReason.Summary))
+			if failureReason.Remediation != "" {
+				msg = fmt.Sprintf("%s. %s", msg, failureReason.Remediation)
+			}
+		}
+	}
+	setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+		Type:    ClusterFailureReasonCondition,
+		Status:  condStatus,
+		Reason:  reason,
+		Message: msg,
+	})
+}
+
+// failureCategoryReason turns a failure category such as image-pull into a condition reason such as ImagePullFailure
+func failureCategoryReason(category models.FailureCategory) string {
+	var reason strings.Builder
+	for _, word := range strings.Split(string(category), "-") {
+		reason.WriteString(strings.Title(word))
+	}
+	reason.WriteString("Failure")
+	return reason.String()
+}
+
 func clusterStopped(clusterInstall *hiveext.AgentClusterInstall, status string) {
 	var condStatus corev1.ConditionStatus
 	var reason string
@@ -1372,6 +1583,12 @@ func setClusterConditionsUnknown(clusterInstall *hiveext.AgentClusterInstall) {
 		Reason:  NotAvailableReason,
 		Message: NotAvailableMsg,
 	})
+	setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
+		Type:    ClusterFailureReasonCondition,
+		Status:  corev1.ConditionUnknown,
+		Reason:  NotAvailableReason,
+		Message: NotAvailableMsg,
+	})
 	setClusterCondition(&clusterInstall.Status.Conditions, hivev1.ClusterInstallCondition{
 		Type:    ClusterStoppedCondition,
 		Status:  corev1.ConditionUnknown,
@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/common"
 	hiveext "github.com/openshift/assisted-service/internal/controller/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/internal/controller/api/v1beta1"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/manifests"
@@ -322,6 +323,72 @@ var _ = Describe("cluster reconcile", func() {
 
 				validateCreation(cluster)
 			})
+
+			It("create new cluster from a cluster template", func() {
+				templateID := strfmt.UUID(uuid.New().String())
+				template := &aiv1beta1.ClusterTemplate{
+					ObjectMeta: metav1.ObjectMeta{Name: "edge-template", Namespace: testNamespace},
+					Status:     aiv1beta1.ClusterTemplateStatus{TemplateID: templateID.String(), Version: 3},
+				}
+				Expect(c.Create(ctx, template)).ShouldNot(HaveOccurred())
+				mockInstallerInternal.EXPECT().RegisterClusterInternal(gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(ctx, kubeKey interface{}, params installer.RegisterClusterParams) {
+						Expect(*params.NewClusterParams.TemplateID).To(Equal(templateID))
+						Expect(swag.Int64Value(params.NewClusterParams.TemplateVersion)).To(Equal(int64(3)))
+						Expect(params.NewClusterParams.TemplateParams).To(Equal(map[string]string{"site": "store-42"}))
+					}).Return(clusterReply, nil)
+				mockInstallerInternal.EXPECT().AddOpenshiftVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(openshiftVersion, nil)
+
+				cluster := newClusterDeployment(clusterName, testNamespace, defaultClusterSpec)
+				Expect(c.Create(ctx, cluster)).ShouldNot(HaveOccurred())
+				aci := newAgentClusterInstall(agentClusterInstallName, testNamespace, defaultAgentClusterInstallSpec, cluster)
+				aci.ObjectMeta.SetAnnotations(map[string]string{
+					ClusterTemplate:       "edge-template",
+					ClusterTemplateParams: `{"site": "store-42"}`,
+				})
+				Expect(c.Create(ctx, aci)).ShouldNot(HaveOccurred())
+				validateCreation(cluster)
+			})
+		})
+
+		It("create new cluster from a cluster template that is not synced", func() {
+			template := &aiv1beta1.ClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "edge-template", Namespace: testNamespace},
+			}
+			Expect(c.Create(ctx, template)).ShouldNot(HaveOccurred())
+			mockInstallerInternal.EXPECT().AddOpenshiftVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(openshiftVersion, nil)
+
+			cluster := newClusterDeployment(clusterName, testNamespace, defaultClusterSpec)
+			Expect(c.Create(ctx, cluster)).ShouldNot(HaveOccurred())
+			aci := newAgentClusterInstall(agentClusterInstallName, testNamespace, defaultAgentClusterInstallSpec, cluster)
+			aci.ObjectMeta.SetAnnotations(map[string]string{ClusterTemplate: "edge-template"})
+			Expect(c.Create(ctx, aci)).ShouldNot(HaveOccurred())
+
+			result, err := cr.Reconcile(ctx, newClusterDeploymentRequest(cluster))
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}))
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, ClusterSpecSyncedCondition).Message).
+				To(ContainSubstring("cluster template edge-template has not been synced yet"))
+		})
+
+		It("create new cluster with illegal cluster template params", func() {
+			mockInstallerInternal.EXPECT().AddOpenshiftVersion(gomock.Any(), gomock.Any(), gomock.Any()).Return(openshiftVersion, nil)
+
+			cluster := newClusterDeployment(clusterName, testNamespace, defaultClusterSpec)
+			Expect(c.Create(ctx, cluster)).ShouldNot(HaveOccurred())
+			aci := newAgentClusterInstall(agentClusterInstallName, testNamespace, defaultAgentClusterInstallSpec, cluster)
+			aci.ObjectMeta.SetAnnotations(map[string]string{
+				ClusterTemplate:       "edge-template",
+				ClusterTemplateParams: "site=store-42",
+			})
+			Expect(c.Create(ctx, aci)).ShouldNot(HaveOccurred())
+
+			result, err := cr.Reconcile(ctx, newClusterDeploymentRequest(cluster))
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, ClusterSpecSyncedCondition).Reason).To(Equal(InputErrorReason))
 		})
 
 		It("create new cluster backend failure", func() {
@@ -1614,6 +1681,234 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(result).To(Equal(ctrl.Result{}))
 		})
 
+		It("add validation policy annotation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInsufficient),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			validationPolicy := `{"disabled_host_validations": ["ntp-synced"], "packet_loss_percentage": 5}`
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sId,
+					Status:           swag.String(models.ClusterStatusInsufficient),
+					ValidationPolicy: validationPolicy,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterValidationPolicyInternal(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.UpdateClusterValidationPolicyParams) {
+					Expect(param.ClusterID).To(Equal(sId))
+					Expect(param.ValidationPolicy.DisabledHostValidations).To(Equal([]models.HostValidationID{models.HostValidationIDNtpSynced}))
+					Expect(param.ValidationPolicy.PacketLossPercentage).To(Equal(swag.Float64(5)))
+				}).Return(updateReply, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{ValidationPolicy: validationPolicy})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
+		It("Unchanged validation policy annotation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInsufficient),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+					ValidationPolicy:         `{"disabled_cluster_validations":null,"disabled_host_validations":["ntp-synced"],"warning_cluster_validations":null,"warning_host_validations":null}`,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{ValidationPolicy: `{"disabled_host_validations": ["ntp-synced"]}`})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
+		It("add stage timeouts annotation during the installation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInstalling),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			stageTimeouts := `{"default_timeout_seconds": 5400, "timeouts": [{"stage": "Rebooting", "timeout_seconds": 7200}]}`
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:            &sId,
+					Status:        swag.String(models.ClusterStatusInstalling),
+					StageTimeouts: stageTimeouts,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterStageTimeoutsInternal(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.UpdateClusterStageTimeoutsParams) {
+					Expect(param.ClusterID).To(Equal(sId))
+					Expect(param.StageTimeouts.DefaultTimeoutSeconds).To(Equal(int64(5400)))
+					Expect(param.StageTimeouts.Timeouts).To(HaveLen(1))
+					Expect(param.StageTimeouts.Timeouts[0].Stage).To(Equal(models.HostStageRebooting))
+					Expect(param.StageTimeouts.Timeouts[0].TimeoutSeconds).To(Equal(swag.Int64(7200)))
+				}).Return(updateReply, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{StageTimeouts: stageTimeouts})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
+		It("add install retry policy annotation during the installation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInstalling),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			installRetryPolicy := `{"max_retries": 2, "stages": ["Writing image to disk"]}`
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                 &sId,
+					Status:             swag.String(models.ClusterStatusInstalling),
+					InstallRetryPolicy: installRetryPolicy,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterInstallRetryPolicyInternal(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.UpdateClusterInstallRetryPolicyParams) {
+					Expect(param.ClusterID).To(Equal(sId))
+					Expect(param.InstallRetryPolicy.MaxRetries).To(Equal(swag.Int64(2)))
+					Expect(param.InstallRetryPolicy.Stages).To(Equal([]models.HostStage{models.HostStageWritingImageToDisk}))
+				}).Return(updateReply, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{InstallRetryPolicy: installRetryPolicy})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
+		It("add worker success threshold annotation during the installation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInstalling),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			workerSuccessThreshold := `{"min_workers": 2, "min_workers_percentage": 90}`
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                     &sId,
+					Status:                 swag.String(models.ClusterStatusInstalling),
+					WorkerSuccessThreshold: workerSuccessThreshold,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterWorkerSuccessThresholdInternal(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.UpdateClusterWorkerSuccessThresholdParams) {
+					Expect(param.ClusterID).To(Equal(sId))
+					Expect(param.WorkerSuccessThreshold.MinWorkers).To(Equal(swag.Int64(2)))
+					Expect(param.WorkerSuccessThreshold.MinWorkersPercentage).To(Equal(swag.Int64(90)))
+				}).Return(updateReply, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{WorkerSuccessThreshold: workerSuccessThreshold})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
+		It("invalid validation policy annotation", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                       &sId,
+					Name:                     clusterName,
+					OpenshiftVersion:         "4.8",
+					ClusterNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].CIDR,
+					ClusterNetworkHostPrefix: int64(defaultAgentClusterInstallSpec.Networking.ClusterNetwork[0].HostPrefix),
+					Status:                   swag.String(models.ClusterStatusInsufficient),
+					ServiceNetworkCidr:       defaultAgentClusterInstallSpec.Networking.ServiceNetwork[0],
+					IngressVip:               defaultAgentClusterInstallSpec.IngressVIP,
+					APIVip:                   defaultAgentClusterInstallSpec.APIVIP,
+					BaseDNSDomain:            defaultClusterSpec.BaseDomain,
+					SSHPublicKey:             defaultAgentClusterInstallSpec.SSHPublicKey,
+					Hyperthreading:           models.ClusterHyperthreadingAll,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			aci.ObjectMeta.SetAnnotations(map[string]string{ValidationPolicy: `{"disabled_host_validations": "ntp-synced"}`})
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, ClusterSpecSyncedCondition).Reason).To(Equal(InputErrorReason))
+		})
+
 	})
 
 	Context("cluster update not needed", func() {
@@ -1716,6 +2011,7 @@ var _ = Describe("TestConditions", func() {
 		clusterStatus  string
 		statusInfo     string
 		validationInfo string
+		failureReason  string
 		conditions     []hivev1.ClusterInstallCondition
 	}{
 		{
@@ -1976,6 +2272,12 @@ var _ = Describe("TestConditions", func() {
 					Reason:  ClusterFailedReason,
 					Status:  corev1.ConditionTrue,
 				},
+				{
+					Type:    ClusterFailureReasonCondition,
+					Message: ClusterFailureNotClassifiedMsg,
+					Reason:  ClusterFailureNotClassifiedReason,
+					Status:  corev1.ConditionFalse,
+				},
 				{
 					Type:    ClusterStoppedCondition,
 					Message: ClusterStoppedFailedMsg,
@@ -1984,6 +2286,27 @@ var _ = Describe("TestConditions", func() {
 				},
 			},
 		},
+		{
+			name:           "Error with a classified failure",
+			clusterStatus:  models.ClusterStatusError,
+			statusInfo:     "failed due to some error",
+			validationInfo: "{\"some-check\":[{\"id\":\"checking2\",\"status\":\"success\",\"message\":\"Check2 is OK\"}]}",
+			failureReason:  `{"category":"image-pull","summary":"Container images could not be pulled","remediation":"Check the pull secret."}`,
+			conditions: []hivev1.ClusterInstallCondition{
+				{
+					Type:    ClusterFailedCondition,
+					Message: ClusterFailedMsg + " failed due to some error",
+					Reason:  ClusterFailedReason,
+					Status:  corev1.ConditionTrue,
+				},
+				{
+					Type:    ClusterFailureReasonCondition,
+					Message: ClusterFailureClassifiedMsg + " Container images could not be pulled. Check the pull secret.",
+					Reason:  "ImagePullFailure",
+					Status:  corev1.ConditionTrue,
+				},
+			},
+		},
 	}
 
 	for i := range tests {
@@ -1992,6 +2315,7 @@ var _ = Describe("TestConditions", func() {
 			backEndCluster.Status = swag.String(t.clusterStatus)
 			backEndCluster.StatusInfo = swag.String(t.statusInfo)
 			backEndCluster.ValidationsInfo = t.validationInfo
+			backEndCluster.FailureReason = t.failureReason
 			cid := strfmt.UUID(uuid.New().String())
 			backEndCluster.ID = &cid
 			_, err := cr.Reconcile(ctx, clusterRequest)
@@ -0,0 +1,232 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
+	aiv1beta1 "github.com/openshift/assisted-service/internal/controller/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const ClusterTemplateFinalizerName = "clustertemplate." + aiv1beta1.Group + "/ai-deprovision"
+
+// ClusterTemplateReconciler reconciles a ClusterTemplate object
+type ClusterTemplateReconciler struct {
+	client.Client
+	Log       logrus.FieldLogger
+	Templates clustertemplates.ClusterTemplatesInternals
+}
+
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=clustertemplates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=clustertemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=agent-install.openshift.io,resources=clustertemplates/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+func (r *ClusterTemplateReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := addRequestIdIfNeeded(origCtx)
+	log := logutil.FromContext(ctx, r.Log).WithFields(
+		logrus.Fields{
+			"cluster_template":           req.Name,
+			"cluster_template_namespace": req.Namespace,
+		})
+
+	defer func() {
+		log.Info("ClusterTemplate Reconcile ended")
+	}()
+
+	log.Info("ClusterTemplate Reconcile started")
+
+	template := &aiv1beta1.ClusterTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, template); err != nil {
+		log.WithError(err).Errorf("Failed to get resource %s", req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if template.ObjectMeta.DeletionTimestamp.IsZero() {
+		// Register a finalizer if it is absent.
+		if !funk.ContainsString(template.GetFinalizers(), ClusterTemplateFinalizerName) {
+			controllerutil.AddFinalizer(template, ClusterTemplateFinalizerName)
+			if err := r.Update(ctx, template); err != nil {
+				log.WithError(err).Errorf("failed to add finalizer %s to resource %s %s",
+					ClusterTemplateFinalizerName, template.Name, template.Namespace)
+				return ctrl.Result{Requeue: true}, err
+			}
+		}
+	} else {
+		return r.deregisterTemplate(ctx, log, template)
+	}
+
+	if template.Status.TemplateID != "" && template.Status.ObservedGeneration == template.Generation {
+		return ctrl.Result{}, nil
+	}
+
+	params, err := r.templateParams(ctx, template)
+	if err != nil {
+		log.WithError(err).Error("failed to build the template params")
+		return r.updateTemplateStatus(ctx, log, template, nil, err)
+	}
+
+	var synced *models.ClusterTemplate
+	if template.Status.TemplateID == "" {
+		synced, err = r.Templates.CreateClusterTemplateInternal(ctx, operations.CreateClusterTemplateParams{
+			NewClusterTemplateParams: params,
+		})
+	} else {
+		synced, err = r.Templates.UpdateClusterTemplateInternal(ctx, operations.UpdateClusterTemplateParams{
+			ClusterTemplateID:           strfmt.UUID(template.Status.TemplateID),
+			ClusterTemplateUpdateParams: params,
+		})
+	}
+	if err != nil {
+		log.WithError(err).Error("failed to sync the template")
+	}
+	return r.updateTemplateStatus(ctx, log, template, synced, err)
+}
+
+func (r *ClusterTemplateReconciler) deregisterTemplate(ctx context.Context, log logrus.FieldLogger, template *aiv1beta1.ClusterTemplate) (ctrl.Result, error) {
+	if !funk.ContainsString(template.GetFinalizers(), ClusterTemplateFinalizerName) {
+		return ctrl.Result{}, nil
+	}
+	if template.Status.TemplateID != "" {
+		err := r.Templates.DeleteClusterTemplateInternal(ctx, operations.DeleteClusterTemplateParams{
+			ClusterTemplateID: strfmt.UUID(template.Status.TemplateID),
+		})
+		if err != nil && !IsHTTPError(err, http.StatusNotFound) {
+			log.WithError(err).Errorf("failed to delete cluster template %s", template.Status.TemplateID)
+			return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, nil
+		}
+	}
+	controllerutil.RemoveFinalizer(template, ClusterTemplateFinalizerName)
+	if err := r.Update(ctx, template); err != nil {
+		log.WithError(err).Errorf("failed to remove finalizer %s from resource %s %s",
+			ClusterTemplateFinalizerName, template.Name, template.Namespace)
+		return ctrl.Result{Requeue: true}, err
+	}
+	log.Info("cluster template deregistered")
+	return ctrl.Result{}, nil
+}
+
+func (r *ClusterTemplateReconciler) templateParams(ctx context.Context, template *aiv1beta1.ClusterTemplate) (*models.ClusterTemplateCreateParams, error) {
+	spec := template.Spec
+	clusterParams := &models.ClusterTemplateParams{
+		HighAvailabilityMode:     spec.HighAvailabilityMode,
+		BaseDNSDomain:            spec.BaseDomain,
+		ClusterNetworkCidr:       spec.ClusterNetworkCIDR,
+		ClusterNetworkHostPrefix: spec.ClusterNetworkHostPrefix,
+		ServiceNetworkCidr:       spec.ServiceNetworkCIDR,
+		SSHPublicKey:             spec.SSHPublicKey,
+		VipDhcpAllocation:        spec.VIPDHCPAllocation,
+		UserManagedNetworking:    spec.UserManagedNetworking,
+		AdditionalNtpSource:      strings.Join(spec.AdditionalNTPSources, ","),
+		Hyperthreading:           spec.Hyperthreading,
+	}
+	if spec.Proxy != nil {
+		clusterParams.HTTPProxy = spec.Proxy.HTTPProxy
+		clusterParams.HTTPSProxy = spec.Proxy.HTTPSProxy
+		clusterParams.NoProxy = spec.Proxy.NoProxy
+	}
+	for _, operator := range spec.Operators {
+		clusterParams.OlmOperators = append(clusterParams.OlmOperators, &models.OperatorCreateParams{
+			Name:       operator.Name,
+			Properties: operator.Properties,
+		})
+	}
+
+	params := &models.ClusterTemplateCreateParams{
+		Name:                   swag.String(template.Name),
+		Description:            spec.Description,
+		ClusterParams:          clusterParams,
+		InstallConfigOverrides: spec.InstallConfigOverrides,
+	}
+
+	if spec.ManifestsConfigMapRef != nil {
+		configMap := &corev1.ConfigMap{}
+		key := types.NamespacedName{Namespace: template.Namespace, Name: spec.ManifestsConfigMapRef.Name}
+		if err := r.Get(ctx, key, configMap); err != nil {
+			return nil, errors.Wrapf(err, "failed to get the manifests config map %s", key)
+		}
+		fileNames := make([]string, 0, len(configMap.Data))
+		for fileName := range configMap.Data {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+		for _, fileName := range fileNames {
+			params.Manifests = append(params.Manifests, &models.CreateManifestParams{
+				FileName: swag.String(fileName),
+				Folder:   swag.String(models.ManifestFolderOpenshift),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(configMap.Data[fileName]))),
+			})
+		}
+	}
+	return params, nil
+}
+
+func (r *ClusterTemplateReconciler) updateTemplateStatus(ctx context.Context, log logrus.FieldLogger, template *aiv1beta1.ClusterTemplate,
+	synced *models.ClusterTemplate, syncErr error) (ctrl.Result, error) {
+	if syncErr != nil {
+		conditionsv1.SetStatusConditionNoHeartbeat(&template.Status.Conditions, conditionsv1.Condition{
+			Type:    aiv1beta1.TemplateSyncedCondition,
+			Status:  corev1.ConditionFalse,
+			Reason:  aiv1beta1.TemplateSyncError,
+			Message: aiv1beta1.TemplateSyncErrorMsg + " " + syncErr.Error(),
+		})
+	} else {
+		template.Status.TemplateID = synced.ID.String()
+		template.Status.Version = swag.Int64Value(synced.Version)
+		template.Status.ObservedGeneration = template.Generation
+		conditionsv1.SetStatusConditionNoHeartbeat(&template.Status.Conditions, conditionsv1.Condition{
+			Type:    aiv1beta1.TemplateSyncedCondition,
+			Status:  corev1.ConditionTrue,
+			Reason:  aiv1beta1.TemplateSyncedReason,
+			Message: aiv1beta1.TemplateSyncedMsg,
+		})
+	}
+
+	if updateErr := r.Status().Update(ctx, template); updateErr != nil {
+		log.WithError(updateErr).Error("failed to update ClusterTemplate status")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if syncErr != nil && !IsUserError(syncErr) {
+		return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ClusterTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1beta1.ClusterTemplate{}).
+		Complete(r)
+}
@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/clustertemplates"
+	"github.com/openshift/assisted-service/internal/common"
+	aiv1beta1 "github.com/openshift/assisted-service/internal/controller/api/v1beta1"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newClusterTemplate(name, namespace string, spec aiv1beta1.ClusterTemplateSpec) *aiv1beta1.ClusterTemplate {
+	return &aiv1beta1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+		Spec: spec,
+	}
+}
+
+var _ = Describe("cluster template reconcile", func() {
+	var (
+		c             client.Client
+		tr            *ClusterTemplateReconciler
+		mockCtrl      *gomock.Controller
+		mockTemplates *clustertemplates.MockClusterTemplatesInternals
+		ctx           = context.Background()
+		templateName  = "edge-template"
+		templateID    strfmt.UUID
+	)
+
+	newRequest := func() ctrl.Request {
+		return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: templateName}}
+	}
+
+	getTemplate := func() *aiv1beta1.ClusterTemplate {
+		template := &aiv1beta1.ClusterTemplate{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: templateName}, template)).To(Succeed())
+		return template
+	}
+
+	syncedTemplate := func(version int64) *models.ClusterTemplate {
+		return &models.ClusterTemplate{ID: &templateID, Version: swag.Int64(version), Name: swag.String(templateName)}
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTemplates = clustertemplates.NewMockClusterTemplatesInternals(mockCtrl)
+		tr = &ClusterTemplateReconciler{
+			Client:    c,
+			Log:       common.GetTestLog(),
+			Templates: mockTemplates,
+		}
+		templateID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("creates the template in the service", func() {
+		template := newClusterTemplate(templateName, testNamespace, aiv1beta1.ClusterTemplateSpec{
+			BaseDomain:            "${site}.example.com",
+			AdditionalNTPSources:  []string{"ntp1.example.com", "ntp2.example.com"},
+			Proxy:                 &aiv1beta1.Proxy{HTTPProxy: "http://proxy.${site}.example.com:3128"},
+			Operators:             []aiv1beta1.ClusterTemplateOperator{{Name: "lso"}},
+			ManifestsConfigMapRef: &corev1.LocalObjectReference{Name: "edge-manifests"},
+		})
+		Expect(c.Create(ctx, template)).To(Succeed())
+		Expect(c.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "edge-manifests", Namespace: testNamespace},
+			Data:       map[string]string{"b.yaml": "b: ${site}", "a.yaml": "a: 1"},
+		})).To(Succeed())
+
+		mockTemplates.EXPECT().CreateClusterTemplateInternal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params operations.CreateClusterTemplateParams) (*models.ClusterTemplate, error) {
+				Expect(swag.StringValue(params.NewClusterTemplateParams.Name)).To(Equal(templateName))
+				clusterParams := params.NewClusterTemplateParams.ClusterParams
+				Expect(clusterParams.BaseDNSDomain).To(Equal("${site}.example.com"))
+				Expect(clusterParams.AdditionalNtpSource).To(Equal("ntp1.example.com,ntp2.example.com"))
+				Expect(clusterParams.HTTPProxy).To(Equal("http://proxy.${site}.example.com:3128"))
+				Expect(clusterParams.OlmOperators).To(HaveLen(1))
+				Expect(clusterParams.OlmOperators[0].Name).To(Equal("lso"))
+				manifests := params.NewClusterTemplateParams.Manifests
+				Expect(manifests).To(HaveLen(2))
+				Expect(swag.StringValue(manifests[0].FileName)).To(Equal("a.yaml"))
+				Expect(swag.StringValue(manifests[1].FileName)).To(Equal("b.yaml"))
+				Expect(swag.StringValue(manifests[1].Content)).To(Equal(base64.StdEncoding.EncodeToString([]byte("b: ${site}"))))
+				return syncedTemplate(1), nil
+			})
+
+		result, err := tr.Reconcile(ctx, newRequest())
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		template = getTemplate()
+		Expect(template.GetFinalizers()).To(ContainElement(ClusterTemplateFinalizerName))
+		Expect(template.Status.TemplateID).To(Equal(templateID.String()))
+		Expect(template.Status.Version).To(Equal(int64(1)))
+		Expect(template.Status.ObservedGeneration).To(Equal(int64(1)))
+		condition := conditionsv1.FindStatusCondition(template.Status.Conditions, aiv1beta1.TemplateSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(aiv1beta1.TemplateSyncedReason))
+	})
+
+	It("creates a new version when the spec changes", func() {
+		template := newClusterTemplate(templateName, testNamespace, aiv1beta1.ClusterTemplateSpec{BaseDomain: "example.com"})
+		template.Generation = 2
+		template.Status = aiv1beta1.ClusterTemplateStatus{TemplateID: templateID.String(), Version: 1, ObservedGeneration: 1}
+		Expect(c.Create(ctx, template)).To(Succeed())
+
+		mockTemplates.EXPECT().UpdateClusterTemplateInternal(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params operations.UpdateClusterTemplateParams) (*models.ClusterTemplate, error) {
+				Expect(params.ClusterTemplateID).To(Equal(templateID))
+				return syncedTemplate(2), nil
+			})
+
+		_, err := tr.Reconcile(ctx, newRequest())
+		Expect(err).To(BeNil())
+
+		template = getTemplate()
+		Expect(template.Status.Version).To(Equal(int64(2)))
+		Expect(template.Status.ObservedGeneration).To(Equal(int64(2)))
+	})
+
+	It("doesn't sync an observed generation again", func() {
+		template := newClusterTemplate(templateName, testNamespace, aiv1beta1.ClusterTemplateSpec{BaseDomain: "example.com"})
+		template.Finalizers = []string{ClusterTemplateFinalizerName}
+		template.Status = aiv1beta1.ClusterTemplateStatus{TemplateID: templateID.String(), Version: 1, ObservedGeneration: 1}
+		Expect(c.Create(ctx, template)).To(Succeed())
+
+		_, err := tr.Reconcile(ctx, newRequest())
+		Expect(err).To(BeNil())
+		Expect(getTemplate().Status.Version).To(Equal(int64(1)))
+	})
+
+	It("reports a sync error without requeueing user errors", func() {
+		Expect(c.Create(ctx, newClusterTemplate(templateName, testNamespace, aiv1beta1.ClusterTemplateSpec{
+			InstallConfigOverrides: "{",
+		}))).To(Succeed())
+
+		mockTemplates.EXPECT().CreateClusterTemplateInternal(gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("Install config overrides of the template have an illegal JSON format")))
+
+		result, err := tr.Reconcile(ctx, newRequest())
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		template := getTemplate()
+		Expect(template.Status.TemplateID).To(BeEmpty())
+		condition := conditionsv1.FindStatusCondition(template.Status.Conditions, aiv1beta1.TemplateSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(aiv1beta1.TemplateSyncError))
+		Expect(condition.Message).To(ContainSubstring("illegal JSON format"))
+	})
+
+	It("requeues when the manifests config map is missing", func() {
+		Expect(c.Create(ctx, newClusterTemplate(templateName, testNamespace, aiv1beta1.ClusterTemplateSpec{
+			ManifestsConfigMapRef: &corev1.LocalObjectReference{Name: "missing"},
+		}))).To(Succeed())
+
+		result, err := tr.Reconcile(ctx, newRequest())
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}))
+		condition := conditionsv1.FindStatusCondition(getTemplate().Status.Conditions, aiv1beta1.TemplateSyncedCondition)
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("deletes the template from the service", func() {
+		template := newClusterTemplate(templateName, testNamespace, aiv1beta1.ClusterTemplateSpec{})
+		template.Finalizers = []string{ClusterTemplateFinalizerName}
+		template.Status = aiv1beta1.ClusterTemplateStatus{TemplateID: templateID.String(), Version: 1, ObservedGeneration: 1}
+		Expect(c.Create(ctx, template)).To(Succeed())
+		template = getTemplate()
+		template.DeletionTimestamp = kubeTimeNow()
+		Expect(c.Update(ctx, template)).To(Succeed())
+
+		mockTemplates.EXPECT().DeleteClusterTemplateInternal(gomock.Any(), operations.DeleteClusterTemplateParams{
+			ClusterTemplateID: templateID,
+		}).Return(nil)
+
+		_, err := tr.Reconcile(ctx, newRequest())
+		Expect(err).To(BeNil())
+		Expect(getTemplate().GetFinalizers()).NotTo(ContainElement(ClusterTemplateFinalizerName))
+	})
+})
@@ -79,6 +79,11 @@ const (
 	ClusterNotFailedReason string = "InstallationNotFailed"
 	ClusterNotFailedMsg    string = "The installation has not failed"
 
+	ClusterFailureReasonCondition     string = "FailureReason"
+	ClusterFailureNotClassifiedReason string = "FailureNotClassified"
+	ClusterFailureNotClassifiedMsg    string = "The installation has not failed or its failure has not been classified yet"
+	ClusterFailureClassifiedMsg       string = "The installation most likely failed because:"
+
 	ClusterStoppedCondition       string = hivev1.ClusterInstallStopped
 	ClusterStoppedFailedReason    string = "InstallationFailed"
 	ClusterStoppedFailedMsg       string = "The installation has stopped due to error"
@@ -50,3 +50,15 @@ func (c *controllerEventsWrapper) AddMetricsEvent(ctx context.Context, clusterID
 func (c *controllerEventsWrapper) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
 	return c.events.GetEvents(clusterID, hostID, categories...)
 }
+
+func (c *controllerEventsWrapper) GetEventsSince(clusterID strfmt.UUID, hostID *strfmt.UUID, since time.Time, severities []string, categories ...string) ([]*common.Event, error) {
+	return c.events.GetEventsSince(clusterID, hostID, since, severities, categories...)
+}
+
+func (c *controllerEventsWrapper) QueryEvents(filter *events.Filter) ([]*common.Event, int64, error) {
+	return c.events.QueryEvents(filter)
+}
+
+func (c *controllerEventsWrapper) Subscribe(clusterID strfmt.UUID) (<-chan struct{}, func()) {
+	return c.events.Subscribe(clusterID)
+}
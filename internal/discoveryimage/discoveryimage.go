@@ -0,0 +1,115 @@
+package discoveryimage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+)
+
+// PlaceholderIgnitionConfig is embedded in the shared images in place of the ignition config of a cluster, which
+// identifies the cluster and is only embedded in the image of the cluster while it is downloaded
+const PlaceholderIgnitionConfig = `{"ignition":{"version":"3.1.0"}}`
+
+// Content holds everything that determines the contents of a generated discovery image, other than the ignition
+// config of the cluster. Clusters that generate images with the same content share a single image object.
+type Content struct {
+	// BaseISOObject is the name of the object that the image is created from, which also tells the image type
+	BaseISOObject       string
+	StaticNetworkConfig string
+	HTTPProxy           string
+	HTTPSProxy          string
+	NoProxy             string
+}
+
+// ObjectName returns the name of the content-addressed image object
+func (c Content) ObjectName() string {
+	hash := sha256.New()
+	for _, field := range []string{c.BaseISOObject, c.StaticNetworkConfig, c.HTTPProxy, c.HTTPSProxy, c.NoProxy} {
+		// Length-prefix the fields so that different contents can't be concatenated to the same bytes
+		fmt.Fprintf(hash, "%d:%s", len(field), field)
+	}
+	return fmt.Sprintf("%s%s.iso", s3wrapper.SharedDiscoveryImagePrefix, hex.EncodeToString(hash.Sum(nil)))
+}
+
+// ObjectPrefix returns the name of the content-addressed image object without the .iso suffix
+func (c Content) ObjectPrefix() string {
+	return strings.TrimSuffix(c.ObjectName(), ".iso")
+}
+
+// LegacyObjectName returns the name of the images that were generated before images were content-addressed
+func LegacyObjectName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, clusterID.String()))
+}
+
+// ObjectName returns the name of the object holding the discovery image of the cluster
+func ObjectName(cluster *common.Cluster) string {
+	if cluster.ImageObjectName != "" {
+		return cluster.ImageObjectName
+	}
+	return LegacyObjectName(*cluster.ID)
+}
+
+// IgnitionObjectName returns the name of the object holding the ignition config that is embedded in the discovery
+// image of the cluster
+func IgnitionObjectName(clusterID strfmt.UUID) string {
+	return fmt.Sprintf("%s/discovery.ign", clusterID)
+}
+
+// IsShared returns whether the object is a content-addressed image, which may be shared by several clusters
+func IsShared(objectName string) bool {
+	return strings.HasPrefix(objectName, s3wrapper.SharedDiscoveryImagePrefix)
+}
+
+// references returns a query of the clusters that still point at the image object, which are the clusters
+// whose images didn't expire yet
+func references(db *gorm.DB, objectName string, excludeClusterID *strfmt.UUID) *gorm.DB {
+	query := db.Model(&common.Cluster{}).Where("image_object_name = ? and image_expires_at > ?", objectName, strfmt.DateTime(time.Now()))
+	if excludeClusterID != nil {
+		query = query.Where("id <> ?", excludeClusterID.String())
+	}
+	return query
+}
+
+// CountReferences returns the number of clusters, other than the excluded one, that still point at the image object
+func CountReferences(db *gorm.DB, objectName string, excludeClusterID *strfmt.UUID) (int64, error) {
+	var count int64
+	if err := references(db, objectName, excludeClusterID).Count(&count).Error; err != nil {
+		return 0, errors.Wrapf(err, "failed to count the references to image %s", objectName)
+	}
+	return count, nil
+}
+
+// IsGenerated returns whether a cluster other than the excluded one still points at the image object, after
+// generating it successfully
+func IsGenerated(db *gorm.DB, objectName string, excludeClusterID *strfmt.UUID) (bool, error) {
+	var count int64
+	if err := references(db, objectName, excludeClusterID).Where("image_generated = ?", true).Count(&count).Error; err != nil {
+		return false, errors.Wrapf(err, "failed to count the references to image %s", objectName)
+	}
+	return count > 0, nil
+}
+
+// DeleteIfUnreferenced deletes the image object unless a cluster other than the excluded one still points at it.
+// Legacy images belong to a single cluster and are always deleted. It returns whether the object existed and was
+// deleted.
+func DeleteIfUnreferenced(ctx context.Context, db *gorm.DB, objectHandler s3wrapper.API, objectName string, excludeClusterID *strfmt.UUID) (bool, error) {
+	if IsShared(objectName) {
+		count, err := CountReferences(db, objectName, excludeClusterID)
+		if err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return false, nil
+		}
+	}
+	return objectHandler.DeleteObject(ctx, objectName)
+}
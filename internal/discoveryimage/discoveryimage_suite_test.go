@@ -0,0 +1,13 @@
+package discoveryimage
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDiscoveryImage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "discovery image tests")
+}
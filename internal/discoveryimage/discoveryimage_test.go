@@ -0,0 +1,64 @@
+package discoveryimage
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+)
+
+var _ = Describe("Content", func() {
+	content := Content{
+		BaseISOObject: "rhcos-4.6.iso",
+		HTTPProxy:     "http://proxy.example.com",
+	}
+
+	It("names the object after the content", func() {
+		name := content.ObjectName()
+		Expect(name).To(HavePrefix(s3wrapper.SharedDiscoveryImagePrefix))
+		Expect(name).To(HaveSuffix(".iso"))
+		Expect(name).To(Equal(content.ObjectName()))
+		Expect(content.ObjectPrefix() + ".iso").To(Equal(name))
+		Expect(IsShared(name)).To(BeTrue())
+	})
+
+	It("names different contents differently", func() {
+		changed := content
+		changed.NoProxy = "example.com"
+		Expect(changed.ObjectName()).ToNot(Equal(content.ObjectName()))
+
+		changed = content
+		changed.BaseISOObject = "rhcos-4.6-minimal.iso"
+		Expect(changed.ObjectName()).ToNot(Equal(content.ObjectName()))
+	})
+
+	It("doesn't confuse fields with the same concatenation", func() {
+		first := Content{HTTPProxy: "ab", HTTPSProxy: "c"}
+		second := Content{HTTPProxy: "a", HTTPSProxy: "bc"}
+		Expect(first.ObjectName()).ToNot(Equal(second.ObjectName()))
+	})
+})
+
+var _ = Describe("ObjectName", func() {
+	clusterID := strfmt.UUID(uuid.New().String())
+
+	It("falls back to the legacy name", func() {
+		cluster := &common.Cluster{}
+		cluster.ID = &clusterID
+		Expect(ObjectName(cluster)).To(Equal("discovery-image-" + clusterID.String() + ".iso"))
+		Expect(ObjectName(cluster)).To(Equal(LegacyObjectName(clusterID)))
+		Expect(IsShared(ObjectName(cluster))).To(BeFalse())
+	})
+
+	It("names the saved ignition config after the cluster", func() {
+		Expect(IgnitionObjectName(clusterID)).To(Equal(clusterID.String() + "/discovery.ign"))
+	})
+
+	It("uses the shared image of the cluster", func() {
+		cluster := &common.Cluster{ImageObjectName: Content{BaseISOObject: "rhcos-4.6.iso"}.ObjectName()}
+		cluster.ID = &clusterID
+		Expect(ObjectName(cluster)).To(Equal(cluster.ImageObjectName))
+	})
+})
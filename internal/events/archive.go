@@ -0,0 +1,302 @@
+package events
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// RetentionConfig sets how long events are kept in the database before they are archived. A zero
+// retention keeps the events of the category in the database until the cluster is deleted
+type RetentionConfig struct {
+	ArchiveInterval        time.Duration `envconfig:"EVENTS_ARCHIVE_INTERVAL" default:"1h"`
+	UserEventsRetention    time.Duration `envconfig:"USER_EVENTS_RETENTION" default:"0"`
+	MetricsEventsRetention time.Duration `envconfig:"METRICS_EVENTS_RETENTION" default:"0"`
+	MaxClustersPerInterval int           `envconfig:"EVENTS_ARCHIVE_MAX_CLUSTERS_PER_INTERVAL" default:"100"`
+	MaxEventsPerObject     int           `envconfig:"EVENTS_ARCHIVE_MAX_EVENTS_PER_OBJECT" default:"10000"`
+}
+
+// Validate returns an error if the configuration would archive no events at all
+func (c *RetentionConfig) Validate() error {
+	if c.MaxEventsPerObject <= 0 {
+		return errors.Errorf("the maximal number of events per archive object must be positive, got %d", c.MaxEventsPerObject)
+	}
+	if c.MaxClustersPerInterval <= 0 {
+		return errors.Errorf("the maximal number of clusters archived per interval must be positive, got %d", c.MaxClustersPerInterval)
+	}
+	return nil
+}
+
+// ArchiveStorage is the part of s3wrapper.API that the archive uses, which can't be imported here
+// since s3wrapper reports metrics that depend on events
+type ArchiveStorage interface {
+	Upload(ctx context.Context, data []byte, objectName string) error
+	Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error)
+}
+
+// Archive moves events that are older than their retention from the database to gzipped JSON lines
+// objects, and reads them back for queries whose time range reaches into the archive
+type Archive struct {
+	RetentionConfig
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	objectHandler ArchiveStorage
+	leaderElector leader.Leader
+}
+
+func NewArchive(cfg RetentionConfig, db *gorm.DB, log logrus.FieldLogger, objectHandler ArchiveStorage, leaderElector leader.Leader) *Archive {
+	return &Archive{
+		RetentionConfig: cfg,
+		db:              db,
+		log:             log,
+		objectHandler:   objectHandler,
+		leaderElector:   leaderElector,
+	}
+}
+
+// ArchivePrefix is the folder of the cluster objects in which its events are archived
+const ArchivePrefix = "events"
+
+func archiveObjectName(clusterID strfmt.UUID, category string, firstID, lastID uint) string {
+	return fmt.Sprintf("%s/%s/%s/%d-%d.jsonl.gz", clusterID, ArchivePrefix, category, firstID, lastID)
+}
+
+// ArchiveEvents archives the events that are older than the retention of their category
+func (a *Archive) ArchiveEvents() {
+	if !a.leaderElector.IsLeader() {
+		a.log.Debugf("Not a leader, exiting periodic events archiving")
+		return
+	}
+
+	retentions := map[string]time.Duration{
+		models.EventCategoryUser:    a.UserEventsRetention,
+		models.EventCategoryMetrics: a.MetricsEventsRetention,
+	}
+	for category, retention := range retentions {
+		if retention <= 0 {
+			continue
+		}
+		a.archiveCategory(context.Background(), category, time.Now().Add(-retention))
+	}
+}
+
+func (a *Archive) archiveCategory(ctx context.Context, category string, olderThan time.Time) {
+	var clusterIDs []string
+	if err := a.db.Model(&common.Event{}).Where("category = ? AND event_time < ?", category, olderThan).
+		Limit(a.MaxClustersPerInterval).Pluck("DISTINCT cluster_id", &clusterIDs).Error; err != nil {
+		a.log.WithError(err).Errorf("failed to get the clusters with %s events to archive", category)
+		return
+	}
+
+	for _, clusterID := range clusterIDs {
+		for {
+			archived, err := a.archiveClusterEvents(ctx, strfmt.UUID(clusterID), category, olderThan)
+			if err != nil {
+				a.log.WithError(err).Errorf("failed to archive %s events of cluster %s", category, clusterID)
+				break
+			}
+			// a full object may be followed by more events to archive, while an empty one means that no
+			// events were removed and the next attempt would select the same events again
+			if archived == 0 || archived < a.MaxEventsPerObject {
+				break
+			}
+		}
+	}
+}
+
+// archiveClusterEvents moves up to MaxEventsPerObject events of the cluster into a single object, and
+// returns the number of events that were removed from the database. The name of the object is derived
+// from the events it holds, so an object uploaded by a failed attempt is overwritten by the next one
+func (a *Archive) archiveClusterEvents(ctx context.Context, clusterID strfmt.UUID, category string, olderThan time.Time) (int, error) {
+	var evs []*common.Event
+	if err := a.db.Where("cluster_id = ? AND category = ? AND event_time < ?", clusterID.String(), category, olderThan).
+		Order("id").Limit(a.MaxEventsPerObject).Find(&evs).Error; err != nil {
+		return 0, err
+	}
+	if len(evs) == 0 {
+		return 0, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(zw)
+	record := &common.EventArchive{
+		ObjectName: archiveObjectName(clusterID, category, evs[0].ID, evs[len(evs)-1].ID),
+		ClusterID:  clusterID,
+		Category:   category,
+		EventCount: len(evs),
+	}
+	ids := make([]uint, len(evs))
+	for i, ev := range evs {
+		if err := encoder.Encode(ev); err != nil {
+			return 0, errors.Wrapf(err, "failed to encode event %d", ev.ID)
+		}
+		ids[i] = ev.ID
+		eventTime := time.Time(*ev.EventTime)
+		if i == 0 || eventTime.Before(record.FirstEventTime) {
+			record.FirstEventTime = eventTime
+		}
+		if i == 0 || eventTime.After(record.LastEventTime) {
+			record.LastEventTime = eventTime
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return 0, errors.Wrapf(err, "failed to compress events")
+	}
+
+	if err := a.objectHandler.Upload(ctx, buf.Bytes(), record.ObjectName); err != nil {
+		return 0, errors.Wrapf(err, "failed to upload %s", record.ObjectName)
+	}
+
+	var removed int64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+		reply := tx.Unscoped().Where("id IN (?)", ids).Delete(&common.Event{})
+		removed = reply.RowsAffected
+		return reply.Error
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to remove the events archived in %s", record.ObjectName)
+	}
+
+	a.log.Infof("Archived %d %s events of cluster %s to %s", len(evs), category, clusterID, record.ObjectName)
+	return int(removed), nil
+}
+
+// Events returns the archived events that match the filter, in no particular order. The archive is
+// only read when the filter has a time range, since reading it for every query would be costly
+func (a *Archive) Events(ctx context.Context, filter *Filter) ([]*common.Event, error) {
+	if filter.Since == nil && filter.Until == nil {
+		return nil, nil
+	}
+
+	query := a.db.Where("cluster_id = ? AND category IN (?)", filter.ClusterID.String(), selectCategories(filter.Categories))
+	if filter.Since != nil {
+		query = query.Where("last_event_time >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("first_event_time < ?", *filter.Until)
+	}
+	var records []*common.EventArchive
+	if err := query.Order("first_event_time").Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	var ret []*common.Event
+	for _, record := range records {
+		evs, err := a.download(ctx, record.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range evs {
+			if filter.matches(ev) {
+				ret = append(ret, ev)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (a *Archive) download(ctx context.Context, objectName string) ([]*common.Event, error) {
+	reader, _, err := a.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %s", objectName)
+	}
+	defer reader.Close()
+
+	zr, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress %s", objectName)
+	}
+	defer zr.Close()
+
+	var evs []*common.Event
+	decoder := json.NewDecoder(zr)
+	for {
+		var ev common.Event
+		if err = decoder.Decode(&ev); err == io.EOF {
+			return evs, nil
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", objectName)
+		}
+		evs = append(evs, &ev)
+	}
+}
+
+// matches filters archived events the same way filterQuery filters the events in the database
+func (f *Filter) matches(ev *common.Event) bool {
+	if ev.ClusterID == nil || *ev.ClusterID != f.ClusterID {
+		return false
+	}
+	if !contains(selectCategories(f.Categories), ev.Category) {
+		return false
+	}
+	if f.HostID != nil && ev.HostID != *f.HostID {
+		return false
+	}
+	if len(f.Severities) > 0 && (ev.Severity == nil || !contains(f.Severities, *ev.Severity)) {
+		return false
+	}
+	eventTime := time.Time(*ev.EventTime)
+	if f.Since != nil && eventTime.Before(*f.Since) {
+		return false
+	}
+	if f.Until != nil && !eventTime.Before(*f.Until) {
+		return false
+	}
+	if f.Message != nil && *f.Message != "" &&
+		(ev.Message == nil || !strings.Contains(strings.ToLower(*ev.Message), strings.ToLower(*f.Message))) {
+		return false
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeEvents orders the events of the database and of the archive the way QueryEvents does, and
+// returns the requested page along with the total number of events
+func mergeEvents(evs []*common.Event, archived []*common.Event, filter *Filter) ([]*common.Event, int64) {
+	all := append(archived, evs...)
+	sort.SliceStable(all, func(i, j int) bool {
+		ti, tj := time.Time(*all[i].EventTime), time.Time(*all[j].EventTime)
+		if !ti.Equal(tj) {
+			return ti.Before(tj) != filter.Descending
+		}
+		return (all[i].ID < all[j].ID) != filter.Descending
+	})
+
+	total := int64(len(all))
+	if filter.Offset != nil {
+		if *filter.Offset >= total {
+			return []*common.Event{}, total
+		}
+		all = all[*filter.Offset:]
+	}
+	if filter.Limit != nil && *filter.Limit >= 0 && *filter.Limit < int64(len(all)) {
+		all = all[:*filter.Limit]
+	}
+	return all, total
+}
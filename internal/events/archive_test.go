@@ -0,0 +1,189 @@
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	eventsapi "github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type memoryStorage map[string][]byte
+
+func (m memoryStorage) Upload(_ context.Context, data []byte, objectName string) error {
+	m[objectName] = data
+	return nil
+}
+
+func (m memoryStorage) Download(_ context.Context, objectName string) (io.ReadCloser, int64, error) {
+	data, ok := m[objectName]
+	if !ok {
+		return nil, 0, errors.Errorf("object %s not found", objectName)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+var _ = Describe("Events archive", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		theEvents *events.Events
+		storage   memoryStorage
+		archive   *events.Archive
+		cluster1  = strfmt.UUID("46a8d745-dfce-4fd8-9df0-549ee8eabb3d")
+		cluster2  = strfmt.UUID("60415d9c-7c44-4978-89f5-53d510b03a47")
+		now       = time.Now()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		theEvents = events.New(db, logrus.WithField("pkg", "events"))
+		storage = memoryStorage{}
+		archive = events.NewArchive(events.RetentionConfig{
+			UserEventsRetention:    24 * time.Hour,
+			MaxClustersPerInterval: 10,
+			MaxEventsPerObject:     2,
+		}, db, logrus.WithField("pkg", "events-archive"), storage, &leader.DummyElector{})
+
+		for i := 0; i < 3; i++ {
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, fmt.Sprintf("Old event %d", i),
+				now.Add(-72*time.Hour).Add(time.Duration(i)*time.Hour))
+		}
+		theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityWarning, "New event", now.Add(-time.Hour))
+		theEvents.AddEvent(context.TODO(), cluster2, nil, models.EventSeverityInfo, "Old event of another cluster", now.Add(-72*time.Hour))
+		theEvents.AddMetricsEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "Old metrics event", now.Add(-72*time.Hour))
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	messages := func(evs []*common.Event) []string {
+		ret := make([]string, len(evs))
+		for i, ev := range evs {
+			ret[i] = *ev.Message
+		}
+		return ret
+	}
+
+	It("validates the configuration", func() {
+		cfg := events.RetentionConfig{MaxClustersPerInterval: 10, MaxEventsPerObject: 2}
+		Expect(cfg.Validate()).Should(Succeed())
+		cfg.MaxEventsPerObject = 0
+		Expect(cfg.Validate()).ShouldNot(Succeed())
+		cfg = events.RetentionConfig{MaxClustersPerInterval: -1, MaxEventsPerObject: 2}
+		Expect(cfg.Validate()).ShouldNot(Succeed())
+	})
+
+	It("moves events older than the retention of their category to the archive", func() {
+		archive.ArchiveEvents()
+
+		evs, err := theEvents.GetEvents(cluster1, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(messages(evs)).Should(Equal([]string{"New event"}))
+		evs, err = theEvents.GetEvents(cluster2, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(evs).Should(BeEmpty())
+		evs, err = theEvents.GetEvents(cluster1, nil, models.EventCategoryMetrics)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(messages(evs)).Should(Equal([]string{"Old metrics event"}))
+
+		var records []*common.EventArchive
+		Expect(db.Order("first_event_time").Find(&records, "cluster_id = ?", cluster1.String()).Error).ShouldNot(HaveOccurred())
+		Expect(records).Should(HaveLen(2))
+		Expect(records[0].EventCount).Should(Equal(2))
+		Expect(records[1].EventCount).Should(Equal(1))
+		Expect(len(storage)).Should(Equal(3))
+		for _, record := range records {
+			Expect(storage).Should(HaveKey(record.ObjectName))
+		}
+	})
+
+	It("does nothing when not the leader", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockLeader := leader.NewMockLeader(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+
+		events.NewArchive(events.RetentionConfig{UserEventsRetention: 24 * time.Hour}, db,
+			logrus.WithField("pkg", "events-archive"), storage, mockLeader).ArchiveEvents()
+		Expect(storage).Should(BeEmpty())
+		evs, err := theEvents.GetEvents(cluster1, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(evs).Should(HaveLen(4))
+	})
+
+	Context("listing", func() {
+		var api *events.Api
+
+		BeforeEach(func() {
+			archive.ArchiveEvents()
+			api = events.NewApi(theEvents, archive, logrus.WithField("pkg", "eventsApi"))
+		})
+
+		listEvents := func(params eventsapi.ListEventsParams) *eventsapi.ListEventsOK {
+			reply := api.ListEvents(context.TODO(), params)
+			Expect(reply).Should(BeAssignableToTypeOf(eventsapi.NewListEventsOK()))
+			return reply.(*eventsapi.ListEventsOK)
+		}
+
+		payloadMessages := func(payload models.EventList) []string {
+			ret := make([]string, len(payload))
+			for i, ev := range payload {
+				ret[i] = *ev.Message
+			}
+			return ret
+		}
+
+		It("includes archived events when the time range reaches into the archive", func() {
+			since := strfmt.DateTime(now.Add(-100 * time.Hour))
+			ok := listEvents(eventsapi.ListEventsParams{ClusterID: cluster1, Since: &since})
+			Expect(ok.EventCount).Should(Equal(int64(4)))
+			Expect(payloadMessages(ok.Payload)).Should(Equal([]string{"Old event 0", "Old event 1", "Old event 2", "New event"}))
+		})
+
+		It("pages the archived and the new events together", func() {
+			since := strfmt.DateTime(now.Add(-100 * time.Hour))
+			ok := listEvents(eventsapi.ListEventsParams{ClusterID: cluster1, Since: &since, Order: swag.String("descending"),
+				Limit: swag.Int64(2), Offset: swag.Int64(1)})
+			Expect(ok.EventCount).Should(Equal(int64(4)))
+			Expect(payloadMessages(ok.Payload)).Should(Equal([]string{"Old event 2", "Old event 1"}))
+		})
+
+		It("filters the archived events", func() {
+			since := strfmt.DateTime(now.Add(-100 * time.Hour))
+			until := strfmt.DateTime(now.Add(-70 * time.Hour))
+			ok := listEvents(eventsapi.ListEventsParams{ClusterID: cluster1, Since: &since, Until: &until, Message: swag.String("EVENT 1")})
+			Expect(ok.EventCount).Should(Equal(int64(1)))
+			Expect(payloadMessages(ok.Payload)).Should(Equal([]string{"Old event 1"}))
+
+			ok = listEvents(eventsapi.ListEventsParams{ClusterID: cluster1, Since: &since,
+				Severities: []string{models.EventSeverityWarning}})
+			Expect(payloadMessages(ok.Payload)).Should(Equal([]string{"New event"}))
+		})
+
+		It("doesn't read the archive when the time range doesn't reach into it", func() {
+			since := strfmt.DateTime(now.Add(-2 * time.Hour))
+			ok := listEvents(eventsapi.ListEventsParams{ClusterID: cluster1, Since: &since})
+			Expect(payloadMessages(ok.Payload)).Should(Equal([]string{"New event"}))
+
+			ok = listEvents(eventsapi.ListEventsParams{ClusterID: cluster1})
+			Expect(payloadMessages(ok.Payload)).Should(Equal([]string{"New event"}))
+		})
+	})
+})
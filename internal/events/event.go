@@ -25,6 +25,31 @@ type Handler interface {
 	//Get a list of events. Events can be filtered by category. if no filter is specified,
 	//events with the default category are returned
 	GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories ...string) ([]*common.Event, error)
+	//Get the events that occurred at or after the given time, ordered by their time and their ID.
+	//Events can be filtered by severity and by category, the same way as in GetEvents
+	GetEventsSince(clusterID strfmt.UUID, hostID *strfmt.UUID, since time.Time, severities []string, categories ...string) ([]*common.Event, error)
+	//Get a page of the events matching the filter, ordered by their time, along with the total number
+	//of events matching the filter
+	QueryEvents(filter *Filter) ([]*common.Event, int64, error)
+	//Subscribe to notifications on new events of the cluster. The returned function cancels the subscription
+	Subscribe(clusterID strfmt.UUID) (<-chan struct{}, func())
+}
+
+//Filter selects the events returned by QueryEvents. Only the cluster ID is mandatory
+type Filter struct {
+	ClusterID strfmt.UUID
+	HostID    *strfmt.UUID
+	//events with the default categories are returned if no category is specified
+	Categories []string
+	Severities []string
+	//events that occurred at or after Since and before Until are returned
+	Since *time.Time
+	Until *time.Time
+	//text the message of the events contains, ignoring case
+	Message    *string
+	Descending bool
+	Limit      *int64
+	Offset     *int64
 }
 
 var _ Handler = &Events{}
@@ -34,14 +59,16 @@ var DefaultEventCategories = []string{
 }
 
 type Events struct {
-	db  *gorm.DB
-	log logrus.FieldLogger
+	db          *gorm.DB
+	log         logrus.FieldLogger
+	subscribers *subscribers
 }
 
 func New(db *gorm.DB, log logrus.FieldLogger) *Events {
 	return &Events{
-		db:  db,
-		log: log,
+		db:          db,
+		log:         log,
+		subscribers: newSubscribers(),
 	}
 }
 
@@ -78,8 +105,8 @@ func (e *Events) saveEvent(ctx context.Context, clusterID strfmt.UUID, hostID *s
 		if dberr != nil {
 			log.Warnf("Rolling back transaction on event=%s", message)
 			tx.Rollback()
-		} else {
-			tx.Commit()
+		} else if dberr = tx.Commit().Error; dberr == nil {
+			e.subscribers.notify(clusterID)
 		}
 	}()
 	if dberr = tx.Create(&event).Error; err != nil {
@@ -102,14 +129,7 @@ func (e Events) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories
 	var err error
 	var events []*common.Event
 
-	//initialize the selectedCategories either from the filter, if exists, or from the default values
-	selectedCategories := make([]string, 0)
-	if len(categories) > 0 {
-		selectedCategories = categories[:]
-	} else {
-		selectedCategories = append(selectedCategories, DefaultEventCategories...)
-	}
-
+	selectedCategories := selectCategories(categories)
 	if hostID == nil {
 		err = e.clusterEventsQuery(&events, selectedCategories, clusterID).Error
 	} else {
@@ -118,6 +138,77 @@ func (e Events) GetEvents(clusterID strfmt.UUID, hostID *strfmt.UUID, categories
 	return events, err
 }
 
+func (e Events) GetEventsSince(clusterID strfmt.UUID, hostID *strfmt.UUID, since time.Time, severities []string, categories ...string) ([]*common.Event, error) {
+	var events []*common.Event
+
+	query := e.filterQuery(&Filter{ClusterID: clusterID, HostID: hostID, Severities: severities, Categories: categories, Since: &since})
+	err := query.Order("event_time").Order("id").Find(&events).Error
+	return events, err
+}
+
+func (e Events) QueryEvents(filter *Filter) ([]*common.Event, int64, error) {
+	var events []*common.Event
+	var total int64
+
+	query := e.filterQuery(filter)
+	if err := query.Model(&common.Event{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "ASC"
+	if filter.Descending {
+		order = "DESC"
+	}
+	//the ID breaks ties between events with the same time so that pages are stable
+	query = query.Order("event_time " + order).Order("id " + order)
+	if filter.Limit != nil {
+		query = query.Limit(*filter.Limit)
+	}
+	if filter.Offset != nil {
+		query = query.Offset(*filter.Offset)
+	}
+	if err := query.Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (e Events) filterQuery(filter *Filter) *gorm.DB {
+	query := e.db.Where("cluster_id = ?", filter.ClusterID.String()).
+		Where("category IN (?)", selectCategories(filter.Categories))
+	if filter.HostID != nil {
+		query = query.Where("host_id = ?", filter.HostID.String())
+	}
+	if len(filter.Severities) > 0 {
+		query = query.Where("severity IN (?)", filter.Severities)
+	}
+	if filter.Since != nil {
+		query = query.Where("event_time >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("event_time < ?", *filter.Until)
+	}
+	if filter.Message != nil && *filter.Message != "" {
+		query = query.Where("message ILIKE ?", "%"+common.EscapeLikePattern(*filter.Message)+"%")
+	}
+	return query
+}
+
+func (e *Events) Subscribe(clusterID strfmt.UUID) (<-chan struct{}, func()) {
+	return e.subscribers.subscribe(clusterID)
+}
+
+//initialize the selected categories either from the filter, if exists, or from the default values
+func selectCategories(categories []string) []string {
+	selectedCategories := make([]string, 0)
+	if len(categories) > 0 {
+		selectedCategories = categories[:]
+	} else {
+		selectedCategories = append(selectedCategories, DefaultEventCategories...)
+	}
+	return selectedCategories
+}
+
 func (e Events) clusterEventsQuery(events *[]*common.Event, selectedCategories []string, clusterID strfmt.UUID) *gorm.DB {
 	return e.db.Where("category IN (?)", selectedCategories).Order("event_time").
 		Find(events, "cluster_id = ?", clusterID.String())
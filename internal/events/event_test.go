@@ -3,6 +3,11 @@ package events_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +23,7 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/requestid"
+	eventsapi "github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/sirupsen/logrus"
 )
 
@@ -175,6 +181,241 @@ var _ = Describe("Events library", func() {
 		})
 	})
 
+	Context("events since a time", func() {
+		var since time.Time
+
+		BeforeEach(func() {
+			since = time.Now()
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "e1", since.Add(-time.Minute))
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityError, "e3", since.Add(time.Second))
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityWarning, "e2", since)
+			theEvents.AddEvent(context.TODO(), cluster2, nil, models.EventSeverityError, "e4", since)
+			theEvents.AddMetricsEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "metrics", since)
+		})
+
+		It("returns only the newer events of the cluster ordered by their time", func() {
+			evs, err := theEvents.GetEventsSince(cluster1, nil, since, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(evs)).Should(Equal(2))
+			Expect(evs[0]).Should(WithMessage(swag.String("e2")))
+			Expect(evs[1]).Should(WithMessage(swag.String("e3")))
+		})
+
+		It("filters by host", func() {
+			evs, err := theEvents.GetEventsSince(cluster1, &host, time.Time{}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(evs)).Should(Equal(1))
+			Expect(evs[0]).Should(WithMessage(swag.String("e2")))
+		})
+
+		It("filters by severity", func() {
+			evs, err := theEvents.GetEventsSince(cluster1, nil, time.Time{}, []string{models.EventSeverityInfo, models.EventSeverityError})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(evs)).Should(Equal(2))
+			Expect(evs[0]).Should(WithMessage(swag.String("e1")))
+			Expect(evs[1]).Should(WithMessage(swag.String("e3")))
+		})
+
+		It("filters by category", func() {
+			evs, err := theEvents.GetEventsSince(cluster1, nil, since, nil, models.EventCategoryMetrics)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(evs)).Should(Equal(1))
+			Expect(evs[0]).Should(WithMessage(swag.String("metrics")))
+		})
+	})
+
+	Context("query events", func() {
+		var start time.Time
+
+		BeforeEach(func() {
+			start = time.Now().Add(-time.Hour)
+			for i := 0; i < 5; i++ {
+				theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, fmt.Sprintf("Event %d", i),
+					start.Add(time.Duration(i)*time.Minute))
+			}
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityError, "Host failed 50% of the checks",
+				start.Add(10*time.Minute))
+			theEvents.AddEvent(context.TODO(), cluster2, nil, models.EventSeverityInfo, "Event of another cluster", start)
+		})
+
+		messages := func(evs []*common.Event) []string {
+			ret := make([]string, len(evs))
+			for i, ev := range evs {
+				ret[i] = *ev.Message
+			}
+			return ret
+		}
+
+		It("returns all the events of the cluster by default", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(6)))
+			Expect(messages(evs)).Should(Equal([]string{"Event 0", "Event 1", "Event 2", "Event 3", "Event 4",
+				"Host failed 50% of the checks"}))
+		})
+
+		It("pages the events", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Limit: swag.Int64(2), Offset: swag.Int64(1)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(6)))
+			Expect(messages(evs)).Should(Equal([]string{"Event 1", "Event 2"}))
+		})
+
+		It("orders the events by descending time", func() {
+			evs, _, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Descending: true, Limit: swag.Int64(2)})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(messages(evs)).Should(Equal([]string{"Host failed 50% of the checks", "Event 4"}))
+		})
+
+		It("filters by time", func() {
+			since := start.Add(time.Minute)
+			until := start.Add(3 * time.Minute)
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Since: &since, Until: &until})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(2)))
+			Expect(messages(evs)).Should(Equal([]string{"Event 1", "Event 2"}))
+		})
+
+		It("filters by severity and host", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Severities: []string{models.EventSeverityError}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(1)))
+			Expect(messages(evs)).Should(Equal([]string{"Host failed 50% of the checks"}))
+
+			evs, total, err = theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, HostID: &host})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(1)))
+			Expect(messages(evs)).Should(Equal([]string{"Host failed 50% of the checks"}))
+		})
+
+		It("searches the message ignoring case", func() {
+			evs, total, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Message: swag.String("event 3")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(total).Should(Equal(int64(1)))
+			Expect(messages(evs)).Should(Equal([]string{"Event 3"}))
+		})
+
+		It("matches wildcards in the message literally", func() {
+			evs, _, err := theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Message: swag.String("50%")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(messages(evs)).Should(Equal([]string{"Host failed 50% of the checks"}))
+
+			evs, _, err = theEvents.QueryEvents(&events.Filter{ClusterID: cluster1, Message: swag.String("Event_")})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(evs).Should(BeEmpty())
+		})
+
+		It("returns the total count in the list events response", func() {
+			api := events.NewApi(theEvents, nil, logrus.WithField("pkg", "eventsApi"))
+			params := eventsapi.NewListEventsParams()
+			params.ClusterID = cluster1
+			params.Limit = swag.Int64(3)
+			params.Order = swag.String("descending")
+			reply := api.ListEvents(context.TODO(), params)
+			Expect(reply).Should(BeAssignableToTypeOf(eventsapi.NewListEventsOK()))
+			ok := reply.(*eventsapi.ListEventsOK)
+			Expect(ok.EventCount).Should(Equal(int64(6)))
+			Expect(len(ok.Payload)).Should(Equal(3))
+			Expect(*ok.Payload[0].Message).Should(Equal("Host failed 50% of the checks"))
+		})
+	})
+
+	Context("subscriptions", func() {
+		It("notifies subscribers of the cluster only", func() {
+			notifications1, cancel1 := theEvents.Subscribe(cluster1)
+			defer cancel1()
+			notifications2, cancel2 := theEvents.Subscribe(cluster2)
+			defer cancel2()
+
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "e1", time.Now())
+			Eventually(notifications1).Should(Receive())
+			Consistently(notifications2, 100*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("does not notify after the subscription is canceled", func() {
+			notifications, cancel := theEvents.Subscribe(cluster1)
+			cancel()
+
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "e1", time.Now())
+			Consistently(notifications, 100*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	Context("stream events", func() {
+		var (
+			api *events.Api
+			now time.Time
+		)
+
+		BeforeEach(func() {
+			api = events.NewApi(theEvents, nil, logrus.WithField("pkg", "eventsApi"))
+			now = time.Now()
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "e1", now.Add(-time.Second))
+			theEvents.AddEvent(context.TODO(), cluster1, &host, models.EventSeverityError, "e2", now)
+		})
+
+		streamEvents := func(params eventsapi.StreamEventsParams) *httptest.ResponseRecorder {
+			//the stream ends once the already existing events are written since the request is canceled
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			rec := httptest.NewRecorder()
+			api.StreamEvents(ctx, params).WriteResponse(rec, nil)
+			return rec
+		}
+
+		// eventIDs returns the IDs of the server-sent events in the stream by their messages
+		eventIDs := func(rec *httptest.ResponseRecorder) map[string]string {
+			ret := make(map[string]string)
+			for _, match := range regexp.MustCompile(`id: (\S+)\ndata: .*"message":"(\w+)"`).FindAllStringSubmatch(rec.Body.String(), -1) {
+				ret[match[2]] = match[1]
+			}
+			return ret
+		}
+
+		It("streams the events of the cluster", func() {
+			rec := streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1})
+			Expect(rec.Code).Should(Equal(http.StatusOK))
+			Expect(rec.Header().Get("Content-Type")).Should(Equal("text/event-stream"))
+
+			evs, err := theEvents.GetEventsSince(cluster1, nil, time.Time{}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(evs)).Should(Equal(2))
+			ids := eventIDs(rec)
+			Expect(ids).Should(HaveLen(2))
+			Expect(ids["e1"]).Should(Equal(fmt.Sprintf("%d-%d", time.Time(*evs[0].EventTime).UnixNano(), evs[0].ID)))
+			Expect(ids["e2"]).Should(Equal(fmt.Sprintf("%d-%d", time.Time(*evs[1].EventTime).UnixNano(), evs[1].ID)))
+			Expect(strings.Index(rec.Body.String(), `"message":"e1"`)).Should(BeNumerically("<", strings.Index(rec.Body.String(), `"message":"e2"`)))
+		})
+
+		It("resumes after the last event ID", func() {
+			ids := eventIDs(streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1}))
+			rec := streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1, LastEventID: swag.String(ids["e1"])})
+			Expect(rec.Code).Should(Equal(http.StatusOK))
+			Expect(eventIDs(rec)).Should(Equal(map[string]string{"e2": ids["e2"]}))
+		})
+
+		It("sends again the events of the overlap window, which may have been saved after the last event", func() {
+			ids := eventIDs(streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1}))
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "late", now.Add(-2*time.Second))
+			theEvents.AddEvent(context.TODO(), cluster1, nil, models.EventSeverityInfo, "old", now.Add(-time.Hour))
+			rec := streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1, LastEventID: swag.String(ids["e2"])})
+			Expect(eventIDs(rec)).Should(And(HaveKey("e1"), HaveKey("late"), Not(HaveKey("e2")), Not(HaveKey("old"))))
+		})
+
+		It("filters by severity", func() {
+			rec := streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1, Severities: []string{models.EventSeverityInfo}})
+			Expect(rec.Body.String()).Should(ContainSubstring(`"message":"e1"`))
+			Expect(rec.Body.String()).ShouldNot(ContainSubstring(`"message":"e2"`))
+		})
+
+		It("rejects an invalid last event ID", func() {
+			for _, id := range []string{"abc", "12", "abc-12", "12-abc"} {
+				rec := streamEvents(eventsapi.StreamEventsParams{ClusterID: cluster1, LastEventID: swag.String(id)})
+				Expect(rec.Code).Should(Equal(http.StatusBadRequest))
+			}
+		})
+	})
+
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 	})
@@ -3,26 +3,35 @@ package events
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 var _ restapi.EventsAPI = &Api{}
 
+const orderDescending = "descending"
+
 type Api struct {
 	handler Handler
+	archive *Archive
 	log     logrus.FieldLogger
 }
 
-func NewApi(handler Handler, log logrus.FieldLogger) *Api {
+// NewApi creates the events API. The archive may be nil, in which case only the events in the
+// database are listed
+func NewApi(handler Handler, archive *Archive, log logrus.FieldLogger) *Api {
 	return &Api{
 		handler: handler,
+		archive: archive,
 		log:     log,
 	}
 }
@@ -30,21 +39,94 @@ func NewApi(handler Handler, log logrus.FieldLogger) *Api {
 func (a *Api) ListEvents(ctx context.Context, params events.ListEventsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, a.log)
 
-	evs, err := a.handler.GetEvents(params.ClusterID, params.HostID, params.Categories...)
+	filter := &Filter{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		Categories: params.Categories,
+		Severities: params.Severities,
+		Message:    params.Message,
+		Descending: swag.StringValue(params.Order) == orderDescending,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+	}
+	if params.Since != nil {
+		since := time.Time(*params.Since)
+		filter.Since = &since
+	}
+	if params.Until != nil {
+		until := time.Time(*params.Until)
+		filter.Until = &until
+	}
+
+	evs, total, err := a.queryEvents(ctx, filter)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get events")
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 	ret := make(models.EventList, len(evs))
 	for i, ev := range evs {
-		ret[i] = &models.Event{
-			ClusterID: ev.ClusterID,
-			HostID:    ev.HostID,
-			Severity:  ev.Severity,
-			EventTime: ev.EventTime,
-			Message:   ev.Message,
-			Props:     ev.Props,
+		ret[i] = toModelEvent(ev)
+	}
+	return events.NewListEventsOK().WithPayload(ret).WithEventCount(total)
+}
+
+func (a *Api) StreamEvents(ctx context.Context, params events.StreamEventsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	var cursor *streamCursor
+	if params.LastEventID != nil {
+		c, err := parseStreamCursor(*params.LastEventID)
+		if err != nil {
+			log.WithError(err).Errorf("invalid Last-Event-ID %s", *params.LastEventID)
+			return &streamErrorResponder{common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid Last-Event-ID %s", *params.LastEventID))}
 		}
+		cursor = &c
+	}
+
+	return &eventStreamResponder{
+		ctx:        ctx,
+		handler:    a.handler,
+		log:        log,
+		clusterID:  params.ClusterID,
+		hostID:     params.HostID,
+		severities: params.Severities,
+		categories: params.Categories,
+		cursor:     cursor,
+	}
+}
+
+// queryEvents includes the archived events that match the filter. When there are any, the page is
+// taken after merging them with all the matching events in the database
+func (a *Api) queryEvents(ctx context.Context, filter *Filter) ([]*common.Event, int64, error) {
+	if a.archive == nil {
+		return a.handler.QueryEvents(filter)
+	}
+	archived, err := a.archive.Events(ctx, filter)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to get archived events")
+	}
+	if len(archived) == 0 {
+		return a.handler.QueryEvents(filter)
+	}
+
+	unpaged := *filter
+	unpaged.Limit = nil
+	unpaged.Offset = nil
+	evs, _, err := a.handler.QueryEvents(&unpaged)
+	if err != nil {
+		return nil, 0, err
+	}
+	page, total := mergeEvents(evs, archived, filter)
+	return page, total, nil
+}
+
+func toModelEvent(ev *common.Event) *models.Event {
+	return &models.Event{
+		ClusterID: ev.ClusterID,
+		HostID:    ev.HostID,
+		Severity:  ev.Severity,
+		EventTime: ev.EventTime,
+		Message:   ev.Message,
+		Props:     ev.Props,
 	}
-	return events.NewListEventsOK().WithPayload(ret)
 }
@@ -89,3 +89,54 @@ func (mr *MockHandlerMockRecorder) GetEvents(clusterID, hostID interface{}, cate
 	varargs := append([]interface{}{clusterID, hostID}, categories...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockHandler)(nil).GetEvents), varargs...)
 }
+
+// GetEventsSince mocks base method
+func (m *MockHandler) GetEventsSince(clusterID strfmt.UUID, hostID *strfmt.UUID, since time.Time, severities []string, categories ...string) ([]*common.Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{clusterID, hostID, since, severities}
+	for _, a := range categories {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventsSince", varargs...)
+	ret0, _ := ret[0].([]*common.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsSince indicates an expected call of GetEventsSince
+func (mr *MockHandlerMockRecorder) GetEventsSince(clusterID, hostID, since, severities interface{}, categories ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{clusterID, hostID, since, severities}, categories...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsSince", reflect.TypeOf((*MockHandler)(nil).GetEventsSince), varargs...)
+}
+
+// QueryEvents mocks base method
+func (m *MockHandler) QueryEvents(filter *Filter) ([]*common.Event, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryEvents", filter)
+	ret0, _ := ret[0].([]*common.Event)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryEvents indicates an expected call of QueryEvents
+func (mr *MockHandlerMockRecorder) QueryEvents(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryEvents", reflect.TypeOf((*MockHandler)(nil).QueryEvents), filter)
+}
+
+// Subscribe mocks base method
+func (m *MockHandler) Subscribe(clusterID strfmt.UUID) (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", clusterID)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockHandlerMockRecorder) Subscribe(clusterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockHandler)(nil).Subscribe), clusterID)
+}
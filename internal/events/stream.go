@@ -0,0 +1,230 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// Events added by other replicas of the service are not notified in-process, so open
+	// streams also look for new events periodically
+	streamPollInterval = 5 * time.Second
+	// Comments sent on idle streams prevent proxies and load balancers from closing them
+	streamKeepAliveInterval = 30 * time.Second
+	// Events are not saved in the order of their times, and the transactions that save them
+	// commit in any order, so streams look again for events this far before the last event
+	// they sent
+	streamOverlapWindow = 10 * time.Second
+)
+
+// subscribers notifies listeners of a cluster when new events of that cluster are saved.
+// Notifications carry no payload: the listeners query for the events they haven't seen yet,
+// which keeps them consistent with the events saved by other replicas of the service
+type subscribers struct {
+	lock      sync.Mutex
+	byCluster map[strfmt.UUID]map[chan struct{}]struct{}
+}
+
+func newSubscribers() *subscribers {
+	return &subscribers{
+		byCluster: make(map[strfmt.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+func (s *subscribers) subscribe(clusterID strfmt.UUID) (<-chan struct{}, func()) {
+	//a single pending notification is enough, since a listener reads all the new events at once
+	ch := make(chan struct{}, 1)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.byCluster[clusterID]; !ok {
+		s.byCluster[clusterID] = make(map[chan struct{}]struct{})
+	}
+	s.byCluster[clusterID][ch] = struct{}{}
+
+	return ch, func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.byCluster[clusterID], ch)
+		if len(s.byCluster[clusterID]) == 0 {
+			delete(s.byCluster, clusterID)
+		}
+	}
+}
+
+func (s *subscribers) notify(clusterID strfmt.UUID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for ch := range s.byCluster[clusterID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// streamCursor is the position of an event in a stream, which is ordered by the times of the
+// events and then by their IDs
+type streamCursor struct {
+	eventTime time.Time
+	id        uint
+}
+
+func newStreamCursor(ev *common.Event) streamCursor {
+	return streamCursor{eventTime: time.Time(*ev.EventTime), id: ev.ID}
+}
+
+func (c streamCursor) before(other streamCursor) bool {
+	return c.eventTime.Before(other.eventTime) || (c.eventTime.Equal(other.eventTime) && c.id < other.id)
+}
+
+// String is the ID of the server-sent event, which holds the time of the event in nanoseconds
+// and the ID of the event
+func (c streamCursor) String() string {
+	return fmt.Sprintf("%d-%d", c.eventTime.UnixNano(), c.id)
+}
+
+func parseStreamCursor(value string) (streamCursor, error) {
+	parts := strings.Split(value, "-")
+	if len(parts) != 2 {
+		return streamCursor{}, errors.Errorf("expected <event time>-<event ID>")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return streamCursor{}, errors.Wrapf(err, "invalid event time")
+	}
+	id, err := strconv.ParseUint(parts[1], 10, 0)
+	if err != nil {
+		return streamCursor{}, errors.Wrapf(err, "invalid event ID")
+	}
+	return streamCursor{eventTime: time.Unix(0, nanos), id: uint(id)}, nil
+}
+
+// eventStreamResponder writes the events of a cluster as server-sent events until the client
+// disconnects. A reconnecting client resumes from the last event it received by sending its ID
+// in the Last-Event-ID header. Since events may be saved late, the events of the overlap window
+// before the last event are sent again on resume, and clients can recognize them by their IDs
+type eventStreamResponder struct {
+	ctx        context.Context
+	handler    Handler
+	log        logrus.FieldLogger
+	clusterID  strfmt.UUID
+	hostID     *strfmt.UUID
+	severities []string
+	categories []string
+	// the last event that was sent, or the one the client resumes from
+	cursor *streamCursor
+	// the IDs of the events of the overlap window that were already sent
+	sent map[uint]time.Time
+}
+
+func (r *eventStreamResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		r.log.Error("response writer does not support streaming events")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	notifications, unsubscribe := r.handler.Subscribe(r.clusterID)
+	defer unsubscribe()
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	poll := time.NewTicker(streamPollInterval)
+	defer poll.Stop()
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		if err := r.writeNewEvents(rw); err != nil {
+			r.log.WithError(err).Warnf("stopped streaming events of cluster %s", r.clusterID)
+			return
+		}
+		flusher.Flush()
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-notifications:
+		case <-poll.C:
+		case <-keepAlive.C:
+			if _, err := io.WriteString(rw, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (r *eventStreamResponder) writeNewEvents(w io.Writer) error {
+	if r.sent == nil {
+		r.sent = make(map[uint]time.Time)
+		if r.cursor != nil {
+			r.sent[r.cursor.id] = r.cursor.eventTime
+		}
+	}
+	var since time.Time
+	if r.cursor != nil {
+		since = r.cursor.eventTime.Add(-streamOverlapWindow)
+	}
+	evs, err := r.handler.GetEventsSince(r.clusterID, r.hostID, since, r.severities, r.categories...)
+	if err != nil {
+		return err
+	}
+	for _, ev := range evs {
+		if _, ok := r.sent[ev.ID]; ok {
+			continue
+		}
+		cursor := newStreamCursor(ev)
+		if err = writeServerSentEvent(w, cursor, ev); err != nil {
+			return err
+		}
+		r.sent[ev.ID] = cursor.eventTime
+		if r.cursor == nil || r.cursor.before(cursor) {
+			r.cursor = &cursor
+		}
+	}
+	for id, eventTime := range r.sent {
+		if eventTime.Before(r.cursor.eventTime.Add(-streamOverlapWindow)) {
+			delete(r.sent, id)
+		}
+	}
+	return nil
+}
+
+// streamErrorResponder writes the errors of stream requests as JSON, since there is no producer
+// that serializes them as an event stream
+type streamErrorResponder struct {
+	*common.ApiErrorResponse
+}
+
+func (r *streamErrorResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.Header().Set("Content-Type", "application/json")
+	r.ApiErrorResponse.WriteResponse(rw, runtime.JSONProducer())
+}
+
+func writeServerSentEvent(w io.Writer, cursor streamCursor, ev *common.Event) error {
+	data, err := json.Marshal(toModelEvent(ev))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\ndata: %s\n\n", cursor, data)
+	return err
+}
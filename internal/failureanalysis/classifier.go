@@ -0,0 +1,340 @@
+package failureanalysis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/logartifacts"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/sirupsen/logrus"
+)
+
+// The weights of the findings. The state of the hosts says the most about a failure, while the logs have many lines
+// that match the signatures in failures that are caused by something else.
+const (
+	hostErrorWeight           = 4
+	hostStuckWeight           = 1
+	eventWeight               = 2
+	validationFailingWeight   = 2
+	validationWeight          = 1
+	logsWeight                = 1
+	maxLogFindingsPerCategory = 3
+	maxEvidence               = 10
+)
+
+// categoryOrder breaks the ties between the categories, the specific ones first
+var categoryOrder = []models.FailureCategory{
+	models.FailureCategoryBootOrder,
+	models.FailureCategoryDisk,
+	models.FailureCategoryDNS,
+	models.FailureCategoryCertificate,
+	models.FailureCategoryImagePull,
+	models.FailureCategoryIgnition,
+	models.FailureCategoryNetwork,
+	models.FailureCategoryControlPlane,
+	models.FailureCategoryOperators,
+	models.FailureCategoryHostInstallation,
+	models.FailureCategoryTimeout,
+}
+
+// finding is a piece of evidence along with how much it counts
+type finding struct {
+	evidence *models.FailureEvidence
+	weight   int
+}
+
+// Classifier finds the most likely root cause of a failed installation by correlating the progress of the hosts, the
+// events of the installation, the failing validations and the known error signatures in the uploaded logs
+type Classifier struct {
+	log           logrus.FieldLogger
+	eventsHandler events.Handler
+	logIndexer    *logsearch.Indexer
+}
+
+func NewClassifier(log logrus.FieldLogger, eventsHandler events.Handler, logIndexer *logsearch.Indexer) *Classifier {
+	return &Classifier{
+		log:           log,
+		eventsHandler: eventsHandler,
+		logIndexer:    logIndexer,
+	}
+}
+
+// Classify returns the failure reason of the cluster, which must be loaded with its hosts. Sources of evidence that
+// can't be read are skipped, so a failure reason is always returned.
+func (c *Classifier) Classify(ctx context.Context, cluster *common.Cluster) *models.FailureReason {
+	log := logutil.FromContext(ctx, c.log)
+	findings := hostProgressFindings(cluster)
+	findings = append(findings, validationFindings(log, cluster)...)
+	eventFindings, err := c.eventFindings(cluster)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get the events of cluster %s for classifying its failure", cluster.ID)
+	}
+	findings = append(findings, eventFindings...)
+	if c.logIndexer != nil {
+		findings = append(findings, c.logFindings(ctx, log, cluster)...)
+	}
+	return classify(cluster, findings, time.Now())
+}
+
+// hostProgressFindings reports the hosts that failed or that didn't finish their installation
+func hostProgressFindings(cluster *common.Cluster) []*finding {
+	var findings []*finding
+	for _, h := range cluster.Hosts {
+		status := swag.StringValue(h.Status)
+		var stage models.HostStage
+		if h.Progress != nil {
+			stage = h.Progress.CurrentStage
+		}
+		weight := hostErrorWeight
+		var category models.FailureCategory
+		switch {
+		case status == models.HostStatusInstallingPendingUserAction:
+			category = models.FailureCategoryBootOrder
+		case status == models.HostStatusError:
+			category = hostCategory(stage, swag.StringValue(h.StatusInfo))
+		case (status == models.HostStatusInstalling || status == models.HostStatusInstallingInProgress) &&
+			stage != models.HostStageDone:
+			weight = hostStuckWeight
+			category = hostCategory(stage, swag.StringValue(h.StatusInfo))
+		default:
+			continue
+		}
+
+		message := fmt.Sprintf("Host %s is %s", hostutil.GetHostnameForMsg(h), status)
+		if stage != "" {
+			message += fmt.Sprintf(" in stage %s", stage)
+		}
+		if statusInfo := swag.StringValue(h.StatusInfo); statusInfo != "" {
+			message += ": " + statusInfo
+		}
+		evidence := &models.FailureEvidence{
+			Source:   swag.String(models.FailureEvidenceSourceHostProgress),
+			Category: category,
+			HostID:   *h.ID,
+			Message:  swag.String(message),
+		}
+		if h.Progress != nil && !time.Time(h.Progress.StageUpdatedAt).IsZero() {
+			t := h.Progress.StageUpdatedAt
+			evidence.Time = &t
+		}
+		findings = append(findings, &finding{evidence: evidence, weight: weight})
+	}
+	return findings
+}
+
+// hostCategory returns the category of the failure of a host. Known signatures in its status info are more specific
+// than the stage it stopped in, except for timeouts.
+func hostCategory(stage models.HostStage, statusInfo string) models.FailureCategory {
+	if category, ok := matchSignature(statusInfo); ok && category != models.FailureCategoryTimeout {
+		return category
+	}
+	if category, ok := stageCategories[stage]; ok {
+		return category
+	}
+	return models.FailureCategoryHostInstallation
+}
+
+// validationFindings reports the validations that the hosts were failing when the installation started
+func validationFindings(log logrus.FieldLogger, cluster *common.Cluster) []*finding {
+	var findings []*finding
+	for _, h := range cluster.Hosts {
+		ids, err := failedValidationIDs(h)
+		if err != nil {
+			log.WithError(err).Warnf("Failed to get the validations of host %s for classifying the failure of cluster %s",
+				h.ID, cluster.ID)
+			continue
+		}
+		for _, id := range ids {
+			if category, ok := validationCategories[id]; ok {
+				findings = append(findings, &finding{
+					evidence: &models.FailureEvidence{
+						Source:   swag.String(models.FailureEvidenceSourceValidation),
+						Category: category,
+						HostID:   *h.ID,
+						Message:  swag.String(fmt.Sprintf("Host %s: validation '%s' is failing", hostutil.GetHostnameForMsg(h), id)),
+					},
+					weight: validationWeight,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// eventFindings reports the errors that were reported during the installation, and the validations that started
+// failing during it
+func (c *Classifier) eventFindings(cluster *common.Cluster) ([]*finding, error) {
+	filter := &events.Filter{
+		ClusterID:  *cluster.ID,
+		Severities: []string{models.EventSeverityWarning, models.EventSeverityError, models.EventSeverityCritical},
+	}
+	if startedAt := time.Time(cluster.InstallStartedAt); !startedAt.IsZero() {
+		filter.Since = &startedAt
+	}
+	clusterEvents, _, err := c.eventsHandler.QueryEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []*finding
+	for _, event := range clusterEvents {
+		message := swag.StringValue(event.Message)
+		evidence := &models.FailureEvidence{
+			Source:  swag.String(models.FailureEvidenceSourceEvent),
+			HostID:  event.HostID,
+			Message: swag.String(message),
+			Time:    event.EventTime,
+		}
+		weight := eventWeight
+		if match := validationFailingRegex.FindStringSubmatch(message); match != nil {
+			category, ok := validationCategories[models.HostValidationID(match[1])]
+			if !ok {
+				continue
+			}
+			evidence.Source = swag.String(models.FailureEvidenceSourceValidation)
+			evidence.Category = category
+			weight = validationFailingWeight
+		} else if swag.StringValue(event.Severity) == models.EventSeverityWarning {
+			continue
+		} else if category, ok := matchSignature(message); ok {
+			evidence.Category = category
+		} else {
+			continue
+		}
+		findings = append(findings, &finding{evidence: evidence, weight: weight})
+	}
+	return findings, nil
+}
+
+// logFindings reports the lines of the uploaded logs of the hosts and of the controller that match known signatures
+func (c *Classifier) logFindings(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster) []*finding {
+	filter := &logsearch.Filter{Levels: []string{logsearch.LevelWarning, logsearch.LevelError, logsearch.LevelFatal}}
+	if err := filter.SetPattern(signaturesPattern); err != nil {
+		log.WithError(err).Error("Invalid failure signatures")
+		return nil
+	}
+
+	var findings []*finding
+	searchArchive := func(logID string, hostID strfmt.UUID) {
+		// The archive is stored under one of its names, depending on whether it was recompressed
+		for _, archive := range logartifacts.ArchiveObjectNames(cluster.ID.String(), logID) {
+			archive := archive
+			counts := make(map[models.FailureCategory]int)
+			found, err := c.logIndexer.Search(ctx, archive, filter, func(entry *logsearch.Entry) {
+				category, ok := matchSignature(entry.Message)
+				if !ok || counts[category] >= maxLogFindingsPerCategory {
+					return
+				}
+				counts[category]++
+				evidence := &models.FailureEvidence{
+					Source:    swag.String(models.FailureEvidenceSourceLogs),
+					Category:  category,
+					HostID:    hostID,
+					Message:   swag.String(entry.Message),
+					Reference: fmt.Sprintf("%s:%s:%d", strings.TrimPrefix(archive, cluster.ID.String()+"/"), entry.File, entry.Line),
+				}
+				if entry.Time != nil {
+					t := strfmt.DateTime(*entry.Time)
+					evidence.Time = &t
+				}
+				findings = append(findings, &finding{evidence: evidence, weight: logsWeight})
+			})
+			if err != nil {
+				log.WithError(err).Warnf("Failed to search log archive %s for classifying the failure of cluster %s",
+					archive, cluster.ID)
+			}
+			if found {
+				return
+			}
+		}
+	}
+	for _, h := range cluster.Hosts {
+		searchArchive(h.ID.String(), *h.ID)
+	}
+	searchArchive(string(models.LogsTypeController), "")
+	return findings
+}
+
+// classify picks the category with the most weight and the host that most of its evidence is about
+func classify(cluster *common.Cluster, findings []*finding, now time.Time) *models.FailureReason {
+	categoryWeights := make(map[models.FailureCategory]int)
+	for _, f := range findings {
+		categoryWeights[f.evidence.Category] += f.weight
+	}
+	category := models.FailureCategoryUnknown
+	for _, c := range categoryOrder {
+		if categoryWeights[c] > categoryWeights[category] {
+			category = c
+		}
+	}
+
+	hostWeights := make(map[strfmt.UUID]int)
+	var suspectedHostID strfmt.UUID
+	for _, f := range findings {
+		if f.evidence.Category != category || f.evidence.HostID == "" {
+			continue
+		}
+		hostWeights[f.evidence.HostID] += f.weight
+		if hostWeights[f.evidence.HostID] > hostWeights[suspectedHostID] {
+			suspectedHostID = f.evidence.HostID
+		}
+	}
+
+	// The evidence of the category comes first, and the evidence of the other categories follows in the order of
+	// the categories
+	sort.SliceStable(findings, func(i, j int) bool {
+		return categoryRank(findings[i].evidence.Category, category) < categoryRank(findings[j].evidence.Category, category)
+	})
+	evidence := make([]*models.FailureEvidence, 0, maxEvidence)
+	for _, f := range findings {
+		if len(evidence) == maxEvidence {
+			break
+		}
+		evidence = append(evidence, f.evidence)
+	}
+
+	hints := categoryHints[category]
+	summary := hints.summary
+	reason := &models.FailureReason{
+		Category:     category,
+		Remediation:  hints.remediation,
+		ClassifiedAt: strfmt.DateTime(now),
+		Evidence:     evidence,
+	}
+	if suspectedHostID != "" {
+		reason.SuspectedHostID = suspectedHostID
+		for _, h := range cluster.Hosts {
+			if *h.ID == suspectedHostID {
+				reason.SuspectedHostname = hostutil.GetHostnameForMsg(h)
+				summary += fmt.Sprintf(" (suspected host %s)", reason.SuspectedHostname)
+			}
+		}
+	}
+	if category == models.FailureCategoryUnknown && swag.StringValue(cluster.StatusInfo) != "" {
+		summary += ": " + swag.StringValue(cluster.StatusInfo)
+	}
+	reason.Summary = swag.String(summary)
+	return reason
+}
+
+func categoryRank(category, classified models.FailureCategory) int {
+	if category == classified {
+		return -1
+	}
+	for i, c := range categoryOrder {
+		if c == category {
+			return i
+		}
+	}
+	return len(categoryOrder)
+}
@@ -0,0 +1,229 @@
+package failureanalysis
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/events"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestFailureAnalysis(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "failure analysis tests")
+}
+
+func logsArchive(fileName, contents string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	Expect(tw.WriteHeader(&tar.Header{Name: fileName, Mode: 0600, Size: int64(len(contents)), ModTime: time.Now(),
+		Typeflag: tar.TypeReg})).To(Succeed())
+	_, err := tw.Write([]byte(contents))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(tw.Close()).To(Succeed())
+	Expect(zw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("failure classification", func() {
+	var (
+		ctx        = context.Background()
+		log        = logrus.New()
+		clusterID  = strfmt.UUID("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1")
+		masterID   = strfmt.UUID("2b0c55c6-9e36-4a5e-a3b7-6e07b2f0a2a7")
+		workerID   = strfmt.UUID("3b0c55c6-9e36-4a5e-a3b7-6e07b2f0a2a7")
+		ctrl       *gomock.Controller
+		mockEvents *events.MockHandler
+		baseDir    string
+		storage    s3wrapper.API
+		classifier *Classifier
+		cluster    *common.Cluster
+	)
+
+	newHost := func(id strfmt.UUID, name, status string, stage models.HostStage, statusInfo string) *models.Host {
+		return &models.Host{
+			ID:                &id,
+			ClusterID:         clusterID,
+			RequestedHostname: name,
+			Status:            swag.String(status),
+			StatusInfo:        swag.String(statusInfo),
+			Progress:          &models.HostProgressInfo{CurrentStage: stage},
+		}
+	}
+
+	expectEvents := func(clusterEvents ...*common.Event) {
+		mockEvents.EXPECT().QueryEvents(gomock.Any()).DoAndReturn(func(filter *events.Filter) ([]*common.Event, int64, error) {
+			Expect(filter.ClusterID).To(Equal(clusterID))
+			return clusterEvents, int64(len(clusterEvents)), nil
+		})
+	}
+
+	newEvent := func(hostID strfmt.UUID, severity, message string) *common.Event {
+		eventTime := strfmt.DateTime(time.Now())
+		return &common.Event{Event: models.Event{
+			ClusterID: &clusterID,
+			HostID:    hostID,
+			Severity:  swag.String(severity),
+			Message:   swag.String(message),
+			EventTime: &eventTime,
+		}}
+	}
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		var err error
+		baseDir, err = ioutil.TempDir("", "failureanalysis")
+		Expect(err).ToNot(HaveOccurred())
+		mockMetrics := metrics.NewMockAPI(ctrl)
+		mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		storage = s3wrapper.NewFSClient(baseDir, log, nil, nil, mockMetrics, 0, 0)
+		classifier = NewClassifier(log, mockEvents, logsearch.NewIndexer(logsearch.Config{MaxIndexedLines: 100, MaxLineLength: 1024}, log, storage))
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Status:           swag.String(models.ClusterStatusError),
+			StatusInfo:       swag.String("cluster has hosts in error"),
+			InstallStartedAt: strfmt.DateTime(time.Now().Add(-time.Hour)),
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	It("classifies hosts that failed to write the image as disk failures", func() {
+		cluster.Hosts = []*models.Host{
+			newHost(masterID, "master-0", models.HostStatusError, models.HostStageWritingImageToDisk,
+				"Host failed to install because its installation stage Writing image to disk took longer than expected 1h0m0s"),
+			newHost(workerID, "worker-0", models.HostStatusInstallingInProgress, models.HostStageRebooting, ""),
+		}
+		expectEvents()
+		reason := classifier.Classify(ctx, cluster)
+		Expect(reason.Category).To(Equal(models.FailureCategoryDisk))
+		Expect(reason.SuspectedHostID).To(Equal(masterID))
+		Expect(reason.SuspectedHostname).To(Equal("master-0"))
+		Expect(*reason.Summary).To(ContainSubstring("master-0"))
+		Expect(reason.Remediation).ToNot(BeEmpty())
+		Expect(reason.Evidence).To(HaveLen(2))
+		Expect(*reason.Evidence[0].Source).To(Equal(models.FailureEvidenceSourceHostProgress))
+		Expect(reason.Evidence[0].HostID).To(Equal(masterID))
+		Expect(reason.Evidence[1].Category).To(Equal(models.FailureCategoryBootOrder))
+	})
+
+	It("prefers the signatures of the status info to the stage of the host", func() {
+		Expect(hostCategory(models.HostStageWaitingForIgnition, "x509: certificate has expired or is not yet valid")).
+			To(Equal(models.FailureCategoryCertificate))
+		Expect(hostCategory(models.HostStageWaitingForIgnition, "timed out")).To(Equal(models.FailureCategoryIgnition))
+		Expect(hostCategory("", "failed")).To(Equal(models.FailureCategoryHostInstallation))
+	})
+
+	It("classifies hosts that wait for the user to fix the boot order", func() {
+		cluster.Hosts = []*models.Host{
+			newHost(masterID, "master-0", models.HostStatusInstallingPendingUserAction, models.HostStageRebooting, ""),
+			newHost(workerID, "worker-0", models.HostStatusInstalled, models.HostStageDone, ""),
+		}
+		expectEvents()
+		reason := classifier.Classify(ctx, cluster)
+		Expect(reason.Category).To(Equal(models.FailureCategoryBootOrder))
+		Expect(reason.SuspectedHostID).To(Equal(masterID))
+	})
+
+	It("correlates the events and the validations that started failing during the installation", func() {
+		cluster.Hosts = []*models.Host{
+			newHost(masterID, "master-0", models.HostStatusInstalled, models.HostStageDone, ""),
+			newHost(workerID, "worker-0", models.HostStatusInstalled, models.HostStageDone, ""),
+		}
+		cluster.Hosts[1].ValidationsInfo = `{"network":[{"id":"api-domain-name-resolved-correctly","status":"failure","message":"failed"}]}`
+		expectEvents(
+			newEvent(workerID, models.EventSeverityWarning, "Host worker-0: validation 'apps-domain-name-resolved-correctly' that used to succeed is now failing"),
+			newEvent(workerID, models.EventSeverityWarning, "Host worker-0: validation 'ntp-synced' that used to succeed is now failing, ignored by the validation policy of the cluster"),
+			newEvent("", models.EventSeverityError, "dial tcp: lookup api.test.com: no such host"),
+			newEvent("", models.EventSeverityError, "Something unrelated failed"),
+			newEvent("", models.EventSeverityWarning, "connection refused"),
+		)
+		reason := classifier.Classify(ctx, cluster)
+		Expect(reason.Category).To(Equal(models.FailureCategoryDNS))
+		Expect(reason.SuspectedHostID).To(Equal(workerID))
+		Expect(reason.Evidence).To(HaveLen(3))
+		sources := []string{}
+		for _, evidence := range reason.Evidence {
+			Expect(evidence.Category).To(Equal(models.FailureCategoryDNS))
+			sources = append(sources, *evidence.Source)
+		}
+		Expect(sources).To(ConsistOf(models.FailureEvidenceSourceValidation, models.FailureEvidenceSourceValidation,
+			models.FailureEvidenceSourceEvent))
+	})
+
+	It("finds known signatures in the uploaded logs", func() {
+		cluster.Hosts = []*models.Host{
+			newHost(masterID, "master-0", models.HostStatusInstalled, models.HostStageDone, ""),
+		}
+		archive := logsearch.ArchiveObjectName(clusterID.String(), masterID.String())
+		Expect(storage.Upload(ctx, logsArchive("journal.log",
+			"Mar 01 12:00:00 master-0 crio[1]: Error: failed to pull image quay.io/app: unauthorized: authentication required\n"+
+				"Mar 01 12:00:01 master-0 kubelet[2]: Started\n"+
+				"Mar 01 12:00:02 master-0 kubelet[2]: Error: ImagePullBackOff\n"), archive)).To(Succeed())
+		controllerArchive := logsearch.ArchiveObjectName(clusterID.String(), string(models.LogsTypeController))
+		Expect(storage.Upload(ctx, logsArchive("controller.log",
+			`time="2021-03-01T12:00:00Z" level=error msg="operator console is degraded"`+"\n"), controllerArchive)).To(Succeed())
+		expectEvents()
+		reason := classifier.Classify(ctx, cluster)
+		Expect(reason.Category).To(Equal(models.FailureCategoryImagePull))
+		Expect(reason.SuspectedHostID).To(Equal(masterID))
+		Expect(reason.Evidence).To(HaveLen(3))
+		Expect(*reason.Evidence[0].Source).To(Equal(models.FailureEvidenceSourceLogs))
+		Expect(reason.Evidence[0].Reference).To(Equal("logs/" + masterID.String() + "/logs.tar.gz:journal.log:1"))
+		Expect(reason.Evidence[0].Time).ToNot(BeNil())
+		Expect(reason.Evidence[1].Reference).To(HaveSuffix(":3"))
+		Expect(reason.Evidence[2].Category).To(Equal(models.FailureCategoryOperators))
+		Expect(reason.Evidence[2].HostID).To(BeEmpty())
+	})
+
+	It("classifies failures without evidence as unknown", func() {
+		mockEvents.EXPECT().QueryEvents(gomock.Any()).Return(nil, int64(0), errors.New("failed"))
+		reason := classifier.Classify(ctx, cluster)
+		Expect(reason.Category).To(Equal(models.FailureCategoryUnknown))
+		Expect(*reason.Summary).To(HaveSuffix(": cluster has hosts in error"))
+		Expect(reason.SuspectedHostID).To(BeEmpty())
+		Expect(reason.Evidence).To(BeEmpty())
+		Expect(time.Time(reason.ClassifiedAt)).ToNot(BeZero())
+	})
+
+	It("caps the evidence", func() {
+		var findings []*finding
+		for i := 0; i < 2*maxEvidence; i++ {
+			findings = append(findings, &finding{
+				evidence: &models.FailureEvidence{Category: models.FailureCategoryTimeout, Message: swag.String("timed out")},
+				weight:   1,
+			})
+		}
+		findings = append(findings, &finding{
+			evidence: &models.FailureEvidence{Category: models.FailureCategoryNetwork, Message: swag.String("connection refused")},
+			weight:   1,
+		})
+		reason := classify(cluster, findings, time.Now())
+		Expect(reason.Category).To(Equal(models.FailureCategoryTimeout))
+		Expect(reason.Evidence).To(HaveLen(maxEvidence))
+		Expect(reason.Evidence[0].Category).To(Equal(models.FailureCategoryTimeout))
+	})
+})
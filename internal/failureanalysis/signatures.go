@@ -0,0 +1,168 @@
+package failureanalysis
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/models"
+)
+
+// signature is a known error message of a category of failures
+type signature struct {
+	category models.FailureCategory
+	pattern  string
+}
+
+// signatures are matched in order, so the specific ones come before the generic ones. The timeout signature is the
+// last one since most failures end in a timeout of something.
+var signatures = []signature{
+	{models.FailureCategoryDisk, `no space left on device|input/output error|i/o error on device|read-only file system|failed to write image to disk|coreos-installer.*(failed|error)`},
+	{models.FailureCategoryDNS, `no such host|server misbehaving|could not resolve|temporary failure in name resolution`},
+	{models.FailureCategoryCertificate, `x509:|certificate has expired|certificate is not yet valid|certificate signed by unknown authority|tls: bad certificate`},
+	{models.FailureCategoryImagePull, `imagepullbackoff|errimagepull|failed to pull image|error pulling image|manifest unknown|unauthorized: authentication required`},
+	{models.FailureCategoryIgnition, `ignition.*(failed|error)|failed to fetch (the )?config|machine config server`},
+	{models.FailureCategoryNetwork, `connection refused|no route to host|network is unreachable|connection reset by peer|i/o timeout`},
+	{models.FailureCategoryControlPlane, `etcd.*(failed|error|unhealthy)|bootkube.*(failed|error)|kube-apiserver.*(failed|error|not ready)`},
+	{models.FailureCategoryOperators, `operator.*(degraded|unavailable)|cluster version.*(failed|not available)|operators? (are|is) not available`},
+	{models.FailureCategoryTimeout, `timed out|timeout|deadline exceeded|took longer than expected`},
+}
+
+var signatureRegexes = compileSignatures()
+
+// signaturesPattern matches any of the signatures, in the syntax of the log search patterns
+var signaturesPattern = func() string {
+	patterns := make([]string, 0, len(signatures))
+	for _, s := range signatures {
+		patterns = append(patterns, "(?:"+s.pattern+")")
+	}
+	return strings.Join(patterns, "|")
+}()
+
+func compileSignatures() []*regexp.Regexp {
+	regexes := make([]*regexp.Regexp, 0, len(signatures))
+	for _, s := range signatures {
+		regexes = append(regexes, regexp.MustCompile("(?i)"+s.pattern))
+	}
+	return regexes
+}
+
+// matchSignature returns the category of the first signature that the text matches
+func matchSignature(text string) (models.FailureCategory, bool) {
+	for i, re := range signatureRegexes {
+		if re.MatchString(text) {
+			return signatures[i].category, true
+		}
+	}
+	return "", false
+}
+
+// stageCategories are the categories of the failures of hosts that stopped progressing in a stage
+var stageCategories = map[models.HostStage]models.FailureCategory{
+	models.HostStageStartingInstallation: models.FailureCategoryHostInstallation,
+	models.HostStageInstalling:           models.FailureCategoryHostInstallation,
+	models.HostStageWritingImageToDisk:   models.FailureCategoryDisk,
+	// A host that doesn't get past rebooting usually boots the discovery image again instead of the disk
+	models.HostStageRebooting:              models.FailureCategoryBootOrder,
+	models.HostStageWaitingForIgnition:     models.FailureCategoryIgnition,
+	models.HostStageWaitingForControlPlane: models.FailureCategoryControlPlane,
+	models.HostStageWaitingForBootkube:     models.FailureCategoryControlPlane,
+	models.HostStageWaitingForController:   models.FailureCategoryControlPlane,
+	models.HostStageConfiguring:            models.FailureCategoryHostInstallation,
+	models.HostStageJoined:                 models.FailureCategoryHostInstallation,
+}
+
+// validationCategories are the categories of the failures that failing host validations may cause
+var validationCategories = map[models.HostValidationID]models.FailureCategory{
+	models.HostValidationIDHasMinValidDisks:                           models.FailureCategoryDisk,
+	models.HostValidationIDSufficientInstallationDiskSpeed:            models.FailureCategoryDisk,
+	models.HostValidationIDConnected:                                  models.FailureCategoryNetwork,
+	models.HostValidationIDBelongsToMachineCidr:                       models.FailureCategoryNetwork,
+	models.HostValidationIDBelongsToMajorityGroup:                     models.FailureCategoryNetwork,
+	models.HostValidationIDSufficientNetworkLatencyRequirementForRole: models.FailureCategoryNetwork,
+	models.HostValidationIDSufficientPacketLossRequirementForRole:     models.FailureCategoryNetwork,
+	models.HostValidationIDAPIDomainNameResolvedCorrectly:             models.FailureCategoryDNS,
+	models.HostValidationIDAPIIntDomainNameResolvedCorrectly:          models.FailureCategoryDNS,
+	models.HostValidationIDAppsDomainNameResolvedCorrectly:            models.FailureCategoryDNS,
+	// The certificates of the cluster aren't valid yet on hosts whose clocks are behind
+	models.HostValidationIDNtpSynced:                models.FailureCategoryCertificate,
+	models.HostValidationIDContainerImagesAvailable: models.FailureCategoryImagePull,
+	// The API VIP validation checks that the host can fetch its ignition from the machine config server
+	models.HostValidationIDAPIVipConnected: models.FailureCategoryIgnition,
+}
+
+// validationFailingRegex matches the events of host validations that started failing, except for the ones whose
+// failures the validation policy of the cluster ignores
+var validationFailingRegex = regexp.MustCompile(`validation '([a-z0-9-]+)' that used to succeed is now failing$`)
+
+// categoryHints describe the categories of failures and what can be done about them
+var categoryHints = map[models.FailureCategory]struct {
+	summary     string
+	remediation string
+}{
+	models.FailureCategoryDisk: {
+		"The installation disk could not be written",
+		"Check that the installation disk of the host is healthy, large and fast enough and not read-only, or choose another installation disk.",
+	},
+	models.FailureCategoryNetwork: {
+		"The hosts could not reach each other or the services they depend on",
+		"Check the connectivity between the hosts and to the API and ingress VIPs, the firewall rules, the MTU and the proxy settings.",
+	},
+	models.FailureCategoryDNS: {
+		"Names could not be resolved",
+		"Check that the DNS servers of the hosts resolve the API, internal API and application domains of the cluster and the registries.",
+	},
+	models.FailureCategoryImagePull: {
+		"Container images could not be pulled",
+		"Check the pull secret, the access of the hosts to the registries and the mirror registry configuration.",
+	},
+	models.FailureCategoryCertificate: {
+		"Certificates were rejected",
+		"Check that the clocks of the hosts are synchronized with NTP and that the additional trust bundle includes the certificates of the proxy and the registries.",
+	},
+	models.FailureCategoryIgnition: {
+		"The hosts could not get their ignition configuration",
+		"Check that the hosts can reach the machine config server on port 22623 of the API VIP or of the bootstrap host.",
+	},
+	models.FailureCategoryBootOrder: {
+		"The hosts did not boot from the installation disk after the reboot",
+		"Set the installation disk first in the boot order of the host, or eject the discovery image after the host writes the installation image.",
+	},
+	models.FailureCategoryControlPlane: {
+		"The control plane did not come up",
+		"Check the logs of the bootstrap host and of the masters for etcd and kube-apiserver errors, and the resources of the masters.",
+	},
+	models.FailureCategoryOperators: {
+		"Cluster operators did not become available",
+		"Check the conditions of the degraded cluster operators in the controller logs.",
+	},
+	models.FailureCategoryHostInstallation: {
+		"A host failed to install",
+		"Check the status and the logs of the host, then reset the installation and install the cluster again.",
+	},
+	models.FailureCategoryTimeout: {
+		"The installation timed out",
+		"Check the events of the cluster for the stage that timed out, and the resources and the connectivity of the hosts.",
+	},
+	models.FailureCategoryUnknown: {
+		"The cause of the failure could not be determined",
+		"Download the logs of the cluster and check the events of the cluster.",
+	},
+}
+
+// failedValidationIDs returns the IDs of the failing validations of a host
+func failedValidationIDs(h *models.Host) ([]models.HostValidationID, error) {
+	validations, err := host.GetValidations(h)
+	if err != nil {
+		return nil, err
+	}
+	var ids []models.HostValidationID
+	for _, results := range validations {
+		for _, result := range results {
+			if result.Status == host.ValidationFailure {
+				ids = append(ids, models.HostValidationID(result.ID))
+			}
+		}
+	}
+	return ids, nil
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/jinzhu/gorm"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/logartifacts"
+	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/leader"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
@@ -18,6 +21,9 @@ type Config struct {
 	DeletedUnregisteredAfter time.Duration `envconfig:"DELETED_UNREGISTERED_AFTER" default:"72h"` // 3d
 	DeregisterInactiveAfter  time.Duration `envconfig:"DELETED_INACTIVE_AFTER" default:"480h"`    // 20d
 	MaxGCClustersPerInterval int           `envconfig:"MAX_GC_CLUSTERS_PER_INTERVAL" default:"100"`
+
+	// Set from the log artifacts configuration of the service, which the inventory shares
+	LogArtifacts logartifacts.Config `ignored:"true"`
 }
 
 type GarbageCollectors interface {
@@ -93,3 +99,40 @@ func (g garbageCollector) PermanentlyDeleteUnregisteredClustersAndHosts() {
 		return
 	}
 }
+
+func (g garbageCollector) DeleteExpiredLogs() {
+	if !g.leaderElector.IsLeader() {
+		g.log.Debugf("Not a leader, exiting periodic expired logs deletion")
+		return
+	}
+	rules := g.LogArtifacts.RetentionRules
+	if len(rules) == 0 {
+		return
+	}
+
+	// Logs expire after the installation of their cluster completes, so clusters that completed more recently than
+	// the shortest retention have no expired logs
+	now := time.Now()
+	var clusters []*common.Cluster
+	if err := g.db.Select("id, status, install_completed_at").
+		Where("status IN (?) AND install_completed_at < ?",
+			[]string{models.ClusterStatusInstalled, models.ClusterStatusError, models.ClusterStatusCancelled},
+			now.Add(-rules.MinRetention())).
+		Find(&clusters).Error; err != nil {
+		g.log.WithError(err).Errorf("Failed to find clusters with expired logs")
+		return
+	}
+
+	ctx := context.Background()
+	var deleted int
+	for _, c := range clusters {
+		n, err := logartifacts.DeleteExpired(ctx, g.log, g.objectHandler, c, rules, now)
+		deleted += n
+		if err != nil {
+			g.log.WithError(err).Errorf("Failed to delete the expired logs of cluster %s", c.ID)
+		}
+	}
+	if deleted > 0 {
+		g.log.Infof("Deleted %d expired log archives of %d completed clusters", deleted, len(clusters))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 )
 
 func ECDSAKeyPairPEM() (string, string, error) {
@@ -32,13 +33,35 @@ func ECDSAKeyPairPEM() (string, string, error) {
 		return "", "", err
 	}
 
+	pubKeyPEM, err := publicKeyPEM(priv)
+	if err != nil {
+		return "", "", err
+	}
+
+	return pubKeyPEM, privKeyPEM.String(), nil
+}
+
+// ECPublicKeyPEM returns the PEM of the public key of the given EC private key
+func ECPublicKeyPEM(privateKeyPEM string) (string, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return "", errors.New("failed to decode the PEM of the private key")
+	}
+	priv, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	return publicKeyPEM(priv)
+}
+
+func publicKeyPEM(priv *ecdsa.PrivateKey) (string, error) {
 	// encode public key to PEM string
 	pubBytes, err := x509.MarshalPKIXPublicKey(priv.Public())
 	if err != nil {
-		return "", "", err
+		return "", err
 	}
 
-	block = &pem.Block{
+	block := &pem.Block{
 		Type:  "EC PUBLIC KEY",
 		Bytes: pubBytes,
 	}
@@ -46,8 +69,8 @@ func ECDSAKeyPairPEM() (string, string, error) {
 	var pubKeyPEM bytes.Buffer
 	err = pem.Encode(&pubKeyPEM, block)
 	if err != nil {
-		return "", "", err
+		return "", err
 	}
 
-	return pubKeyPEM.String(), privKeyPEM.String(), nil
+	return pubKeyPEM.String(), nil
 }
@@ -0,0 +1,19 @@
+package gencrypto
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EC keys", func() {
+	It("returns the public key of a private key", func() {
+		publicKeyPEM, privateKeyPEM, err := ECDSAKeyPairPEM()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ECPublicKeyPEM(privateKeyPEM)).To(Equal(publicKeyPEM))
+	})
+
+	It("fails for a private key that isn't PEM", func() {
+		_, err := ECPublicKeyPEM("not a key")
+		Expect(err).To(HaveOccurred())
+	})
+})
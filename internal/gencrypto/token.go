@@ -17,14 +17,19 @@ func LocalJWT(cluster_id string) (string, error) {
 }
 
 func LocalJWTForKey(cluster_id string, private_key_pem string) (string, error) {
+	return SignedJWTForKey(jwt.MapClaims{
+		"cluster_id": cluster_id,
+	}, private_key_pem)
+}
+
+// SignedJWTForKey returns a JWT with the given claims, signed with ES256 by the given EC private key
+func SignedJWTForKey(claims jwt.Claims, private_key_pem string) (string, error) {
 	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(private_key_pem))
 	if err != nil {
 		return "", err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
-		"cluster_id": cluster_id,
-	})
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
 
 	tokenString, err := token.SignedString(priv)
 	if err != nil {
@@ -166,6 +166,11 @@ func (v *validator) GetClusterHostRequirements(ctx context.Context, cluster *com
 		return nil, err
 	}
 	total := totalizeRequirements(ocpRequirements, operatorsRequirements)
+	policy, err := common.GetValidationPolicy(cluster)
+	if err != nil {
+		return nil, err
+	}
+	common.ApplyValidationPolicyThresholds(policy, &total)
 	return &models.ClusterHostRequirements{
 		HostID:    *host.ID,
 		Ocp:       &ocpRequirements,
@@ -528,6 +528,37 @@ var _ = Describe("Cluster host requirements", func() {
 		Expect(result.Total.PacketLossPercentage).To(Equal(details1.PacketLossPercentage))
 	})
 
+	It("should override the thresholds with the validation policy of the cluster", func() {
+		role := models.HostRoleWorker
+		id1 := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id1, ClusterID: *cluster.ID, Role: role}
+		cluster.ValidationPolicy = `{"installation_disk_speed_threshold_ms":30,"network_latency_threshold_ms":500,"packet_loss_percentage":5}`
+
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Eq(cluster), gomock.Eq(host)).Return(operatorRequirements, nil)
+
+		result, err := hwvalidator.GetClusterHostRequirements(context.TODO(), cluster, host)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Ocp.InstallationDiskSpeedThresholdMs).To(BeEquivalentTo(defaultWorkerDiskSpeedThreshold))
+		Expect(result.Total.CPUCores).To(BeEquivalentTo(defaultWorkerCores + details1.CPUCores + details2.CPUCores))
+		Expect(result.Total.InstallationDiskSpeedThresholdMs).To(BeEquivalentTo(30))
+		Expect(result.Total.NetworkLatencyThresholdMs).To(Equal(pointer.Float64Ptr(500)))
+		Expect(result.Total.PacketLossPercentage).To(Equal(pointer.Float64Ptr(5)))
+	})
+
+	It("should fail on an invalid validation policy", func() {
+		role := models.HostRoleWorker
+		id1 := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id1, ClusterID: *cluster.ID, Role: role}
+		cluster.ValidationPolicy = "{"
+
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Eq(cluster), gomock.Eq(host)).Return(operatorRequirements, nil)
+
+		_, err := hwvalidator.GetClusterHostRequirements(context.TODO(), cluster, host)
+
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("should fail providing on operator API error", func() {
 		role := models.HostRoleWorker
 		id1 := strfmt.UUID(uuid.New().String())
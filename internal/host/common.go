@@ -34,6 +34,11 @@ const (
 	statusInfoConnectionTimedOut                               = "Host failed to install due to timeout while connecting to host"
 	statusInfoInstallationInProgressTimedOut                   = "Host failed to install because its installation stage $STAGE took longer than expected $MAX_TIME"
 	statusInfoInstallationInProgressWritingImageToDiskTimedOut = "Host failed to install because its installation stage $STAGE did not sufficiently progress in the last $MAX_TIME."
+	statusInfoRetryingInstallation                             = "Retrying the installation of the host (retry %d of %d) after it failed in stage %s: %s"
+	statusInfoInstallationRetryStageTimedOut                   = "the stage took longer than expected $MAX_TIME"
+	statusInfoInstallationRetryTimedOut                        = "Host failed to install because it did not register again after its installation was reset to be retried"
+	statusInfoInstallingPaused                                 = "Installation was paused by user"
+	statusInfoInstallingPausedFailed                           = "Installation was paused by user, and then failed: %s"
 )
 
 var hostStatusesBeforeInstallation = [...]string{
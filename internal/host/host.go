@@ -97,6 +97,7 @@ type Config struct {
 	ResetTimeout            time.Duration           `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
 	MonitorBatchSize        int                     `envconfig:"HOST_MONITOR_BATCH_SIZE" default:"100"`
 	DisabledHostvalidations DisabledHostValidations `envconfig:"DISABLED_HOST_VALIDATIONS" default:"sufficient-network-latency-requirement-for-role,sufficient-packet-loss-requirement-for-role"` // Which host validations to disable (should not run in preprocess)
+	StageTimeouts           StageTimeouts           `envconfig:"HOST_STAGE_TIMEOUTS" default:"{}"`                                                                                                // Service defaults of the installation stage timeouts, overriding the built-in ones
 }
 
 //go:generate mockgen -package=host -aux_files=github.com/openshift/assisted-service/internal/host/hostcommands=instruction_manager.go -destination=mock_host_api.go . API
@@ -113,6 +114,10 @@ type API interface {
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
+	// PauseInstallation pauses the installation of a host that is installing, and does nothing for other hosts
+	PauseInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse
+	// ResumeInstallation resumes the installation of a paused host, and does nothing for other hosts
+	ResumeInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
 	ResetHost(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	ResetPendingUserAction(ctx context.Context, h *models.Host, db *gorm.DB) error
@@ -127,6 +132,8 @@ type API interface {
 	// auto assign host role
 	AutoAssignRole(ctx context.Context, h *models.Host, db *gorm.DB) error
 	IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error)
+	// Run the validations of a host that isn't registered, as if it was part of the cluster
+	ValidateHypotheticalHost(ctx context.Context, h *models.Host, c *common.Cluster) (models.HostValidationResults, error)
 	SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.DB) error
 	UpdateLogsProgress(ctx context.Context, h *models.Host, progress string) error
 	PermanentHostsDeletion(olderThan strfmt.DateTime) error
@@ -137,6 +144,7 @@ type API interface {
 	UpdateInventory(ctx context.Context, h *models.Host, inventory string) error
 	RefreshInventory(ctx context.Context, cluster *common.Cluster, h *models.Host, db *gorm.DB) error
 	UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error
+	UpdateDomainResolution(ctx context.Context, h *models.Host, domainResolutionReport string, db *gorm.DB) error
 	UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB, h *models.Host, machineConfigPoolName string) error
 	UpdateInstallationDisk(ctx context.Context, db *gorm.DB, h *models.Host, installationDiskId string) error
 	GetHostValidDisks(role *models.Host) ([]*models.Disk, error)
@@ -441,12 +449,25 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 		}
 	}
 
+	stageTimeouts, err := stageTimeoutsOfCluster(m.Config.StageTimeouts, c)
+	if err != nil {
+		return err
+	}
+	var retryPolicy *models.InstallRetryPolicy
+	if c != nil {
+		if retryPolicy, err = common.GetInstallRetryPolicy(c); err != nil {
+			return errors.Wrapf(err, "failed to get the install retry policy of cluster %s", c.ID)
+		}
+	}
+
 	err = m.sm.Run(TransitionTypeRefresh, newStateHost(h), &TransitionArgsRefreshHost{
 		ctx:               ctx,
 		db:                db,
 		eventHandler:      m.eventsHandler,
 		conditions:        conditions,
 		validationResults: newValidationRes,
+		stageTimeouts:     stageTimeouts,
+		retryPolicy:       retryPolicy,
 	})
 	if err != nil {
 		return common.NewApiError(http.StatusConflict, err)
@@ -507,10 +528,12 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 
 	validStatuses := []string{
 		models.HostStatusInstalling, models.HostStatusInstallingInProgress, models.HostStatusInstallingPendingUserAction,
+		models.HostStatusInstallingPaused,
 	}
 	if !funk.ContainsString(validStatuses, swag.StringValue(h.Status)) {
 		return errors.Errorf("Can't set progress <%s> to host in status <%s>", progress.CurrentStage, swag.StringValue(h.Status))
 	}
+	isPaused := swag.StringValue(h.Status) == models.HostStatusInstallingPaused
 
 	if previousProgress.CurrentStage != "" && progress.CurrentStage != models.HostStageFailed {
 		// Verify the new stage is higher or equal to the current host stage according to its role stages array
@@ -542,6 +565,30 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 			statusInfo += fmt.Sprintf(" - %s", progress.ProgressInfo)
 		}
 
+		if isPaused {
+			// The host keeps being paused with the failure recorded, the failure is retried or final when the
+			// installation is resumed
+			m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning,
+				fmt.Sprintf("Host %s: installation failed while paused (%s)", hostutil.GetHostnameForMsg(h), statusInfo), time.Now())
+			_, err = hostutil.UpdateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+				swag.StringValue(h.Status), models.HostStatusInstallingPaused, fmt.Sprintf(statusInfoInstallingPausedFailed, statusInfo),
+				"paused_installation_failure", statusInfo)
+			break
+		}
+
+		var retryPolicy *models.InstallRetryPolicy
+		if retryPolicy, err = installRetryPolicyOfCluster(m.db, h.ClusterID); err != nil {
+			return err
+		}
+		if canRetryInstallation(h, retryPolicy) {
+			err = m.sm.Run(TransitionTypeHostInstallationFailed, newStateHost(h), &TransitionArgsHostInstallationFailed{
+				ctx:         ctx,
+				reason:      statusInfo,
+				retryPolicy: retryPolicy,
+			})
+			break
+		}
+
 		_, err = hostutil.UpdateHostStatus(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusError, statusInfo)
 	case models.HostStageRebooting:
@@ -553,6 +600,13 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 		}
 		fallthrough
 	default:
+		if isPaused {
+			// The host keeps being paused, its progress is recorded so that it is resumed in the right stage
+			_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
+				swag.StringValue(h.Status), models.HostStatusInstallingPaused, swag.StringValue(h.StatusInfo),
+				previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
+			break
+		}
 		_, err = hostutil.UpdateHostProgress(ctx, logutil.FromContext(ctx, m.log), m.db, m.eventsHandler, h.ClusterID, *h.ID,
 			swag.StringValue(h.Status), models.HostStatusInstallingInProgress, statusInfo,
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo)
@@ -693,6 +747,15 @@ func (m *Manager) UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*m
 	return db.Model(h).Update("ntp_sources", string(bytes)).Error
 }
 
+func (m *Manager) UpdateDomainResolution(ctx context.Context, h *models.Host, domainResolutionReport string, db *gorm.DB) error {
+	if h.DomainResolution != domainResolutionReport {
+		if err := db.Model(h).Update("domain_resolution", domainResolutionReport).Error; err != nil {
+			return errors.Wrapf(err, "failed to set domain_resolution to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
 func (m *Manager) UpdateImageStatus(ctx context.Context, h *models.Host, newImageStatus *models.ContainerImageAvailability, db *gorm.DB) error {
 	hostImageStatuses, err := common.UnmarshalImageStatuses(h.ImagesStatus)
 	if err != nil {
@@ -800,6 +863,43 @@ func (m *Manager) CancelInstallation(ctx context.Context, h *models.Host, reason
 	return nil
 }
 
+func (m *Manager) PauseInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse {
+	if !funk.ContainsString([]string{models.HostStatusInstalling, models.HostStatusInstallingInProgress}, swag.StringValue(h.Status)) {
+		return nil
+	}
+	err := m.sm.Run(TransitionTypePauseInstallation, newStateHost(h), &TransitionArgsPauseInstallation{
+		ctx: ctx,
+		db:  db,
+	})
+	if err != nil {
+		m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityError,
+			fmt.Sprintf("Failed to pause installation of host %s: %s", hostutil.GetHostnameForMsg(h), err.Error()), time.Now())
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
+func (m *Manager) ResumeInstallation(ctx context.Context, h *models.Host, db *gorm.DB) *common.ApiErrorResponse {
+	if swag.StringValue(h.Status) != models.HostStatusInstallingPaused {
+		return nil
+	}
+	retryPolicy, err := installRetryPolicyOfCluster(db, h.ClusterID)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	err = m.sm.Run(TransitionTypeResumeInstallation, newStateHost(h), &TransitionArgsResumeInstallation{
+		ctx:         ctx,
+		db:          db,
+		retryPolicy: retryPolicy,
+	})
+	if err != nil {
+		m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityError,
+			fmt.Sprintf("Failed to resume installation of host %s: %s", hostutil.GetHostnameForMsg(h), err.Error()), time.Now())
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
 func (m *Manager) IsRequireUserActionReset(h *models.Host) bool {
 	if swag.StringValue(h.Status) != models.HostStatusResetting {
 		return false
@@ -950,7 +1050,11 @@ func (m *Manager) reportValidationStatusChanged(ctx context.Context, vc *validat
 					eventMsg := fmt.Sprintf("Host %s: validation '%s' that used to succeed is now failing", hostutil.GetHostnameForMsg(h), v.ID)
 					m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, eventMsg, time.Now())
 				}
-				if v.Status == ValidationSuccess && currentStatus == ValidationFailure {
+				if v.Status == ValidationWarning && currentStatus == ValidationSuccess {
+					eventMsg := fmt.Sprintf("Host %s: validation '%s' that used to succeed is now failing, ignored by the validation policy of the cluster", hostutil.GetHostnameForMsg(h), v.ID)
+					m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityWarning, eventMsg, time.Now())
+				}
+				if v.Status == ValidationSuccess && (currentStatus == ValidationFailure || currentStatus == ValidationWarning) {
 					eventMsg := fmt.Sprintf("Host %s: validation '%s' is now fixed", hostutil.GetHostnameForMsg(h), v.ID)
 					m.eventsHandler.AddEvent(ctx, h.ClusterID, h.ID, models.EventSeverityInfo, eventMsg, time.Now())
 				}
@@ -1079,6 +1183,47 @@ func (m *Manager) IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *
 	return false, nil
 }
 
+// The validations that can't be evaluated for a hypothetical host, since their input is only available
+// once the host is registered
+var hypotheticalHostUnknownValidations = map[validationID]string{
+	IsNTPSynced:            "The NTP synchronization is only reported by the agent of a registered host",
+	BelongsToMajorityGroup: "The connectivity groups are only calculated for registered hosts",
+}
+
+func (m *Manager) ValidateHypotheticalHost(ctx context.Context, h *models.Host, c *common.Cluster) (models.HostValidationResults, error) {
+	// The host is added to a copy of the cluster, so that the validations comparing it with the other
+	// hosts take it into account
+	cluster := *c
+	cluster.Hosts = append(append([]*models.Host{}, c.Hosts...), h)
+	cluster.ConnectivityMajorityGroups = ""
+
+	vc, err := newValidationContext(h, &cluster, m.db, m.hwValidator)
+	if err != nil {
+		return nil, err
+	}
+	_, validationsStatus, err := m.rp.preprocess(vc)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(models.HostValidationResults)
+	for category, results := range validationsStatus {
+		ret[category] = make([]*models.HostValidationResult, 0, len(results))
+		for _, result := range results {
+			message, unknown := hypotheticalHostUnknownValidations[result.ID]
+			if unknown && (result.Status == ValidationFailure || result.Status == ValidationPending) {
+				result.Status = ValidationPending
+				result.Message = message
+			}
+			ret[category] = append(ret[category], &models.HostValidationResult{
+				ID:      models.HostValidationID(result.ID),
+				Status:  swag.String(result.Status.String()),
+				Message: swag.String(result.Message),
+			})
+		}
+	}
+	return ret, nil
+}
+
 func (m *Manager) canBeMaster(conditions map[string]bool) bool {
 	if conditions[HasCPUCoresForRole.String()] && conditions[HasMemoryForRole.String()] {
 		return true
@@ -485,6 +485,46 @@ var _ = Describe("update_progress", func() {
 		})
 	})
 
+	Context("paused host", func() {
+		var progress models.HostProgress
+
+		BeforeEach(func() {
+			setDefaultReportHostInstallationMetrics(mockMetric)
+			mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			host.Status = swag.String(models.HostStatusInstallingPaused)
+			host.StatusInfo = swag.String(statusInfoInstallingPaused)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("records the progress and stays paused", func() {
+			progress.CurrentStage = models.HostStageWritingImageToDisk
+			progress.ProgressInfo = "20%"
+			Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+			hostFromDB := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+			Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstallingPaused))
+			Expect(*hostFromDB.StatusInfo).Should(Equal(statusInfoInstallingPaused))
+			Expect(hostFromDB.Progress.CurrentStage).Should(Equal(progress.CurrentStage))
+			Expect(hostFromDB.Progress.ProgressInfo).Should(Equal(progress.ProgressInfo))
+		})
+
+		It("done", func() {
+			progress.CurrentStage = models.HostStageDone
+			Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+			hostFromDB := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+			Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstalled))
+		})
+
+		It("failed", func() {
+			progress.CurrentStage = models.HostStageFailed
+			progress.ProgressInfo = "reason"
+			Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+			hostFromDB := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+			Expect(*hostFromDB.Status).Should(Equal(models.HostStatusInstallingPaused))
+			Expect(*hostFromDB.StatusInfo).Should(Equal(fmt.Sprintf(statusInfoInstallingPausedFailed, "Failed - reason")))
+			Expect(hostFromDB.PausedInstallationFailure).Should(Equal("Failed - reason"))
+		})
+	})
+
 	It("invalid stage", func() {
 		Expect(state.UpdateInstallProgress(ctx, &host,
 			&models.HostProgress{CurrentStage: common.TestDefaultConfig.HostProgressStage})).Should(HaveOccurred())
@@ -2157,6 +2197,97 @@ var _ = Describe("IsValidMasterCandidate", func() {
 	})
 })
 
+var _ = Describe("ValidateHypotheticalHost", func() {
+	var (
+		ctx       = context.Background()
+		clusterId strfmt.UUID
+		cluster   common.Cluster
+		hapi      API
+		ctrl      *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		clusterId = strfmt.UUID(uuid.New().String())
+		testLog := common.GetTestLog()
+		hwValidatorCfg := createValidatorCfg()
+		ctrl = gomock.NewController(GinkgoT())
+		mockOperators := operators.NewMockAPI(ctrl)
+		hwValidator := hardware.NewValidator(testLog, *hwValidatorCfg, mockOperators)
+		mockOperators.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]*models.OperatorHostRequirements{}, nil)
+		mockOperators.EXPECT().GetPreflightRequirementsBreakdownForCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]*models.OperatorHardwareRequirements{}, nil)
+		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
+			{Status: api.Success, ValidationId: string(models.HostValidationIDOcsRequirementsSatisfied)},
+			{Status: api.Failure, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso failure"}},
+		}, nil)
+		hapi = NewManager(testLog, nil, nil, hwValidator, nil, hwValidatorCfg, nil, defaultConfig, &leader.DummyElector{}, mockOperators)
+
+		cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+		existing := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), clusterId, models.HostStatusKnown)
+		existing.Inventory = hostutil.GenerateMasterInventoryWithHostname("existing")
+		cluster.Hosts = []*models.Host{&existing}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	hypotheticalHost := func(role models.HostRole, inventory string) *models.Host {
+		h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), clusterId, models.HostStatusDiscovering)
+		h.Role = role
+		h.Inventory = inventory
+		return &h
+	}
+
+	getResult := func(results models.HostValidationResults, id validationID) *models.HostValidationResult {
+		for _, categoryResults := range results {
+			for _, result := range categoryResults {
+				if result.ID == models.HostValidationID(id) {
+					return result
+				}
+			}
+		}
+		return nil
+	}
+
+	It("validates a host that meets the requirements of its role", func() {
+		results, err := hapi.ValidateHypotheticalHost(ctx, hypotheticalHost(models.HostRoleMaster, hostutil.GenerateMasterInventory()), &cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(getResult(results, HasCPUCoresForRole).Status)).To(Equal(ValidationSuccess.String()))
+		Expect(swag.StringValue(getResult(results, HasMemoryForRole).Status)).To(Equal(ValidationSuccess.String()))
+		Expect(swag.StringValue(getResult(results, IsHostnameUnique).Status)).To(Equal(ValidationSuccess.String()))
+		Expect(swag.StringValue(getResult(results, AreOcsRequirementsSatisfied).Status)).To(Equal(ValidationSuccess.String()))
+		Expect(swag.StringValue(getResult(results, AreLsoRequirementsSatisfied).Status)).To(Equal(ValidationFailure.String()))
+		Expect(swag.StringValue(getResult(results, AreLsoRequirementsSatisfied).Message)).To(Equal("lso failure"))
+		Expect(cluster.Hosts).To(HaveLen(1))
+	})
+
+	It("reports the validations that depend on the agent as pending", func() {
+		results, err := hapi.ValidateHypotheticalHost(ctx, hypotheticalHost(models.HostRoleMaster, hostutil.GenerateMasterInventory()), &cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		result := getResult(results, IsNTPSynced)
+		Expect(swag.StringValue(result.Status)).To(Equal(ValidationPending.String()))
+		Expect(swag.StringValue(result.Message)).To(Equal(hypotheticalHostUnknownValidations[IsNTPSynced]))
+	})
+
+	It("fails the validations of a host that doesn't meet the requirements of its role", func() {
+		results, err := hapi.ValidateHypotheticalHost(ctx, hypotheticalHost(models.HostRoleMaster, workerInventory()), &cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(getResult(results, HasCPUCoresForRole).Status)).To(Equal(ValidationFailure.String()))
+		Expect(swag.StringValue(getResult(results, HasMemoryForRole).Status)).To(Equal(ValidationFailure.String()))
+	})
+
+	It("compares the host with the hosts of the cluster", func() {
+		results, err := hapi.ValidateHypotheticalHost(ctx, hypotheticalHost(models.HostRoleWorker, hostutil.GenerateMasterInventoryWithHostname("existing")), &cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(getResult(results, IsHostnameUnique).Status)).To(Equal(ValidationFailure.String()))
+	})
+
+	It("fails with an invalid inventory", func() {
+		_, err := hapi.ValidateHypotheticalHost(ctx, hypotheticalHost(models.HostRoleWorker, "{}"), &cluster)
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
 var _ = Describe("Validation metrics and events", func() {
 
 	const (
@@ -2487,3 +2618,173 @@ var _ = Describe("Disabled Host Validation", func() {
 	})
 
 })
+
+var _ = Describe("Host stage timeouts", func() {
+	const stageTimeoutsEnvironmentName = "HOST_STAGE_TIMEOUTS"
+
+	AfterEach(func() {
+		os.Unsetenv(stageTimeoutsEnvironmentName)
+	})
+
+	It("should have the service defaults when environment is defined", func() {
+		Expect(os.Setenv(stageTimeoutsEnvironmentName, `{"Rebooting":"2h","DEFAULT":"90m"}`)).NotTo(HaveOccurred())
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ToNot(HaveOccurred())
+		Expect(cfg.StageTimeouts).To(Equal(StageTimeouts{
+			models.HostStageRebooting: 2 * time.Hour,
+			defaultStageTimeout:       90 * time.Minute,
+		}))
+	})
+
+	It("should be empty when environment is not defined", func() {
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ToNot(HaveOccurred())
+		Expect(cfg.StageTimeouts).To(BeEmpty())
+	})
+
+	It("should error when environment value is malformed", func() {
+		for _, value := range []string{`["Rebooting"]`, `{"Sleeping":"1h"}`, `{"Rebooting":"an hour"}`, `{"Rebooting":"-1h"}`} {
+			Expect(os.Setenv(stageTimeoutsEnvironmentName, value)).NotTo(HaveOccurred())
+			cfg := Config{}
+			Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).To(HaveOccurred(), value)
+		}
+	})
+
+	It("overrides the built-in timeouts by the service defaults and then by the cluster timeouts", func() {
+		serviceTimeouts := StageTimeouts{
+			models.HostStageRebooting:   2 * time.Hour,
+			models.HostStageConfiguring: 2 * time.Hour,
+			defaultStageTimeout:         90 * time.Minute,
+		}
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			StageTimeouts: `{"timeouts":[{"stage":"Configuring","timeout_seconds":600}]}`,
+		}}
+		timeouts, err := stageTimeoutsOfCluster(serviceTimeouts, cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeouts.Timeout(models.HostStageWritingImageToDisk)).To(Equal(InstallationProgressTimeout[models.HostStageWritingImageToDisk]))
+		Expect(timeouts.Timeout(models.HostStageRebooting)).To(Equal(2 * time.Hour))
+		Expect(timeouts.Timeout(models.HostStageConfiguring)).To(Equal(10 * time.Minute))
+		Expect(timeouts.Timeout("not_mentioned_stage")).To(Equal(90 * time.Minute))
+
+		cluster.StageTimeouts = `{"default_timeout_seconds":300}`
+		timeouts, err = stageTimeoutsOfCluster(nil, cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeouts.Timeout(models.HostStageRebooting)).To(Equal(InstallationProgressTimeout[models.HostStageRebooting]))
+		Expect(timeouts.Timeout("not_mentioned_stage")).To(Equal(5 * time.Minute))
+
+		cluster.StageTimeouts = "not json"
+		_, err = stageTimeoutsOfCluster(nil, cluster)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Install retry", func() {
+	var (
+		ctx        = context.Background()
+		db         *gorm.DB
+		state      API
+		host       models.Host
+		cluster    common.Cluster
+		ctrl       *gomock.Controller
+		mockEvents *events.MockHandler
+		mockMetric *metrics.MockAPI
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ReportHostInstallationMetrics(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		state = NewManager(common.GetTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric, defaultConfig, &leader.DummyElector{}, nil)
+		clusterId := strfmt.UUID(uuid.New().String())
+		cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+		cluster.Status = swag.String(models.ClusterStatusInstalling)
+		cluster.InstallRetryPolicy = `{"max_retries":2}`
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, clusterId, models.HostStatusInstallingInProgress)
+		host.Progress = &models.HostProgressInfo{
+			CurrentStage:   models.HostStageWritingImageToDisk,
+			StageStartedAt: strfmt.DateTime(time.Now()),
+			StageUpdatedAt: strfmt.DateTime(time.Now()),
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	failInstallation := func() {
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		progress := models.HostProgress{CurrentStage: models.HostStageFailed, ProgressInfo: "no space left on device"}
+		Expect(state.UpdateInstallProgress(ctx, &host, &progress)).ShouldNot(HaveOccurred())
+	}
+
+	It("resets the installation of a host that failed in a retried stage", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, gomock.Any(),
+			fmt.Sprintf("Host %s: updated status from \"installing-in-progress\" to \"resetting\" "+
+				"(Retrying the installation of the host (retry 1 of 2) after it failed in stage Writing image to disk: "+
+				"Failed - no space left on device)", host.ID.String()), gomock.Any())
+		failInstallation()
+		h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusResetting))
+		Expect(h.InstallationRetries).Should(Equal(int64(1)))
+		Expect(h.Progress.CurrentStage).Should(BeEmpty())
+	})
+
+	It("fails the installation of a host that was retried as many times as the policy allows", func() {
+		host.InstallationRetries = 2
+		mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, models.EventSeverityError, gomock.Any(), gomock.Any())
+		failInstallation()
+		h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusError))
+		Expect(h.InstallationRetries).Should(Equal(int64(2)))
+	})
+
+	It("fails the installation of a host that failed in a stage that isn't retried", func() {
+		host.Progress.CurrentStage = models.HostStageWaitingForControlPlane
+		mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, models.EventSeverityError, gomock.Any(), gomock.Any())
+		failInstallation()
+		h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusError))
+	})
+
+	It("installs the host again when it registers after its installation was reset", func() {
+		mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+		failInstallation()
+		Expect(state.RegisterHost(ctx, &models.Host{ID: host.ID, ClusterID: host.ClusterID, DiscoveryAgentVersion: "v1.0.1"}, db)).
+			ShouldNot(HaveOccurred())
+		h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInstalling))
+		Expect(swag.StringValue(h.StatusInfo)).Should(Equal(statusInfoInstalling))
+		Expect(h.InstallationRetries).Should(Equal(int64(1)))
+	})
+
+	It("clears the retries when the host is reset", func() {
+		host.Status = swag.String(models.HostStatusError)
+		host.InstallationRetries = 2
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID, host.ID, gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+		Expect(state.ResetHost(ctx, &host, "reset", db)).Should(BeNil())
+		h := hostutil.GetHostFromDB(*host.ID, host.ClusterID, db)
+		Expect(h.InstallationRetries).Should(BeZero())
+	})
+
+	It("decides which installations are retried", func() {
+		policy := &models.InstallRetryPolicy{MaxRetries: swag.Int64(1)}
+		Expect(canRetryInstallation(&host, nil)).To(BeFalse())
+		Expect(canRetryInstallation(&host, &models.InstallRetryPolicy{})).To(BeFalse())
+		Expect(canRetryInstallation(&host, policy)).To(BeTrue())
+		host.Progress.CurrentStage = models.HostStageRebooting
+		Expect(canRetryInstallation(&host, policy)).To(BeFalse())
+		host.Progress.CurrentStage = models.HostStageWaitingForControlPlane
+		Expect(canRetryInstallation(&host, policy)).To(BeFalse())
+		policy.Stages = []models.HostStage{models.HostStageWaitingForControlPlane}
+		Expect(canRetryInstallation(&host, policy)).To(BeTrue())
+		host.InstallationRetries = 1
+		Expect(canRetryInstallation(&host, policy)).To(BeFalse())
+	})
+})
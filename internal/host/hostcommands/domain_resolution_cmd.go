@@ -0,0 +1,74 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+type domainResolutionCmd struct {
+	baseCmd
+	domainResolutionImage string
+	db                    *gorm.DB
+}
+
+func NewDomainResolutionCmd(log logrus.FieldLogger, domainResolutionImage string, db *gorm.DB) *domainResolutionCmd {
+	return &domainResolutionCmd{
+		baseCmd:               baseCmd{log: log},
+		domainResolutionImage: domainResolutionImage,
+		db:                    db,
+	}
+}
+
+func (f *domainResolutionCmd) prepareParam(domainNames *network.ClusterDomainNames) (string, error) {
+	request := models.DomainResolutionRequest{}
+	for _, name := range domainNames.All() {
+		request.Domains = append(request.Domains, &models.DomainResolutionRequestDomain{DomainName: swag.String(name)})
+	}
+	b, err := json.Marshal(&request)
+	if err != nil {
+		f.log.WithError(err).Warn("Json marshal")
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetSteps asks the host to resolve the domain names of the cluster. The DNS records are only provided
+// by the user when the networking is user managed, otherwise they are served by the cluster itself
+func (f *domainResolutionCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	var cluster common.Cluster
+	if err := f.db.Take(&cluster, "id = ?", host.ClusterID.String()).Error; err != nil {
+		return nil, err
+	}
+	if !swag.BoolValue(cluster.UserManagedNetworking) {
+		return nil, nil
+	}
+	domainNames := network.GetClusterDomainNames(&cluster)
+	if domainNames == nil {
+		return nil, nil
+	}
+
+	param, err := f.prepareParam(domainNames)
+	if err != nil {
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeDomainResolution,
+		Command:  "podman",
+		Args: []string{
+			"run", "--privileged", "--net=host", "--rm", "--quiet",
+			"-v", "/var/log:/var/log",
+			"-v", "/run/systemd/journal/socket:/run/systemd/journal/socket",
+			f.domainResolutionImage,
+			"domain_resolution",
+			param,
+		},
+	}
+	return []*models.Step{step}, nil
+}
@@ -0,0 +1,84 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("domainresolutioncmd", func() {
+	ctx := context.Background()
+	var host models.Host
+	var cluster common.Cluster
+	var db *gorm.DB
+	var domainResolutionCmd *domainResolutionCmd
+	var id, clusterID strfmt.UUID
+	var stepReply []*models.Step
+	var stepErr error
+	var dbName string
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		domainResolutionCmd = NewDomainResolutionCmd(common.GetTestLog(), "quay.io/ocpmetal/assisted-installer-agent:latest", db)
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, clusterID, models.HostStatusInsufficient)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		cluster = common.Cluster{Cluster: models.Cluster{ID: &clusterID, Name: "test-cluster", BaseDNSDomain: "example.com",
+			UserManagedNetworking: swag.Bool(true)}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+	})
+
+	It("get_step", func() {
+		stepReply, stepErr = domainResolutionCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeDomainResolution))
+
+		var request models.DomainResolutionRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[len(stepReply[0].Args)-1]), &request)).ShouldNot(HaveOccurred())
+		names := make([]string, len(request.Domains))
+		for i, domain := range request.Domains {
+			names[i] = swag.StringValue(domain.DomainName)
+		}
+		Expect(names).To(Equal([]string{"api.test-cluster.example.com", "api-int.test-cluster.example.com",
+			"console-openshift-console.apps.test-cluster.example.com"}))
+	})
+
+	It("get_step_not_user_managed_networking", func() {
+		Expect(db.Model(&cluster).Update("user_managed_networking", false).Error).ShouldNot(HaveOccurred())
+		stepReply, stepErr = domainResolutionCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("get_step_no_base_domain", func() {
+		Expect(db.Model(&cluster).Update("base_dns_domain", "").Error).ShouldNot(HaveOccurred())
+		stepReply, stepErr = domainResolutionCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("get_step_unknown_cluster_id", func() {
+		host.ClusterID = strfmt.UUID(uuid.New().String())
+		stepReply, stepErr = domainResolutionCmd.GetSteps(ctx, &host)
+		Expect(stepReply).To(BeNil())
+		Expect(stepErr).Should(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		stepReply = nil
+		stepErr = nil
+	})
+})
@@ -126,6 +126,9 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInstall,
 				})
 			})
+			It("installing-paused", func() {
+				checkStep(models.HostStatusInstallingPaused, []models.StepType{})
+			})
 			It("reset", func() {
 				checkStep(models.HostStatusResetting, []models.StepType{
 					models.StepTypeResetInstallation,
@@ -196,6 +199,9 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeDhcpLeaseAllocate,
 				})
 			})
+			It("installing-paused", func() {
+				checkStep(models.HostStatusInstallingPaused, []models.StepType{})
+			})
 			It("reset", func() {
 				checkStep(models.HostStatusResetting, []models.StepType{
 					models.StepTypeResetInstallation,
@@ -204,6 +210,37 @@ var _ = Describe("instruction_manager", func() {
 		})
 	})
 
+	Context("User managed networking", func() {
+		BeforeEach(func() {
+			cluster := common.Cluster{Cluster: models.Cluster{ID: &clusterId, UserManagedNetworking: swag.Bool(true),
+				Name: "test-cluster", BaseDNSDomain: "example.com"}}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		})
+		Context("get_next_steps", func() {
+			It("known", func() {
+				checkStep(models.HostStatusKnown, []models.StepType{
+					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
+					models.StepTypeInventory, models.StepTypeNtpSynchronizer,
+					models.StepTypeDomainResolution,
+				})
+			})
+			It("insufficient", func() {
+				checkStep(models.HostStatusInsufficient, []models.StepType{
+					models.StepTypeInventory, models.StepTypeConnectivityCheck,
+					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
+					models.StepTypeDomainResolution,
+				})
+			})
+			It("pending-for-input", func() {
+				checkStep(models.HostStatusPendingForInput, []models.StepType{
+					models.StepTypeInventory, models.StepTypeConnectivityCheck,
+					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
+					models.StepTypeDomainResolution,
+				})
+			})
+		})
+	})
+
 	AfterEach(func() {
 		// cleanup
 		common.DeleteTestDB(db, dbName)
@@ -0,0 +1,69 @@
+package host
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// defaultRetriedStages are the installation stages whose failures are retried when the retry policy lists none. Their
+// failures are usually transient, such as a slow disk or a registry that couldn't be reached for a while.
+var defaultRetriedStages = []models.HostStage{
+	models.HostStageStartingInstallation,
+	models.HostStageInstalling,
+	models.HostStageWritingImageToDisk,
+}
+
+// canRetryInstallation returns whether the failed installation of the host is retried by the policy, which is when
+// the host failed in one of the retried stages and was retried fewer times than the policy allows. The installation
+// of hosts that are added to installed clusters isn't retried, and neither is the installation of hosts that already
+// rebooted out of the discovery image, since their agent can't reset the installation.
+func canRetryInstallation(h *models.Host, policy *models.InstallRetryPolicy) bool {
+	if policy == nil || hostutil.IsDay2Host(h) || h.Progress == nil {
+		return false
+	}
+	if !IsRetryableStage(h.Progress.CurrentStage) {
+		return false
+	}
+	if h.InstallationRetries >= swag.Int64Value(policy.MaxRetries) {
+		return false
+	}
+	stages := policy.Stages
+	if len(stages) == 0 {
+		stages = defaultRetriedStages
+	}
+	return funk.Contains(stages, h.Progress.CurrentStage)
+}
+
+// IsRetryableStage returns whether the failures in the installation stage can be retried, which is when the host is
+// still running the discovery image in the stage
+func IsRetryableStage(stage models.HostStage) bool {
+	return stage != models.HostStageFailed && !funk.Contains(manualRebootStages, stage)
+}
+
+// installRetryPolicyOfCluster returns the install retry policy of the cluster, or nil if the cluster doesn't exist
+func installRetryPolicyOfCluster(db *gorm.DB, clusterID strfmt.UUID) (*models.InstallRetryPolicy, error) {
+	cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	policy, err := common.GetInstallRetryPolicy(cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the install retry policy of cluster %s", clusterID)
+	}
+	return policy, nil
+}
+
+// isRetryingInstallation returns whether the installation of the host is being retried in the cluster
+func isRetryingInstallation(h *models.Host, cluster *common.Cluster) bool {
+	return h.InstallationRetries > 0 && funk.ContainsString([]string{models.ClusterStatusInstalling,
+		models.ClusterStatusInstallingPendingUserAction}, swag.StringValue(cluster.Status))
+}
@@ -221,6 +221,20 @@ func (mr *MockAPIMockRecorder) IsValidMasterCandidate(arg0, arg1, arg2, arg3 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValidMasterCandidate", reflect.TypeOf((*MockAPI)(nil).IsValidMasterCandidate), arg0, arg1, arg2, arg3)
 }
 
+// PauseInstallation mocks base method
+func (m *MockAPI) PauseInstallation(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) *common.ApiErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseInstallation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*common.ApiErrorResponse)
+	return ret0
+}
+
+// PauseInstallation indicates an expected call of PauseInstallation
+func (mr *MockAPIMockRecorder) PauseInstallation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseInstallation", reflect.TypeOf((*MockAPI)(nil).PauseInstallation), arg0, arg1, arg2)
+}
+
 // PermanentHostsDeletion mocks base method
 func (m *MockAPI) PermanentHostsDeletion(arg0 strfmt.DateTime) error {
 	m.ctrl.T.Helper()
@@ -347,6 +361,20 @@ func (mr *MockAPIMockRecorder) ResetPendingUserAction(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPendingUserAction", reflect.TypeOf((*MockAPI)(nil).ResetPendingUserAction), arg0, arg1, arg2)
 }
 
+// ResumeInstallation mocks base method
+func (m *MockAPI) ResumeInstallation(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) *common.ApiErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeInstallation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*common.ApiErrorResponse)
+	return ret0
+}
+
+// ResumeInstallation indicates an expected call of ResumeInstallation
+func (mr *MockAPIMockRecorder) ResumeInstallation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeInstallation", reflect.TypeOf((*MockAPI)(nil).ResumeInstallation), arg0, arg1, arg2)
+}
+
 // SetBootstrap mocks base method
 func (m *MockAPI) SetBootstrap(arg0 context.Context, arg1 *models.Host, arg2 bool, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -417,6 +445,20 @@ func (mr *MockAPIMockRecorder) UpdateConnectivityReport(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateDomainResolution mocks base method
+func (m *MockAPI) UpdateDomainResolution(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomainResolution", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDomainResolution indicates an expected call of UpdateDomainResolution
+func (mr *MockAPIMockRecorder) UpdateDomainResolution(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainResolution", reflect.TypeOf((*MockAPI)(nil).UpdateDomainResolution), arg0, arg1, arg2, arg3)
+}
+
 // UpdateHostname mocks base method
 func (m *MockAPI) UpdateHostname(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -542,3 +584,18 @@ func (mr *MockAPIMockRecorder) UpdateRole(arg0, arg1, arg2, arg3 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockAPI)(nil).UpdateRole), arg0, arg1, arg2, arg3)
 }
+
+// ValidateHypotheticalHost mocks base method
+func (m *MockAPI) ValidateHypotheticalHost(arg0 context.Context, arg1 *models.Host, arg2 *common.Cluster) (models.HostValidationResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateHypotheticalHost", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.HostValidationResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateHypotheticalHost indicates an expected call of ValidateHypotheticalHost
+func (mr *MockAPIMockRecorder) ValidateHypotheticalHost(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateHypotheticalHost", reflect.TypeOf((*MockAPI)(nil).ValidateHypotheticalHost), arg0, arg1, arg2)
+}
@@ -32,6 +32,7 @@ func (m *Manager) initMonitoringQueryGenerator() {
 		models.HostStatusInstalled,
 		models.HostStatusInstallingPendingUserAction,
 		models.HostStatusResettingPendingUserAction,
+		models.HostStatusResetting, // for the hosts whose installation is retried
 		models.HostStatusCancelled, // for limited time, until log collection finished or timed-out
 		models.HostStatusError,     // for limited time, until log collection finished or timed-out
 	}
@@ -6,9 +6,9 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/operators"
-	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -53,11 +53,18 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 	}
 }
 
-const validationDisabledByConfiguration = "Validation disabled by configuration"
+const (
+	validationDisabledByConfiguration = "Validation disabled by configuration"
+	validationDisabledByPolicy        = "Validation disabled by the validation policy of the cluster"
+)
 
 func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool, ValidationsStatus, error) {
 	conditions := make(map[string]bool)
 	validationsOutput := make(ValidationsStatus)
+	policy, err := common.GetValidationPolicy(c.cluster)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse the validation policy of cluster %s", c.cluster.ID.String())
+	}
 	for _, v := range r.validations {
 
 		var st ValidationStatus
@@ -66,10 +73,15 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 			st = ValidationDisabled
 			message = validationDisabledByConfiguration
 			conditions[v.id.String()] = true
+		} else if common.IsHostValidationDisabledByPolicy(policy, v.id.String()) {
+			st = ValidationDisabled
+			message = validationDisabledByPolicy
+			conditions[v.id.String()] = true
 		} else {
 			st = v.condition(c)
 			message = v.formatter(c, st)
-			conditions[v.id.String()] = st == ValidationSuccess
+			st = applyWarningPolicy(policy, v.id, st)
+			conditions[v.id.String()] = st == ValidationSuccess || st == ValidationWarning
 		}
 
 		// skip the validations per states
@@ -99,7 +111,6 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 	}
 	for _, result := range results {
 		id := validationID(result.ValidationId)
-		conditions[id.String()] = result.Status == api.Success
 		category, err := id.category()
 		if err != nil {
 			logrus.WithError(err).Warn("id.category()")
@@ -107,11 +118,19 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 		}
 
 		status := ValidationStatus(result.Status)
+		message := strings.Join(result.Reasons, "\n")
+		if common.IsHostValidationDisabledByPolicy(policy, id.String()) {
+			status = ValidationDisabled
+			message = validationDisabledByPolicy
+		} else {
+			status = applyWarningPolicy(policy, id, status)
+		}
+		conditions[id.String()] = status == ValidationSuccess || status == ValidationWarning || status == ValidationDisabled
 
 		validationsOutput[category] = append(validationsOutput[category], ValidationResult{
 			ID:      id,
 			Status:  status,
-			Message: strings.Join(result.Reasons, "\n"),
+			Message: message,
 		})
 		sortByValidationResultID(validationsOutput[category])
 	}
@@ -119,6 +138,15 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 	return conditions, validationsOutput, nil
 }
 
+// applyWarningPolicy downgrades the failure of a validation to a warning when the validation policy of the
+// cluster says so. Warnings don't prevent the installation
+func applyWarningPolicy(policy *models.ValidationPolicy, id validationID, status ValidationStatus) ValidationStatus {
+	if status == ValidationFailure && common.IsHostValidationWarningByPolicy(policy, id.String()) {
+		return ValidationWarning
+	}
+	return status
+}
+
 // sortByValidationResultID sorts results by models.HostValidationID
 func sortByValidationResultID(validationResults []ValidationResult) {
 	sort.SliceStable(validationResults, func(i, j int) bool {
@@ -224,6 +252,21 @@ func newValidations(v *validator) []validation {
 			condition: v.hasSufficientPacketLossRequirementForRole,
 			formatter: v.printSufficientPacketLossRequirementForRole,
 		},
+		{
+			id:        IsAPIDomainNameResolvedCorrectly,
+			condition: v.isAPIDomainNameResolvedCorrectly,
+			formatter: v.printAPIDomainNameResolvedCorrectly,
+		},
+		{
+			id:        IsAPIInternalDomainNameResolvedCorrectly,
+			condition: v.isAPIInternalDomainNameResolvedCorrectly,
+			formatter: v.printAPIInternalDomainNameResolvedCorrectly,
+		},
+		{
+			id:        IsAppsDomainNameResolvedCorrectly,
+			condition: v.isAppsDomainNameResolvedCorrectly,
+			formatter: v.printAppsDomainNameResolvedCorrectly,
+		},
 	}
 }
 
@@ -0,0 +1,79 @@
+package host
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// defaultStageTimeout is the key of the timeout of the installation stages that have no timeout of their own
+const defaultStageTimeout models.HostStage = "DEFAULT"
+
+// StageTimeouts are how long a host may stay in each installation stage before its installation fails. The service
+// defaults are decoded from a JSON object of stages and durations such as {"Rebooting":"2h","DEFAULT":"90m"}.
+type StageTimeouts map[models.HostStage]time.Duration
+
+func (t *StageTimeouts) Decode(value string) error {
+	var durations map[models.HostStage]string
+	if err := json.Unmarshal([]byte(value), &durations); err != nil {
+		return errors.Wrap(err, "failed to decode the host stage timeouts")
+	}
+	timeouts := StageTimeouts{}
+	for stage, durationStr := range durations {
+		if stage != defaultStageTimeout {
+			if err := stage.Validate(strfmt.Default); err != nil {
+				return errors.Wrapf(err, "invalid host stage timeout")
+			}
+		}
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid timeout of host stage %s", stage)
+		}
+		if duration <= 0 {
+			return errors.Errorf("timeout of host stage %s must be positive", stage)
+		}
+		timeouts[stage] = duration
+	}
+	*t = timeouts
+	return nil
+}
+
+// Timeout returns the timeout of the stage, or the default timeout if the stage has none
+func (t StageTimeouts) Timeout(stage models.HostStage) time.Duration {
+	if timeout, ok := t[stage]; ok {
+		return timeout
+	}
+	return t[defaultStageTimeout]
+}
+
+// stageTimeoutsOfCluster returns the stage timeouts of the hosts of the cluster. The built-in timeouts are overridden
+// by the service defaults, which are overridden by the timeouts that were set for the cluster.
+func stageTimeoutsOfCluster(serviceTimeouts StageTimeouts, cluster *common.Cluster) (StageTimeouts, error) {
+	timeouts := StageTimeouts{}
+	for stage, timeout := range InstallationProgressTimeout {
+		timeouts[stage] = timeout
+	}
+	for stage, timeout := range serviceTimeouts {
+		timeouts[stage] = timeout
+	}
+	if cluster == nil {
+		return timeouts, nil
+	}
+	clusterTimeouts, err := common.GetStageTimeouts(cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the stage timeouts of cluster %s", cluster.ID)
+	}
+	if clusterTimeouts.DefaultTimeoutSeconds > 0 {
+		timeouts[defaultStageTimeout] = time.Duration(clusterTimeouts.DefaultTimeoutSeconds) * time.Second
+	}
+	for _, timeout := range clusterTimeouts.Timeouts {
+		if timeout != nil && timeout.TimeoutSeconds != nil {
+			timeouts[timeout.Stage] = time.Duration(*timeout.TimeoutSeconds) * time.Second
+		}
+	}
+	return timeouts, nil
+}
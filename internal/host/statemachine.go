@@ -2,6 +2,7 @@ package host
 
 import (
 	"github.com/filanov/stateswitch"
+	"github.com/openshift/assisted-service/internal/statemachine"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -16,10 +17,12 @@ const (
 	TransitionTypeResettingPendingUserAction = "ResettingPendingUserAction"
 	TransitionTypeRefresh                    = "RefreshHost"
 	TransitionTypeRegisterInstalledHost      = "RegisterInstalledHost"
+	TransitionTypePauseInstallation          = "PauseInstallation"
+	TransitionTypeResumeInstallation         = "ResumeInstallation"
 )
 
-func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
-	sm := stateswitch.NewStateMachine()
+func NewHostStateMachine(th *transitionHandler) *statemachine.Recorder {
+	sm := statemachine.NewRecorder("host")
 
 	// Register host
 	sm.AddTransition(stateswitch.TransitionRule{
@@ -45,6 +48,17 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		DestinationState: stateswitch.State(models.HostStatusDisabled),
 	})
 
+	// Host whose failed installation was reset to be retried registers again, and is installed from the start
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRegisterHost,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusResetting),
+		},
+		Condition:        th.IsInstallationRetried,
+		DestinationState: stateswitch.State(models.HostStatusInstalling),
+		PostTransition:   th.PostRegisterRetriedInstallation,
+	})
+
 	// Do nothing when host in reboot tries to register from resetting state.
 	// On such cases cluster monitor is responsible to set the host state to
 	// resetting-pending-user-action.
@@ -62,7 +76,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusResetting),
 		},
-		Condition:        stateswitch.Not(th.IsHostInReboot),
+		Condition:        statemachine.Not(th.IsHostInReboot),
 		DestinationState: stateswitch.State(models.HostStatusDiscovering),
 		PostTransition:   th.PostRegisterHost,
 	})
@@ -75,6 +89,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusInstallingInProgress),
 			stateswitch.State(models.HostStatusInstallingPendingUserAction),
 			stateswitch.State(models.HostStatusAddedToExistingCluster),
+			stateswitch.State(models.HostStatusInstallingPaused),
 		},
 		DestinationState: stateswitch.State(models.HostStatusInstallingPendingUserAction),
 		PostTransition:   th.PostRegisterDuringReboot,
@@ -86,6 +101,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusInstalling),
 			stateswitch.State(models.HostStatusInstallingInProgress),
+			stateswitch.State(models.HostStatusInstallingPaused),
 		},
 		DestinationState: stateswitch.State(models.HostStatusError),
 		PostTransition:   th.PostRegisterDuringInstallation,
@@ -112,6 +128,18 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		PostTransition:   th.PostRegisterInstalledHost,
 	})
 
+	// Installation failure that is retried by the install retry policy of the cluster
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeHostInstallationFailed,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstalling),
+			stateswitch.State(models.HostStatusInstallingInProgress),
+		},
+		Condition:        th.IsInstallationRetryable,
+		DestinationState: stateswitch.State(models.HostStatusResetting),
+		PostTransition:   th.PostRetryInstallation,
+	})
+
 	// Installation failure
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeHostInstallationFailed,
@@ -141,6 +169,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusInstallingInProgress),
 			stateswitch.State(models.HostStatusInstalled),
 			stateswitch.State(models.HostStatusError),
+			stateswitch.State(models.HostStatusInstallingPaused),
 		},
 		DestinationState: stateswitch.State(models.HostStatusCancelled),
 		PostTransition:   th.PostCancelInstallation,
@@ -184,6 +213,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusError),
 			stateswitch.State(models.HostStatusCancelled),
 			stateswitch.State(models.HostStatusAddedToExistingCluster),
+			stateswitch.State(models.HostStatusInstallingPaused),
 		},
 		DestinationState: stateswitch.State(models.HostStatusResetting),
 		PostTransition:   th.PostResetHost,
@@ -207,6 +237,60 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		DestinationState: stateswitch.State(models.HostStatusKnown),
 	})
 
+	// Pause the installation of the host, it is given no installation steps until the installation is resumed
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypePauseInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstalling),
+			stateswitch.State(models.HostStatusInstallingInProgress),
+		},
+		DestinationState: stateswitch.State(models.HostStatusInstallingPaused),
+		PostTransition:   th.PostPauseInstallation,
+	})
+
+	// Resume the installation of a host that failed while it was paused, retrying it by the install retry policy of
+	// the cluster
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeResumeInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingPaused),
+		},
+		Condition:        statemachine.And(th.HasInstallationFailedWhilePaused, th.IsInstallationRetryable),
+		DestinationState: stateswitch.State(models.HostStatusResetting),
+		PostTransition:   th.PostRetryInstallation,
+	})
+
+	// Resume the installation of a host that failed while it was paused, and can't be retried
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeResumeInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingPaused),
+		},
+		Condition:        th.HasInstallationFailedWhilePaused,
+		DestinationState: stateswitch.State(models.HostStatusError),
+		PostTransition:   th.PostResumeFailedInstallation,
+	})
+
+	// Resume the installation of a host that reported its progress before or while it was paused
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeResumeInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingPaused),
+		},
+		Condition:        th.HasInstallationStarted,
+		DestinationState: stateswitch.State(models.HostStatusInstallingInProgress),
+		PostTransition:   th.PostResumeInstallation,
+	})
+
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeResumeInstallation,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingPaused),
+		},
+		DestinationState: stateswitch.State(models.HostStatusInstalling),
+		PostTransition:   th.PostResumeInstallation,
+	})
+
 	// Install host
 
 	// Install disabled host will not do anything
@@ -287,7 +371,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	// Prepare for installation
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefresh,
-		Condition:      stateswitch.And(If(ValidRoleForInstallation), If(IsConnected), If(ClusterPreparingForInstallation)),
+		Condition:      statemachine.And(If(ValidRoleForInstallation), If(IsConnected), If(ClusterPreparingForInstallation)),
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusKnown),
 		},
@@ -296,27 +380,27 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	})
 
 	// Unknown validations
-	installationDiskSpeedUnknown := stateswitch.And(stateswitch.Not(If(InstallationDiskSpeedCheckSuccessful)), If(SufficientOrUnknownInstallationDiskSpeed))
-	imagesAvailabilityUnknown := stateswitch.And(stateswitch.Not(If(SuccessfulContainerImageAvailability)), If(SucessfullOrUnknownContainerImagesAvailability))
+	installationDiskSpeedUnknown := statemachine.And(statemachine.Not(If(InstallationDiskSpeedCheckSuccessful)), If(SufficientOrUnknownInstallationDiskSpeed))
+	imagesAvailabilityUnknown := statemachine.And(statemachine.Not(If(SuccessfulContainerImageAvailability)), If(SucessfullOrUnknownContainerImagesAvailability))
 
 	// All validations are successful
-	allConditionsSuccessful := stateswitch.And(If(InstallationDiskSpeedCheckSuccessful), If(SuccessfulContainerImageAvailability))
+	allConditionsSuccessful := statemachine.And(If(InstallationDiskSpeedCheckSuccessful), If(SuccessfulContainerImageAvailability))
 
 	// All validations are successful, or were not evaluated
-	allConditionsSuccessfulOrUnknown := stateswitch.And(If(SufficientOrUnknownInstallationDiskSpeed), If(SucessfullOrUnknownContainerImagesAvailability))
+	allConditionsSuccessfulOrUnknown := statemachine.And(If(SufficientOrUnknownInstallationDiskSpeed), If(SucessfullOrUnknownContainerImagesAvailability))
 
 	// At least one of the validations failed
-	atLeastOneConditionFailed := stateswitch.Not(allConditionsSuccessfulOrUnknown)
+	atLeastOneConditionFailed := statemachine.Not(allConditionsSuccessfulOrUnknown)
 
 	// At least one of the validations has not been evaluated and there are no failed validations
-	atLeastOneConditionUnknown := stateswitch.And(stateswitch.Or(installationDiskSpeedUnknown, imagesAvailabilityUnknown), allConditionsSuccessfulOrUnknown)
+	atLeastOneConditionUnknown := statemachine.And(statemachine.Or(installationDiskSpeedUnknown, imagesAvailabilityUnknown), allConditionsSuccessfulOrUnknown)
 
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefresh,
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingForInstallation),
 		},
-		Condition:        stateswitch.And(If(IsConnected), allConditionsSuccessful, If(ClusterPreparingForInstallation)),
+		Condition:        statemachine.And(If(IsConnected), allConditionsSuccessful, If(ClusterPreparingForInstallation)),
 		DestinationState: stateswitch.State(models.HostStatusPreparingSuccessful),
 		PostTransition:   th.PostRefreshHost(statusInfoHostPreparationSuccessful),
 	})
@@ -326,7 +410,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingSuccessful),
 		},
-		Condition:        stateswitch.And(If(IsConnected), If(ClusterPreparingForInstallation)),
+		Condition:        statemachine.And(If(IsConnected), If(ClusterPreparingForInstallation)),
 		DestinationState: stateswitch.State(models.HostStatusPreparingSuccessful),
 	})
 
@@ -336,7 +420,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingSuccessful),
 		},
-		Condition:        stateswitch.And(If(IsConnected), If(ClusterInstalling)),
+		Condition:        statemachine.And(If(IsConnected), If(ClusterInstalling)),
 		DestinationState: stateswitch.State(models.HostStatusInstalling),
 		PostTransition:   th.PostRefreshHost(statusInfoInstalling),
 	})
@@ -346,7 +430,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingForInstallation),
 		},
-		Condition:        stateswitch.And(If(IsConnected), atLeastOneConditionFailed),
+		Condition:        statemachine.And(If(IsConnected), atLeastOneConditionFailed),
 		DestinationState: stateswitch.State(models.HostStatusInsufficient),
 		PostTransition:   th.PostRefreshHost(statusInfoNotReadyForInstall),
 	})
@@ -356,7 +440,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingForInstallation),
 		},
-		Condition:        stateswitch.And(If(IsConnected), allConditionsSuccessfulOrUnknown, stateswitch.Not(If(ClusterPreparingForInstallation))),
+		Condition:        statemachine.And(If(IsConnected), allConditionsSuccessfulOrUnknown, statemachine.Not(If(ClusterPreparingForInstallation))),
 		DestinationState: stateswitch.State(models.HostStatusKnown),
 		PostTransition:   th.PostRefreshHost(statusInfoKnown),
 	})
@@ -366,7 +450,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingForInstallation),
 		},
-		Condition:        stateswitch.And(If(IsConnected), atLeastOneConditionUnknown, If(ClusterPreparingForInstallation)),
+		Condition:        statemachine.And(If(IsConnected), atLeastOneConditionUnknown, If(ClusterPreparingForInstallation)),
 		DestinationState: stateswitch.State(models.HostStatusPreparingForInstallation),
 	})
 
@@ -375,7 +459,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusPreparingSuccessful),
 		},
-		Condition:        stateswitch.And(If(IsConnected), stateswitch.Not(stateswitch.Or(If(ClusterPreparingForInstallation), If(ClusterInstalling)))),
+		Condition:        statemachine.And(If(IsConnected), statemachine.Not(statemachine.Or(If(ClusterPreparingForInstallation), If(ClusterInstalling)))),
 		DestinationState: stateswitch.State(models.HostStatusKnown),
 		PostTransition:   th.PostRefreshHost(statusInfoKnown),
 	})
@@ -389,7 +473,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusPendingForInput),
 			stateswitch.State(models.HostStatusDisconnected),
 		},
-		Condition:        stateswitch.Not(If(IsConnected)),
+		Condition:        statemachine.Not(If(IsConnected)),
 		DestinationState: stateswitch.State(models.HostStatusDisconnected),
 		PostTransition:   th.PostRefreshHost(statusInfoDisconnected),
 	})
@@ -404,7 +488,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusResettingPendingUserAction),
 			stateswitch.State(models.HostStatusInstallingPendingUserAction),
 		},
-		Condition:        stateswitch.And(If(ClusterInError), stateswitch.Not(th.IsDay2Host)),
+		Condition:        statemachine.And(If(ClusterInError), statemachine.Not(th.IsDay2Host)),
 		DestinationState: stateswitch.State(models.HostStatusError),
 		PostTransition:   th.PostRefreshHost(statusInfoAbortingDueClusterErrors),
 	})
@@ -425,7 +509,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusInstalling),
 		},
-		Condition:        stateswitch.Not(If(IsConnected)),
+		Condition:        statemachine.Not(If(IsConnected)),
 		DestinationState: stateswitch.State(models.HostStatusError),
 		PostTransition:   th.PostRefreshHost(statusInfoConnectionTimedOut),
 	})
@@ -436,7 +520,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusPreparingForInstallation),
 			stateswitch.State(models.HostStatusPreparingSuccessful),
 		},
-		Condition:        stateswitch.Not(If(IsConnected)),
+		Condition:        statemachine.Not(If(IsConnected)),
 		DestinationState: stateswitch.State(models.HostStatusDisconnected),
 		PostTransition:   th.PostRefreshHost(statusInfoConnectionTimedOut),
 	})
@@ -452,7 +536,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		DestinationState: stateswitch.State(models.HostStatusError),
 		PostTransition:   th.PostRefreshHost(statusInfoConnectionTimedOut),
 	})
-	shouldIgnoreInstallationProgressTimeout := stateswitch.And(If(StageInWrongBootStages), If(ClusterPendingUserAction))
+	shouldIgnoreInstallationProgressTimeout := statemachine.And(If(StageInWrongBootStages), If(ClusterPendingUserAction))
 
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefresh,
@@ -463,14 +547,27 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		PostTransition:   th.PostRefreshHostRefreshStageUpdateTime,
 	})
 
+	// Time out while host installationInProgress, retried by the install retry policy of the cluster
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusInstallingInProgress)},
+		Condition: statemachine.And(
+			th.HasInstallationInProgressTimedOut,
+			statemachine.Not(shouldIgnoreInstallationProgressTimeout),
+			th.IsInstallationRetryable),
+		DestinationState: stateswitch.State(models.HostStatusResetting),
+		PostTransition:   th.PostRetryInstallation,
+	})
+
 	// Time out while host installationInProgress
 	sm.AddTransition(stateswitch.TransitionRule{
 		TransitionType: TransitionTypeRefresh,
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusInstallingInProgress)},
-		Condition: stateswitch.And(
+		Condition: statemachine.And(
 			th.HasInstallationInProgressTimedOut,
-			stateswitch.Not(shouldIgnoreInstallationProgressTimeout)),
+			statemachine.Not(shouldIgnoreInstallationProgressTimeout)),
 		DestinationState: stateswitch.State(models.HostStatusError),
 		PostTransition:   th.PostRefreshHost(statusInfoInstallationInProgressTimedOut),
 	})
@@ -486,7 +583,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		sm.AddTransition(stateswitch.TransitionRule{
 			TransitionType:   TransitionTypeRefresh,
 			SourceStates:     []stateswitch.State{state},
-			Condition:        stateswitch.Not(If(ClusterInError)),
+			Condition:        statemachine.Not(If(ClusterInError)),
 			DestinationState: state,
 		})
 	}
@@ -497,17 +594,18 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusDisconnected),
 			stateswitch.State(models.HostStatusDiscovering),
 		},
-		Condition:        stateswitch.And(If(IsConnected), stateswitch.Not(If(HasInventory))),
+		Condition:        statemachine.And(If(IsConnected), statemachine.Not(If(HasInventory))),
 		DestinationState: stateswitch.State(models.HostStatusDiscovering),
 		PostTransition:   th.PostRefreshHost(statusInfoDiscovering),
 	})
 
-	var hasMinRequiredHardware = stateswitch.And(If(HasMinValidDisks), If(HasMinCPUCores), If(HasMinMemory), If(IsPlatformValid))
+	var hasMinRequiredHardware = statemachine.And(If(HasMinValidDisks), If(HasMinCPUCores), If(HasMinMemory), If(IsPlatformValid))
 
-	var requiredInputFieldsExist = stateswitch.And(If(IsMachineCidrDefined))
+	var requiredInputFieldsExist = statemachine.And(If(IsMachineCidrDefined))
 
-	var isSufficientForInstall = stateswitch.And(If(HasMemoryForRole), If(HasCPUCoresForRole), If(BelongsToMachineCidr), If(IsHostnameUnique), If(IsHostnameValid), If(IsAPIVipConnected), If(BelongsToMajorityGroup),
-		If(AreOcsRequirementsSatisfied), If(AreLsoRequirementsSatisfied), If(AreCnvRequirementsSatisfied), If(SufficientOrUnknownInstallationDiskSpeed), If(SucessfullOrUnknownContainerImagesAvailability), If(HasSufficientNetworkLatencyRequirementForRole), If(HasSufficientPacketLossRequirementForRole))
+	var isSufficientForInstall = statemachine.And(If(HasMemoryForRole), If(HasCPUCoresForRole), If(BelongsToMachineCidr), If(IsHostnameUnique), If(IsHostnameValid), If(IsAPIVipConnected), If(BelongsToMajorityGroup),
+		If(AreOcsRequirementsSatisfied), If(AreLsoRequirementsSatisfied), If(AreCnvRequirementsSatisfied), If(SufficientOrUnknownInstallationDiskSpeed), If(SucessfullOrUnknownContainerImagesAvailability), If(HasSufficientNetworkLatencyRequirementForRole), If(HasSufficientPacketLossRequirementForRole),
+		If(IsAPIDomainNameResolvedCorrectly), If(IsAPIInternalDomainNameResolvedCorrectly), If(IsAppsDomainNameResolvedCorrectly))
 
 	// In order for this transition to be fired at least one of the validations in minRequiredHardwareValidations must fail.
 	// This transition handles the case that a host does not pass minimum hardware requirements for any of the roles
@@ -519,8 +617,8 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusInsufficient),
 			stateswitch.State(models.HostStatusKnown),
 		},
-		Condition: stateswitch.And(If(IsConnected), If(HasInventory),
-			stateswitch.Not(hasMinRequiredHardware)),
+		Condition: statemachine.And(If(IsConnected), If(HasInventory),
+			statemachine.Not(hasMinRequiredHardware)),
 		DestinationState: stateswitch.State(models.HostStatusInsufficient),
 		PostTransition:   th.PostRefreshHost(statusInfoInsufficientHardware),
 	})
@@ -536,9 +634,9 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusKnown),
 			stateswitch.State(models.HostStatusPendingForInput),
 		},
-		Condition: stateswitch.And(If(IsConnected), If(HasInventory),
+		Condition: statemachine.And(If(IsConnected), If(HasInventory),
 			hasMinRequiredHardware,
-			stateswitch.Not(requiredInputFieldsExist)),
+			statemachine.Not(requiredInputFieldsExist)),
 		DestinationState: stateswitch.State(models.HostStatusPendingForInput),
 		PostTransition:   th.PostRefreshHost(statusInfoPendingForInput),
 	})
@@ -555,10 +653,10 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusDiscovering),
 			stateswitch.State(models.HostStatusKnown),
 		},
-		Condition: stateswitch.And(If(IsConnected), If(HasInventory),
+		Condition: statemachine.And(If(IsConnected), If(HasInventory),
 			hasMinRequiredHardware,
 			requiredInputFieldsExist,
-			stateswitch.Not(isSufficientForInstall)),
+			statemachine.Not(isSufficientForInstall)),
 		DestinationState: stateswitch.State(models.HostStatusInsufficient),
 		PostTransition:   th.PostRefreshHost(statusInfoNotReadyForInstall),
 	})
@@ -572,7 +670,7 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 			stateswitch.State(models.HostStatusPendingForInput),
 			stateswitch.State(models.HostStatusDiscovering),
 		},
-		Condition: stateswitch.And(If(IsConnected), If(HasInventory),
+		Condition: statemachine.And(If(IsConnected), If(HasInventory),
 			hasMinRequiredHardware,
 			requiredInputFieldsExist,
 			isSufficientForInstall),
@@ -585,11 +683,11 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		SourceStates: []stateswitch.State{
 			stateswitch.State(models.HostStatusKnown),
 		},
-		Condition: stateswitch.And(If(IsConnected), If(HasInventory),
+		Condition: statemachine.And(If(IsConnected), If(HasInventory),
 			hasMinRequiredHardware,
 			requiredInputFieldsExist,
 			isSufficientForInstall,
-			stateswitch.Not(stateswitch.And(If(ClusterPreparingForInstallation), If(ValidRoleForInstallation)))),
+			statemachine.Not(statemachine.And(If(ClusterPreparingForInstallation), If(ValidRoleForInstallation)))),
 		DestinationState: stateswitch.State(models.HostStatusKnown),
 		PostTransition:   th.PostRefreshHost(statusInfoKnown),
 	})
@@ -607,6 +705,31 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 		})
 	}
 
+	// Abort the retried installation of host if cluster has errors
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusResetting),
+		},
+		Condition:        statemachine.And(th.HasInstallationRetries, If(ClusterInError)),
+		DestinationState: stateswitch.State(models.HostStatusError),
+		PostTransition:   th.PostRefreshHost(statusInfoAbortingDueClusterErrors),
+	})
+
+	// Time out while host whose installation is retried didn't register again
+	sm.AddTransition(stateswitch.TransitionRule{
+		TransitionType: TransitionTypeRefresh,
+		SourceStates: []stateswitch.State{
+			stateswitch.State(models.HostStatusResetting),
+		},
+		Condition: statemachine.And(
+			th.HasInstallationRetries,
+			statemachine.Or(If(ClusterInstalling), If(ClusterPendingUserAction)),
+			th.HasInstallationRetryTimedOut),
+		DestinationState: stateswitch.State(models.HostStatusError),
+		PostTransition:   th.PostRefreshHost(statusInfoInstallationRetryTimedOut),
+	})
+
 	// Noop transitions
 	for _, state := range []stateswitch.State{
 		stateswitch.State(models.HostStatusDisabled),
@@ -633,3 +756,8 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 
 	return sm
 }
+
+// DescribeStateMachine returns the transitions of the state machine of the hosts
+func DescribeStateMachine() *models.StateMachine {
+	return NewHostStateMachine(&transitionHandler{}).Describe()
+}
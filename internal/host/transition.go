@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/statemachine"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/pkg/errors"
@@ -29,7 +30,8 @@ type transitionHandler struct {
 	eventsHandler events.Handler
 }
 
-var resetFields = [...]interface{}{"inventory", "", "bootstrap", false, "ntp_sources", ""}
+var resetFields = [...]interface{}{"inventory", "", "bootstrap", false, "ntp_sources", "", "installation_retries", 0,
+	"paused_installation_failure", ""}
 var resetLogsField = []interface{}{"logs_info", "", "logs_started_at", strfmt.DateTime(time.Time{}), "logs_collected_at", strfmt.DateTime(time.Time{})}
 
 ////////////////////////////////////////////////////////////////////////////
@@ -175,8 +177,9 @@ func (th *transitionHandler) PostRegisterInstalledHost(sw stateswitch.StateSwitc
 ////////////////////////////////////////////////////////////////////////////
 
 type TransitionArgsHostInstallationFailed struct {
-	ctx    context.Context
-	reason string
+	ctx         context.Context
+	reason      string
+	retryPolicy *models.InstallRetryPolicy
 }
 
 func (th *transitionHandler) PostHostInstallationFailed(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
@@ -217,6 +220,105 @@ func (th *transitionHandler) PostCancelInstallation(sw stateswitch.StateSwitch,
 		params.reason)
 }
 
+////////////////////////////////////////////////////////////////////////////
+// Pause Installation
+////////////////////////////////////////////////////////////////////////////
+
+type TransitionArgsPauseInstallation struct {
+	ctx context.Context
+	db  *gorm.DB
+}
+
+func (th *transitionHandler) PostPauseInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostPauseInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsPauseInstallation)
+	if !ok {
+		return errors.New("PostPauseInstallation invalid argument")
+	}
+
+	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+		statusInfoInstallingPaused)
+}
+
+////////////////////////////////////////////////////////////////////////////
+// Resume Installation
+////////////////////////////////////////////////////////////////////////////
+
+type TransitionArgsResumeInstallation struct {
+	ctx         context.Context
+	db          *gorm.DB
+	retryPolicy *models.InstallRetryPolicy
+}
+
+// HasInstallationFailedWhilePaused is true for hosts that reported the failure of their installation while they were
+// paused
+func (th *transitionHandler) HasInstallationFailedWhilePaused(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("HasInstallationFailedWhilePaused incompatible type of StateSwitch")
+	}
+	return sHost.host.PausedInstallationFailure != "", nil
+}
+
+// PostResumeFailedInstallation moves a host that failed while it was paused to error, with the reason of the failure
+func (th *transitionHandler) PostResumeFailedInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostResumeFailedInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsResumeInstallation)
+	if !ok {
+		return errors.New("PostResumeFailedInstallation invalid argument")
+	}
+
+	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+		sHost.host.PausedInstallationFailure, "paused_installation_failure", "")
+}
+
+// HasInstallationStarted is true for hosts that reported the progress of their installation
+func (th *transitionHandler) HasInstallationStarted(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("HasInstallationStarted incompatible type of StateSwitch")
+	}
+	return sHost.host.Progress != nil && sHost.host.Progress.CurrentStage != "", nil
+}
+
+// PostResumeInstallation moves the time the current installation stage of the host was last updated forward by the
+// time the host was paused, so that the paused time doesn't count towards the timeout of the stage. A stage that
+// was reported while the host was paused starts counting when the host is resumed.
+func (th *transitionHandler) PostResumeInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostResumeInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsResumeInstallation)
+	if !ok {
+		return errors.New("PostResumeInstallation invalid argument")
+	}
+
+	progress := sHost.host.Progress
+	if progress == nil || progress.CurrentStage == "" {
+		return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+			statusInfoInstalling)
+	}
+
+	now := time.Now()
+	// the host has been paused since its status was last updated
+	pausedAt := time.Time(sHost.host.StatusUpdatedAt)
+	stageUpdatedAt := time.Time(progress.StageUpdatedAt)
+	if stageUpdatedAt.Before(pausedAt) {
+		stageUpdatedAt = stageUpdatedAt.Add(now.Sub(pausedAt))
+	} else {
+		stageUpdatedAt = now
+	}
+	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+		string(progress.CurrentStage), "progress_stage_updated_at", strfmt.DateTime(stageUpdatedAt))
+}
+
 ////////////////////////////////////////////////////////////////////////////
 // Reset Host
 ////////////////////////////////////////////////////////////////////////////
@@ -237,7 +339,8 @@ func (th *transitionHandler) PostResetHost(sw stateswitch.StateSwitch, args stat
 		return errors.New("PostResetHost invalid argument")
 	}
 
-	extra := append(append(make([]interface{}, 0), "StatusUpdatedAt", strfmt.DateTime(time.Now())), resetLogsField...)
+	extra := append(append(make([]interface{}, 0), "StatusUpdatedAt", strfmt.DateTime(time.Now()), "installation_retries", 0,
+		"paused_installation_failure", ""), resetLogsField...)
 	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
 		params.reason, extra...)
 }
@@ -373,6 +476,8 @@ type TransitionArgsRefreshHost struct {
 	conditions        map[string]bool
 	validationResults ValidationsStatus
 	db                *gorm.DB
+	stageTimeouts     StageTimeouts
+	retryPolicy       *models.InstallRetryPolicy
 }
 
 func If(id stringer) stateswitch.Condition {
@@ -387,7 +492,7 @@ func If(id stringer) stateswitch.Condition {
 		}
 		return b, nil
 	}
-	return ret
+	return statemachine.Named(fmt.Sprintf("If(%s)", id.String()), ret)
 }
 
 func (th *transitionHandler) HasClusterError(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
@@ -467,15 +572,16 @@ func (th *transitionHandler) HasInstallationTimedOut(sw stateswitch.StateSwitch,
 	return time.Since(time.Time(sHost.host.StatusUpdatedAt)) > InstallationTimeout, nil
 }
 
-func (th *transitionHandler) HasInstallationInProgressTimedOut(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+func (th *transitionHandler) HasInstallationInProgressTimedOut(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
 	sHost, ok := sw.(*stateHost)
 	if !ok {
 		return false, errors.New("HasInstallationInProgressTimedOut incompatible type of StateSwitch")
 	}
-	maxDuration, ok := InstallationProgressTimeout[sHost.host.Progress.CurrentStage]
+	params, ok := args.(*TransitionArgsRefreshHost)
 	if !ok {
-		maxDuration = InstallationProgressTimeout["DEFAULT"]
+		return false, errors.New("HasInstallationInProgressTimedOut invalid argument")
 	}
+	maxDuration := params.stageTimeouts.Timeout(sHost.host.Progress.CurrentStage)
 	return time.Since(time.Time(sHost.host.Progress.StageUpdatedAt)) > maxDuration, nil
 }
 
@@ -499,8 +605,7 @@ func (th *transitionHandler) PostRefreshHost(reason string) stateswitch.PostTran
 			reason == statusInfoInstallationInProgressTimedOut {
 			template = statusInfoInstallationInProgressWritingImageToDiskTimedOut
 		}
-		template = strings.Replace(template, "$STAGE", string(sHost.host.Progress.CurrentStage), 1)
-		template = strings.Replace(template, "$MAX_TIME", InstallationProgressTimeout[sHost.host.Progress.CurrentStage].String(), 1)
+		template = formatStageTimeout(template, sHost.host, params.stageTimeouts)
 
 		if strings.Contains(template, "$FAILING_VALIDATIONS") {
 			failedValidations := getFailedValidations(params)
@@ -518,6 +623,11 @@ func (th *transitionHandler) PostRefreshHost(reason string) stateswitch.PostTran
 	return ret
 }
 
+func formatStageTimeout(template string, h *models.Host, stageTimeouts StageTimeouts) string {
+	template = strings.Replace(template, "$STAGE", string(h.Progress.CurrentStage), 1)
+	return strings.Replace(template, "$MAX_TIME", stageTimeouts.Timeout(h.Progress.CurrentStage).String(), 1)
+}
+
 func (th *transitionHandler) IsDay2Host(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
 	sHost, ok := sw.(*stateHost)
 	if !ok {
@@ -583,3 +693,112 @@ func (th *transitionHandler) PostRefreshHostRefreshStageUpdateTime(
 		sHost.srcState)
 	return err
 }
+
+////////////////////////////////////////////////////////////////////////////
+// Installation retry
+////////////////////////////////////////////////////////////////////////////
+
+func (th *transitionHandler) IsInstallationRetryable(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("IsInstallationRetryable incompatible type of StateSwitch")
+	}
+	switch params := args.(type) {
+	case *TransitionArgsRefreshHost:
+		return canRetryInstallation(sHost.host, params.retryPolicy), nil
+	case *TransitionArgsHostInstallationFailed:
+		return canRetryInstallation(sHost.host, params.retryPolicy), nil
+	case *TransitionArgsResumeInstallation:
+		return canRetryInstallation(sHost.host, params.retryPolicy), nil
+	default:
+		return false, errors.New("IsInstallationRetryable invalid argument")
+	}
+}
+
+func (th *transitionHandler) HasInstallationRetries(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("HasInstallationRetries incompatible type of StateSwitch")
+	}
+	return sHost.host.InstallationRetries > 0, nil
+}
+
+// HasInstallationRetryTimedOut returns whether the host didn't register again in time after its installation was
+// reset to be retried
+func (th *transitionHandler) HasInstallationRetryTimedOut(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("HasInstallationRetryTimedOut incompatible type of StateSwitch")
+	}
+	return time.Since(time.Time(sHost.host.StatusUpdatedAt)) > th.config.ResetTimeout, nil
+}
+
+func (th *transitionHandler) IsInstallationRetried(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return false, errors.New("IsInstallationRetried incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsRegisterHost)
+	if !ok {
+		return false, errors.New("IsInstallationRetried invalid argument")
+	}
+	if sHost.host.InstallationRetries == 0 {
+		return false, nil
+	}
+	cluster, err := common.GetClusterFromDB(params.db, sHost.host.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return false, err
+	}
+	return isRetryingInstallation(sHost.host, cluster), nil
+}
+
+// PostRetryInstallation resets the installation of the host, so it reboots into the discovery image and registers
+// again to be installed from the start
+func (th *transitionHandler) PostRetryInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostRetryInstallation incompatible type of StateSwitch")
+	}
+	var (
+		ctx    context.Context
+		db     *gorm.DB
+		policy *models.InstallRetryPolicy
+		reason string
+	)
+	switch params := args.(type) {
+	case *TransitionArgsRefreshHost:
+		ctx, db, policy = params.ctx, params.db, params.retryPolicy
+		reason = formatStageTimeout(statusInfoInstallationRetryStageTimedOut, sHost.host, params.stageTimeouts)
+	case *TransitionArgsHostInstallationFailed:
+		ctx, db, policy, reason = params.ctx, th.db, params.retryPolicy, params.reason
+	case *TransitionArgsResumeInstallation:
+		ctx, db, policy, reason = params.ctx, params.db, params.retryPolicy, sHost.host.PausedInstallationFailure
+	default:
+		return errors.New("PostRetryInstallation invalid argument")
+	}
+
+	retries := sHost.host.InstallationRetries + 1
+	statusInfo := fmt.Sprintf(statusInfoRetryingInstallation, retries, swag.Int64Value(policy.MaxRetries),
+		sHost.host.Progress.CurrentStage, reason)
+	return th.updateTransitionHost(ctx, logutil.FromContext(ctx, th.log), db, sHost, statusInfo,
+		"installation_retries", retries,
+		"paused_installation_failure", "",
+		"progress_current_stage", "",
+		"progress_progress_info", "",
+		"progress_stage_started_at", strfmt.DateTime(time.Time{}),
+		"progress_stage_updated_at", strfmt.DateTime(time.Time{}))
+}
+
+func (th *transitionHandler) PostRegisterRetriedInstallation(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	sHost, ok := sw.(*stateHost)
+	if !ok {
+		return errors.New("PostRegisterRetriedInstallation incompatible type of StateSwitch")
+	}
+	params, ok := args.(*TransitionArgsRegisterHost)
+	if !ok {
+		return errors.New("PostRegisterRetriedInstallation invalid argument")
+	}
+
+	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost,
+		statusInfoInstalling, "discovery_agent_version", params.discoveryAgentVersion)
+}
@@ -567,6 +567,7 @@ var _ = Describe("Cancel host installation", func() {
 		{state: models.HostStatusError, success: true, changeState: true},
 		{state: models.HostStatusDisabled, success: true, changeState: false},
 		{state: models.HostStatusInstallingPendingUserAction, success: true, changeState: true},
+		{state: models.HostStatusInstallingPaused, success: true, changeState: true},
 		{state: models.HostStatusDiscovering, success: false, statusCode: http.StatusConflict, changeState: false},
 		{state: models.HostStatusKnown, success: true, changeState: false},
 		{state: models.HostStatusPendingForInput, success: false, statusCode: http.StatusConflict, changeState: false},
@@ -623,6 +624,143 @@ var _ = Describe("Cancel host installation", func() {
 	})
 })
 
+var _ = Describe("Pause and resume host installation", func() {
+	var (
+		ctx               = context.Background()
+		dbName            string
+		hapi              API
+		db                *gorm.DB
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		ctrl              *gomock.Controller
+		mockEventsHandler *events.MockHandler
+		mockMetric        *metrics.MockAPI
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEventsHandler = events.NewMockHandler(ctrl)
+		mockEventsHandler.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockHwValidator := hardware.NewMockValidator(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		mockMetric.EXPECT().ReportHostInstallationMetrics(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil)
+		hapi = NewManager(common.GetTestLog(), db, mockEventsHandler, mockHwValidator, nil, createValidatorCfg(), mockMetric, defaultConfig, nil, operatorsManager)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	createHost := func(state string, progress *models.HostProgressInfo, statusUpdatedAt time.Time) {
+		host = hostutil.GenerateTestHost(hostId, clusterId, state)
+		host.Progress = progress
+		host.StatusUpdatedAt = strfmt.DateTime(statusUpdatedAt)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	}
+
+	for _, state := range []string{models.HostStatusInstalling, models.HostStatusInstallingInProgress} {
+		state := state
+		It(fmt.Sprintf("pauses a host in state %s", state), func() {
+			createHost(state, &models.HostProgressInfo{}, time.Now())
+			Expect(hapi.PauseInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInstallingPaused))
+			Expect(swag.StringValue(h.StatusInfo)).Should(Equal(statusInfoInstallingPaused))
+		})
+	}
+
+	for _, state := range []string{models.HostStatusInstalled, models.HostStatusError, models.HostStatusInstallingPendingUserAction} {
+		state := state
+		It(fmt.Sprintf("leaves a host in state %s as is", state), func() {
+			createHost(state, &models.HostProgressInfo{}, time.Now())
+			Expect(hapi.PauseInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+			Expect(hapi.ResumeInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(swag.StringValue(h.Status)).Should(Equal(state))
+		})
+	}
+
+	It("resumes a host that didn't start installing", func() {
+		createHost(models.HostStatusInstallingPaused, &models.HostProgressInfo{}, time.Now())
+		Expect(hapi.ResumeInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+		h := hostutil.GetHostFromDB(hostId, clusterId, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInstalling))
+		Expect(swag.StringValue(h.StatusInfo)).Should(Equal(statusInfoInstalling))
+	})
+
+	It("doesn't count the paused time towards the timeout of the stage", func() {
+		now := time.Now()
+		createHost(models.HostStatusInstallingPaused, &models.HostProgressInfo{
+			CurrentStage:   models.HostStageWritingImageToDisk,
+			StageStartedAt: strfmt.DateTime(now.Add(-30 * time.Minute)),
+			StageUpdatedAt: strfmt.DateTime(now.Add(-30 * time.Minute)),
+		}, now.Add(-10*time.Minute))
+		Expect(hapi.ResumeInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+		h := hostutil.GetHostFromDB(hostId, clusterId, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInstallingInProgress))
+		Expect(swag.StringValue(h.StatusInfo)).Should(Equal(string(models.HostStageWritingImageToDisk)))
+		Expect(time.Time(h.Progress.StageUpdatedAt)).Should(BeTemporally("~", now.Add(-20*time.Minute), time.Minute))
+	})
+
+	It("starts counting a stage that was reported while paused when resumed", func() {
+		now := time.Now()
+		createHost(models.HostStatusInstallingPaused, &models.HostProgressInfo{
+			CurrentStage:   models.HostStageRebooting,
+			StageStartedAt: strfmt.DateTime(now.Add(-5 * time.Minute)),
+			StageUpdatedAt: strfmt.DateTime(now.Add(-5 * time.Minute)),
+		}, now.Add(-10*time.Minute))
+		Expect(hapi.ResumeInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+		h := hostutil.GetHostFromDB(hostId, clusterId, db)
+		Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInstallingInProgress))
+		Expect(time.Time(h.Progress.StageUpdatedAt)).Should(BeTemporally("~", now, time.Minute))
+	})
+
+	Context("host that failed while paused", func() {
+		failWhilePaused := func(retryPolicy string) {
+			cluster := hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			cluster.InstallRetryPolicy = retryPolicy
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			createHost(models.HostStatusInstallingPaused, &models.HostProgressInfo{
+				CurrentStage: models.HostStageWritingImageToDisk,
+			}, time.Now())
+			Expect(hapi.UpdateInstallProgress(ctx, &host, &models.HostProgress{
+				CurrentStage: models.HostStageFailed,
+				ProgressInfo: "reason",
+			})).ShouldNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusInstallingPaused))
+			Expect(swag.StringValue(h.StatusInfo)).Should(Equal(fmt.Sprintf(statusInfoInstallingPausedFailed, "Failed - reason")))
+			Expect(h.PausedInstallationFailure).Should(Equal("Failed - reason"))
+			host = *h
+		}
+
+		It("retries the installation by the install retry policy of the cluster when resumed", func() {
+			failWhilePaused(`{"max_retries":1}`)
+			Expect(hapi.ResumeInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusResetting))
+			Expect(swag.StringValue(h.StatusInfo)).Should(Equal("Retrying the installation of the host (retry 1 of 1) " +
+				"after it failed in stage Writing image to disk: Failed - reason"))
+			Expect(h.InstallationRetries).Should(Equal(int64(1)))
+			Expect(h.PausedInstallationFailure).Should(BeEmpty())
+		})
+
+		It("moves to error when resumed if the failure can't be retried", func() {
+			failWhilePaused("")
+			Expect(hapi.ResumeInstallation(ctx, &host, db)).ShouldNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, clusterId, db)
+			Expect(swag.StringValue(h.Status)).Should(Equal(models.HostStatusError))
+			Expect(swag.StringValue(h.StatusInfo)).Should(Equal("Failed - reason"))
+			Expect(h.PausedInstallationFailure).Should(BeEmpty())
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+})
+
 var _ = Describe("Reset host", func() {
 	var (
 		ctx               = context.Background()
@@ -1668,6 +1806,103 @@ var _ = Describe("Refresh Host", func() {
 			Expect(swag.StringValue(resultHost.StatusInfo)).To(Equal(info))
 		})
 
+		It("uses the stage timeouts of the cluster", func() {
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			cluster.StageTimeouts = `{"default_timeout_seconds":900,"timeouts":[{"stage":"Rebooting","timeout_seconds":7200}]}`
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+
+			createInProgressHost := func(id strfmt.UUID, stage models.HostStage) *models.Host {
+				h := hostutil.GenerateTestHost(id, clusterId, models.HostStatusInstallingInProgress)
+				h.Inventory = hostutil.GenerateMasterInventory()
+				h.Role = models.HostRoleMaster
+				h.CheckedInAt = strfmt.DateTime(time.Now())
+				h.Progress = &models.HostProgressInfo{
+					CurrentStage:   stage,
+					StageStartedAt: strfmt.DateTime(time.Now().Add(-90 * time.Minute)),
+					StageUpdatedAt: strfmt.DateTime(time.Now().Add(-90 * time.Minute)),
+				}
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+				return &h
+			}
+			rebooting := createInProgressHost(strfmt.UUID(uuid.New().String()), models.HostStageRebooting)
+			configuring := createInProgressHost(strfmt.UUID(uuid.New().String()), models.HostStageConfiguring)
+
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, configuring.ID,
+				hostutil.GetEventSeverityFromHostStatus(models.HostStatusError), gomock.Any(), gomock.Any())
+			Expect(hapi.RefreshStatus(ctx, rebooting, db)).ToNot(HaveOccurred())
+			Expect(hapi.RefreshStatus(ctx, configuring, db)).ToNot(HaveOccurred())
+
+			var resultHost models.Host
+			Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", rebooting.ID.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusInstallingInProgress))
+			Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", configuring.ID.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusError))
+			Expect(swag.StringValue(resultHost.StatusInfo)).To(Equal(
+				"Host failed to install because its installation stage Configuring took longer than expected 15m0s"))
+		})
+
+		It("retries the installation of a host whose stage timed out by the install retry policy of the cluster", func() {
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			cluster.StageTimeouts = `{"timeouts":[{"stage":"Writing image to disk","timeout_seconds":900}]}`
+			cluster.InstallRetryPolicy = `{"max_retries":1}`
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+
+			createInProgressHost := func(retries int64) *models.Host {
+				h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), clusterId, models.HostStatusInstallingInProgress)
+				h.Inventory = hostutil.GenerateMasterInventory()
+				h.Role = models.HostRoleMaster
+				h.CheckedInAt = strfmt.DateTime(time.Now())
+				h.InstallationRetries = retries
+				h.Progress = &models.HostProgressInfo{
+					CurrentStage:   models.HostStageWritingImageToDisk,
+					StageStartedAt: strfmt.DateTime(time.Now().Add(-90 * time.Minute)),
+					StageUpdatedAt: strfmt.DateTime(time.Now().Add(-90 * time.Minute)),
+				}
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+				return &h
+			}
+			retried := createInProgressHost(0)
+			exhausted := createInProgressHost(1)
+
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, retried.ID, gomock.Any(), gomock.Any(), gomock.Any())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, exhausted.ID,
+				hostutil.GetEventSeverityFromHostStatus(models.HostStatusError), gomock.Any(), gomock.Any())
+			Expect(hapi.RefreshStatus(ctx, retried, db)).ToNot(HaveOccurred())
+			Expect(hapi.RefreshStatus(ctx, exhausted, db)).ToNot(HaveOccurred())
+
+			var resultHost models.Host
+			Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", retried.ID.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusResetting))
+			Expect(swag.StringValue(resultHost.StatusInfo)).To(Equal("Retrying the installation of the host (retry 1 of 1) " +
+				"after it failed in stage Writing image to disk: the stage took longer than expected 15m0s"))
+			Expect(resultHost.InstallationRetries).To(Equal(int64(1)))
+			Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", exhausted.ID.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusError))
+		})
+
+		It("fails the retried installation of a host that didn't register again in time", func() {
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			cluster.Status = swag.String(models.ClusterStatusInstalling)
+			cluster.InstallRetryPolicy = `{"max_retries":1}`
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusResetting)
+			host.Inventory = hostutil.GenerateMasterInventory()
+			host.Role = models.HostRoleMaster
+			host.InstallationRetries = 1
+			host.StatusUpdatedAt = strfmt.DateTime(time.Now().Add(-10 * time.Minute))
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+
+			mockEvents.EXPECT().AddEvent(gomock.Any(), clusterId, &hostId,
+				hostutil.GetEventSeverityFromHostStatus(models.HostStatusError), gomock.Any(), gomock.Any())
+			Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+
+			var resultHost models.Host
+			Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+			Expect(swag.StringValue(resultHost.Status)).To(Equal(models.HostStatusError))
+			Expect(swag.StringValue(resultHost.StatusInfo)).To(Equal(statusInfoInstallationRetryTimedOut))
+		})
+
 	})
 
 	Context("Validate host", func() {
@@ -3951,6 +4186,110 @@ var _ = Describe("Refresh Host", func() {
 			})
 		}
 	})
+	Context("validation policy of the cluster", func() {
+
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
+			Expect(err).NotTo(HaveOccurred())
+			host = hostutil.GenerateTestHost(hostId, clusterId, models.HostStatusDiscovering)
+			host.Inventory = hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.GibToBytes(16), "master")
+			host.Role = models.HostRoleMaster
+			host.NtpSources = string(defaultNTPSourcesInBytes)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().AddEvent(gomock.Any(), host.ClusterID,
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes()
+		})
+
+		tests := []struct {
+			name             string
+			validationPolicy string
+			dstState         string
+			expectedStatuses map[validationID]ValidationStatus
+		}{
+			{
+				name:             "Nominal: Host is known with validations disabled by the policy",
+				validationPolicy: `{"disabled_host_validations":["belongs-to-majority-group","container-images-available"]}`,
+				dstState:         models.HostStatusKnown,
+				expectedStatuses: map[validationID]ValidationStatus{
+					BelongsToMajorityGroup:                         ValidationDisabled,
+					SucessfullOrUnknownContainerImagesAvailability: ValidationDisabled,
+				},
+			},
+			{
+				name:             "Nominal: Host is known with failures downgraded to warnings by the policy",
+				validationPolicy: `{"warning_host_validations":["belongs-to-majority-group","container-images-available"]}`,
+				dstState:         models.HostStatusKnown,
+				expectedStatuses: map[validationID]ValidationStatus{
+					BelongsToMajorityGroup:                         ValidationWarning,
+					SucessfullOrUnknownContainerImagesAvailability: ValidationWarning,
+				},
+			},
+			{
+				name:             "KO: Host is insufficient when only some of the failing validations are overridden",
+				validationPolicy: `{"disabled_host_validations":["belongs-to-majority-group"]}`,
+				dstState:         models.HostStatusInsufficient,
+				expectedStatuses: map[validationID]ValidationStatus{
+					BelongsToMajorityGroup:                         ValidationDisabled,
+					SucessfullOrUnknownContainerImagesAvailability: ValidationFailure,
+				},
+			},
+			{
+				name:             "KO: Host is insufficient with an empty policy",
+				validationPolicy: "",
+				dstState:         models.HostStatusInsufficient,
+				expectedStatuses: map[validationID]ValidationStatus{
+					BelongsToMajorityGroup:                         ValidationFailure,
+					SucessfullOrUnknownContainerImagesAvailability: ValidationFailure,
+				},
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+				cluster.ValidationPolicy = t.validationPolicy
+				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+				defaultConfig.DisabledHostvalidations = DisabledHostValidations{}
+				hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+
+				err := hapi.RefreshStatus(ctx, &host, db)
+				Expect(err).ToNot(HaveOccurred())
+
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId, clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(resultHost.Status).To(Equal(&t.dstState))
+				validationRes := ValidationsStatus{}
+				Expect(json.Unmarshal([]byte(resultHost.ValidationsInfo), &validationRes)).ToNot(HaveOccurred())
+				for id, status := range t.expectedStatuses {
+					found := false
+					for _, cat := range validationRes {
+						for _, val := range cat {
+							if val.ID == id {
+								found = true
+								Expect(val.Status).To(Equal(status))
+								if status == ValidationDisabled {
+									Expect(val.Message).To(Equal(validationDisabledByPolicy))
+								}
+							}
+						}
+					}
+					Expect(found).To(BeTrue())
+				}
+			})
+		}
+
+		It("fails refreshing the host with an invalid policy", func() {
+			cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+			cluster.ValidationPolicy = "{"
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+
+			Expect(hapi.RefreshStatus(ctx, &host, db)).To(HaveOccurred())
+		})
+	})
 	Context("L3 network latency and packet loss validation", func() {
 
 		defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
@@ -4128,6 +4467,126 @@ var _ = Describe("Refresh Host", func() {
 		}
 	})
 
+	Context("Domain name resolution validations", func() {
+		const (
+			apiDomainName         = "api.test-cluster.example.com"
+			apiInternalDomainName = "api-int.test-cluster.example.com"
+			appsDomainName        = "console-openshift-console.apps.test-cluster.example.com"
+		)
+
+		defaultNTPSourcesInBytes, err := json.Marshal(defaultNTPSources)
+		Expect(err).ShouldNot(HaveOccurred())
+		BeforeEach(func() {
+			mockDefaultClusterHostRequirements(mockHwValidator)
+			hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, validatorCfg, nil, defaultConfig, nil, operatorsManager)
+		})
+
+		domainResolution := func(resolutions map[string][]strfmt.IPv4) string {
+			response := models.DomainResolutionResponse{}
+			for _, name := range []string{apiDomainName, apiInternalDomainName, appsDomainName} {
+				addresses, ok := resolutions[name]
+				if !ok {
+					continue
+				}
+				response.Resolutions = append(response.Resolutions, &models.DomainResolutionResponseDomain{
+					DomainName:    swag.String(name),
+					IPV4Addresses: addresses,
+				})
+			}
+			b, err := json.Marshal(&response)
+			Expect(err).ShouldNot(HaveOccurred())
+			return string(b)
+		}
+
+		tests := []struct {
+			name               string
+			domainResolution   string
+			dstState           string
+			validationsChecker *validationsChecker
+		}{
+			{
+				name: "all the domain names are resolved",
+				domainResolution: domainResolution(map[string][]strfmt.IPv4{
+					apiDomainName:         {"10.0.0.1"},
+					apiInternalDomainName: {"10.0.0.1"},
+					appsDomainName:        {"10.0.0.2"},
+				}),
+				dstState: models.HostStatusKnown,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsAPIDomainNameResolvedCorrectly:         {status: ValidationSuccess, messagePattern: "Domain name api.test-cluster.example.com resolves to 10.0.0.1"},
+					IsAPIInternalDomainNameResolvedCorrectly: {status: ValidationSuccess, messagePattern: "Domain name api-int.test-cluster.example.com resolves to 10.0.0.1"},
+					IsAppsDomainNameResolvedCorrectly:        {status: ValidationSuccess, messagePattern: "resolves to 10.0.0.2"},
+				}),
+			},
+			{
+				name: "a domain name is missing",
+				domainResolution: domainResolution(map[string][]strfmt.IPv4{
+					apiDomainName:         {"10.0.0.1"},
+					apiInternalDomainName: {},
+					appsDomainName:        {"10.0.0.2"},
+				}),
+				dstState: models.HostStatusInsufficient,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsAPIDomainNameResolvedCorrectly:         {status: ValidationSuccess, messagePattern: "resolves to 10.0.0.1"},
+					IsAPIInternalDomainNameResolvedCorrectly: {status: ValidationFailure, messagePattern: "Domain name api-int.test-cluster.example.com could not be resolved"},
+					IsAppsDomainNameResolvedCorrectly:        {status: ValidationSuccess, messagePattern: "resolves to 10.0.0.2"},
+				}),
+			},
+			{
+				name:             "the domain names were not resolved yet",
+				domainResolution: "",
+				dstState:         models.HostStatusKnown,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsAPIDomainNameResolvedCorrectly:         {status: ValidationSuccess, messagePattern: "Domain name api.test-cluster.example.com was not resolved yet"},
+					IsAPIInternalDomainNameResolvedCorrectly: {status: ValidationSuccess, messagePattern: "was not resolved yet"},
+					IsAppsDomainNameResolvedCorrectly:        {status: ValidationSuccess, messagePattern: "was not resolved yet"},
+				}),
+			},
+			{
+				name:             "the domain resolution can't be parsed",
+				domainResolution: "not json",
+				dstState:         models.HostStatusInsufficient,
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsAPIDomainNameResolvedCorrectly: {status: ValidationError, messagePattern: "Parse error for domain name resolutions"},
+				}),
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				cluster = hostutil.GenerateTestCluster(clusterId, "1.2.3.0/24")
+				cluster.UserManagedNetworking = swag.Bool(true)
+				cluster.Name = "test-cluster"
+				cluster.BaseDNSDomain = "example.com"
+				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+				hosts := []*models.Host{}
+				for n, address := range hostutil.GenerateIPv4Addresses(3, "1.2.3.1/24") {
+					netAddr := common.NetAddress{Hostname: fmt.Sprintf("master-%d", n), IPv4Address: []string{address}}
+					h := hostutil.GenerateTestHostWithNetworkAddress(strfmt.UUID(uuid.New().String()), clusterId, models.HostRoleMaster,
+						models.HostStatusDiscovering, netAddr)
+					h.NtpSources = string(defaultNTPSourcesInBytes)
+					hosts = append(hosts, h)
+				}
+				hosts[0].DomainResolution = t.domainResolution
+				for n, h := range hosts {
+					rep := hostutil.GenerateL3ConnectivityReport(append(append([]*models.Host{}, hosts[:n]...), hosts[n+1:]...), 50, 0)
+					b, err := json.Marshal(&rep)
+					Expect(err).NotTo(HaveOccurred())
+					h.Connectivity = string(b)
+					Expect(db.Create(h).Error).ShouldNot(HaveOccurred())
+				}
+				mockEvents.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+				Expect(hapi.RefreshStatus(ctx, hosts[0], db)).NotTo(HaveOccurred())
+
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hosts[0].ID, clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(resultHost.Status).To(Equal(&t.dstState))
+				t.validationsChecker.check(resultHost.ValidationsInfo)
+			})
+		}
+	})
+
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
 		ctrl.Finish()
@@ -4179,7 +4638,7 @@ func mockPreflightHardwareRequirements(mockHwValidator *hardware.MockValidator,
 }
 
 func formatProgressTimedOutInfo(stage models.HostStage) string {
-	timeFormat := InstallationProgressTimeout[stage].String()
+	timeFormat := StageTimeouts(InstallationProgressTimeout).Timeout(stage).String()
 	statusInfo := statusInfoInstallationInProgressTimedOut
 	if stage == models.HostStageWritingImageToDisk {
 		statusInfo = statusInfoInstallationInProgressWritingImageToDiskTimedOut
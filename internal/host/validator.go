@@ -33,6 +33,7 @@ const (
 	ValidationPending  ValidationStatus = "pending"
 	ValidationError    ValidationStatus = "error"
 	ValidationDisabled ValidationStatus = "disabled"
+	ValidationWarning  ValidationStatus = "warning"
 )
 
 var (
@@ -795,3 +796,85 @@ func (v *validator) printSufficientPacketLossRequirementForRole(c *validationCon
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+// domainNameResolution checks the way the host resolved one of the domain names of the cluster. With
+// user managed networking the DNS records are provided by the user, and a name that is missing is a
+// failure. Names that were not resolved yet are not failed, so that the host is not held back until
+// the agent reports them. A single node cluster must also resolve them to the address of its node
+func (v *validator) domainNameResolution(c *validationContext, domainName func(*network.ClusterDomainNames) string) (ValidationStatus, string) {
+	if !swag.BoolValue(c.cluster.UserManagedNetworking) {
+		return ValidationSuccess, "Domain name resolution is not required (not User Managed Networking)"
+	}
+	domainNames := network.GetClusterDomainNames(c.cluster)
+	if domainNames == nil {
+		return ValidationSuccess, "Domain name resolution is not checked, the cluster name or base domain are not set"
+	}
+	name := domainName(domainNames)
+	resolution, err := network.GetDomainResolution(c.host, name)
+	if err != nil {
+		v.log.WithError(err).Warn("Parse domain resolution")
+		return ValidationError, "Parse error for domain name resolutions"
+	}
+	if resolution == nil {
+		return ValidationSuccess, fmt.Sprintf("Domain name %s was not resolved yet", name)
+	}
+	addresses := network.ResolvedAddresses(resolution)
+	if len(addresses) == 0 {
+		return ValidationFailure, fmt.Sprintf("Domain name %s could not be resolved, please add a DNS record for it", name)
+	}
+	if common.IsSingleNodeCluster(c.cluster) && c.inventory != nil {
+		hostAddresses := inventoryAddresses(c.inventory)
+		if len(funk.IntersectString(addresses, hostAddresses)) == 0 {
+			return ValidationFailure, fmt.Sprintf("Domain name %s resolves to %s instead of an address of the host (%s)",
+				name, strings.Join(addresses, ", "), strings.Join(hostAddresses, ", "))
+		}
+	}
+	return ValidationSuccess, fmt.Sprintf("Domain name %s resolves to %s", name, strings.Join(addresses, ", "))
+}
+
+// inventoryAddresses returns the addresses of the interfaces of the host, without their prefix length
+func inventoryAddresses(inventory *models.Inventory) []string {
+	var ret []string
+	for _, intf := range inventory.Interfaces {
+		for _, cidr := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+			if ip, _, err := net.ParseCIDR(cidr); err == nil {
+				ret = append(ret, ip.String())
+			}
+		}
+	}
+	return ret
+}
+
+func apiDomainName(n *network.ClusterDomainNames) string         { return n.API }
+func apiInternalDomainName(n *network.ClusterDomainNames) string { return n.APIInternal }
+func appsDomainName(n *network.ClusterDomainNames) string        { return n.Apps }
+
+func (v *validator) isAPIDomainNameResolvedCorrectly(c *validationContext) ValidationStatus {
+	status, _ := v.domainNameResolution(c, apiDomainName)
+	return status
+}
+
+func (v *validator) printAPIDomainNameResolvedCorrectly(c *validationContext, status ValidationStatus) string {
+	_, message := v.domainNameResolution(c, apiDomainName)
+	return message
+}
+
+func (v *validator) isAPIInternalDomainNameResolvedCorrectly(c *validationContext) ValidationStatus {
+	status, _ := v.domainNameResolution(c, apiInternalDomainName)
+	return status
+}
+
+func (v *validator) printAPIInternalDomainNameResolvedCorrectly(c *validationContext, status ValidationStatus) string {
+	_, message := v.domainNameResolution(c, apiInternalDomainName)
+	return message
+}
+
+func (v *validator) isAppsDomainNameResolvedCorrectly(c *validationContext) ValidationStatus {
+	status, _ := v.domainNameResolution(c, appsDomainName)
+	return status
+}
+
+func (v *validator) printAppsDomainNameResolvedCorrectly(c *validationContext, status ValidationStatus) string {
+	_, message := v.domainNameResolution(c, appsDomainName)
+	return message
+}
@@ -6,9 +6,13 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/discoveryimage"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/leader"
+	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
@@ -24,6 +28,7 @@ var (
 )
 
 type Manager struct {
+	db            *gorm.DB
 	objectHandler s3wrapper.API
 	eventsHandler events.Handler
 	deleteTime    time.Duration
@@ -31,8 +36,9 @@ type Manager struct {
 	enableKubeAPI bool
 }
 
-func NewManager(objectHandler s3wrapper.API, eventsHandler events.Handler, deleteTime time.Duration, leaderElector leader.ElectorInterface, enableKubeAPI bool) *Manager {
+func NewManager(db *gorm.DB, objectHandler s3wrapper.API, eventsHandler events.Handler, deleteTime time.Duration, leaderElector leader.ElectorInterface, enableKubeAPI bool) *Manager {
 	return &Manager{
+		db:            db,
 		objectHandler: objectHandler,
 		eventsHandler: eventsHandler,
 		deleteTime:    deleteTime,
@@ -48,6 +54,7 @@ func (m *Manager) ExpirationTask() {
 	ctx := requestid.ToContext(context.Background(), requestid.NewID())
 	if !m.enableKubeAPI {
 		m.objectHandler.ExpireObjects(ctx, imagePrefix, m.deleteTime, m.DeletedImageCallback)
+		m.expireSharedImages(ctx)
 	}
 	m.objectHandler.ExpireObjects(ctx, AssistedServiceLiveISOPrefix, m.deleteTime, m.DeletedImageNoCallback)
 }
@@ -65,3 +72,38 @@ func (m *Manager) DeletedImageCallback(ctx context.Context, log logrus.FieldLogg
 
 func (m *Manager) DeletedImageNoCallback(ctx context.Context, log logrus.FieldLogger, objectName string) {
 }
+
+// expireSharedImages deletes the shared discovery images that no cluster points at anymore. Unlike the images that
+// are named after their cluster, the expiration of shared images is tracked by the clusters that point at them.
+func (m *Manager) expireSharedImages(ctx context.Context) {
+	log := logutil.FromContext(ctx, logrus.StandardLogger())
+	objectNames, err := m.objectHandler.ListObjectsByPrefix(ctx, s3wrapper.SharedDiscoveryImagePrefix)
+	if err != nil {
+		log.WithError(err).Error("Error listing shared images")
+		return
+	}
+	for _, objectName := range objectNames {
+		deleted, err := discoveryimage.DeleteIfUnreferenced(ctx, m.db, m.objectHandler, objectName, nil)
+		if err != nil {
+			log.WithError(err).Errorf("Error deleting expired shared image %s", objectName)
+			continue
+		}
+		if !deleted {
+			continue
+		}
+		log.Infof("Deleted expired shared image %s", objectName)
+		m.DeletedSharedImageCallback(ctx, log, objectName)
+	}
+}
+
+func (m *Manager) DeletedSharedImageCallback(ctx context.Context, log logrus.FieldLogger, objectName string) {
+	var clusters []*common.Cluster
+	if err := m.db.Select("id").Where("image_object_name = ?", objectName).Find(&clusters).Error; err != nil {
+		log.WithError(err).Errorf("Error finding the clusters of shared image %s", objectName)
+		return
+	}
+	for _, c := range clusters {
+		m.eventsHandler.AddEvent(ctx, *c.ID, nil, models.EventSeverityInfo,
+			"Deleted image from backend because it expired. It may be generated again at any time.", time.Now())
+	}
+}
@@ -9,8 +9,12 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/discoveryimage"
 	"github.com/openshift/assisted-service/internal/events"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/leader"
@@ -20,6 +24,8 @@ import (
 
 func TestJob(t *testing.T) {
 	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
 	RunSpecs(t, "imgexpirer")
 }
 
@@ -39,7 +45,7 @@ var _ = Describe("imgexpirer", func() {
 		mockEvents = events.NewMockHandler(ctrl)
 		deleteTime, _ := time.ParseDuration("60m")
 		leaderMock = leader.NewMockElectorInterface(ctrl)
-		imgExp = NewManager(nil, mockEvents, deleteTime, leaderMock, false)
+		imgExp = NewManager(nil, nil, mockEvents, deleteTime, leaderMock, false)
 	})
 	It("callback_valid_objname", func() {
 		clusterId := "53116787-3eb0-4211-93ac-611d5cedaa30"
@@ -55,3 +61,84 @@ var _ = Describe("imgexpirer", func() {
 		ctrl.Finish()
 	})
 })
+
+var _ = Describe("shared images", func() {
+	var (
+		imgExp       *Manager
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		db           *gorm.DB
+		dbName       string
+		mockEvents   *events.MockHandler
+		mockS3Client *s3wrapper.MockAPI
+		log          = logrus.New()
+		imgName      = discoveryimage.Content{BaseISOObject: "rhcos"}.ObjectName()
+		expiredMsg   = "Deleted image from backend because it expired. It may be generated again at any time."
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = events.NewMockHandler(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		imgExp = NewManager(db, mockS3Client, mockEvents, time.Hour, leader.NewMockElectorInterface(ctrl), false)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	// addCluster adds a cluster that points at the given image, which expires at the given time
+	addCluster := func(objectName string, expiresAt time.Time) strfmt.UUID {
+		clusterID := strfmt.UUID(uuid.New().String())
+		c := common.Cluster{
+			Cluster:         models.Cluster{ID: &clusterID, ImageInfo: &models.ImageInfo{ExpiresAt: strfmt.DateTime(expiresAt)}},
+			ImageGenerated:  true,
+			ImageObjectName: objectName,
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		return clusterID
+	}
+
+	It("deletes an image whose clusters expired and notifies them", func() {
+		first := addCluster(imgName, time.Now().Add(-time.Minute))
+		second := addCluster(imgName, time.Now().Add(-time.Hour))
+		addCluster(discoveryimage.Content{BaseISOObject: "rhcos-minimal"}.ObjectName(), time.Now().Add(-time.Minute))
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, s3wrapper.SharedDiscoveryImagePrefix).Return([]string{imgName}, nil)
+		mockS3Client.EXPECT().DeleteObject(ctx, imgName).Return(true, nil)
+		mockEvents.EXPECT().AddEvent(ctx, first, nil, models.EventSeverityInfo, expiredMsg, gomock.Any())
+		mockEvents.EXPECT().AddEvent(ctx, second, nil, models.EventSeverityInfo, expiredMsg, gomock.Any())
+		imgExp.expireSharedImages(ctx)
+	})
+
+	It("keeps an image that a cluster still points at", func() {
+		addCluster(imgName, time.Now().Add(-time.Minute))
+		addCluster(imgName, time.Now().Add(time.Hour))
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, s3wrapper.SharedDiscoveryImagePrefix).Return([]string{imgName}, nil)
+		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Times(0)
+		imgExp.expireSharedImages(ctx)
+	})
+
+	It("doesn't notify the clusters of an image that was already deleted", func() {
+		addCluster(imgName, time.Now().Add(-time.Minute))
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, s3wrapper.SharedDiscoveryImagePrefix).Return([]string{imgName}, nil)
+		mockS3Client.EXPECT().DeleteObject(ctx, imgName).Return(false, nil)
+		imgExp.expireSharedImages(ctx)
+	})
+
+	It("deletes nothing when the images can't be listed", func() {
+		addCluster(imgName, time.Now().Add(-time.Minute))
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, s3wrapper.SharedDiscoveryImagePrefix).Return(nil, fmt.Errorf("list failed"))
+		mockS3Client.EXPECT().DeleteObject(gomock.Any(), gomock.Any()).Times(0)
+		imgExp.expireSharedImages(ctx)
+	})
+
+	It("notifies only the clusters of the deleted image", func() {
+		clusterID := addCluster(imgName, time.Now().Add(-time.Minute))
+		addCluster(discoveryimage.Content{BaseISOObject: "rhcos-minimal"}.ObjectName(), time.Now().Add(-time.Minute))
+		mockEvents.EXPECT().AddEvent(ctx, clusterID, nil, models.EventSeverityInfo, expiredMsg, gomock.Any()).Times(1)
+		imgExp.DeletedSharedImageCallback(ctx, log, imgName)
+	})
+})
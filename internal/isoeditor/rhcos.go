@@ -120,7 +120,7 @@ func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, staticNetworkConf
 		return "", errors.Wrap(err, "failed to add ignition archive")
 	}
 
-	if staticNetworkConfig != "" || clusterProxyInfo.HTTPProxy != "" || clusterProxyInfo.HTTPSProxy != "" {
+	if NeedsCustomRAMDisk(staticNetworkConfig, clusterProxyInfo) {
 		if err := e.addCustomRAMDisk(clusterISOPath, staticNetworkConfig, clusterProxyInfo, ramDiskOffsetInfo); err != nil {
 			return "", errors.Wrap(err, "failed to add additional ramdisk")
 		}
@@ -201,56 +201,69 @@ func (e *rhcosEditor) addIgnitionArchive(clusterISOPath, ignition string, igniti
 }
 
 func (e *rhcosEditor) addCustomRAMDisk(clusterISOPath, staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo, ramdiskOffsetInfo *OffsetInfo) error {
+	compressedArchive, err := CustomRAMDiskArchive(e.staticNetworkConfig, staticNetworkConfig, clusterProxyInfo)
+	if err != nil {
+		return err
+	}
+
+	// Ensures RAM placeholder is large enough to accommodate the compressed archive
+	if uint64(len(compressedArchive)) > ramdiskOffsetInfo.Length {
+		return errors.Errorf("Custom RAM disk is larger than the placeholder in ISO (%d bytes > %d bytes)",
+			len(compressedArchive), RamDiskPaddingLength)
+	}
+
+	return writeAt(compressedArchive, int64(ramdiskOffsetInfo.Offset), clusterISOPath)
+}
+
+// NeedsCustomRAMDisk returns whether the minimal ISO of a cluster needs a custom RAM disk, which holds its static
+// network config and proxy settings
+func NeedsCustomRAMDisk(staticNetworkConfig string, clusterProxyInfo *ClusterProxyInfo) bool {
+	return staticNetworkConfig != "" || clusterProxyInfo.HTTPProxy != "" || clusterProxyInfo.HTTPSProxy != ""
+}
+
+// CustomRAMDiskArchive takes the static network config and proxy settings of a cluster and returns the gzipped
+// CPIO archive (in bytes) of the custom RAM disk that applies them, or err on failure.
+func CustomRAMDiskArchive(staticNetworkConfigGenerator staticnetworkconfig.StaticNetworkConfig, staticNetworkConfig string,
+	clusterProxyInfo *ClusterProxyInfo) ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	w := cpio.NewWriter(buffer)
 	if staticNetworkConfig != "" {
-		filesList, newErr := e.staticNetworkConfig.GenerateStaticNetworkConfigData(staticNetworkConfig)
+		filesList, newErr := staticNetworkConfigGenerator.GenerateStaticNetworkConfigData(staticNetworkConfig)
 		if newErr != nil {
-			return newErr
+			return nil, newErr
 		}
 		for _, file := range filesList {
 			err := addFileToArchive(w, filepath.Join("/etc/assisted/network", file.FilePath), file.FileContents, 0o600)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 		scriptPath := "/usr/lib/dracut/hooks/initqueue/settled/90-assisted-pre-static-network-config.sh"
 		scriptContent := constants.PreNetworkConfigScript
 
 		if err := addFileToArchive(w, scriptPath, scriptContent, 0o755); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if clusterProxyInfo.HTTPProxy != "" || clusterProxyInfo.HTTPSProxy != "" {
 		rootfsServiceConfigPath := "/etc/systemd/system/coreos-livepxe-rootfs.service.d/10-proxy.conf"
-		rootfsServiceConfig, err := e.formatRootfsServiceConfigFile(clusterProxyInfo)
+		rootfsServiceConfig, err := formatRootfsServiceConfigFile(clusterProxyInfo)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := addFileToArchive(w, rootfsServiceConfigPath, rootfsServiceConfig, 0o664); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if err := w.Close(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Compress custom RAM disk
-	compressedArchive, err := getCompressedArchive(buffer)
-	if err != nil {
-		return err
-	}
-
-	// Ensures RAM placeholder is large enough to accommodate the compressed archive
-	if uint64(len(compressedArchive)) > ramdiskOffsetInfo.Length {
-		return errors.Errorf("Custom RAM disk is larger than the placeholder in ISO (%d bytes > %d bytes)",
-			len(compressedArchive), RamDiskPaddingLength)
-	}
-
-	return writeAt(compressedArchive, int64(ramdiskOffsetInfo.Offset), clusterISOPath)
+	return getCompressedArchive(buffer)
 }
 
-func (e *rhcosEditor) formatRootfsServiceConfigFile(clusterProxyInfo *ClusterProxyInfo) (string, error) {
+func formatRootfsServiceConfigFile(clusterProxyInfo *ClusterProxyInfo) (string, error) {
 	var rootfsServicConfigParams = map[string]string{
 		"HTTP_PROXY":  clusterProxyInfo.HTTPProxy,
 		"HTTPS_PROXY": clusterProxyInfo.HTTPSProxy,
@@ -0,0 +1,187 @@
+package isoeditor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// area is a part of the ISO whose contents are replaced while it is streamed
+type area struct {
+	offset   int64
+	contents []byte
+}
+
+// SystemAreaSize is the size of the system area at the beginning of an ISO, which holds the offsets of the embedded
+// areas
+const SystemAreaSize = headerLength
+
+// ClusterISO is the discovery ISO of a cluster, which differs from the base ISO only in the contents of its embedded
+// areas
+type ClusterISO struct {
+	areas []area
+}
+
+// clusterISOReader streams a base ISO while replacing the contents of its embedded areas
+type clusterISOReader struct {
+	base   io.Closer
+	reader io.Reader
+	offset int64
+	// end is the offset where the reader ends, or -1 when it reads the base ISO until its end
+	end   int64
+	areas []area
+}
+
+// NewClusterISOReader returns a reader of the discovery ISO of a cluster, which is created on the fly out of a
+// reader of the base ISO without writing a copy of it. The ignition config and, in minimal ISOs, the custom RAM disk
+// are embedded in the areas whose offsets are written in the system area of the base ISO, so the returned ISO has
+// the same size as the base ISO. Closing the returned reader closes the reader of the base ISO.
+func NewClusterISOReader(baseISO io.ReadCloser, ignitionConfig string, ramDisk []byte) (io.ReadCloser, error) {
+	systemArea := make([]byte, SystemAreaSize)
+	if _, err := io.ReadFull(baseISO, systemArea); err != nil {
+		return nil, errors.Wrap(err, "Failed to read the system area of the base ISO")
+	}
+	iso, err := NewClusterISO(systemArea, ignitionConfig, ramDisk)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterISOReader{
+		base:   baseISO,
+		reader: io.MultiReader(bytes.NewReader(systemArea), baseISO),
+		end:    -1,
+		areas:  iso.areas,
+	}, nil
+}
+
+// NewClusterISO returns the discovery ISO of a cluster that embeds the ignition config and, in minimal ISOs, the
+// custom RAM disk in a base ISO with the given system area
+func NewClusterISO(systemArea []byte, ignitionConfig string, ramDisk []byte) (*ClusterISO, error) {
+	if int64(len(systemArea)) != SystemAreaSize {
+		return nil, errors.Errorf("The system area of the base ISO must be %d bytes long", SystemAreaSize)
+	}
+	ignitionOffsetInfo, err := GetIgnitionArea(systemArea[headerLength-ignitionHeaderSize:])
+	if err != nil {
+		return nil, err
+	}
+	ignitionArchive, err := IgnitionImageArchive(ignitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	ignitionArea, err := newArea(ignitionOffsetInfo, ignitionArchive)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to embed the ignition config")
+	}
+	areas := []area{*ignitionArea}
+
+	if len(ramDisk) > 0 {
+		ramDiskOffsetInfo, err := GetRamDiskArea(systemArea[headerLength-2*ignitionHeaderSize : headerLength-ignitionHeaderSize])
+		if err != nil {
+			return nil, errors.Wrap(err, "The base ISO has no area for a custom RAM disk")
+		}
+		ramDiskArea, err := newArea(ramDiskOffsetInfo, ramDisk)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to embed the custom RAM disk")
+		}
+		areas = append(areas, *ramDiskArea)
+	}
+
+	sort.Slice(areas, func(i, j int) bool { return areas[i].offset < areas[j].offset })
+	for i := 1; i < len(areas); i++ {
+		if areas[i-1].offset+int64(len(areas[i-1].contents)) > areas[i].offset {
+			return nil, errors.New("The embedded areas of the base ISO overlap")
+		}
+	}
+	return &ClusterISO{areas: areas}, nil
+}
+
+// Reader returns a reader of the given number of bytes of the cluster ISO, starting at the given offset, out of a
+// reader of the same bytes of the base ISO. Closing the returned reader closes the reader of the base ISO.
+func (c *ClusterISO) Reader(baseISO io.ReadCloser, offset, length int64) io.ReadCloser {
+	return &clusterISOReader{
+		base:   baseISO,
+		reader: io.LimitReader(baseISO, length),
+		offset: offset,
+		end:    offset + length,
+		areas:  c.areas,
+	}
+}
+
+// ETag returns an entity tag of the cluster ISO, which changes whenever the base ISO or the embedded contents change
+func (c *ClusterISO) ETag(baseISOETag string) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%d:%s", len(baseISOETag), baseISOETag)
+	for _, a := range c.areas {
+		fmt.Fprintf(hash, "%d:%d:", a.offset, len(a.contents))
+		hash.Write(a.contents)
+	}
+	return fmt.Sprintf("\"%s\"", hex.EncodeToString(hash.Sum(nil)))
+}
+
+// newArea returns the area that replaces the embedded area with the given contents, padded with zeros to clear any
+// previous contents
+func newArea(offsetInfo *OffsetInfo, contents []byte) (*area, error) {
+	if uint64(len(contents)) > offsetInfo.Length {
+		return nil, errors.Errorf("Embedded contents are larger than the area in the ISO (%d bytes > %d bytes)",
+			len(contents), offsetInfo.Length)
+	}
+	if offsetInfo.Offset < uint64(headerLength) {
+		return nil, errors.Errorf("Embedded area at offset %d overlaps the system area of the ISO", offsetInfo.Offset)
+	}
+	padded := make([]byte, offsetInfo.Length)
+	copy(padded, contents)
+	return &area{offset: int64(offsetInfo.Offset), contents: padded}, nil
+}
+
+func (r *clusterISOReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	start, end := r.offset, r.offset+int64(n)
+	for _, a := range r.areas {
+		areaEnd := a.offset + int64(len(a.contents))
+		if areaEnd <= start || a.offset >= end {
+			continue
+		}
+		from := max64(start, a.offset)
+		to := min64(end, areaEnd)
+		copy(p[from-start:to-start], a.contents[from-a.offset:to-a.offset])
+	}
+	r.offset = end
+
+	if err == io.EOF {
+		if r.end >= 0 {
+			if r.offset < r.end {
+				return n, io.ErrUnexpectedEOF
+			}
+			return n, err
+		}
+		// A base ISO that ends before its embedded areas would produce a corrupted ISO
+		for _, a := range r.areas {
+			if a.offset+int64(len(a.contents)) > r.offset {
+				return n, io.ErrUnexpectedEOF
+			}
+		}
+	}
+	return n, err
+}
+
+func (r *clusterISOReader) Close() error {
+	return r.base.Close()
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,192 @@
+package isoeditor
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing/iotest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewClusterISOReader", func() {
+	const (
+		ignitionConfig  = `{"ignition":{"version":"3.1.0"}}`
+		ignitionOffset  = uint64(40960)
+		ramDiskOffset   = uint64(65536)
+		baseISOSize     = 131072
+		baseISOFileMode = 0o600
+	)
+
+	var (
+		baseISOPath      string
+		clusterProxyInfo = &ClusterProxyInfo{HTTPProxy: "http://proxy.example.com"}
+	)
+
+	writeBaseISO := func(withRAMDisk bool) {
+		f, err := ioutil.TempFile("", "base-iso")
+		Expect(err).ToNot(HaveOccurred())
+		baseISOPath = f.Name()
+		Expect(f.Truncate(baseISOSize)).To(Succeed())
+		// Fill the parts outside of the embedded areas, so that changes there are noticed
+		_, err = f.WriteAt(bytes.Repeat([]byte{0xff}, int(ignitionOffset-uint64(headerLength))), headerLength)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		var ignitionOffsetInfo, ramDiskOffsetInfo OffsetInfo
+		copy(ignitionOffsetInfo.Key[:], ignitionHeaderKey)
+		ignitionOffsetInfo.Offset = ignitionOffset
+		ignitionOffsetInfo.Length = IgnitionPaddingLength / 16
+		if withRAMDisk {
+			copy(ramDiskOffsetInfo.Key[:], ramdiskHeaderKey)
+			ramDiskOffsetInfo.Offset = ramDiskOffset
+			ramDiskOffsetInfo.Length = RamDiskPaddingLength / 32
+		}
+		Expect(writeHeader(&ignitionOffsetInfo, &ramDiskOffsetInfo, baseISOPath)).To(Succeed())
+	}
+
+	openBaseISO := func() io.ReadCloser {
+		f, err := os.Open(baseISOPath)
+		Expect(err).ToNot(HaveOccurred())
+		return f
+	}
+
+	// editedISO returns the ISO that the editor writes when it creates the minimal ISO of the cluster
+	editedISO := func(ramDisk bool) []byte {
+		contents, err := ioutil.ReadFile(baseISOPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(baseISOPath+".edited", contents, baseISOFileMode)).To(Succeed())
+		defer os.Remove(baseISOPath + ".edited")
+
+		editor := &rhcosEditor{log: getTestLog()}
+		ignitionOffsetInfo, err := GetIgnitionArea(contents[headerLength-ignitionHeaderSize : headerLength])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(editor.addIgnitionArchive(baseISOPath+".edited", ignitionConfig, ignitionOffsetInfo.Offset)).To(Succeed())
+		if ramDisk {
+			ramDiskOffsetInfo, err := GetRamDiskArea(contents[headerLength-2*ignitionHeaderSize : headerLength-ignitionHeaderSize])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(editor.addCustomRAMDisk(baseISOPath+".edited", "", clusterProxyInfo, ramDiskOffsetInfo)).To(Succeed())
+		}
+		edited, err := ioutil.ReadFile(baseISOPath + ".edited")
+		Expect(err).ToNot(HaveOccurred())
+		return edited
+	}
+
+	AfterEach(func() {
+		os.Remove(baseISOPath)
+	})
+
+	It("streams the same ISO that the editor writes", func() {
+		writeBaseISO(true)
+		ramDisk, err := CustomRAMDiskArchive(nil, "", clusterProxyInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		reader, err := NewClusterISOReader(openBaseISO(), ignitionConfig, ramDisk)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		streamed, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(streamed).To(HaveLen(baseISOSize))
+		Expect(streamed).To(Equal(editedISO(true)))
+	})
+
+	It("streams the same ISO when read in small chunks", func() {
+		writeBaseISO(true)
+		ramDisk, err := CustomRAMDiskArchive(nil, "", clusterProxyInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		reader, err := NewClusterISOReader(openBaseISO(), ignitionConfig, ramDisk)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		streamed, err := ioutil.ReadAll(iotest.OneByteReader(reader))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(streamed).To(Equal(editedISO(true)))
+	})
+
+	It("embeds only the ignition config without a custom RAM disk", func() {
+		writeBaseISO(false)
+		reader, err := NewClusterISOReader(openBaseISO(), ignitionConfig, nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		streamed, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(streamed).To(Equal(editedISO(false)))
+	})
+
+	It("streams ranges of the ISO out of ranges of the base ISO", func() {
+		writeBaseISO(true)
+		ramDisk, err := CustomRAMDiskArchive(nil, "", clusterProxyInfo)
+		Expect(err).ToNot(HaveOccurred())
+		base, err := ioutil.ReadFile(baseISOPath)
+		Expect(err).ToNot(HaveOccurred())
+		edited := editedISO(true)
+
+		iso, err := NewClusterISO(base[:SystemAreaSize], ignitionConfig, ramDisk)
+		Expect(err).ToNot(HaveOccurred())
+		for _, r := range [][2]int64{{0, 100}, {int64(ignitionOffset) - 10, 20}, {int64(ignitionOffset) + 5, 1000}, {int64(ramDiskOffset) - 1, 2}, {baseISOSize - 7, 7}} {
+			offset, length := r[0], r[1]
+			reader := iso.Reader(ioutil.NopCloser(bytes.NewReader(base[offset:offset+length])), offset, length)
+			streamed, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(streamed).To(Equal(edited[offset:offset+length]), "range at offset %d", offset)
+		}
+	})
+
+	It("fails when a range of the base ISO ends early", func() {
+		writeBaseISO(false)
+		base, err := ioutil.ReadFile(baseISOPath)
+		Expect(err).ToNot(HaveOccurred())
+		iso, err := NewClusterISO(base[:SystemAreaSize], ignitionConfig, nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = ioutil.ReadAll(iso.Reader(ioutil.NopCloser(bytes.NewReader(base[100:150])), 100, 100))
+		Expect(err).To(Equal(io.ErrUnexpectedEOF))
+	})
+
+	It("changes the entity tag with the embedded contents", func() {
+		writeBaseISO(false)
+		base, err := ioutil.ReadFile(baseISOPath)
+		Expect(err).ToNot(HaveOccurred())
+		iso, err := NewClusterISO(base[:SystemAreaSize], ignitionConfig, nil)
+		Expect(err).ToNot(HaveOccurred())
+		otherISO, err := NewClusterISO(base[:SystemAreaSize], `{"ignition":{"version":"3.2.0"}}`, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(iso.ETag(`"base"`)).To(Equal(iso.ETag(`"base"`)))
+		Expect(iso.ETag(`"base"`)).ToNot(Equal(iso.ETag(`"other-base"`)))
+		Expect(iso.ETag(`"base"`)).ToNot(Equal(otherISO.ETag(`"base"`)))
+	})
+
+	It("fails when the base ISO has no area for the custom RAM disk", func() {
+		writeBaseISO(false)
+		_, err := NewClusterISOReader(openBaseISO(), ignitionConfig, []byte("ramdisk"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the ignition config is too large", func() {
+		writeBaseISO(false)
+		randomBytes := make([]byte, IgnitionPaddingLength)
+		_, err := rand.Read(randomBytes)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = NewClusterISOReader(openBaseISO(), base64.StdEncoding.EncodeToString(randomBytes), nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the base ISO is not a live ISO", func() {
+		_, err := NewClusterISOReader(ioutil.NopCloser(bytes.NewReader(make([]byte, baseISOSize))), ignitionConfig, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the base ISO ends before its embedded areas", func() {
+		writeBaseISO(false)
+		contents, err := ioutil.ReadFile(baseISOPath)
+		Expect(err).ToNot(HaveOccurred())
+		reader, err := NewClusterISOReader(ioutil.NopCloser(bytes.NewReader(contents[:ignitionOffset+10])), ignitionConfig, nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = ioutil.ReadAll(reader)
+		Expect(err).To(Equal(io.ErrUnexpectedEOF))
+	})
+})
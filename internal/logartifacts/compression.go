@@ -0,0 +1,116 @@
+package logartifacts
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	gzipExtension = ".tar.gz"
+	zstdExtension = ".tar.zst"
+)
+
+// ArchiveObjectNames returns the names that the log archive of the host, or of the controller when the log ID is the
+// controller logs type, may be stored as, in the order they should be looked up. Uploaded archives are stored
+// gzipped, and replaced by a zstd archive when they are recompressed.
+func ArchiveObjectNames(clusterID, logID string) []string {
+	archive := logsearch.ArchiveObjectName(clusterID, logID)
+	return []string{archive, RecompressedObjectName(archive)}
+}
+
+// RecompressedObjectName returns the name of the zstd archive that replaces a gzipped log archive
+func RecompressedObjectName(archive string) string {
+	return strings.TrimSuffix(archive, gzipExtension) + zstdExtension
+}
+
+// IsGzipped returns whether the object is a gzipped log archive, as the log archives are uploaded
+func IsGzipped(objectName string) bool {
+	return strings.HasSuffix(objectName, gzipExtension)
+}
+
+// IsRecompressed returns whether the log archive of the object was recompressed with zstd
+func IsRecompressed(objectName string) bool {
+	return strings.HasSuffix(objectName, zstdExtension)
+}
+
+// Compression returns the compression of the log archive of the object
+func Compression(objectName string) string {
+	if IsRecompressed(objectName) {
+		return models.LogArtifactCompressionZstd
+	}
+	return models.LogArtifactCompressionGzip
+}
+
+// Recompress replaces the gzipped log archive with a zstd archive of the same tarball and returns the name of the new
+// archive. The gzipped archive is kept if it can't be recompressed.
+func Recompress(ctx context.Context, log logrus.FieldLogger, objectHandler s3wrapper.API, archive string) (string, error) {
+	log = logutil.FromContext(ctx, log)
+	reader, _, err := objectHandler.Download(ctx, archive)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to download log archive %s", archive)
+	}
+	defer reader.Close()
+	zr, err := gzip.NewReader(reader)
+	if err != nil {
+		return "", errors.Wrapf(err, "log archive %s is not gzipped", archive)
+	}
+	defer zr.Close()
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		zw, err := zstd.NewWriter(pw, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err = io.Copy(zw, zr); err != nil {
+			zw.Close()
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(zw.Close())
+	}()
+
+	recompressed := RecompressedObjectName(archive)
+	err = objectHandler.UploadStream(ctx, pr, recompressed)
+	// Stops the compression if the upload failed before reading all of it
+	pr.CloseWithError(io.ErrClosedPipe)
+	<-done
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to upload recompressed log archive %s", recompressed)
+	}
+	if _, err = objectHandler.DeleteObject(ctx, archive); err != nil {
+		log.WithError(err).Warnf("Failed to delete log archive %s after recompressing it", archive)
+	}
+	return recompressed, nil
+}
+
+// FindArchive returns the name and the info of the stored log archive of the host, or of the controller when the log
+// ID is the controller logs type. It returns common.NotFound if the logs were not uploaded or were deleted.
+func FindArchive(ctx context.Context, objectHandler s3wrapper.API, clusterID, logID string) (string, *s3wrapper.ObjectInfo, error) {
+	var err error
+	for _, objectName := range ArchiveObjectNames(clusterID, logID) {
+		var info *s3wrapper.ObjectInfo
+		info, err = objectHandler.GetObjectInfo(ctx, objectName)
+		if err == nil {
+			return objectName, info, nil
+		}
+		if _, ok := err.(common.NotFound); !ok {
+			return "", nil, err
+		}
+	}
+	return "", nil, err
+}
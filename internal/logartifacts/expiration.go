@@ -0,0 +1,79 @@
+package logartifacts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// logsTypeOfFolder returns the type of the logs that are stored in a folder of the logs of a cluster, which is named
+// after the host that uploaded them or after the controller logs type
+func logsTypeOfFolder(folder string) (models.LogsType, bool) {
+	if folder == string(models.LogsTypeController) {
+		return models.LogsTypeController, true
+	}
+	if _, err := uuid.Parse(folder); err == nil {
+		return models.LogsTypeHost, true
+	}
+	return "", false
+}
+
+// DeleteExpired deletes the log archives of the cluster that expired by the rules at the given time, along with their
+// indexes, and returns the number of the folders of logs that were deleted. The cluster must be loaded with its status
+// and the time its installation completed.
+func DeleteExpired(ctx context.Context, log logrus.FieldLogger, objectHandler s3wrapper.API, cluster *common.Cluster,
+	rules RetentionRules, now time.Time) (int, error) {
+	log = logutil.FromContext(ctx, log)
+	prefix := fmt.Sprintf("%s/logs/", cluster.ID)
+	objects, err := objectHandler.ListObjectsByPrefix(ctx, prefix)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list the logs of cluster %s", cluster.ID)
+	}
+
+	expired := make(map[string]bool)
+	var deleted int
+	for _, objectName := range objects {
+		parts := strings.SplitN(strings.TrimPrefix(objectName, prefix), "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		folder := parts[0]
+		isExpired, checked := expired[folder]
+		if !checked {
+			logsType, ok := logsTypeOfFolder(folder)
+			if ok {
+				expiresAt := rules.Expiration(logsType, cluster)
+				isExpired = expiresAt != nil && !now.Before(*expiresAt)
+			}
+			expired[folder] = isExpired
+			if isExpired {
+				log.Infof("Deleting the %s logs in %s%s of cluster %s, which expired", logsType, prefix, folder, cluster.ID)
+				deleted++
+			}
+		}
+		if !isExpired {
+			continue
+		}
+		if _, err = objectHandler.DeleteObject(ctx, objectName); err != nil {
+			return deleted, errors.Wrapf(err, "failed to delete expired log object %s", objectName)
+		}
+	}
+
+	// The tarred logs of the cluster are made on download out of all its logs, and would keep copies of the expired ones
+	if deleted > 0 {
+		tarredLogs := fmt.Sprintf("%s/logs/cluster_logs.tar", cluster.ID)
+		if _, err = objectHandler.DeleteObject(ctx, tarredLogs); err != nil {
+			return deleted, errors.Wrapf(err, "failed to delete %s", tarredLogs)
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,219 @@
+package logartifacts
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/logsearch"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLogArtifacts(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "log artifacts tests")
+}
+
+const (
+	clusterID = "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1"
+	hostID    = "2b0c55c6-9e36-4a5e-a3b7-6e07b2f0a2a7"
+)
+
+func logsArchive(fileName, contents string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	Expect(tw.WriteHeader(&tar.Header{Name: fileName, Mode: 0600, Size: int64(len(contents)), ModTime: time.Now(),
+		Typeflag: tar.TypeReg})).To(Succeed())
+	_, err := tw.Write([]byte(contents))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(tw.Close()).To(Succeed())
+	Expect(zw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func completedCluster(status string, completedAt time.Time) *common.Cluster {
+	id := strfmt.UUID(clusterID)
+	return &common.Cluster{Cluster: models.Cluster{
+		ID:                 &id,
+		Status:             swag.String(status),
+		InstallCompletedAt: strfmt.DateTime(completedAt),
+	}}
+}
+
+var _ = Describe("retention rules", func() {
+	decode := func(value string) (RetentionRules, error) {
+		var rules RetentionRules
+		err := rules.Decode(value)
+		return rules, err
+	}
+
+	It("decodes the rules", func() {
+		rules, err := decode(`[{"logs_type":"host","cluster_statuses":["installed"],"retention":"168h"},` +
+			`{"logs_type":"controller","retention":"720h"}]`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules).To(Equal(RetentionRules{
+			{LogsType: models.LogsTypeHost, ClusterStatuses: []string{models.ClusterStatusInstalled}, Retention: 168 * time.Hour},
+			{LogsType: models.LogsTypeController, Retention: 720 * time.Hour},
+		}))
+		Expect(rules.MinRetention()).To(Equal(168 * time.Hour))
+
+		rules, err = decode("[]")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules).To(BeEmpty())
+		Expect(rules.MinRetention()).To(BeZero())
+	})
+
+	It("rejects invalid rules", func() {
+		for _, value := range []string{
+			`{"logs_type":"host"}`,
+			`[{"logs_type":"all","retention":"1h"}]`,
+			`[{"logs_type":"host","cluster_statuses":["installing"],"retention":"1h"}]`,
+			`[{"logs_type":"host","retention":"a week"}]`,
+			`[{"logs_type":"host","retention":"0s"}]`,
+		} {
+			_, err := decode(value)
+			Expect(err).To(HaveOccurred(), value)
+		}
+	})
+
+	It("counts the retention from the completion of the installation", func() {
+		completedAt := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+		rules := RetentionRules{
+			{LogsType: models.LogsTypeHost, ClusterStatuses: []string{models.ClusterStatusInstalled}, Retention: time.Hour},
+			{LogsType: models.LogsTypeHost, Retention: 2 * time.Hour},
+		}
+		Expect(*rules.Expiration(models.LogsTypeHost, completedCluster(models.ClusterStatusInstalled, completedAt))).
+			To(Equal(completedAt.Add(time.Hour)))
+		Expect(*rules.Expiration(models.LogsTypeHost, completedCluster(models.ClusterStatusError, completedAt))).
+			To(Equal(completedAt.Add(2 * time.Hour)))
+		Expect(rules.Expiration(models.LogsTypeController, completedCluster(models.ClusterStatusError, completedAt))).To(BeNil())
+		Expect(rules.Expiration(models.LogsTypeHost, completedCluster(models.ClusterStatusInstalling, completedAt))).To(BeNil())
+		Expect(rules.Expiration(models.LogsTypeHost, completedCluster(models.ClusterStatusError, time.Time{}))).To(BeNil())
+	})
+
+	It("validates the compression", func() {
+		Expect((&Config{}).Validate()).To(Succeed())
+		Expect((&Config{Compression: models.LogArtifactCompressionZstd}).Validate()).To(Succeed())
+		Expect((&Config{Compression: "xz"}).Validate()).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("stored log archives", func() {
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		ctrl    *gomock.Controller
+		baseDir string
+		storage s3wrapper.API
+		archive = logsearch.ArchiveObjectName(clusterID, hostID)
+	)
+
+	exists := func(objectName string) bool {
+		found, err := storage.DoesObjectExist(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		return found
+	}
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "logartifacts")
+		Expect(err).ToNot(HaveOccurred())
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetrics := metrics.NewMockAPI(ctrl)
+		mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		storage = s3wrapper.NewFSClient(baseDir, log, nil, nil, mockMetrics, 0, 0)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	It("recompresses gzipped archives with zstd", func() {
+		Expect(storage.Upload(ctx, logsArchive("journal.log", "ignition[1234]: failed to fetch config\n"), archive)).To(Succeed())
+		objectName, info, err := FindArchive(ctx, storage, clusterID, hostID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objectName).To(Equal(archive))
+		Expect(Compression(objectName)).To(Equal(models.LogArtifactCompressionGzip))
+		Expect(info.Size).To(BeNumerically(">", 0))
+
+		recompressed, err := Recompress(ctx, log, storage, archive)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recompressed).To(Equal(clusterID + "/logs/" + hostID + "/logs.tar.zst"))
+		Expect(exists(archive)).To(BeFalse())
+		objectName, _, err = FindArchive(ctx, storage, clusterID, hostID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objectName).To(Equal(recompressed))
+		Expect(Compression(objectName)).To(Equal(models.LogArtifactCompressionZstd))
+
+		// The recompressed archive can still be searched
+		var entries []*logsearch.Entry
+		found, err := logsearch.NewIndexer(logsearch.Config{MaxIndexedLines: 100, MaxLineLength: 1024}, log, storage).
+			Search(ctx, recompressed, &logsearch.Filter{}, func(entry *logsearch.Entry) {
+				entries = append(entries, entry)
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].File).To(Equal("journal.log"))
+		Expect(entries[0].Level).To(Equal(logsearch.LevelError))
+	})
+
+	It("keeps archives that are not gzipped", func() {
+		Expect(storage.Upload(ctx, []byte("not gzipped"), archive)).To(Succeed())
+		_, err := Recompress(ctx, log, storage, archive)
+		Expect(err).To(HaveOccurred())
+		Expect(exists(archive)).To(BeTrue())
+		Expect(exists(RecompressedObjectName(archive))).To(BeFalse())
+	})
+
+	It("returns that archives that were not uploaded were not found", func() {
+		_, _, err := FindArchive(ctx, storage, clusterID, hostID)
+		Expect(err).To(BeAssignableToTypeOf(common.NotFound("")))
+	})
+
+	It("deletes the expired archives", func() {
+		controllerArchive := RecompressedObjectName(logsearch.ArchiveObjectName(clusterID, string(models.LogsTypeController)))
+		tarredLogs := clusterID + "/logs/cluster_logs.tar"
+		other := clusterID + "/logs/other/logs.tar.gz"
+		for _, objectName := range []string{archive, logsearch.IndexObjectName(archive), controllerArchive, tarredLogs, other} {
+			Expect(storage.Upload(ctx, []byte("logs"), objectName)).To(Succeed())
+		}
+		rules := RetentionRules{
+			{LogsType: models.LogsTypeHost, Retention: time.Hour},
+			{LogsType: models.LogsTypeController, Retention: 3 * time.Hour},
+		}
+		now := time.Now()
+
+		deleted, err := DeleteExpired(ctx, log, storage, completedCluster(models.ClusterStatusInstalled, now.Add(-30*time.Minute)), rules, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeZero())
+		Expect(exists(archive)).To(BeTrue())
+		Expect(exists(tarredLogs)).To(BeTrue())
+
+		deleted, err = DeleteExpired(ctx, log, storage, completedCluster(models.ClusterStatusInstalled, now.Add(-2*time.Hour)), rules, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(1))
+		Expect(exists(archive)).To(BeFalse())
+		Expect(exists(logsearch.IndexObjectName(archive))).To(BeFalse())
+		Expect(exists(tarredLogs)).To(BeFalse())
+		Expect(exists(controllerArchive)).To(BeTrue())
+		Expect(exists(other)).To(BeTrue())
+	})
+})
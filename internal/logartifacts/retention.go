@@ -0,0 +1,128 @@
+package logartifacts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// Config sets how the uploaded log archives are stored and how long they are kept
+type Config struct {
+	// Compression is the compression that the uploaded log archives are converted to. The archives are kept gzipped
+	// as they are uploaded when it is empty.
+	Compression string `envconfig:"LOG_COMPRESSION" default:""`
+	// RetentionRules are the rules that the garbage collector deletes the log archives by. The archives that no rule
+	// matches are kept until the cluster is deleted.
+	RetentionRules RetentionRules `envconfig:"LOG_RETENTION_RULES" default:"[]"`
+}
+
+// Validate returns an error if the configuration has an unknown compression
+func (c *Config) Validate() error {
+	if c.Compression != "" && c.Compression != models.LogArtifactCompressionZstd {
+		return errors.Errorf("unsupported log compression %q, only %q is supported", c.Compression,
+			models.LogArtifactCompressionZstd)
+	}
+	return nil
+}
+
+// completedStatuses are the statuses that the installation of a cluster ends with, and that the retention of its logs
+// is counted from
+var completedStatuses = []string{models.ClusterStatusInstalled, models.ClusterStatusError, models.ClusterStatusCancelled}
+
+// RetentionRule keeps the log archives of a type for a duration after the installation of the cluster completed. A
+// rule with no cluster statuses applies to the clusters of all the completed statuses.
+type RetentionRule struct {
+	LogsType        models.LogsType `json:"logs_type"`
+	ClusterStatuses []string        `json:"cluster_statuses,omitempty"`
+	Retention       time.Duration   `json:"-"`
+}
+
+type retentionRuleJSON struct {
+	LogsType        models.LogsType `json:"logs_type"`
+	ClusterStatuses []string        `json:"cluster_statuses,omitempty"`
+	Retention       string          `json:"retention"`
+}
+
+func (r *RetentionRule) UnmarshalJSON(data []byte) error {
+	var rule retentionRuleJSON
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return err
+	}
+	retention, err := time.ParseDuration(rule.Retention)
+	if err != nil {
+		return errors.Wrapf(err, "invalid retention of %s logs", rule.LogsType)
+	}
+	*r = RetentionRule{LogsType: rule.LogsType, ClusterStatuses: rule.ClusterStatuses, Retention: retention}
+	return nil
+}
+
+func (r *RetentionRule) validate() error {
+	if r.LogsType != models.LogsTypeHost && r.LogsType != models.LogsTypeController {
+		return errors.Errorf("logs type %q must be %s or %s", r.LogsType, models.LogsTypeHost, models.LogsTypeController)
+	}
+	for _, status := range r.ClusterStatuses {
+		if !funk.ContainsString(completedStatuses, status) {
+			return errors.Errorf("cluster status %q of the %s logs rule must be one of %v", status, r.LogsType, completedStatuses)
+		}
+	}
+	if r.Retention <= 0 {
+		return errors.Errorf("retention of %s logs must be positive", r.LogsType)
+	}
+	return nil
+}
+
+func (r *RetentionRule) matches(logsType models.LogsType, clusterStatus string) bool {
+	return r.LogsType == logsType && (len(r.ClusterStatuses) == 0 || funk.ContainsString(r.ClusterStatuses, clusterStatus))
+}
+
+// RetentionRules are matched in order, so the first rule that matches a log archive sets its retention. They are
+// decoded from a JSON list such as
+// [{"logs_type":"host","cluster_statuses":["installed"],"retention":"168h"},{"logs_type":"controller","retention":"720h"}]
+type RetentionRules []RetentionRule
+
+func (r *RetentionRules) Decode(value string) error {
+	var rules []RetentionRule
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return errors.Wrap(err, "failed to decode the log retention rules")
+	}
+	for i := range rules {
+		if err := rules[i].validate(); err != nil {
+			return errors.Wrap(err, "invalid log retention rule")
+		}
+	}
+	*r = rules
+	return nil
+}
+
+// MinRetention returns the shortest retention of the rules, or zero if there are no rules
+func (r RetentionRules) MinRetention() time.Duration {
+	var min time.Duration
+	for _, rule := range r {
+		if min == 0 || rule.Retention < min {
+			min = rule.Retention
+		}
+	}
+	return min
+}
+
+// Expiration returns the time that the log archives of the type expire for the cluster, or nil if they are kept until
+// the cluster is deleted. Archives don't expire before the installation of the cluster completes.
+func (r RetentionRules) Expiration(logsType models.LogsType, cluster *common.Cluster) *time.Time {
+	status := swag.StringValue(cluster.Status)
+	completedAt := time.Time(cluster.InstallCompletedAt)
+	if completedAt.IsZero() || !funk.ContainsString(completedStatuses, status) {
+		return nil
+	}
+	for _, rule := range r {
+		if rule.matches(logsType, status) {
+			expiresAt := completedAt.Add(rule.Retention)
+			return &expiresAt
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package logsearch
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Filter selects the entries of the indexes. The zero value of each field matches all the entries.
+type Filter struct {
+	pattern *regexp.Regexp
+	Levels  []string
+	// File is a text that the path of the file within the archive contains
+	File  string
+	Since *time.Time
+	Until *time.Time
+}
+
+// SetPattern sets the regular expression that the lines must match, ignoring case
+func (f *Filter) SetPattern(pattern string) error {
+	if pattern == "" {
+		f.pattern = nil
+		return nil
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return errors.Wrapf(err, "invalid pattern %q", pattern)
+	}
+	f.pattern = re
+	return nil
+}
+
+func (f *Filter) matches(entry *Entry) bool {
+	if len(f.Levels) > 0 && !contains(f.Levels, entry.Level) {
+		return false
+	}
+	if f.File != "" && !strings.Contains(entry.File, f.File) {
+		return false
+	}
+	if f.Since != nil || f.Until != nil {
+		if entry.Time == nil {
+			return false
+		}
+		if f.Since != nil && entry.Time.Before(*f.Since) {
+			return false
+		}
+		if f.Until != nil && !entry.Time.Before(*f.Until) {
+			return false
+		}
+	}
+	return f.pattern == nil || f.pattern.MatchString(entry.Message)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
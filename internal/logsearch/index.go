@@ -0,0 +1,306 @@
+package logsearch
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Config limits the size of the indexes of the log archives
+type Config struct {
+	// MaxIndexedLines is the number of lines of an archive that are indexed regardless of their level. The warning,
+	// error and fatal lines that follow them are still indexed.
+	MaxIndexedLines int `envconfig:"LOG_INDEX_MAX_LINES" default:"200000"`
+	// MaxLineLength is the number of bytes of a line that are kept in the index
+	MaxLineLength int `envconfig:"LOG_INDEX_MAX_LINE_LENGTH" default:"1024"`
+}
+
+// ArchiveObjectName returns the name of the object of the log archive that the host, or the controller when the log ID
+// is the controller logs type, uploads for the cluster
+func ArchiveObjectName(clusterID, logID string) string {
+	return fmt.Sprintf("%s/logs/%s/logs.tar.gz", clusterID, logID)
+}
+
+// indexFileName is the name of the index object, which is stored in the folder of the archive it indexes
+const indexFileName = "index.jsonl.gz"
+
+// IndexObjectName returns the name of the object that holds the index of a log archive
+func IndexObjectName(archive string) string {
+	return path.Join(path.Dir(archive), indexFileName)
+}
+
+// IsIndexObject returns whether the object holds the index of a log archive rather than logs
+func IsIndexObject(objectName string) bool {
+	return path.Base(objectName) == indexFileName
+}
+
+// indexHeader is the first record of an index. The ETag of the archive tells whether the archive was replaced after
+// it was indexed.
+type indexHeader struct {
+	Archive   string    `json:"archive"`
+	ETag      string    `json:"etag"`
+	IndexedAt time.Time `json:"indexed_at"`
+}
+
+// Entry is an indexed line of a log archive
+type Entry struct {
+	// File is the path of the file within the archive
+	File string `json:"file"`
+	// Line is the number of the line within the file, starting at 1
+	Line int64 `json:"line"`
+	// Time is the time the line was logged, if it could be parsed
+	Time *time.Time `json:"time,omitempty"`
+	// Level is one of the Level constants, or empty if the line has no level
+	Level   string `json:"level,omitempty"`
+	Message string `json:"message"`
+}
+
+// Indexer indexes the lines of the tarred log archives that the hosts and the controller upload, so that they can be
+// searched without downloading them. Each index is stored as gzipped JSON lines next to its archive.
+type Indexer struct {
+	Config
+	log           logrus.FieldLogger
+	objectHandler s3wrapper.API
+}
+
+func NewIndexer(cfg Config, log logrus.FieldLogger, objectHandler s3wrapper.API) *Indexer {
+	return &Indexer{
+		Config:        cfg,
+		log:           log,
+		objectHandler: objectHandler,
+	}
+}
+
+// Index reads the log archive and stores its index, replacing the previous index of the archive
+func (i *Indexer) Index(ctx context.Context, archive string) error {
+	log := logutil.FromContext(ctx, i.log)
+	info, err := i.objectHandler.GetObjectInfo(ctx, archive)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the info of log archive %s", archive)
+	}
+	reader, _, err := i.objectHandler.Download(ctx, archive)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download log archive %s", archive)
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(zw)
+	if err = encoder.Encode(&indexHeader{Archive: archive, ETag: info.ETag, IndexedAt: time.Now().UTC()}); err != nil {
+		return errors.Wrapf(err, "failed to encode the index header of %s", archive)
+	}
+	lines, skipped, err := i.indexArchive(reader, encoder)
+	if err != nil {
+		return errors.Wrapf(err, "failed to index log archive %s", archive)
+	}
+	if err = zw.Close(); err != nil {
+		return errors.Wrapf(err, "failed to compress the index of %s", archive)
+	}
+
+	indexName := IndexObjectName(archive)
+	if err = i.objectHandler.Upload(ctx, buf.Bytes(), indexName); err != nil {
+		return errors.Wrapf(err, "failed to upload %s", indexName)
+	}
+	if skipped > 0 {
+		log.Warnf("Indexed only the warning, error and fatal lines of %s after its first %d lines, skipping %d lines",
+			archive, i.MaxIndexedLines, skipped)
+	}
+	log.Infof("Indexed %d lines of log archive %s", lines, archive)
+	return nil
+}
+
+// indexArchive encodes the entries of the text files of a tar archive, which may be gzipped or zstd compressed, and returns the number of
+// lines that were indexed and skipped
+func (i *Indexer) indexArchive(reader io.Reader, encoder *json.Encoder) (int, int, error) {
+	archiveReader, err := decompress(bufio.NewReader(reader))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer archiveReader.Close()
+	var indexed, skipped int
+	tr := tar.NewReader(archiveReader)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return indexed, skipped, nil
+		}
+		if err != nil {
+			return indexed, skipped, errors.Wrap(err, "failed to read tar archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		fileReader, err := decompress(bufio.NewReader(tr))
+		if err != nil {
+			// A corrupted file doesn't prevent indexing the other files of the archive
+			continue
+		}
+		err = i.indexFile(header, fileReader, encoder, &indexed, &skipped)
+		fileReader.Close()
+		if err != nil {
+			return indexed, skipped, err
+		}
+	}
+}
+
+// indexFile encodes the entries of a text file of an archive and adds the number of lines that were indexed and
+// skipped to the counts. Binary files are skipped.
+func (i *Indexer) indexFile(header *tar.Header, fileReader io.Reader, encoder *json.Encoder, indexed, skipped *int) error {
+	lines := bufio.NewReader(fileReader)
+	if isBinary(lines) {
+		return nil
+	}
+	parser := newLineParser(header.ModTime)
+	for lineNumber := int64(1); ; lineNumber++ {
+		line, err := readLine(lines, i.MaxLineLength)
+		if err != nil && err != io.EOF {
+			return nil
+		}
+		if line != "" {
+			entry := parser.parse(line)
+			if *indexed >= i.MaxIndexedLines && !isSevere(entry.Level) {
+				*skipped++
+			} else {
+				entry.File = strings.TrimPrefix(header.Name, "./")
+				entry.Line = lineNumber
+				if encodeErr := encoder.Encode(entry); encodeErr != nil {
+					return errors.Wrapf(encodeErr, "failed to encode line %d of %s", lineNumber, header.Name)
+				}
+				*indexed++
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// zstdMagic are the first bytes of zstd frames
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// decompress returns a reader of the decompressed contents if the contents are gzipped or zstd compressed, and of the
+// contents as they are otherwise
+func decompress(reader *bufio.Reader) (io.ReadCloser, error) {
+	if magic, err := reader.Peek(len(zstdMagic)); err == nil && bytes.Equal(magic, zstdMagic) {
+		zr, err := zstd.NewReader(reader, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	}
+	magic, err := reader.Peek(2)
+	if err != nil || magic[0] != 0x1f || magic[1] != 0x8b {
+		return ioutil.NopCloser(reader), nil
+	}
+	return gzip.NewReader(reader)
+}
+
+// isBinary guesses whether the contents are binary by looking for a null byte in their beginning
+func isBinary(reader *bufio.Reader) bool {
+	head, _ := reader.Peek(512)
+	return bytes.IndexByte(head, 0) >= 0
+}
+
+// readLine returns the next line without its line ending, truncated to maxLength bytes
+func readLine(reader *bufio.Reader, maxLength int) (string, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := reader.ReadLine()
+		if err != nil {
+			return string(line), err
+		}
+		if room := maxLength - len(line); room > 0 {
+			if len(chunk) > room {
+				chunk = chunk[:room]
+			}
+			line = append(line, chunk...)
+		}
+		if !isPrefix {
+			return string(line), nil
+		}
+	}
+}
+
+// Search passes the entries of the archive that match the filter to the callback, in the order of the archive. The
+// archive is indexed first if it has no index yet or if it was replaced since it was indexed. It returns false if the
+// archive doesn't exist.
+func (i *Indexer) Search(ctx context.Context, archive string, filter *Filter, callback func(entry *Entry)) (bool, error) {
+	info, err := i.objectHandler.GetObjectInfo(ctx, archive)
+	if err != nil {
+		if _, ok := err.(common.NotFound); ok {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get the info of log archive %s", archive)
+	}
+
+	found, err := i.scan(ctx, archive, info.ETag, filter, callback)
+	if err != nil || found {
+		return true, err
+	}
+	if err = i.Index(ctx, archive); err != nil {
+		return true, err
+	}
+	if _, err = i.scan(ctx, archive, "", filter, callback); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// scan passes the matching entries of the index of the archive to the callback. It returns false without calling the
+// callback if there is no index, or if the index was made from an archive whose ETag isn't the given one.
+func (i *Indexer) scan(ctx context.Context, archive, etag string, filter *Filter, callback func(entry *Entry)) (bool, error) {
+	indexName := IndexObjectName(archive)
+	exists, err := i.objectHandler.DoesObjectExist(ctx, indexName)
+	if err != nil || !exists {
+		return false, err
+	}
+	reader, _, err := i.objectHandler.Download(ctx, indexName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to download %s", indexName)
+	}
+	defer reader.Close()
+
+	zr, err := gzip.NewReader(reader)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to decompress %s", indexName)
+	}
+	defer zr.Close()
+
+	decoder := json.NewDecoder(zr)
+	var header indexHeader
+	if err = decoder.Decode(&header); err != nil {
+		return false, errors.Wrapf(err, "failed to decode the header of %s", indexName)
+	}
+	if etag != "" && header.ETag != etag {
+		return false, nil
+	}
+	for {
+		var entry Entry
+		if err = decoder.Decode(&entry); err == io.EOF {
+			return true, nil
+		} else if err != nil {
+			return true, errors.Wrapf(err, "failed to decode %s", indexName)
+		}
+		if filter.matches(&entry) {
+			callback(&entry)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package logsearch
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLogSearch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "log search tests")
+}
+
+type archiveFile struct {
+	name     string
+	contents string
+	gzipped  bool
+}
+
+func tarGz(modTime time.Time, files ...archiveFile) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for _, f := range files {
+		contents := []byte(f.contents)
+		if f.gzipped {
+			var gzipped bytes.Buffer
+			w := gzip.NewWriter(&gzipped)
+			_, err := w.Write(contents)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(w.Close()).To(Succeed())
+			contents = gzipped.Bytes()
+		}
+		Expect(tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0600, Size: int64(len(contents)), ModTime: modTime,
+			Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write(contents)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	Expect(zw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("log search", func() {
+	const archive = "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/logs/2b0c55c6-9e36-4a5e-a3b7-6e07b2f0a2a7/logs.tar.gz"
+
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		modTime = time.Date(2021, time.March, 2, 10, 0, 0, 0, time.UTC)
+		ctrl    *gomock.Controller
+		baseDir string
+		storage s3wrapper.API
+		indexer *Indexer
+		files   = []archiveFile{
+			{name: "./journal.log", contents: "Mar 01 12:00:00 master-0 ignition[1234]: fetching config\n" +
+				"Mar 01 12:00:01 master-0 ignition[1234]: failed to fetch config: connection refused\n" +
+				"\n" +
+				"Mar 01 12:00:02 master-0 systemd[1]: Started Ignition\n"},
+			{name: "installer.log", contents: `time="2021-03-01T12:05:00Z" level=info msg="Writing image to disk"` + "\n" +
+				`time="2021-03-01T12:06:00Z" level=error msg="Failed to write ignition to disk"` + "\n"},
+			{name: "kubelet.log.gz", gzipped: true, contents: "W0301 12:10:00.000000    1234 kubelet.go:100] node not ready\n"},
+			{name: "image.bin", contents: "\x00\x01\x02ignition"},
+		}
+	)
+
+	search := func(filter *Filter) []*Entry {
+		var entries []*Entry
+		found, err := indexer.Search(ctx, archive, filter, func(entry *Entry) {
+			entries = append(entries, entry)
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		return entries
+	}
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "logsearch")
+		Expect(err).ToNot(HaveOccurred())
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetrics := metrics.NewMockAPI(ctrl)
+		mockMetrics.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		storage = s3wrapper.NewFSClient(baseDir, log, nil, nil, mockMetrics, 0, 0)
+		indexer = NewIndexer(Config{MaxIndexedLines: 100, MaxLineLength: 1024}, log, storage)
+		Expect(storage.Upload(ctx, tarGz(modTime, files...), archive)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	It("indexes the lines of the text files", func() {
+		Expect(indexer.Index(ctx, archive)).To(Succeed())
+		exists, err := storage.DoesObjectExist(ctx, IndexObjectName(archive))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		Expect(IsIndexObject(IndexObjectName(archive))).To(BeTrue())
+		Expect(IsIndexObject(archive)).To(BeFalse())
+
+		entries := search(&Filter{})
+		Expect(entries).To(HaveLen(6))
+		Expect(entries[1].File).To(Equal("journal.log"))
+		Expect(entries[1].Line).To(Equal(int64(2)))
+		Expect(entries[1].Level).To(Equal(LevelError))
+		Expect(*entries[1].Time).To(Equal(time.Date(2021, time.March, 1, 12, 0, 1, 0, time.UTC)))
+		Expect(entries[2].Line).To(Equal(int64(4)))
+		Expect(entries[2].Level).To(BeEmpty())
+		Expect(entries[5].File).To(Equal("kubelet.log.gz"))
+		Expect(entries[5].Level).To(Equal(LevelWarning))
+		Expect(*entries[5].Time).To(Equal(time.Date(2021, time.March, 1, 12, 10, 0, 0, time.UTC)))
+	})
+
+	It("filters the lines", func() {
+		filter := &Filter{Levels: []string{LevelError}}
+		Expect(filter.SetPattern("IGNITION")).To(Succeed())
+		entries := search(filter)
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Message).To(ContainSubstring("failed to fetch config"))
+		Expect(entries[1].File).To(Equal("installer.log"))
+
+		since := time.Date(2021, time.March, 1, 12, 5, 0, 0, time.UTC)
+		until := time.Date(2021, time.March, 1, 12, 10, 0, 0, time.UTC)
+		entries = search(&Filter{Since: &since, Until: &until, File: "installer"})
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Level).To(Equal(LevelInfo))
+	})
+
+	It("fails with invalid patterns", func() {
+		Expect((&Filter{}).SetPattern("(")).ToNot(Succeed())
+	})
+
+	It("indexes archives that were replaced again", func() {
+		Expect(indexer.Index(ctx, archive)).To(Succeed())
+		Expect(search(&Filter{})).To(HaveLen(6))
+		// The FS ETag is made of the modification time, which must change
+		time.Sleep(10 * time.Millisecond)
+		Expect(storage.Upload(ctx, tarGz(modTime, files[1]), archive)).To(Succeed())
+		Expect(search(&Filter{})).To(HaveLen(2))
+	})
+
+	It("indexes only the severe lines after the line limit", func() {
+		indexer.MaxIndexedLines = 1
+		entries := search(&Filter{})
+		Expect(entries).To(HaveLen(4))
+		Expect(entries[0].Line).To(Equal(int64(1)))
+		for _, entry := range entries[1:] {
+			Expect(isSevere(entry.Level)).To(BeTrue())
+		}
+	})
+
+	It("truncates long lines", func() {
+		indexer.MaxLineLength = 10
+		entries := search(&Filter{File: "journal"})
+		Expect(entries).To(HaveLen(3))
+		Expect(entries[0].Message).To(Equal("Mar 01 12:"))
+	})
+
+	It("returns that missing archives were not found", func() {
+		found, err := indexer.Search(ctx, strings.Replace(archive, "2b0c55c6", "3b0c55c6", 1), &Filter{}, func(*Entry) {
+			Fail("unexpected entry")
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
+
+var _ = Describe("line parsing", func() {
+	parser := newLineParser(time.Date(2021, time.January, 1, 10, 0, 0, 0, time.UTC))
+
+	It("finds the levels of the lines", func() {
+		for line, level := range map[string]string{
+			`{"level":"warning","msg":"retrying"}`:                      LevelWarning,
+			"2021-03-01 12:00:00,000 [ERROR] agent: step failed":        LevelError,
+			"I0301 12:00:00.000000    1 main.go:10] starting":           LevelInfo,
+			"F0301 12:00:00.000000    1 main.go:10] exiting":            LevelFatal,
+			"CRITICAL: disk full":                                       LevelFatal,
+			"Mar 01 12:00:00 host systemd[1]: Failed to start Ignition": LevelError,
+			"Mar 01 12:00:00 host systemd[1]: Started Ignition":         "",
+		} {
+			Expect(parser.parse(line).Level).To(Equal(level), line)
+		}
+	})
+
+	It("finds the times of the lines", func() {
+		entry := parser.parse("2021-03-01T12:00:00.5+02:00 level=info msg=started")
+		Expect(*entry.Time).To(Equal(time.Date(2021, time.March, 1, 10, 0, 0, 500000000, time.UTC)))
+		// Dates without a year are placed before the modification time of the file
+		entry = parser.parse("Dec 31 23:00:00 host kernel: booting")
+		Expect(*entry.Time).To(Equal(time.Date(2020, time.December, 31, 23, 0, 0, 0, time.UTC)))
+		Expect(parser.parse("no time at all").Time).To(BeNil())
+	})
+})
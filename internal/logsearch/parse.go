@@ -0,0 +1,141 @@
+package logsearch
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// The levels of the indexed lines, from the least to the most severe
+const (
+	LevelDebug   = "debug"
+	LevelInfo    = "info"
+	LevelWarning = "warning"
+	LevelError   = "error"
+	LevelFatal   = "fatal"
+)
+
+var (
+	// Fields such as level=error of logrus, "level":"error" of JSON logs or severity=ERROR
+	levelFieldRegex = regexp.MustCompile(`(?i)\b(?:level|lvl|severity)"?\s*[=:]\s*"?([a-z]+)`)
+	// The level letter and the date of klog, such as E0301 12:00:00.123456
+	klogRegex = regexp.MustCompile(`^([IWEF])(\d{4} \d{2}:\d{2}:\d{2}\.\d+)`)
+	// Levels in capitals, such as [ERROR] or WARN:
+	levelWordRegex = regexp.MustCompile(`\b(TRACE|DEBUG|INFO|NOTICE|WARN|WARNING|ERR|ERROR|CRIT|CRITICAL|FATAL|PANIC|ALERT|EMERG)\b`)
+	// Lines that have no level but report errors, such as the lines of the journal
+	errorWordRegex = regexp.MustCompile(`(?i)\b(error|failed|failure)\b`)
+
+	rfc3339Regex = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:?\d{2})?`)
+	// The date of syslog and of the journal, such as Mar 01 12:00:00, which has no year
+	syslogRegex = regexp.MustCompile(`^[A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2}`)
+)
+
+// timePrefixLength is the number of bytes at the beginning of a line in which its time is looked for, so that times
+// within the messages are ignored
+const timePrefixLength = 64
+
+var rfc3339Layouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+}
+
+var levelNames = map[string]string{
+	"trace":    LevelDebug,
+	"debug":    LevelDebug,
+	"info":     LevelInfo,
+	"notice":   LevelInfo,
+	"warn":     LevelWarning,
+	"warning":  LevelWarning,
+	"err":      LevelError,
+	"error":    LevelError,
+	"crit":     LevelFatal,
+	"critical": LevelFatal,
+	"fatal":    LevelFatal,
+	"panic":    LevelFatal,
+	"alert":    LevelFatal,
+	"emerg":    LevelFatal,
+}
+
+var klogLevels = map[string]string{
+	"I": LevelInfo,
+	"W": LevelWarning,
+	"E": LevelError,
+	"F": LevelFatal,
+}
+
+// lineParser finds the time and the level of the lines of a file. The times of formats without a year are placed in
+// the year before the modification time of the file.
+type lineParser struct {
+	modTime time.Time
+}
+
+func newLineParser(modTime time.Time) *lineParser {
+	return &lineParser{modTime: modTime.UTC()}
+}
+
+func (p *lineParser) parse(line string) *Entry {
+	entry := &Entry{Message: line}
+	if match := klogRegex.FindStringSubmatch(line); match != nil {
+		entry.Level = klogLevels[match[1]]
+		entry.Time = p.withoutYear("0102 15:04:05.999999", match[2])
+		return entry
+	}
+	entry.Time = p.parseTime(line)
+	entry.Level = parseLevel(line)
+	return entry
+}
+
+func (p *lineParser) parseTime(line string) *time.Time {
+	prefix := line
+	if len(prefix) > timePrefixLength {
+		prefix = prefix[:timePrefixLength]
+	}
+	if match := rfc3339Regex.FindString(prefix); match != "" {
+		value := strings.Replace(match, " ", "T", 1)
+		for _, layout := range rfc3339Layouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				t = t.UTC()
+				return &t
+			}
+		}
+	}
+	if match := syslogRegex.FindString(prefix); match != "" {
+		return p.withoutYear(time.Stamp, match)
+	}
+	return nil
+}
+
+// withoutYear parses a time whose format has no year, in the year that places it closest before the modification
+// time of the file. A day of slack is given for clocks that are not synchronized.
+func (p *lineParser) withoutYear(layout, value string) *time.Time {
+	t, err := time.Parse(layout, value)
+	if err != nil || p.modTime.IsZero() {
+		return nil
+	}
+	t = t.AddDate(p.modTime.Year()-t.Year(), 0, 0)
+	if t.After(p.modTime.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	return &t
+}
+
+func parseLevel(line string) string {
+	if match := levelFieldRegex.FindStringSubmatch(line); match != nil {
+		if level, ok := levelNames[strings.ToLower(match[1])]; ok {
+			return level
+		}
+	}
+	if match := levelWordRegex.FindString(line); match != "" {
+		return levelNames[strings.ToLower(match)]
+	}
+	if errorWordRegex.MatchString(line) {
+		return LevelError
+	}
+	return ""
+}
+
+// isSevere returns whether the lines of the level are still indexed once an archive reaches its line limit
+func isSevere(level string) bool {
+	return level == LevelWarning || level == LevelError || level == LevelFatal
+}
@@ -39,6 +39,9 @@ const (
 	counterClusterValidationFailed                = "assisted_installer_cluster_validation_is_in_failed_status_on_cluster_deletion"
 	counterClusterValidationChanged               = "assisted_installer_cluster_validation_failed_after_success_before_installation"
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
+	counterFilesystemEvictions                    = "assisted_installer_filesystem_evictions"
+	counterFilesystemEvictedBytes                 = "assisted_installer_filesystem_evicted_bytes"
+	counterFilesystemQuotaRejections              = "assisted_installer_filesystem_quota_rejections"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
 )
@@ -63,6 +66,9 @@ const (
 	counterDescriptionClusterValidationFailed                = "Number of cluster validation errors"
 	counterDescriptionClusterValidationChanged               = "Number of cluster validations that already succeed but start to fail again"
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
+	counterDescriptionFilesystemEvictions                    = "Number of objects evicted from the filesystem storage to stay within its quota, by object type"
+	counterDescriptionFilesystemEvictedBytes                 = "Number of bytes evicted from the filesystem storage to stay within its quota, by object type"
+	counterDescriptionFilesystemQuotaRejections              = "Number of uploads rejected because they didn't fit the filesystem storage quota"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
 )
@@ -88,6 +94,7 @@ const (
 	hostValidationTypeLabel    = "hostValidationType"
 	clusterValidationTypeLabel = "clusterValidationType"
 	imageLabel                 = "imageName"
+	objectTypeLabel            = "objectType"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
 )
@@ -106,6 +113,8 @@ type API interface {
 	DiskSyncDuration(clusterID strfmt.UUID, hostID strfmt.UUID, diskPath string, syncDuration int64)
 	ImagePullStatus(clusterID, hostID strfmt.UUID, imageName, resultStatus string, downloadRate float64)
 	FileSystemUsage(usageInPercentage float64)
+	FileSystemEviction(objectType string, sizeBytes int64)
+	FileSystemQuotaRejection()
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
 }
@@ -133,6 +142,9 @@ type MetricsManager struct {
 	serviceLogicClusterValidationFailed                *prometheus.CounterVec
 	serviceLogicClusterValidationChanged               *prometheus.CounterVec
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
+	serviceLogicFilesystemEvictions                    *prometheus.CounterVec
+	serviceLogicFilesystemEvictedBytes                 *prometheus.CounterVec
+	serviceLogicFilesystemQuotaRejections              *prometheus.CounterVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
 }
@@ -297,6 +309,30 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler events.Hand
 			}, []string{},
 		),
 
+		serviceLogicFilesystemEvictions: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterFilesystemEvictions,
+				Help:      counterDescriptionFilesystemEvictions,
+			}, []string{objectTypeLabel}),
+
+		serviceLogicFilesystemEvictedBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterFilesystemEvictedBytes,
+				Help:      counterDescriptionFilesystemEvictedBytes,
+			}, []string{objectTypeLabel}),
+
+		serviceLogicFilesystemQuotaRejections: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterFilesystemQuotaRejections,
+				Help:      counterDescriptionFilesystemQuotaRejections,
+			}, []string{}),
+
 		serviceLogicMonitoredHosts: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: subsystem,
@@ -330,6 +366,9 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler events.Hand
 		m.serviceLogicClusterValidationChanged,
 		m.serviceLogicClusterHostImagePullStatus,
 		m.serviceLogicFilesystemUsagePercentage,
+		m.serviceLogicFilesystemEvictions,
+		m.serviceLogicFilesystemEvictedBytes,
+		m.serviceLogicFilesystemQuotaRejections,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
 	)
@@ -505,6 +544,15 @@ func (m *MetricsManager) FileSystemUsage(usageInPercentage float64) {
 	m.serviceLogicFilesystemUsagePercentage.WithLabelValues().Set(usageInPercentage)
 }
 
+func (m *MetricsManager) FileSystemEviction(objectType string, sizeBytes int64) {
+	m.serviceLogicFilesystemEvictions.WithLabelValues(objectType).Inc()
+	m.serviceLogicFilesystemEvictedBytes.WithLabelValues(objectType).Add(float64(sizeBytes))
+}
+
+func (m *MetricsManager) FileSystemQuotaRejection() {
+	m.serviceLogicFilesystemQuotaRejections.WithLabelValues().Inc()
+}
+
 func (m *MetricsManager) MonitoredHostsCount(monitoredHosts int64) {
 	m.serviceLogicMonitoredHosts.WithLabelValues(hosts).Set(float64(monitoredHosts))
 }
@@ -192,6 +192,30 @@ func (mr *MockAPIMockRecorder) FileSystemUsage(usageInPercentage interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSystemUsage", reflect.TypeOf((*MockAPI)(nil).FileSystemUsage), usageInPercentage)
 }
 
+// FileSystemEviction mocks base method
+func (m *MockAPI) FileSystemEviction(objectType string, sizeBytes int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FileSystemEviction", objectType, sizeBytes)
+}
+
+// FileSystemEviction indicates an expected call of FileSystemEviction
+func (mr *MockAPIMockRecorder) FileSystemEviction(objectType, sizeBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSystemEviction", reflect.TypeOf((*MockAPI)(nil).FileSystemEviction), objectType, sizeBytes)
+}
+
+// FileSystemQuotaRejection mocks base method
+func (m *MockAPI) FileSystemQuotaRejection() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FileSystemQuotaRejection")
+}
+
+// FileSystemQuotaRejection indicates an expected call of FileSystemQuotaRejection
+func (mr *MockAPIMockRecorder) FileSystemQuotaRejection() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileSystemQuotaRejection", reflect.TypeOf((*MockAPI)(nil).FileSystemQuotaRejection))
+}
+
 // MonitoredHostsCount mocks base method
 func (m *MockAPI) MonitoredHostsCount(monitoredHosts int64) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,109 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+// A name covered by the *.apps wildcard record, the one of the console route
+const appsDomainNamePrefix = "console-openshift-console.apps"
+
+// ClusterDomainNames are the domain names that must be resolvable by the nodes of a cluster
+type ClusterDomainNames struct {
+	API         string
+	APIInternal string
+	Apps        string
+}
+
+// All returns the domain names in the order they are requested from the agents
+func (n *ClusterDomainNames) All() []string {
+	return []string{n.API, n.APIInternal, n.Apps}
+}
+
+// GetClusterDomainNames returns the domain names of the cluster, or nil when its name or base domain
+// are not set yet
+func GetClusterDomainNames(cluster *common.Cluster) *ClusterDomainNames {
+	if cluster.Name == "" || cluster.BaseDNSDomain == "" {
+		return nil
+	}
+	return &ClusterDomainNames{
+		API:         fmt.Sprintf("api.%s.%s", cluster.Name, cluster.BaseDNSDomain),
+		APIInternal: fmt.Sprintf("api-int.%s.%s", cluster.Name, cluster.BaseDNSDomain),
+		Apps:        fmt.Sprintf("%s.%s.%s", appsDomainNamePrefix, cluster.Name, cluster.BaseDNSDomain),
+	}
+}
+
+// GetDomainResolution returns the resolution of the domain name reported by the host, or nil when the
+// host didn't report it
+func GetDomainResolution(host *models.Host, domainName string) (*models.DomainResolutionResponseDomain, error) {
+	if host.DomainResolution == "" {
+		return nil, nil
+	}
+	var response models.DomainResolutionResponse
+	if err := json.Unmarshal([]byte(host.DomainResolution), &response); err != nil {
+		return nil, err
+	}
+	for _, resolution := range response.Resolutions {
+		if strings.EqualFold(swag.StringValue(resolution.DomainName), domainName) {
+			return resolution, nil
+		}
+	}
+	return nil, nil
+}
+
+// ResolvedAddresses returns the sorted addresses that a domain name was resolved to, in their canonical
+// form so that they can be compared
+func ResolvedAddresses(resolution *models.DomainResolutionResponseDomain) []string {
+	ret := make([]string, 0, len(resolution.IPV4Addresses)+len(resolution.IPV6Addresses))
+	add := func(address string) {
+		if ip := net.ParseIP(address); ip != nil {
+			address = ip.String()
+		}
+		ret = append(ret, address)
+	}
+	for _, ip := range resolution.IPV4Addresses {
+		add(ip.String())
+	}
+	for _, ip := range resolution.IPV6Addresses {
+		add(ip.String())
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// GetInconsistentDomainNames returns the domain names of the cluster that its hosts resolved to
+// different addresses
+func GetInconsistentDomainNames(cluster *common.Cluster) ([]string, error) {
+	domainNames := GetClusterDomainNames(cluster)
+	if domainNames == nil {
+		return nil, nil
+	}
+	var ret []string
+	for _, name := range domainNames.All() {
+		var expected *string
+		for _, h := range cluster.Hosts {
+			resolution, err := GetDomainResolution(h, name)
+			if err != nil {
+				return nil, err
+			}
+			if resolution == nil {
+				continue
+			}
+			addresses := strings.Join(ResolvedAddresses(resolution), ",")
+			if expected == nil {
+				expected = &addresses
+			} else if *expected != addresses {
+				ret = append(ret, name)
+				break
+			}
+		}
+	}
+	return ret, nil
+}
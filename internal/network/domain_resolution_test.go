@@ -0,0 +1,80 @@
+package network
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("domain resolution", func() {
+	var cluster *common.Cluster
+
+	BeforeEach(func() {
+		cluster = &common.Cluster{Cluster: models.Cluster{Name: "test-cluster", BaseDNSDomain: "example.com"}}
+	})
+
+	hostWithResolution := func(resolutions ...*models.DomainResolutionResponseDomain) *models.Host {
+		b, err := json.Marshal(&models.DomainResolutionResponse{Resolutions: resolutions})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{DomainResolution: string(b)}
+	}
+
+	resolution := func(name string, addresses ...string) *models.DomainResolutionResponseDomain {
+		ret := &models.DomainResolutionResponseDomain{DomainName: swag.String(name)}
+		for _, address := range addresses {
+			if net.ParseIP(address).To4() != nil {
+				ret.IPV4Addresses = append(ret.IPV4Addresses, strfmt.IPv4(address))
+			} else {
+				ret.IPV6Addresses = append(ret.IPV6Addresses, strfmt.IPv6(address))
+			}
+		}
+		return ret
+	}
+
+	It("returns the domain names of the cluster", func() {
+		Expect(GetClusterDomainNames(cluster).All()).Should(Equal([]string{
+			"api.test-cluster.example.com",
+			"api-int.test-cluster.example.com",
+			"console-openshift-console.apps.test-cluster.example.com",
+		}))
+		cluster.BaseDNSDomain = ""
+		Expect(GetClusterDomainNames(cluster)).Should(BeNil())
+	})
+
+	It("finds the resolution of a domain name", func() {
+		host := hostWithResolution(resolution("API.test-cluster.example.com", "1001:db8:0::1", "10.0.0.1"))
+		found, err := GetDomainResolution(host, "api.test-cluster.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ResolvedAddresses(found)).Should(Equal([]string{"10.0.0.1", "1001:db8::1"}))
+
+		found, err = GetDomainResolution(host, "api-int.test-cluster.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(found).Should(BeNil())
+
+		found, err = GetDomainResolution(&models.Host{}, "api.test-cluster.example.com")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(found).Should(BeNil())
+
+		_, err = GetDomainResolution(&models.Host{DomainResolution: "not json"}, "api.test-cluster.example.com")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("finds the domain names that the hosts resolve differently", func() {
+		cluster.Hosts = []*models.Host{
+			hostWithResolution(resolution("api.test-cluster.example.com", "10.0.0.1", "10.0.0.2"),
+				resolution("api-int.test-cluster.example.com", "10.0.0.1")),
+			hostWithResolution(resolution("api.test-cluster.example.com", "10.0.0.2", "10.0.0.1"),
+				resolution("api-int.test-cluster.example.com", "10.0.0.3")),
+			{},
+		}
+		names, err := GetInconsistentDomainNames(cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(names).Should(Equal([]string{"api-int.test-cluster.example.com"}))
+	})
+})
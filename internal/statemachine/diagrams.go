@@ -0,0 +1,146 @@
+package statemachine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+)
+
+// initialState is how diagrams show the empty state, which is the state of objects that were not created yet
+const initialState = "start"
+
+type edge struct {
+	source          string
+	destination     string
+	transitionTypes []string
+}
+
+// edges merges the transitions of the state machine that have the same source and destination states, keeping the
+// order in which they first appear
+func edges(machine *models.StateMachine) []*edge {
+	var ret []*edge
+	byStates := make(map[[2]string]*edge)
+	for _, transition := range machine.Transitions {
+		destination := swag.StringValue(transition.DestinationState)
+		for _, source := range transition.SourceStates {
+			key := [2]string{source, destination}
+			e, ok := byStates[key]
+			if !ok {
+				e = &edge{source: source, destination: destination}
+				byStates[key] = e
+				ret = append(ret, e)
+			}
+			transitionType := swag.StringValue(transition.TransitionType)
+			if !contains(e.transitionTypes, transitionType) {
+				e.transitionTypes = append(e.transitionTypes, transitionType)
+			}
+		}
+	}
+	return ret
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func diagramName(machine *models.StateMachine) string {
+	name := swag.StringValue(machine.Name)
+	return strings.ToUpper(name[:1]) + name[1:] + "StateMachine"
+}
+
+// Graphviz returns the state machine as a Graphviz digraph, with an edge for each pair of states labeled with the
+// types of the transitions between them
+func Graphviz(machine *models.StateMachine) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", diagramName(machine))
+	for _, state := range machine.States {
+		if state == "" {
+			fmt.Fprintf(&b, "    %s [shape = house];\n", initialState)
+			continue
+		}
+		fmt.Fprintf(&b, "    %q;\n", state)
+	}
+	for _, e := range edges(machine) {
+		source := fmt.Sprintf("%q", e.source)
+		if e.source == "" {
+			source = initialState
+		}
+		fmt.Fprintf(&b, "    %s -> %q [label = %q];\n", source, e.destination, strings.Join(e.transitionTypes, "\n"))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaidID returns the ID of the state in Mermaid diagrams, which can't contain dashes
+func mermaidID(state string) string {
+	if state == "" {
+		return "[*]"
+	}
+	return strings.ReplaceAll(state, "-", "_")
+}
+
+// Mermaid returns the state machine as a Mermaid state diagram, with an edge for each pair of states labeled with the
+// types of the transitions between them
+func Mermaid(machine *models.StateMachine) string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for _, state := range machine.States {
+		if state != "" && mermaidID(state) != state {
+			fmt.Fprintf(&b, "    state \"%s\" as %s\n", state, mermaidID(state))
+		}
+	}
+	for _, e := range edges(machine) {
+		fmt.Fprintf(&b, "    %s --> %s : %s\n", mermaidID(e.source), mermaidID(e.destination), strings.Join(e.transitionTypes, ", "))
+	}
+	return b.String()
+}
+
+// WithDiagrams fills in the Graphviz and Mermaid diagrams of the state machine
+func WithDiagrams(machine *models.StateMachine) *models.StateMachine {
+	machine.Graphviz = Graphviz(machine)
+	machine.Mermaid = Mermaid(machine)
+	return machine
+}
+
+func escapeTableCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+// Markdown returns a document with the Mermaid diagram and the table of the transitions of each of the state machines
+func Markdown(machines ...*models.StateMachine) string {
+	var b strings.Builder
+	b.WriteString("# State Machines\n\n")
+	b.WriteString("<!-- Generated by `go run ./cmd/state-machines -format markdown`. DO NOT EDIT. -->\n\n")
+	b.WriteString("The states of the hosts and the clusters are changed by the transitions of their state machines. ")
+	b.WriteString("The first transition of a type whose source states and condition match is the one that runs. ")
+	b.WriteString("Conditions of the form `If(<validation>)` are the results of the validations of the host or the cluster.\n")
+	for _, machine := range machines {
+		name := swag.StringValue(machine.Name)
+		fmt.Fprintf(&b, "\n## %s\n\n", strings.ToUpper(name[:1])+name[1:])
+		b.WriteString("```mermaid\n")
+		b.WriteString(Mermaid(machine))
+		b.WriteString("```\n\n")
+		b.WriteString("| Transition type | Source states | Destination state | Condition | Post transition |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, transition := range machine.Transitions {
+			sources := make([]string, 0, len(transition.SourceStates))
+			for _, source := range transition.SourceStates {
+				if source == "" {
+					source = initialState
+				}
+				sources = append(sources, source)
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", swag.StringValue(transition.TransitionType),
+				strings.Join(sources, ", "), swag.StringValue(transition.DestinationState),
+				escapeTableCell(transition.Condition), transition.PostTransition)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,20 @@
+package statemachine_test
+
+import (
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/statemachine"
+)
+
+var _ = Describe("State machines documentation", func() {
+	It("matches the state machines of the code", func() {
+		doc, err := ioutil.ReadFile("../../docs/dev/state-machines.md")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(doc)).To(Equal(statemachine.Markdown(host.DescribeStateMachine(), cluster.DescribeStateMachine())),
+			"docs/dev/state-machines.md is out of date, run: go run ./cmd/state-machines -format markdown -output docs/dev/state-machines.md")
+	})
+})
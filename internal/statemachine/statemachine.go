@@ -0,0 +1,165 @@
+package statemachine
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/filanov/stateswitch"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/models"
+)
+
+// Recorder is a stateswitch state machine that keeps the transition rules that were added to it, so that the state
+// machine can be described
+type Recorder struct {
+	stateswitch.StateMachine
+	name  string
+	rules []stateswitch.TransitionRule
+}
+
+func NewRecorder(name string) *Recorder {
+	return &Recorder{
+		StateMachine: stateswitch.NewStateMachine(),
+		name:         name,
+	}
+}
+
+func (r *Recorder) AddTransition(rule stateswitch.TransitionRule) {
+	r.rules = append(r.rules, rule)
+	r.StateMachine.AddTransition(rule)
+}
+
+// Describe returns the states and the transitions of the state machine, in the order they were added
+func (r *Recorder) Describe() *models.StateMachine {
+	machine := &models.StateMachine{
+		Name:        swag.String(r.name),
+		States:      []string{},
+		Transitions: []*models.StateMachineTransition{},
+	}
+	states := make(map[string]bool)
+	addState := func(state stateswitch.State) {
+		if !states[string(state)] {
+			states[string(state)] = true
+			machine.States = append(machine.States, string(state))
+		}
+	}
+	transitionTypes := make(map[string]bool)
+	for _, rule := range r.rules {
+		transition := &models.StateMachineTransition{
+			TransitionType:   swag.String(string(rule.TransitionType)),
+			SourceStates:     []string{},
+			DestinationState: swag.String(string(rule.DestinationState)),
+			Condition:        DescribeCondition(rule.Condition),
+		}
+		if rule.PostTransition != nil {
+			transition.PostTransition = funcName(rule.PostTransition)
+		}
+		for _, state := range rule.SourceStates {
+			addState(state)
+			transition.SourceStates = append(transition.SourceStates, string(state))
+		}
+		addState(rule.DestinationState)
+		if !transitionTypes[string(rule.TransitionType)] {
+			transitionTypes[string(rule.TransitionType)] = true
+			machine.TransitionTypes = append(machine.TransitionTypes, string(rule.TransitionType))
+		}
+		machine.Transitions = append(machine.Transitions, transition)
+	}
+	return machine
+}
+
+// describeRequest is passed as the transition arguments to the conditions made by this package, which fill in their
+// description instead of being evaluated
+type describeRequest struct {
+	description string
+	// compound is set for conditions that combine several conditions with && or ||
+	compound bool
+}
+
+// describedConditions holds the code pointers of the conditions made by this package
+var describedConditions sync.Map
+
+func described(describe func() string, compound bool, condition stateswitch.Condition) stateswitch.Condition {
+	ret := func(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+		if request, ok := args.(*describeRequest); ok {
+			request.description = describe()
+			request.compound = compound
+			return false, nil
+		}
+		return condition(sw, args)
+	}
+	describedConditions.Store(reflect.ValueOf(ret).Pointer(), true)
+	return ret
+}
+
+// Named returns the condition, described by the given name
+func Named(name string, condition stateswitch.Condition) stateswitch.Condition {
+	return described(func() string { return name }, false, condition)
+}
+
+// And is stateswitch.And, described as the conjunction of the descriptions of its operands
+func And(operands ...stateswitch.Condition) stateswitch.Condition {
+	return described(func() string { return describeOperands(" && ", operands) }, true, stateswitch.And(operands...))
+}
+
+// Or is stateswitch.Or, described as the disjunction of the descriptions of its operands
+func Or(operands ...stateswitch.Condition) stateswitch.Condition {
+	return described(func() string { return describeOperands(" || ", operands) }, true, stateswitch.Or(operands...))
+}
+
+// Not is stateswitch.Not, described as the negation of the description of its operand
+func Not(operand stateswitch.Condition) stateswitch.Condition {
+	return described(func() string { return "!" + describeOperand(operand) }, false, stateswitch.Not(operand))
+}
+
+func describeOperands(separator string, operands []stateswitch.Condition) string {
+	descriptions := make([]string, 0, len(operands))
+	for _, operand := range operands {
+		descriptions = append(descriptions, describeOperand(operand))
+	}
+	return strings.Join(descriptions, separator)
+}
+
+// describeOperand describes a condition that is part of another condition, in parentheses when it combines several
+// conditions
+func describeOperand(condition stateswitch.Condition) string {
+	description, compound := describe(condition)
+	if compound {
+		return "(" + description + ")"
+	}
+	return description
+}
+
+// DescribeCondition returns the description of the conditions made by this package, and the name of the function of
+// any other condition
+func DescribeCondition(condition stateswitch.Condition) string {
+	description, _ := describe(condition)
+	return description
+}
+
+func describe(condition stateswitch.Condition) (string, bool) {
+	if condition == nil {
+		return "", false
+	}
+	if _, ok := describedConditions.Load(reflect.ValueOf(condition).Pointer()); ok {
+		request := &describeRequest{}
+		_, _ = condition(nil, request)
+		return request.description, request.compound
+	}
+	return funcName(condition), false
+}
+
+// funcName returns the name of a function without its package and receiver, such as IsInstalling for
+// th.IsInstalling and PostRefreshCluster for the function returned by th.PostRefreshCluster(reason)
+func funcName(f interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+	parts := strings.Split(name, ".")
+	for len(parts) > 1 && strings.HasPrefix(parts[len(parts)-1], "func") {
+		parts = parts[:len(parts)-1]
+	}
+	return parts[len(parts)-1]
+}
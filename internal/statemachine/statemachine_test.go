@@ -0,0 +1,167 @@
+package statemachine_test
+
+import (
+	"testing"
+
+	"github.com/filanov/stateswitch"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/statemachine"
+	"github.com/openshift/assisted-service/models"
+)
+
+func TestStateMachine(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "state machine tests")
+}
+
+type testSwitch struct {
+	state stateswitch.State
+}
+
+func (s *testSwitch) State() stateswitch.State {
+	return s.state
+}
+
+func (s *testSwitch) SetState(state stateswitch.State) error {
+	s.state = state
+	return nil
+}
+
+type testHandler struct {
+	ready bool
+}
+
+func (h *testHandler) IsReady(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+	return h.ready, nil
+}
+
+func (h *testHandler) PostStart(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	return nil
+}
+
+func always(value bool) stateswitch.Condition {
+	return func(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
+		return value, nil
+	}
+}
+
+var _ = Describe("DescribeCondition", func() {
+	handler := &testHandler{}
+
+	It("names conditions by their functions", func() {
+		Expect(statemachine.DescribeCondition(nil)).To(Equal(""))
+		Expect(statemachine.DescribeCondition(handler.IsReady)).To(Equal("IsReady"))
+	})
+
+	It("describes combined conditions", func() {
+		a := statemachine.Named("a", always(true))
+		b := statemachine.Named("b", always(false))
+		c := statemachine.Named("c", always(true))
+		condition := statemachine.And(a, statemachine.Not(statemachine.Or(handler.IsReady, b)), statemachine.Not(c))
+		Expect(statemachine.DescribeCondition(condition)).To(Equal("a && !(IsReady || b) && !c"))
+		Expect(statemachine.DescribeCondition(statemachine.Or(statemachine.And(a, b), c))).To(Equal("(a && b) || c"))
+	})
+
+	It("evaluates the described conditions", func() {
+		sw := &testSwitch{}
+		for _, tc := range []struct {
+			condition stateswitch.Condition
+			expected  bool
+		}{
+			{statemachine.Named("a", always(true)), true},
+			{statemachine.Not(always(true)), false},
+			{statemachine.And(always(true), always(false)), false},
+			{statemachine.Or(always(false), always(true)), true},
+			{statemachine.And(statemachine.Named("a", always(true)), statemachine.Not(statemachine.Or(always(false), handler.IsReady))), true},
+		} {
+			Expect(tc.condition(sw, nil)).To(Equal(tc.expected))
+		}
+	})
+})
+
+var _ = Describe("Recorder", func() {
+	var (
+		handler *testHandler
+		sm      *statemachine.Recorder
+	)
+
+	BeforeEach(func() {
+		handler = &testHandler{}
+		sm = statemachine.NewRecorder("host")
+		sm.AddTransition(stateswitch.TransitionRule{
+			TransitionType:   "Start",
+			SourceStates:     stateswitch.States{"", "stopped"},
+			DestinationState: "started",
+			Condition:        statemachine.And(handler.IsReady, statemachine.Not(statemachine.Named("If(disabled)", always(false)))),
+			PostTransition:   handler.PostStart,
+		})
+		sm.AddTransition(stateswitch.TransitionRule{
+			TransitionType:   "Start",
+			SourceStates:     stateswitch.States{"stopped"},
+			DestinationState: "stopped",
+		})
+		sm.AddTransition(stateswitch.TransitionRule{
+			TransitionType:   "Stop",
+			SourceStates:     stateswitch.States{"started"},
+			DestinationState: "stopped",
+		})
+	})
+
+	It("runs the transitions that were added", func() {
+		sw := &testSwitch{state: "stopped"}
+		Expect(sm.Run("Start", sw, nil)).To(Succeed())
+		Expect(sw.state).To(Equal(stateswitch.State("stopped")))
+		handler.ready = true
+		Expect(sm.Run("Start", sw, nil)).To(Succeed())
+		Expect(sw.state).To(Equal(stateswitch.State("started")))
+	})
+
+	It("describes the transitions that were added", func() {
+		machine := sm.Describe()
+		Expect(swag.StringValue(machine.Name)).To(Equal("host"))
+		Expect(machine.States).To(Equal([]string{"", "stopped", "started"}))
+		Expect(machine.TransitionTypes).To(Equal([]string{"Start", "Stop"}))
+		Expect(machine.Transitions).To(Equal([]*models.StateMachineTransition{
+			{
+				TransitionType:   swag.String("Start"),
+				SourceStates:     []string{"", "stopped"},
+				DestinationState: swag.String("started"),
+				Condition:        "IsReady && !If(disabled)",
+				PostTransition:   "PostStart",
+			},
+			{
+				TransitionType:   swag.String("Start"),
+				SourceStates:     []string{"stopped"},
+				DestinationState: swag.String("stopped"),
+			},
+			{
+				TransitionType:   swag.String("Stop"),
+				SourceStates:     []string{"started"},
+				DestinationState: swag.String("stopped"),
+			},
+		}))
+	})
+
+	It("draws the state machine", func() {
+		machine := sm.Describe()
+		Expect(statemachine.Graphviz(machine)).To(Equal(`digraph HostStateMachine {
+    start [shape = house];
+    "stopped";
+    "started";
+    start -> "started" [label = "Start"];
+    "stopped" -> "started" [label = "Start"];
+    "stopped" -> "stopped" [label = "Start"];
+    "started" -> "stopped" [label = "Stop"];
+}
+`))
+		Expect(statemachine.Mermaid(machine)).To(Equal(`stateDiagram-v2
+    [*] --> started : Start
+    stopped --> started : Start
+    stopped --> stopped : Start
+    started --> stopped : Stop
+`))
+		Expect(statemachine.Markdown(machine)).To(ContainSubstring("| Start | start, stopped | started | IsReady && !If(disabled) | PostStart |\n"))
+	})
+})
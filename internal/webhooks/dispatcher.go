@@ -0,0 +1,159 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	DispatchInterval         time.Duration `envconfig:"WEBHOOK_DISPATCH_INTERVAL" default:"10s"`
+	RequestTimeout           time.Duration `envconfig:"WEBHOOK_REQUEST_TIMEOUT" default:"10s"`
+	MaxAttempts              int64         `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"10"`
+	RetryBackoff             time.Duration `envconfig:"WEBHOOK_RETRY_BACKOFF" default:"30s"`
+	MaxRetryBackoff          time.Duration `envconfig:"WEBHOOK_MAX_RETRY_BACKOFF" default:"1h"`
+	MaxDeliveriesPerInterval int           `envconfig:"WEBHOOK_MAX_DELIVERIES_PER_INTERVAL" default:"100"`
+}
+
+// Only the beginning of the responses is read, so that connections can be reused
+const maxResponseBodyRead = 64 * 1024
+
+type Dispatcher struct {
+	Config
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	client        *http.Client
+	leaderElector leader.Leader
+}
+
+func NewDispatcher(cfg Config, db *gorm.DB, log logrus.FieldLogger, leaderElector leader.Leader) *Dispatcher {
+	return &Dispatcher{
+		Config:        cfg,
+		db:            db,
+		log:           log,
+		client:        &http.Client{CheckRedirect: rejectRedirect},
+		leaderElector: leaderElector,
+	}
+}
+
+// rejectRedirect makes the redirections of the webhooks fail the delivery, since following them could post the
+// notifications to plain HTTP or internal addresses that the webhooks can't be registered with
+func rejectRedirect(_ *http.Request, _ []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// DispatchDeliveries posts the pending notifications that are due
+func (d *Dispatcher) DispatchDeliveries() {
+	if !d.leaderElector.IsLeader() {
+		d.log.Debugf("Not a leader, exiting periodic webhook deliveries")
+		return
+	}
+
+	var deliveries []*models.WebhookDelivery
+	if err := d.db.Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryStatusPending, time.Now()).
+		Order("next_attempt_at").Limit(d.MaxDeliveriesPerInterval).Find(&deliveries).Error; err != nil {
+		d.log.WithError(err).Error("failed to get pending webhook deliveries")
+		return
+	}
+	for _, delivery := range deliveries {
+		d.deliver(delivery)
+	}
+}
+
+func (d *Dispatcher) deliver(delivery *models.WebhookDelivery) {
+	var hook common.Webhook
+	if err := d.db.Take(&hook, "id = ?", delivery.WebhookID.String()).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			d.log.WithError(err).Errorf("failed to get webhook %s", delivery.WebhookID)
+			return
+		}
+		d.update(delivery, map[string]interface{}{
+			"status":     models.WebhookDeliveryStatusFailed,
+			"last_error": "the webhook was deregistered",
+		})
+		return
+	}
+
+	now := time.Now()
+	attempts := delivery.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":        attempts,
+		"last_attempt_at": strfmt.DateTime(now),
+		"last_error":      "",
+	}
+	code, err := d.post(&hook, delivery)
+	updates["response_code"] = code
+	switch {
+	case err == nil:
+		updates["status"] = models.WebhookDeliveryStatusDelivered
+	case attempts >= d.MaxAttempts:
+		d.log.WithError(err).Warnf("giving up %s notification %s to webhook %s after %d attempts",
+			delivery.Event, *delivery.ID, *hook.ID, attempts)
+		updates["status"] = models.WebhookDeliveryStatusFailed
+		updates["last_error"] = err.Error()
+	default:
+		d.log.WithError(err).Infof("failed to post %s notification %s to webhook %s, will retry",
+			delivery.Event, *delivery.ID, *hook.ID)
+		updates["next_attempt_at"] = strfmt.DateTime(now.Add(d.backoff(attempts)))
+		updates["last_error"] = err.Error()
+	}
+	d.update(delivery, updates)
+}
+
+// The delivery is only updated if it is still pending, since it may have been replayed in the meantime
+func (d *Dispatcher) update(delivery *models.WebhookDelivery, updates map[string]interface{}) {
+	if err := d.db.Model(&models.WebhookDelivery{}).
+		Where("id = ? AND status = ?", delivery.ID.String(), models.WebhookDeliveryStatusPending).
+		Updates(updates).Error; err != nil {
+		d.log.WithError(err).Errorf("failed to update webhook delivery %s", *delivery.ID)
+	}
+}
+
+func (d *Dispatcher) post(hook *common.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *hook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(EventHeader, string(delivery.Event))
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, []byte(delivery.Payload)))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseBodyRead))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, errors.Errorf("the webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff doubles the delay before each retry, up to the configured maximum
+func (d *Dispatcher) backoff(attempts int64) time.Duration {
+	delay := d.RetryBackoff
+	for i := int64(1); i < attempts && delay < d.MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.MaxRetryBackoff {
+		delay = d.MaxRetryBackoff
+	}
+	return delay
+}
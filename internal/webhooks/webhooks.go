@@ -0,0 +1,141 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 of the notification body, keyed by the webhook secret
+	SignatureHeader = "X-Assisted-Signature"
+	// DeliveryHeader carries the ID of the delivery, which stays the same across retries
+	DeliveryHeader = "X-Assisted-Delivery"
+	// EventHeader carries the state change the notification is about
+	EventHeader = "X-Assisted-Event"
+
+	signaturePrefix = "sha256="
+)
+
+var clusterStatusEvents = map[string]models.WebhookEvent{
+	models.ClusterStatusInstalled:                   models.WebhookEventClusterInstalled,
+	models.ClusterStatusError:                       models.WebhookEventClusterError,
+	models.ClusterStatusInstallingPendingUserAction: models.WebhookEventClusterInstallingPendingUserAction,
+}
+
+var hostStatusEvents = map[string]models.WebhookEvent{
+	models.HostStatusInsufficient: models.WebhookEventHostInsufficient,
+}
+
+// Payload is the JSON document posted to webhooks
+type Payload struct {
+	Event      models.WebhookEvent `json:"event"`
+	ClusterID  strfmt.UUID         `json:"cluster_id"`
+	HostID     strfmt.UUID         `json:"host_id,omitempty"`
+	Status     string              `json:"status"`
+	StatusInfo string              `json:"status_info,omitempty"`
+	Timestamp  strfmt.DateTime     `json:"timestamp"`
+}
+
+// NotifyClusterStatus queues notifications to the webhooks of a cluster, if the status the cluster has just moved
+// to is one that webhooks are notified about. The deliveries are saved with the given db, so when it is a transaction
+// they are only sent if the status change is committed
+func NotifyClusterStatus(log logrus.FieldLogger, db *gorm.DB, cluster *common.Cluster) {
+	event, ok := clusterStatusEvents[swag.StringValue(cluster.Status)]
+	if !ok {
+		return
+	}
+	enqueue(log, db, &Payload{
+		Event:      event,
+		ClusterID:  *cluster.ID,
+		Status:     swag.StringValue(cluster.Status),
+		StatusInfo: swag.StringValue(cluster.StatusInfo),
+		Timestamp:  strfmt.DateTime(time.Now()),
+	})
+}
+
+// NotifyHostStatus queues notifications to the webhooks of the cluster of a host, if the status the host has just
+// moved to is one that webhooks are notified about
+func NotifyHostStatus(log logrus.FieldLogger, db *gorm.DB, host *common.Host) {
+	event, ok := hostStatusEvents[swag.StringValue(host.Status)]
+	if !ok {
+		return
+	}
+	enqueue(log, db, &Payload{
+		Event:      event,
+		ClusterID:  host.ClusterID,
+		HostID:     *host.ID,
+		Status:     swag.StringValue(host.Status),
+		StatusInfo: swag.StringValue(host.StatusInfo),
+		Timestamp:  strfmt.DateTime(time.Now()),
+	})
+}
+
+// Failing to queue notifications doesn't fail the state change that caused them
+func enqueue(log logrus.FieldLogger, db *gorm.DB, payload *Payload) {
+	hooks, err := clusterWebhooks(db, payload.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the webhooks of cluster %s", payload.ClusterID)
+		return
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal %s notification of cluster %s", payload.Event, payload.ClusterID)
+		return
+	}
+
+	now := strfmt.DateTime(time.Now())
+	for _, hook := range hooks {
+		id := strfmt.UUID(uuid.New().String())
+		delivery := &models.WebhookDelivery{
+			ID:            &id,
+			WebhookID:     hook.ID,
+			ClusterID:     payload.ClusterID,
+			HostID:        payload.HostID,
+			Event:         payload.Event,
+			Payload:       string(data),
+			Status:        swag.String(models.WebhookDeliveryStatusPending),
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		}
+		if err = db.Create(delivery).Error; err != nil {
+			log.WithError(err).Errorf("failed to queue %s notification of cluster %s to webhook %s",
+				payload.Event, payload.ClusterID, *hook.ID)
+		}
+	}
+}
+
+// clusterWebhooks returns the webhooks registered for a cluster and the webhooks registered for the organization
+// that owns it
+func clusterWebhooks(db *gorm.DB, clusterID strfmt.UUID) ([]*common.Webhook, error) {
+	var hooks []*common.Webhook
+	err := db.Where(clusterWebhooksCondition, clusterID, "", clusterOrg(db, clusterID)).Find(&hooks).Error
+	return hooks, err
+}
+
+const clusterWebhooksCondition = "cluster_id = ? OR (cluster_id = ? AND org_id = (?))"
+
+func clusterOrg(db *gorm.DB, clusterID strfmt.UUID) interface{} {
+	return db.Model(&common.Cluster{}).Select("org_id").Where("id = ?", clusterID).QueryExpr()
+}
+
+// Sign returns the value of the signature header of a notification
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
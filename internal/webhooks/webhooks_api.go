@@ -0,0 +1,189 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ restapi.WebhooksAPI = &Api{}
+
+type Api struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) RegisterWebhook(ctx context.Context, params webhooks.RegisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	clusterID := params.NewWebhookParams.ClusterID
+
+	if clusterID != "" {
+		var cluster common.Cluster
+		if err := a.db.Select("id").Take(&cluster, identity.AddUserFilter(ctx, "id = ?"), clusterID.String()).Error; err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return common.NewApiError(http.StatusNotFound, errors.Errorf("cluster %s not found", clusterID))
+			}
+			log.WithError(err).Errorf("failed to get cluster %s", clusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	hook := &common.Webhook{
+		Webhook: models.Webhook{
+			ID:        &id,
+			URL:       params.NewWebhookParams.URL,
+			ClusterID: clusterID,
+			OrgID:     ocm.OrgIDFromContext(ctx),
+			UserName:  ocm.UserNameFromContext(ctx),
+			CreatedAt: strfmt.DateTime(time.Now()),
+		},
+		Secret: swag.StringValue(params.NewWebhookParams.Secret),
+	}
+	if err := a.db.Create(hook).Error; err != nil {
+		log.WithError(err).Error("failed to register webhook")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Registered webhook %s for %s", id, webhookScope(&hook.Webhook))
+	return webhooks.NewRegisterWebhookCreated().WithPayload(&hook.Webhook)
+}
+
+func (a *Api) ListWebhooks(ctx context.Context, params webhooks.ListWebhooksParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	db := a.db.Where(identity.AddUserFilter(ctx, ""))
+	if params.ClusterID != nil {
+		db = db.Where(clusterWebhooksCondition, params.ClusterID.String(), "", clusterOrg(a.db, *params.ClusterID))
+	}
+	var hooks []*common.Webhook
+	if err := db.Order("created_at").Find(&hooks).Error; err != nil {
+		log.WithError(err).Error("failed to list webhooks")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	ret := make(models.WebhookList, len(hooks))
+	for i, hook := range hooks {
+		ret[i] = &hook.Webhook
+	}
+	return webhooks.NewListWebhooksOK().WithPayload(ret)
+}
+
+func (a *Api) DeregisterWebhook(ctx context.Context, params webhooks.DeregisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if responder := a.checkWebhook(ctx, params.WebhookID); responder != nil {
+		return responder
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", params.WebhookID.String()).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", params.WebhookID.String()).Delete(&common.Webhook{}).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to deregister webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Deregistered webhook %s", params.WebhookID)
+	return webhooks.NewDeregisterWebhookNoContent()
+}
+
+func (a *Api) ListWebhookDeliveries(ctx context.Context, params webhooks.ListWebhookDeliveriesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if responder := a.checkWebhook(ctx, params.WebhookID); responder != nil {
+		return responder
+	}
+
+	db := a.db.Where("webhook_id = ?", params.WebhookID.String())
+	if params.Status != nil {
+		db = db.Where("status = ?", *params.Status)
+	}
+	var deliveries models.WebhookDeliveryList
+	if err := db.Order("created_at DESC").Find(&deliveries).Error; err != nil {
+		log.WithError(err).Errorf("failed to list the deliveries of webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return webhooks.NewListWebhookDeliveriesOK().WithPayload(deliveries)
+}
+
+func (a *Api) ReplayWebhookDelivery(ctx context.Context, params webhooks.ReplayWebhookDeliveryParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	if responder := a.checkWebhook(ctx, params.WebhookID); responder != nil {
+		return responder
+	}
+
+	var delivery models.WebhookDelivery
+	if err := a.db.Take(&delivery, "id = ? AND webhook_id = ?", params.DeliveryID.String(), params.WebhookID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("delivery %s not found", params.DeliveryID))
+		}
+		log.WithError(err).Errorf("failed to get webhook delivery %s", params.DeliveryID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(delivery.Status) == models.WebhookDeliveryStatusPending {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("delivery %s is still pending", params.DeliveryID))
+	}
+
+	now := strfmt.DateTime(time.Now())
+	updates := map[string]interface{}{
+		"status":          models.WebhookDeliveryStatusPending,
+		"attempts":        0,
+		"response_code":   0,
+		"last_error":      "",
+		"next_attempt_at": now,
+	}
+	if err := a.db.Model(&delivery).Updates(updates).Error; err != nil {
+		log.WithError(err).Errorf("failed to replay webhook delivery %s", params.DeliveryID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Replaying %s notification %s to webhook %s", delivery.Event, params.DeliveryID, params.WebhookID)
+	return webhooks.NewReplayWebhookDeliveryAccepted().WithPayload(&delivery)
+}
+
+// checkWebhook returns an error response if the webhook doesn't exist or belongs to another user
+func (a *Api) checkWebhook(ctx context.Context, webhookID strfmt.UUID) middleware.Responder {
+	var hook common.Webhook
+	if err := a.db.Take(&hook, identity.AddUserFilter(ctx, "id = ?"), webhookID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("webhook %s not found", webhookID))
+		}
+		logutil.FromContext(ctx, a.log).WithError(err).Errorf("failed to get webhook %s", webhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+func webhookScope(hook *models.Webhook) string {
+	if hook.ClusterID != "" {
+		return "cluster " + hook.ClusterID.String()
+	}
+	return "the clusters of organization " + hook.OrgID
+}
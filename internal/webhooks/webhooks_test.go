@@ -0,0 +1,414 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/sirupsen/logrus"
+)
+
+func TestWebhooks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Webhooks test Suite")
+}
+
+func userContext(userName, orgID string) context.Context {
+	payload := &ocm.AuthPayload{Role: ocm.UserRole, Username: userName, Organization: orgID}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+func createCluster(db *gorm.DB, userName, orgID string) strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+		ID:       &id,
+		UserName: userName,
+		OrgID:    orgID,
+	}}).Error).ShouldNot(HaveOccurred())
+	return id
+}
+
+func createWebhook(db *gorm.DB, url string, clusterID strfmt.UUID, userName, orgID string) strfmt.UUID {
+	id := strfmt.UUID(uuid.New().String())
+	Expect(db.Create(&common.Webhook{
+		Webhook: models.Webhook{
+			ID:        &id,
+			URL:       swag.String(url),
+			ClusterID: clusterID,
+			UserName:  userName,
+			OrgID:     orgID,
+			CreatedAt: strfmt.DateTime(time.Now()),
+		},
+		Secret: "0123456789abcdef",
+	}).Error).ShouldNot(HaveOccurred())
+	return id
+}
+
+func webhookDeliveries(db *gorm.DB, webhookID strfmt.UUID) []*models.WebhookDelivery {
+	var deliveries []*models.WebhookDelivery
+	Expect(db.Order("created_at").Find(&deliveries, "webhook_id = ?", webhookID.String()).Error).ShouldNot(HaveOccurred())
+	return deliveries
+}
+
+var _ = Describe("Sign", func() {
+	It("signs with HMAC-SHA256 of the secret", func() {
+		Expect(Sign("key", []byte("The quick brown fox jumps over the lazy dog"))).Should(Equal(
+			"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"))
+	})
+})
+
+var _ = Describe("Notify", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		log     = logrus.New()
+		cluster strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		cluster = createCluster(db, "user1", "org1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("queues cluster notifications to the webhooks of the cluster and of its organization", func() {
+		clusterHook := createWebhook(db, "https://cluster.example.com", cluster, "user1", "org1")
+		orgHook := createWebhook(db, "https://org.example.com", "", "user1", "org1")
+		otherOrgHook := createWebhook(db, "https://other-org.example.com", "", "user2", "org2")
+		otherClusterHook := createWebhook(db, "https://other-cluster.example.com",
+			createCluster(db, "user1", "org1"), "user1", "org1")
+
+		NotifyClusterStatus(log, db, &common.Cluster{Cluster: models.Cluster{
+			ID:         &cluster,
+			Status:     swag.String(models.ClusterStatusError),
+			StatusInfo: swag.String("boom"),
+		}})
+
+		for _, hook := range []strfmt.UUID{clusterHook, orgHook} {
+			deliveries := webhookDeliveries(db, hook)
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Event).Should(Equal(models.WebhookEventClusterError))
+			Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+
+			var payload Payload
+			Expect(json.Unmarshal([]byte(deliveries[0].Payload), &payload)).ShouldNot(HaveOccurred())
+			Expect(payload.ClusterID).Should(Equal(cluster))
+			Expect(payload.Status).Should(Equal(models.ClusterStatusError))
+			Expect(payload.StatusInfo).Should(Equal("boom"))
+		}
+		Expect(webhookDeliveries(db, otherOrgHook)).To(BeEmpty())
+		Expect(webhookDeliveries(db, otherClusterHook)).To(BeEmpty())
+	})
+
+	It("ignores cluster statuses that webhooks aren't notified about", func() {
+		hook := createWebhook(db, "https://cluster.example.com", cluster, "user1", "org1")
+		NotifyClusterStatus(log, db, &common.Cluster{Cluster: models.Cluster{
+			ID:     &cluster,
+			Status: swag.String(models.ClusterStatusInstalling),
+		}})
+		Expect(webhookDeliveries(db, hook)).To(BeEmpty())
+	})
+
+	It("queues host notifications", func() {
+		hook := createWebhook(db, "https://cluster.example.com", cluster, "user1", "org1")
+		hostID := strfmt.UUID(uuid.New().String())
+		NotifyHostStatus(log, db, &common.Host{Host: models.Host{
+			ID:        &hostID,
+			ClusterID: cluster,
+			Status:    swag.String(models.HostStatusInsufficient),
+		}})
+
+		deliveries := webhookDeliveries(db, hook)
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Event).Should(Equal(models.WebhookEventHostInsufficient))
+		Expect(deliveries[0].HostID).Should(Equal(hostID))
+	})
+})
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		db           *gorm.DB
+		dbName       string
+		ctrl         *gomock.Controller
+		mockLeader   *leader.MockLeader
+		dispatcher   *Dispatcher
+		server       *httptest.Server
+		responseCode int
+		location     string
+		requests     []*http.Request
+		bodies       []string
+		hook         strfmt.UUID
+		cluster      strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockLeader = leader.NewMockLeader(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+
+		responseCode = http.StatusOK
+		location = ""
+		requests = nil
+		bodies = nil
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+			if location != "" {
+				w.Header().Set("Location", location)
+			}
+			w.WriteHeader(responseCode)
+		}))
+
+		dispatcher = NewDispatcher(Config{
+			RequestTimeout:           time.Second,
+			MaxAttempts:              2,
+			RetryBackoff:             time.Minute,
+			MaxRetryBackoff:          time.Hour,
+			MaxDeliveriesPerInterval: 10,
+		}, db, logrus.New(), mockLeader)
+		dispatcher.client.Transport = server.Client().Transport
+
+		cluster = createCluster(db, "user1", "org1")
+		hook = createWebhook(db, server.URL, cluster, "user1", "org1")
+		NotifyClusterStatus(logrus.New(), db, &common.Cluster{Cluster: models.Cluster{
+			ID:     &cluster,
+			Status: swag.String(models.ClusterStatusInstalled),
+		}})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("posts signed notifications", func() {
+		dispatcher.DispatchDeliveries()
+
+		Expect(requests).To(HaveLen(1))
+		deliveries := webhookDeliveries(db, hook)
+		Expect(requests[0].Header.Get(DeliveryHeader)).Should(Equal(deliveries[0].ID.String()))
+		Expect(requests[0].Header.Get(EventHeader)).Should(Equal(string(models.WebhookEventClusterInstalled)))
+		Expect(requests[0].Header.Get(SignatureHeader)).Should(Equal(Sign("0123456789abcdef", []byte(bodies[0]))))
+		Expect(bodies[0]).Should(Equal(deliveries[0].Payload))
+
+		Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusDelivered))
+		Expect(deliveries[0].Attempts).Should(Equal(int64(1)))
+		Expect(deliveries[0].ResponseCode).Should(Equal(int64(http.StatusOK)))
+
+		dispatcher.DispatchDeliveries()
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("retries failed notifications with backoff", func() {
+		responseCode = http.StatusServiceUnavailable
+		dispatcher.DispatchDeliveries()
+
+		deliveries := webhookDeliveries(db, hook)
+		Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+		Expect(deliveries[0].Attempts).Should(Equal(int64(1)))
+		Expect(deliveries[0].ResponseCode).Should(Equal(int64(http.StatusServiceUnavailable)))
+		Expect(deliveries[0].LastError).ShouldNot(BeEmpty())
+		Expect(time.Time(deliveries[0].NextAttemptAt)).Should(BeTemporally(">", time.Now().Add(50*time.Second)))
+
+		// not due yet
+		dispatcher.DispatchDeliveries()
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("doesn't follow redirections", func() {
+		responseCode = http.StatusTemporaryRedirect
+		location = "http://127.0.0.1/internal"
+		dispatcher.DispatchDeliveries()
+
+		Expect(requests).To(HaveLen(1))
+		deliveries := webhookDeliveries(db, hook)
+		Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+		Expect(deliveries[0].ResponseCode).Should(Equal(int64(http.StatusTemporaryRedirect)))
+		Expect(deliveries[0].LastError).ShouldNot(BeEmpty())
+	})
+
+	It("gives up after the maximal number of attempts", func() {
+		responseCode = http.StatusInternalServerError
+		for i := 0; i < 2; i++ {
+			Expect(db.Model(&models.WebhookDelivery{}).Where("webhook_id = ?", hook.String()).
+				Update("next_attempt_at", strfmt.DateTime(time.Now())).Error).ShouldNot(HaveOccurred())
+			dispatcher.DispatchDeliveries()
+		}
+
+		Expect(requests).To(HaveLen(2))
+		deliveries := webhookDeliveries(db, hook)
+		Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusFailed))
+		Expect(deliveries[0].Attempts).Should(Equal(int64(2)))
+	})
+
+	It("fails the deliveries of deregistered webhooks", func() {
+		Expect(db.Where("id = ?", hook.String()).Delete(&common.Webhook{}).Error).ShouldNot(HaveOccurred())
+		dispatcher.DispatchDeliveries()
+
+		Expect(requests).To(BeEmpty())
+		deliveries := webhookDeliveries(db, hook)
+		Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusFailed))
+	})
+
+	It("does nothing when not the leader", func() {
+		notLeader := leader.NewMockLeader(ctrl)
+		notLeader.EXPECT().IsLeader().Return(false).Times(1)
+		dispatcher.leaderElector = notLeader
+		dispatcher.DispatchDeliveries()
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("doubles the backoff up to the maximum", func() {
+		Expect(dispatcher.backoff(1)).Should(Equal(time.Minute))
+		Expect(dispatcher.backoff(3)).Should(Equal(4 * time.Minute))
+		Expect(dispatcher.backoff(10)).Should(Equal(time.Hour))
+	})
+})
+
+var _ = Describe("Webhooks API", func() {
+	var (
+		db      *gorm.DB
+		dbName  string
+		api     *Api
+		ctx     context.Context
+		cluster strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = NewApi(db, logrus.New())
+		ctx = userContext("user1", "org1")
+		cluster = createCluster(db, "user1", "org1")
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(clusterID strfmt.UUID) *models.Webhook {
+		reply := api.RegisterWebhook(ctx, webhooks.RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{
+				URL:       swag.String("https://example.com/hook"),
+				Secret:    swag.String("0123456789abcdef"),
+				ClusterID: clusterID,
+			},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(webhooks.NewRegisterWebhookCreated()))
+		return reply.(*webhooks.RegisterWebhookCreated).Payload
+	}
+
+	It("registers webhooks owned by the user", func() {
+		hook := register(cluster)
+		Expect(hook.UserName).Should(Equal("user1"))
+		Expect(hook.OrgID).Should(Equal("org1"))
+
+		var saved common.Webhook
+		Expect(db.Take(&saved, "id = ?", hook.ID.String()).Error).ShouldNot(HaveOccurred())
+		Expect(saved.Secret).Should(Equal("0123456789abcdef"))
+	})
+
+	It("doesn't register webhooks for clusters of other users", func() {
+		reply := api.RegisterWebhook(ctx, webhooks.RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{
+				URL:       swag.String("https://example.com/hook"),
+				Secret:    swag.String("0123456789abcdef"),
+				ClusterID: createCluster(db, "user2", "org1"),
+			},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusNotFound, nil)))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("lists the webhooks of the user", func() {
+		clusterHook := register(cluster)
+		orgHook := register("")
+		createWebhook(db, "https://example.com", "", "user2", "org1")
+
+		reply := api.ListWebhooks(ctx, webhooks.ListWebhooksParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(webhooks.NewListWebhooksOK()))
+		payload := reply.(*webhooks.ListWebhooksOK).Payload
+		Expect(payload).To(HaveLen(2))
+		Expect(*payload[0].ID).Should(Equal(*clusterHook.ID))
+		Expect(*payload[1].ID).Should(Equal(*orgHook.ID))
+
+		reply = api.ListWebhooks(ctx, webhooks.ListWebhooksParams{ClusterID: &cluster})
+		Expect(reply.(*webhooks.ListWebhooksOK).Payload).To(HaveLen(2))
+
+		otherCluster := createCluster(db, "user1", "org2")
+		reply = api.ListWebhooks(ctx, webhooks.ListWebhooksParams{ClusterID: &otherCluster})
+		Expect(reply.(*webhooks.ListWebhooksOK).Payload).To(BeEmpty())
+	})
+
+	It("deregisters webhooks and their deliveries", func() {
+		hook := register(cluster)
+		NotifyClusterStatus(logrus.New(), db, &common.Cluster{Cluster: models.Cluster{
+			ID:     &cluster,
+			Status: swag.String(models.ClusterStatusInstalled),
+		}})
+		Expect(webhookDeliveries(db, *hook.ID)).To(HaveLen(1))
+
+		reply := api.DeregisterWebhook(userContext("user2", "org1"), webhooks.DeregisterWebhookParams{WebhookID: *hook.ID})
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusNotFound)))
+
+		reply = api.DeregisterWebhook(ctx, webhooks.DeregisterWebhookParams{WebhookID: *hook.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(webhooks.NewDeregisterWebhookNoContent()))
+		Expect(webhookDeliveries(db, *hook.ID)).To(BeEmpty())
+	})
+
+	It("lists and replays deliveries", func() {
+		hook := register(cluster)
+		NotifyClusterStatus(logrus.New(), db, &common.Cluster{Cluster: models.Cluster{
+			ID:     &cluster,
+			Status: swag.String(models.ClusterStatusInstalled),
+		}})
+
+		reply := api.ListWebhookDeliveries(ctx, webhooks.ListWebhookDeliveriesParams{WebhookID: *hook.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(webhooks.NewListWebhookDeliveriesOK()))
+		deliveries := reply.(*webhooks.ListWebhookDeliveriesOK).Payload
+		Expect(deliveries).To(HaveLen(1))
+		deliveryID := *deliveries[0].ID
+
+		replayParams := webhooks.ReplayWebhookDeliveryParams{WebhookID: *hook.ID, DeliveryID: deliveryID}
+		reply = api.ReplayWebhookDelivery(ctx, replayParams)
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).Should(Equal(int32(http.StatusConflict)))
+
+		Expect(db.Model(&models.WebhookDelivery{}).Where("id = ?", deliveryID.String()).Updates(map[string]interface{}{
+			"status":   models.WebhookDeliveryStatusFailed,
+			"attempts": 10,
+		}).Error).ShouldNot(HaveOccurred())
+		failed := models.WebhookDeliveryStatusFailed
+		reply = api.ListWebhookDeliveries(ctx, webhooks.ListWebhookDeliveriesParams{WebhookID: *hook.ID, Status: &failed})
+		Expect(reply.(*webhooks.ListWebhookDeliveriesOK).Payload).To(HaveLen(1))
+
+		reply = api.ReplayWebhookDelivery(ctx, replayParams)
+		Expect(reply).Should(BeAssignableToTypeOf(webhooks.NewReplayWebhookDeliveryAccepted()))
+		delivery := webhookDeliveries(db, *hook.ID)[0]
+		Expect(swag.StringValue(delivery.Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+		Expect(delivery.Attempts).Should(Equal(int64(0)))
+	})
+})
@@ -217,6 +217,20 @@ func (mr *MockInstallerAPIMockRecorder) EnableHost(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHost", reflect.TypeOf((*MockInstallerAPI)(nil).EnableHost), arg0, arg1)
 }
 
+// ExportClusterDefinition mocks base method
+func (m *MockInstallerAPI) ExportClusterDefinition(arg0 context.Context, arg1 installer.ExportClusterDefinitionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportClusterDefinition", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ExportClusterDefinition indicates an expected call of ExportClusterDefinition
+func (mr *MockInstallerAPIMockRecorder) ExportClusterDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportClusterDefinition", reflect.TypeOf((*MockInstallerAPI)(nil).ExportClusterDefinition), arg0, arg1)
+}
+
 // GenerateClusterISO mocks base method
 func (m *MockInstallerAPI) GenerateClusterISO(arg0 context.Context, arg1 installer.GenerateClusterISOParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -231,6 +245,20 @@ func (mr *MockInstallerAPIMockRecorder) GenerateClusterISO(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateClusterISO", reflect.TypeOf((*MockInstallerAPI)(nil).GenerateClusterISO), arg0, arg1)
 }
 
+// GetChecksumsSigningKey mocks base method
+func (m *MockInstallerAPI) GetChecksumsSigningKey(arg0 context.Context, arg1 installer.GetChecksumsSigningKeyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChecksumsSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetChecksumsSigningKey indicates an expected call of GetChecksumsSigningKey
+func (mr *MockInstallerAPIMockRecorder) GetChecksumsSigningKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChecksumsSigningKey", reflect.TypeOf((*MockInstallerAPI)(nil).GetChecksumsSigningKey), arg0, arg1)
+}
+
 // GetCluster mocks base method
 func (m *MockInstallerAPI) GetCluster(arg0 context.Context, arg1 installer.GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -245,6 +273,20 @@ func (mr *MockInstallerAPIMockRecorder) GetCluster(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockInstallerAPI)(nil).GetCluster), arg0, arg1)
 }
 
+// GetClusterChecksums mocks base method
+func (m *MockInstallerAPI) GetClusterChecksums(arg0 context.Context, arg1 installer.GetClusterChecksumsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterChecksums", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterChecksums indicates an expected call of GetClusterChecksums
+func (mr *MockInstallerAPIMockRecorder) GetClusterChecksums(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterChecksums", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterChecksums), arg0, arg1)
+}
+
 // GetClusterDefaultConfig mocks base method
 func (m *MockInstallerAPI) GetClusterDefaultConfig(arg0 context.Context, arg1 installer.GetClusterDefaultConfigParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -287,6 +329,62 @@ func (mr *MockInstallerAPIMockRecorder) GetClusterInstallConfig(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallConfig), arg0, arg1)
 }
 
+// GetClusterInstallRetryPolicy mocks base method
+func (m *MockInstallerAPI) GetClusterInstallRetryPolicy(arg0 context.Context, arg1 installer.GetClusterInstallRetryPolicyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterInstallRetryPolicy", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterInstallRetryPolicy indicates an expected call of GetClusterInstallRetryPolicy
+func (mr *MockInstallerAPIMockRecorder) GetClusterInstallRetryPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallRetryPolicy", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterInstallRetryPolicy), arg0, arg1)
+}
+
+// GetClusterStageTimeouts mocks base method
+func (m *MockInstallerAPI) GetClusterStageTimeouts(arg0 context.Context, arg1 installer.GetClusterStageTimeoutsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStageTimeouts", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterStageTimeouts indicates an expected call of GetClusterStageTimeouts
+func (mr *MockInstallerAPIMockRecorder) GetClusterStageTimeouts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStageTimeouts", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterStageTimeouts), arg0, arg1)
+}
+
+// GetClusterValidationPolicy mocks base method
+func (m *MockInstallerAPI) GetClusterValidationPolicy(arg0 context.Context, arg1 installer.GetClusterValidationPolicyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterValidationPolicy", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterValidationPolicy indicates an expected call of GetClusterValidationPolicy
+func (mr *MockInstallerAPIMockRecorder) GetClusterValidationPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterValidationPolicy", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterValidationPolicy), arg0, arg1)
+}
+
+// GetClusterWorkerSuccessThreshold mocks base method
+func (m *MockInstallerAPI) GetClusterWorkerSuccessThreshold(arg0 context.Context, arg1 installer.GetClusterWorkerSuccessThresholdParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterWorkerSuccessThreshold", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterWorkerSuccessThreshold indicates an expected call of GetClusterWorkerSuccessThreshold
+func (mr *MockInstallerAPIMockRecorder) GetClusterWorkerSuccessThreshold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterWorkerSuccessThreshold", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterWorkerSuccessThreshold), arg0, arg1)
+}
+
 // GetCredentials mocks base method
 func (m *MockInstallerAPI) GetCredentials(arg0 context.Context, arg1 installer.GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -413,6 +511,20 @@ func (mr *MockInstallerAPIMockRecorder) GetPresignedForClusterFiles(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresignedForClusterFiles", reflect.TypeOf((*MockInstallerAPI)(nil).GetPresignedForClusterFiles), arg0, arg1)
 }
 
+// ImportClusterDefinition mocks base method
+func (m *MockInstallerAPI) ImportClusterDefinition(arg0 context.Context, arg1 installer.ImportClusterDefinitionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportClusterDefinition", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ImportClusterDefinition indicates an expected call of ImportClusterDefinition
+func (mr *MockInstallerAPIMockRecorder) ImportClusterDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportClusterDefinition", reflect.TypeOf((*MockInstallerAPI)(nil).ImportClusterDefinition), arg0, arg1)
+}
+
 // InstallCluster mocks base method
 func (m *MockInstallerAPI) InstallCluster(arg0 context.Context, arg1 installer.InstallClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -455,6 +567,20 @@ func (mr *MockInstallerAPIMockRecorder) InstallHosts(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallHosts", reflect.TypeOf((*MockInstallerAPI)(nil).InstallHosts), arg0, arg1)
 }
 
+// ListClusterLogArtifacts mocks base method
+func (m *MockInstallerAPI) ListClusterLogArtifacts(arg0 context.Context, arg1 installer.ListClusterLogArtifactsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterLogArtifacts", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListClusterLogArtifacts indicates an expected call of ListClusterLogArtifacts
+func (mr *MockInstallerAPIMockRecorder) ListClusterLogArtifacts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterLogArtifacts", reflect.TypeOf((*MockInstallerAPI)(nil).ListClusterLogArtifacts), arg0, arg1)
+}
+
 // ListClusters mocks base method
 func (m *MockInstallerAPI) ListClusters(arg0 context.Context, arg1 installer.ListClustersParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -483,6 +609,34 @@ func (mr *MockInstallerAPIMockRecorder) ListHosts(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHosts", reflect.TypeOf((*MockInstallerAPI)(nil).ListHosts), arg0, arg1)
 }
 
+// ListStateMachines mocks base method
+func (m *MockInstallerAPI) ListStateMachines(arg0 context.Context, arg1 installer.ListStateMachinesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStateMachines", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ListStateMachines indicates an expected call of ListStateMachines
+func (mr *MockInstallerAPIMockRecorder) ListStateMachines(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStateMachines", reflect.TypeOf((*MockInstallerAPI)(nil).ListStateMachines), arg0, arg1)
+}
+
+// PauseInstallation mocks base method
+func (m *MockInstallerAPI) PauseInstallation(arg0 context.Context, arg1 installer.PauseInstallationParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseInstallation", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// PauseInstallation indicates an expected call of PauseInstallation
+func (mr *MockInstallerAPIMockRecorder) PauseInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).PauseInstallation), arg0, arg1)
+}
+
 // PostStepReply mocks base method
 func (m *MockInstallerAPI) PostStepReply(arg0 context.Context, arg1 installer.PostStepReplyParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -581,6 +735,34 @@ func (mr *MockInstallerAPIMockRecorder) ResetHostValidation(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetHostValidation", reflect.TypeOf((*MockInstallerAPI)(nil).ResetHostValidation), arg0, arg1)
 }
 
+// ResumeInstallation mocks base method
+func (m *MockInstallerAPI) ResumeInstallation(arg0 context.Context, arg1 installer.ResumeInstallationParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeInstallation", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ResumeInstallation indicates an expected call of ResumeInstallation
+func (mr *MockInstallerAPIMockRecorder) ResumeInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).ResumeInstallation), arg0, arg1)
+}
+
+// SearchClusterLogs mocks base method
+func (m *MockInstallerAPI) SearchClusterLogs(arg0 context.Context, arg1 installer.SearchClusterLogsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchClusterLogs", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// SearchClusterLogs indicates an expected call of SearchClusterLogs
+func (mr *MockInstallerAPIMockRecorder) SearchClusterLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchClusterLogs", reflect.TypeOf((*MockInstallerAPI)(nil).SearchClusterLogs), arg0, arg1)
+}
+
 // UpdateCluster mocks base method
 func (m *MockInstallerAPI) UpdateCluster(arg0 context.Context, arg1 installer.UpdateClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -609,6 +791,20 @@ func (mr *MockInstallerAPIMockRecorder) UpdateClusterInstallConfig(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterInstallConfig), arg0, arg1)
 }
 
+// UpdateClusterInstallRetryPolicy mocks base method
+func (m *MockInstallerAPI) UpdateClusterInstallRetryPolicy(arg0 context.Context, arg1 installer.UpdateClusterInstallRetryPolicyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterInstallRetryPolicy", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateClusterInstallRetryPolicy indicates an expected call of UpdateClusterInstallRetryPolicy
+func (mr *MockInstallerAPIMockRecorder) UpdateClusterInstallRetryPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterInstallRetryPolicy", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterInstallRetryPolicy), arg0, arg1)
+}
+
 // UpdateClusterLogsProgress mocks base method
 func (m *MockInstallerAPI) UpdateClusterLogsProgress(arg0 context.Context, arg1 installer.UpdateClusterLogsProgressParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -623,6 +819,48 @@ func (mr *MockInstallerAPIMockRecorder) UpdateClusterLogsProgress(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterLogsProgress", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterLogsProgress), arg0, arg1)
 }
 
+// UpdateClusterStageTimeouts mocks base method
+func (m *MockInstallerAPI) UpdateClusterStageTimeouts(arg0 context.Context, arg1 installer.UpdateClusterStageTimeoutsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterStageTimeouts", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateClusterStageTimeouts indicates an expected call of UpdateClusterStageTimeouts
+func (mr *MockInstallerAPIMockRecorder) UpdateClusterStageTimeouts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterStageTimeouts", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterStageTimeouts), arg0, arg1)
+}
+
+// UpdateClusterValidationPolicy mocks base method
+func (m *MockInstallerAPI) UpdateClusterValidationPolicy(arg0 context.Context, arg1 installer.UpdateClusterValidationPolicyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterValidationPolicy", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateClusterValidationPolicy indicates an expected call of UpdateClusterValidationPolicy
+func (mr *MockInstallerAPIMockRecorder) UpdateClusterValidationPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterValidationPolicy", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterValidationPolicy), arg0, arg1)
+}
+
+// UpdateClusterWorkerSuccessThreshold mocks base method
+func (m *MockInstallerAPI) UpdateClusterWorkerSuccessThreshold(arg0 context.Context, arg1 installer.UpdateClusterWorkerSuccessThresholdParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterWorkerSuccessThreshold", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// UpdateClusterWorkerSuccessThreshold indicates an expected call of UpdateClusterWorkerSuccessThreshold
+func (mr *MockInstallerAPIMockRecorder) UpdateClusterWorkerSuccessThreshold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterWorkerSuccessThreshold", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateClusterWorkerSuccessThreshold), arg0, arg1)
+}
+
 // UpdateDiscoveryIgnition mocks base method
 func (m *MockInstallerAPI) UpdateDiscoveryIgnition(arg0 context.Context, arg1 installer.UpdateDiscoveryIgnitionParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -734,3 +972,17 @@ func (mr *MockInstallerAPIMockRecorder) UploadLogs(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).UploadLogs), arg0, arg1)
 }
+
+// ValidateHypotheticalHost mocks base method
+func (m *MockInstallerAPI) ValidateHypotheticalHost(arg0 context.Context, arg1 installer.ValidateHypotheticalHostParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateHypotheticalHost", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ValidateHypotheticalHost indicates an expected call of ValidateHypotheticalHost
+func (mr *MockInstallerAPIMockRecorder) ValidateHypotheticalHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateHypotheticalHost", reflect.TypeOf((*MockInstallerAPI)(nil).ValidateHypotheticalHost), arg0, arg1)
+}
@@ -71,6 +71,9 @@ type Cluster struct {
 	// hosts associated to this cluster that are not in 'disabled' state.
 	EnabledHostCount int64 `json:"enabled_host_count,omitempty" gorm:"-"`
 
+	// JSON-formatted string containing the failure-reason of the installation, which is classified when the cluster ends in error.
+	FailureReason string `json:"failure_reason,omitempty" gorm:"type:text"`
+
 	// JSON-formatted string containing the usage information by feature name
 	FeatureUsage string `json:"feature_usage,omitempty" gorm:"type:text"`
 
@@ -93,12 +96,12 @@ type Cluster struct {
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPProxy string `json:"http_proxy,omitempty"`
+	HTTPProxy string `json:"http_proxy,omitempty" secret:"true"`
 
 	// A proxy URL to use for creating HTTPS connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
-	HTTPSProxy string `json:"https_proxy,omitempty" gorm:"column:https_proxy"`
+	HTTPSProxy string `json:"https_proxy,omitempty" gorm:"column:https_proxy" secret:"true"`
 
 	// Enable/disable hyperthreading on master nodes, worker nodes, or all nodes
 	// Enum: [masters workers all none]
@@ -110,7 +113,7 @@ type Cluster struct {
 	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
 
 	// Json formatted string containing the user overrides for the initial ignition config
-	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty" gorm:"type:text"`
+	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty" gorm:"type:text" secret:"true"`
 
 	// image info
 	// Required: true
@@ -125,7 +128,10 @@ type Cluster struct {
 	InstallCompletedAt strfmt.DateTime `json:"install_completed_at,omitempty" gorm:"type:timestamp with time zone;default:'2000-01-01 00:00:00z'"`
 
 	// JSON-formatted string containing the user overrides for the install-config.yaml file.
-	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text"`
+	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text" secret:"true"`
+
+	// JSON-formatted string containing the policy that the failed host installations of the cluster are automatically retried by.
+	InstallRetryPolicy string `json:"install_retry_policy,omitempty" gorm:"type:text"`
 
 	// The time that this cluster started installation.
 	// Format: date-time
@@ -180,9 +186,12 @@ type Cluster struct {
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
+	// JSON-formatted string containing the installation stage timeouts of the cluster.
+	StageTimeouts string `json:"stage_timeouts,omitempty" gorm:"type:text"`
+
 	// Status of the OpenShift cluster.
 	// Required: true
-	// Enum: [insufficient ready error preparing-for-installation pending-for-input installing finalizing installed adding-hosts cancelled installing-pending-user-action]
+	// Enum: [insufficient ready error preparing-for-installation pending-for-input installing finalizing installed adding-hosts cancelled installing-pending-user-action installing-paused]
 	Status *string `json:"status"`
 
 	// Additional information pertaining to the status of the OpenShift cluster.
@@ -193,6 +202,13 @@ type Cluster struct {
 	// Format: date-time
 	StatusUpdatedAt strfmt.DateTime `json:"status_updated_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// The template that the cluster was registered from.
+	// Format: uuid
+	TemplateID strfmt.UUID `json:"template_id,omitempty"`
+
+	// The version of the template that the cluster was registered from.
+	TemplateVersion int64 `json:"template_version,omitempty"`
+
 	// All hosts associated to this cluster.
 	TotalHostCount int64 `json:"total_host_count,omitempty" gorm:"-"`
 
@@ -206,11 +222,17 @@ type Cluster struct {
 	// user name
 	UserName string `json:"user_name,omitempty"`
 
+	// JSON-formatted string containing the validation policy of the cluster and of its hosts.
+	ValidationPolicy string `json:"validation_policy,omitempty" gorm:"type:text"`
+
 	// JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)
 	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
 
 	// Indicate if virtual IP DHCP allocation mode is enabled.
 	VipDhcpAllocation *bool `json:"vip_dhcp_allocation,omitempty"`
+
+	// JSON-formatted string containing how many of the workers of the cluster must be installed for its installation to succeed.
+	WorkerSuccessThreshold string `json:"worker_success_threshold,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this cluster
@@ -325,6 +347,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTemplateID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -791,7 +817,7 @@ var clusterTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["insufficient","ready","error","preparing-for-installation","pending-for-input","installing","finalizing","installed","adding-hosts","cancelled","installing-pending-user-action"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["insufficient","ready","error","preparing-for-installation","pending-for-input","installing","finalizing","installed","adding-hosts","cancelled","installing-pending-user-action","installing-paused"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -833,6 +859,9 @@ const (
 
 	// ClusterStatusInstallingPendingUserAction captures enum value "installing-pending-user-action"
 	ClusterStatusInstallingPendingUserAction string = "installing-pending-user-action"
+
+	// ClusterStatusInstallingPaused captures enum value "installing-paused"
+	ClusterStatusInstallingPaused string = "installing-paused"
 )
 
 // prop value enum
@@ -879,6 +908,19 @@ func (m *Cluster) validateStatusUpdatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateTemplateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TemplateID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("template_id", "body", "uuid", m.TemplateID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateUpdatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.UpdatedAt) { // not required
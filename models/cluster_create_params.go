@@ -89,6 +89,17 @@ type ClusterCreateParams struct {
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
 
+	// A template whose defaults are used for the parameters that are not set.
+	// Format: uuid
+	TemplateID *strfmt.UUID `json:"template_id,omitempty"`
+
+	// Values substituted for the ${key} placeholders in the string parameters, install config overrides and manifests of the template.
+	TemplateParams map[string]string `json:"template_params,omitempty"`
+
+	// The version of the template to use, the latest version if not set.
+	// Minimum: 1
+	TemplateVersion *int64 `json:"template_version,omitempty"`
+
 	// Indicate if the networking is managed by the user.
 	UserManagedNetworking *bool `json:"user_managed_networking,omitempty"`
 
@@ -140,6 +151,14 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTemplateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTemplateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -354,6 +373,32 @@ func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry
 	return nil
 }
 
+func (m *ClusterCreateParams) validateTemplateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TemplateID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("template_id", "body", "uuid", m.TemplateID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) validateTemplateVersion(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TemplateVersion) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("template_version", "body", int64(*m.TemplateVersion), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ClusterCreateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
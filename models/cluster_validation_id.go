@@ -73,6 +73,9 @@ const (
 
 	// ClusterValidationIDCnvRequirementsSatisfied captures enum value "cnv-requirements-satisfied"
 	ClusterValidationIDCnvRequirementsSatisfied ClusterValidationID = "cnv-requirements-satisfied"
+
+	// ClusterValidationIDDNSDomainNamesResolvedConsistently captures enum value "dns-domain-names-resolved-consistently"
+	ClusterValidationIDDNSDomainNamesResolvedConsistently ClusterValidationID = "dns-domain-names-resolved-consistently"
 )
 
 // for schema
@@ -80,7 +83,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","cnv-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","cnv-requirements-satisfied","dns-domain-names-resolved-consistently"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
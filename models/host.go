@@ -51,6 +51,9 @@ type Host struct {
 	// Additional information about disks, formatted as JSON.
 	DisksInfo string `json:"disks_info,omitempty" gorm:"type:text"`
 
+	// The results of resolving the domain names of the cluster on the host.
+	DomainResolution string `json:"domain_resolution,omitempty" gorm:"type:text"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
@@ -64,7 +67,7 @@ type Host struct {
 	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
 
 	// Json formatted string containing the user overrides for the host's pointer ignition
-	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty" gorm:"type:text"`
+	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty" gorm:"type:text" secret:"true"`
 
 	// Array of image statuses.
 	ImagesStatus string `json:"images_status,omitempty" gorm:"type:text"`
@@ -75,6 +78,9 @@ type Host struct {
 	// Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.
 	InstallationDiskPath string `json:"installation_disk_path,omitempty"`
 
+	// The number of times that the installation of the host was automatically retried.
+	InstallationRetries int64 `json:"installation_retries,omitempty"`
+
 	// installer args
 	InstallerArgs string `json:"installer_args,omitempty"`
 
@@ -108,6 +114,9 @@ type Host struct {
 	// The configured NTP sources on the host.
 	NtpSources string `json:"ntp_sources,omitempty" gorm:"type:text"`
 
+	// The reason of the failure that the host reported while its installation was paused. The failure is retried or final when the installation is resumed.
+	PausedInstallationFailure string `json:"paused_installation_failure,omitempty" gorm:"type:text"`
+
 	// progress
 	Progress *HostProgressInfo `json:"progress,omitempty" gorm:"embedded;embedded_prefix:progress_"`
 
@@ -130,7 +139,7 @@ type Host struct {
 
 	// status
 	// Required: true
-	// Enum: [discovering known disconnected insufficient disabled preparing-for-installation preparing-successful pending-for-input installing installing-in-progress installing-pending-user-action resetting-pending-user-action installed error resetting added-to-existing-cluster cancelled]
+	// Enum: [discovering known disconnected insufficient disabled preparing-for-installation preparing-successful pending-for-input installing installing-in-progress installing-pending-user-action resetting-pending-user-action installed error resetting added-to-existing-cluster cancelled installing-paused]
 	Status *string `json:"status"`
 
 	// status info
@@ -481,7 +490,7 @@ var hostTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["discovering","known","disconnected","insufficient","disabled","preparing-for-installation","preparing-successful","pending-for-input","installing","installing-in-progress","installing-pending-user-action","resetting-pending-user-action","installed","error","resetting","added-to-existing-cluster","cancelled"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["discovering","known","disconnected","insufficient","disabled","preparing-for-installation","preparing-successful","pending-for-input","installing","installing-in-progress","installing-pending-user-action","resetting-pending-user-action","installed","error","resetting","added-to-existing-cluster","cancelled","installing-paused"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -541,6 +550,9 @@ const (
 
 	// HostStatusCancelled captures enum value "cancelled"
 	HostStatusCancelled string = "cancelled"
+
+	// HostStatusInstallingPaused captures enum value "installing-paused"
+	HostStatusInstallingPaused string = "installing-paused"
 )
 
 // prop value enum
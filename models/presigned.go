@@ -17,6 +17,9 @@ import (
 // swagger:model presigned
 type Presigned struct {
 
+	// The hex-encoded SHA-256 digest of the file, when it is known.
+	Sha256 string `json:"sha256,omitempty"`
+
 	// url
 	// Required: true
 	URL *string `json:"url"`
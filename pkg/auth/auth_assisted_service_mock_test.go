@@ -5,6 +5,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openshift/assisted-service/internal/common"
@@ -12,10 +13,13 @@ import (
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
+	auditapi "github.com/openshift/assisted-service/restapi/operations/audit"
+	templatesapi "github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	eventsapi "github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	managed_domains_api "github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	versionsapi "github.com/openshift/assisted-service/restapi/operations/versions"
+	webhooksapi "github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 type fakeInventory struct{}
@@ -32,6 +36,14 @@ func (f fakeInventory) Prog(ctx context.Context, params installer.CancelInstalla
 	return installer.NewCancelInstallationAccepted()
 }
 
+func (f fakeInventory) PauseInstallation(ctx context.Context, params installer.PauseInstallationParams) middleware.Responder {
+	return installer.NewPauseInstallationAccepted()
+}
+
+func (f fakeInventory) ResumeInstallation(ctx context.Context, params installer.ResumeInstallationParams) middleware.Responder {
+	return installer.NewResumeInstallationAccepted()
+}
+
 func (f fakeInventory) CompleteInstallation(ctx context.Context, params installer.CompleteInstallationParams) middleware.Responder {
 	return installer.NewCompleteInstallationAccepted()
 }
@@ -108,10 +120,18 @@ func (f fakeInventory) GenerateClusterISO(ctx context.Context, params installer.
 	return installer.NewGenerateClusterISOCreated()
 }
 
+func (f fakeInventory) GetChecksumsSigningKey(ctx context.Context, params installer.GetChecksumsSigningKeyParams) middleware.Responder {
+	return installer.NewGetChecksumsSigningKeyOK()
+}
+
 func (f fakeInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
 	return installer.NewGetClusterOK()
 }
 
+func (f fakeInventory) GetClusterChecksums(ctx context.Context, params installer.GetClusterChecksumsParams) middleware.Responder {
+	return installer.NewGetClusterChecksumsOK()
+}
+
 func (f fakeInventory) GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder {
 	return installer.NewGetCredentialsOK()
 }
@@ -188,6 +208,54 @@ func (f fakeInventory) UpdateClusterInstallConfig(ctx context.Context, params in
 	return installer.NewUpdateClusterInstallConfigCreated()
 }
 
+func (f fakeInventory) GetClusterValidationPolicy(ctx context.Context, params installer.GetClusterValidationPolicyParams) middleware.Responder {
+	return installer.NewGetClusterValidationPolicyOK()
+}
+
+func (f fakeInventory) UpdateClusterValidationPolicy(ctx context.Context, params installer.UpdateClusterValidationPolicyParams) middleware.Responder {
+	return installer.NewUpdateClusterValidationPolicyOK()
+}
+
+func (f fakeInventory) GetClusterStageTimeouts(ctx context.Context, params installer.GetClusterStageTimeoutsParams) middleware.Responder {
+	return installer.NewGetClusterStageTimeoutsOK()
+}
+
+func (f fakeInventory) UpdateClusterStageTimeouts(ctx context.Context, params installer.UpdateClusterStageTimeoutsParams) middleware.Responder {
+	return installer.NewUpdateClusterStageTimeoutsOK()
+}
+
+func (f fakeInventory) GetClusterInstallRetryPolicy(ctx context.Context, params installer.GetClusterInstallRetryPolicyParams) middleware.Responder {
+	return installer.NewGetClusterInstallRetryPolicyOK()
+}
+
+func (f fakeInventory) UpdateClusterInstallRetryPolicy(ctx context.Context, params installer.UpdateClusterInstallRetryPolicyParams) middleware.Responder {
+	return installer.NewUpdateClusterInstallRetryPolicyOK()
+}
+
+func (f fakeInventory) GetClusterWorkerSuccessThreshold(ctx context.Context, params installer.GetClusterWorkerSuccessThresholdParams) middleware.Responder {
+	return installer.NewGetClusterWorkerSuccessThresholdOK()
+}
+
+func (f fakeInventory) UpdateClusterWorkerSuccessThreshold(ctx context.Context, params installer.UpdateClusterWorkerSuccessThresholdParams) middleware.Responder {
+	return installer.NewUpdateClusterWorkerSuccessThresholdOK()
+}
+
+func (f fakeInventory) ExportClusterDefinition(ctx context.Context, params installer.ExportClusterDefinitionParams) middleware.Responder {
+	return installer.NewExportClusterDefinitionOK()
+}
+
+func (f fakeInventory) ImportClusterDefinition(ctx context.Context, params installer.ImportClusterDefinitionParams) middleware.Responder {
+	return installer.NewImportClusterDefinitionCreated()
+}
+
+func (f fakeInventory) ValidateHypotheticalHost(ctx context.Context, params installer.ValidateHypotheticalHostParams) middleware.Responder {
+	return installer.NewValidateHypotheticalHostOK()
+}
+
+func (f fakeInventory) ListStateMachines(ctx context.Context, params installer.ListStateMachinesParams) middleware.Responder {
+	return installer.NewListStateMachinesOK()
+}
+
 func (f fakeInventory) UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder {
 	return installer.NewUpdateHostInstallProgressOK()
 }
@@ -276,6 +344,14 @@ func (f fakeInventory) GetClusterHostRequirements(ctx context.Context, params in
 	return installer.NewGetClusterHostRequirementsOK().WithPayload(models.ClusterHostRequirementsList{})
 }
 
+func (f fakeInventory) SearchClusterLogs(ctx context.Context, params installer.SearchClusterLogsParams) middleware.Responder {
+	return installer.NewSearchClusterLogsOK()
+}
+
+func (f fakeInventory) ListClusterLogArtifacts(ctx context.Context, params installer.ListClusterLogArtifactsParams) middleware.Responder {
+	return installer.NewListClusterLogArtifactsOK()
+}
+
 func (f fakeInventory) ResetHostValidation(ctx context.Context, params installer.ResetHostValidationParams) middleware.Responder {
 	return installer.NewResetHostValidationOK()
 }
@@ -290,6 +366,84 @@ func (f fakeEventsAPI) ListEvents(
 	return eventsapi.NewListEventsOK()
 }
 
+func (f fakeEventsAPI) StreamEvents(
+	_ context.Context,
+	_ eventsapi.StreamEventsParams) middleware.Responder {
+	return eventsapi.NewStreamEventsOK().WithPayload(ioutil.NopCloser(strings.NewReader("")))
+}
+
+type fakeAuditAPI struct{}
+
+func (f fakeAuditAPI) ListAuditRecords(
+	_ context.Context,
+	_ auditapi.ListAuditRecordsParams) middleware.Responder {
+	return auditapi.NewListAuditRecordsOK()
+}
+
+type fakeWebhooksAPI struct{}
+
+func (f fakeWebhooksAPI) RegisterWebhook(
+	_ context.Context,
+	_ webhooksapi.RegisterWebhookParams) middleware.Responder {
+	return webhooksapi.NewRegisterWebhookCreated()
+}
+
+func (f fakeWebhooksAPI) ListWebhooks(
+	_ context.Context,
+	_ webhooksapi.ListWebhooksParams) middleware.Responder {
+	return webhooksapi.NewListWebhooksOK()
+}
+
+func (f fakeWebhooksAPI) DeregisterWebhook(
+	_ context.Context,
+	_ webhooksapi.DeregisterWebhookParams) middleware.Responder {
+	return webhooksapi.NewDeregisterWebhookNoContent()
+}
+
+func (f fakeWebhooksAPI) ListWebhookDeliveries(
+	_ context.Context,
+	_ webhooksapi.ListWebhookDeliveriesParams) middleware.Responder {
+	return webhooksapi.NewListWebhookDeliveriesOK()
+}
+
+func (f fakeWebhooksAPI) ReplayWebhookDelivery(
+	_ context.Context,
+	_ webhooksapi.ReplayWebhookDeliveryParams) middleware.Responder {
+	return webhooksapi.NewReplayWebhookDeliveryAccepted()
+}
+
+type fakeClusterTemplatesAPI struct{}
+
+func (f fakeClusterTemplatesAPI) CreateClusterTemplate(
+	_ context.Context,
+	_ templatesapi.CreateClusterTemplateParams) middleware.Responder {
+	return templatesapi.NewCreateClusterTemplateCreated()
+}
+
+func (f fakeClusterTemplatesAPI) ListClusterTemplates(
+	_ context.Context,
+	_ templatesapi.ListClusterTemplatesParams) middleware.Responder {
+	return templatesapi.NewListClusterTemplatesOK()
+}
+
+func (f fakeClusterTemplatesAPI) GetClusterTemplate(
+	_ context.Context,
+	_ templatesapi.GetClusterTemplateParams) middleware.Responder {
+	return templatesapi.NewGetClusterTemplateOK()
+}
+
+func (f fakeClusterTemplatesAPI) UpdateClusterTemplate(
+	_ context.Context,
+	_ templatesapi.UpdateClusterTemplateParams) middleware.Responder {
+	return templatesapi.NewUpdateClusterTemplateOK()
+}
+
+func (f fakeClusterTemplatesAPI) DeleteClusterTemplate(
+	_ context.Context,
+	_ templatesapi.DeleteClusterTemplateParams) middleware.Responder {
+	return templatesapi.NewDeleteClusterTemplateNoContent()
+}
+
 type fakeVersionsAPI struct{}
 
 func (f fakeVersionsAPI) ListComponentVersions(
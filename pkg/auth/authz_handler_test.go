@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-openapi/runtime"
+	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/runtime/security"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -20,10 +21,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/client/audit"
+	"github.com/openshift/assisted-service/client/cluster_templates"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/client/webhooks"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -224,6 +228,9 @@ var _ = Describe("authz", func() {
 			Logger:                logrus.Printf,
 			VersionsAPI:           fakeVersionsAPI{},
 			ManagedDomainsAPI:     fakeManagedDomainsAPI{},
+			WebhooksAPI:           fakeWebhooksAPI{},
+			AuditAPI:              fakeAuditAPI{},
+			ClusterTemplatesAPI:   fakeClusterTemplatesAPI{},
 			InnerMiddleware:       nil,
 		})
 	Expect(err).To(BeNil())
@@ -241,6 +248,8 @@ var _ = Describe("authz", func() {
 				URL:      srvUrl,
 				AuthInfo: UserAuthHeaderWriter("bearer " + userToken),
 			})
+		// The generated client has no consumer for the event stream responses
+		userClient.Transport.(*rtclient.Runtime).Consumers["text/event-stream"] = runtime.ByteStreamConsumer()
 		agentClient = client.New(
 			client.Config{
 				URL:      srvUrl,
@@ -296,12 +305,31 @@ var _ = Describe("authz", func() {
 		verifyResponseErrorCode(err, false)
 	})
 
-	tests := []struct {
+	type authzTest struct {
 		name             string
 		allowedRoles     []ocm.RoleType
 		apiCall          func(ctx context.Context, cli *client.AssistedInstall) error
 		agentAuthSupport bool
-	}{
+	}
+
+	// clusterSettingTests covers the get and update APIs of a per-cluster setting, which every user can read and
+	// only the owner can change
+	clusterSettingTests := func(setting string, get, update func(ctx context.Context, cli *client.AssistedInstall) error) []authzTest {
+		return []authzTest{
+			{
+				name:         fmt.Sprintf("get cluster %s", setting),
+				allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+				apiCall:      get,
+			},
+			{
+				name:         fmt.Sprintf("update cluster %s", setting),
+				allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+				apiCall:      update,
+			},
+		}
+	}
+
+	tests := []authzTest{
 		{
 			name:         "register cluster",
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
@@ -354,6 +382,16 @@ var _ = Describe("authz", func() {
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
 			apiCall:      getPresignedForClusterFiles,
 		},
+		{
+			name:         "get cluster checksums",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      getClusterChecksums,
+		},
+		{
+			name:         "get checksums signing key",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      getChecksumsSigningKey,
+		},
 		{
 			name:         "get credentials",
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
@@ -374,6 +412,21 @@ var _ = Describe("authz", func() {
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
 			apiCall:      updateClusterInstallConfig,
 		},
+		{
+			name:         "validate hypothetical host",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      validateHypotheticalHost,
+		},
+		{
+			name:         "export cluster definition",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      exportClusterDefinition,
+		},
+		{
+			name:         "import cluster definition",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      importClusterDefinition,
+		},
 		{
 			name:             "upload cluster ingress cert",
 			apiCall:          uploadClusterIngressCert,
@@ -389,6 +442,16 @@ var _ = Describe("authz", func() {
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
 			apiCall:      cancelInstallation,
 		},
+		{
+			name:         "pause installation",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      pauseInstallation,
+		},
+		{
+			name:         "resume installation",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      resumeInstallation,
+		},
 		{
 			name:         "reset cluster",
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
@@ -465,6 +528,16 @@ var _ = Describe("authz", func() {
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
 			apiCall:      downloadClusterLogs,
 		},
+		{
+			name:         "search cluster logs",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      searchClusterLogs,
+		},
+		{
+			name:         "list cluster log artifacts",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      listClusterLogArtifacts,
+		},
 		{
 			name:         "get free addresses",
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
@@ -475,6 +548,71 @@ var _ = Describe("authz", func() {
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
 			apiCall:      listEvents,
 		},
+		{
+			name:         "stream events",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      streamEvents,
+		},
+		{
+			name:         "register webhook",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      registerWebhook,
+		},
+		{
+			name:         "list webhooks",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      listWebhooks,
+		},
+		{
+			name:         "deregister webhook",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      deregisterWebhook,
+		},
+		{
+			name:         "list webhook deliveries",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      listWebhookDeliveries,
+		},
+		{
+			name:         "replay webhook delivery",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      replayWebhookDelivery,
+		},
+		{
+			name:         "create cluster template",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      createClusterTemplate,
+		},
+		{
+			name:         "list cluster templates",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      listClusterTemplates,
+		},
+		{
+			name:         "get cluster template",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:      getClusterTemplate,
+		},
+		{
+			name:         "update cluster template",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      updateClusterTemplate,
+		},
+		{
+			name:         "delete cluster template",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:      deleteClusterTemplate,
+		},
+		{
+			name:         "list audit records",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole},
+			apiCall:      listAuditRecords,
+		},
+		{
+			name:         "list state machines",
+			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole},
+			apiCall:      listStateMachines,
+		},
 		{
 			name:         "list managed domains",
 			allowedRoles: []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
@@ -512,6 +650,11 @@ var _ = Describe("authz", func() {
 		},
 	}
 
+	tests = append(tests, clusterSettingTests("validation policy", getClusterValidationPolicy, updateClusterValidationPolicy)...)
+	tests = append(tests, clusterSettingTests("stage timeouts", getClusterStageTimeouts, updateClusterStageTimeouts)...)
+	tests = append(tests, clusterSettingTests("install retry policy", getClusterInstallRetryPolicy, updateClusterInstallRetryPolicy)...)
+	tests = append(tests, clusterSettingTests("worker success threshold", getClusterWorkerSuccessThreshold, updateClusterWorkerSuccessThreshold)...)
+
 	for _, tt := range tests {
 		tt := tt
 		It(fmt.Sprintf("test %s", tt.name), func() {
@@ -654,7 +797,7 @@ func downloadClusterISO(ctx context.Context, cli *client.AssistedInstall) error
 	if err != nil {
 		return err
 	}
-	_, err = cli.Installer.DownloadClusterISO(
+	_, _, err = cli.Installer.DownloadClusterISO(
 		ctx,
 		&installer.DownloadClusterISOParams{
 			ClusterID: strfmt.UUID(uuid.New().String()),
@@ -677,7 +820,7 @@ func downloadClusterFiles(ctx context.Context, cli *client.AssistedInstall) erro
 	if err != nil {
 		return err
 	}
-	_, err = cli.Installer.DownloadClusterFiles(
+	_, _, err = cli.Installer.DownloadClusterFiles(
 		ctx,
 		&installer.DownloadClusterFilesParams{
 			ClusterID: strfmt.UUID(uuid.New().String()),
@@ -697,6 +840,20 @@ func getPresignedForClusterFiles(ctx context.Context, cli *client.AssistedInstal
 	return err
 }
 
+func getChecksumsSigningKey(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetChecksumsSigningKey(ctx, &installer.GetChecksumsSigningKeyParams{})
+	return err
+}
+
+func getClusterChecksums(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetClusterChecksums(
+		ctx,
+		&installer.GetClusterChecksumsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
 func getCredentials(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.Installer.GetCredentials(
 		ctx,
@@ -738,6 +895,122 @@ func updateClusterInstallConfig(ctx context.Context, cli *client.AssistedInstall
 	return err
 }
 
+func getClusterValidationPolicy(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetClusterValidationPolicy(
+		ctx,
+		&installer.GetClusterValidationPolicyParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func updateClusterValidationPolicy(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.UpdateClusterValidationPolicy(
+		ctx,
+		&installer.UpdateClusterValidationPolicyParams{
+			ClusterID:        strfmt.UUID(uuid.New().String()),
+			ValidationPolicy: &models.ValidationPolicy{},
+		})
+	return err
+}
+
+func getClusterStageTimeouts(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetClusterStageTimeouts(
+		ctx,
+		&installer.GetClusterStageTimeoutsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func updateClusterStageTimeouts(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.UpdateClusterStageTimeouts(
+		ctx,
+		&installer.UpdateClusterStageTimeoutsParams{
+			ClusterID:     strfmt.UUID(uuid.New().String()),
+			StageTimeouts: &models.StageTimeouts{},
+		})
+	return err
+}
+
+func getClusterInstallRetryPolicy(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetClusterInstallRetryPolicy(
+		ctx,
+		&installer.GetClusterInstallRetryPolicyParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func updateClusterInstallRetryPolicy(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.UpdateClusterInstallRetryPolicy(
+		ctx,
+		&installer.UpdateClusterInstallRetryPolicyParams{
+			ClusterID:          strfmt.UUID(uuid.New().String()),
+			InstallRetryPolicy: &models.InstallRetryPolicy{},
+		})
+	return err
+}
+
+func getClusterWorkerSuccessThreshold(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.GetClusterWorkerSuccessThreshold(
+		ctx,
+		&installer.GetClusterWorkerSuccessThresholdParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func updateClusterWorkerSuccessThreshold(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.UpdateClusterWorkerSuccessThreshold(
+		ctx,
+		&installer.UpdateClusterWorkerSuccessThresholdParams{
+			ClusterID:              strfmt.UUID(uuid.New().String()),
+			WorkerSuccessThreshold: &models.WorkerSuccessThreshold{},
+		})
+	return err
+}
+
+func validateHypotheticalHost(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.ValidateHypotheticalHost(
+		ctx,
+		&installer.ValidateHypotheticalHostParams{
+			HypotheticalHost: &models.HypotheticalHost{
+				OpenshiftVersion: "4.8",
+				Inventory:        &models.Inventory{},
+			},
+		})
+	return err
+}
+
+func exportClusterDefinition(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.ExportClusterDefinition(
+		ctx,
+		&installer.ExportClusterDefinitionParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func importClusterDefinition(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.ImportClusterDefinition(
+		ctx,
+		&installer.ImportClusterDefinitionParams{
+			ImportParams: &models.ClusterDefinitionImportParams{
+				Definition: &models.ClusterDefinition{
+					Version: swag.String(models.ClusterDefinitionVersionV1),
+					ClusterParams: &models.ClusterCreateParams{
+						Name:             swag.String("test"),
+						OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+						PullSecret:       swag.String(""),
+					},
+				},
+				PullSecret: swag.String(`{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dXNlcjpwYXNzd29yZAo=\",\"email\":\"r@r.com\"}}}`),
+			},
+		})
+	return err
+}
+
 func uploadClusterIngressCert(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.Installer.UploadClusterIngressCert(
 		ctx,
@@ -765,6 +1038,24 @@ func cancelInstallation(ctx context.Context, cli *client.AssistedInstall) error
 	return err
 }
 
+func pauseInstallation(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.PauseInstallation(
+		ctx,
+		&installer.PauseInstallationParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func resumeInstallation(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.ResumeInstallation(
+		ctx,
+		&installer.ResumeInstallationParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
 func resetCluster(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.Installer.ResetCluster(
 		ctx,
@@ -934,7 +1225,7 @@ func downloadClusterLogs(ctx context.Context, cli *client.AssistedInstall) error
 	if err != nil {
 		return err
 	}
-	_, err = cli.Installer.DownloadClusterLogs(
+	_, _, err = cli.Installer.DownloadClusterLogs(
 		ctx,
 		&installer.DownloadClusterLogsParams{
 			ClusterID: strfmt.UUID(uuid.New().String()),
@@ -943,6 +1234,24 @@ func downloadClusterLogs(ctx context.Context, cli *client.AssistedInstall) error
 	return err
 }
 
+func searchClusterLogs(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.SearchClusterLogs(
+		ctx,
+		&installer.SearchClusterLogsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func listClusterLogArtifacts(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.ListClusterLogArtifacts(
+		ctx,
+		&installer.ListClusterLogArtifactsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
 func getFreeAddresses(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.Installer.GetFreeAddresses(
 		ctx,
@@ -964,6 +1273,125 @@ func listEvents(ctx context.Context, cli *client.AssistedInstall) error {
 	return err
 }
 
+func streamEvents(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Events.StreamEvents(
+		ctx,
+		&events.StreamEventsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		},
+		ioutil.Discard)
+	return err
+}
+
+func registerWebhook(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Webhooks.RegisterWebhook(
+		ctx,
+		&webhooks.RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{
+				URL:    swag.String("https://example.com/hook"),
+				Secret: swag.String("0123456789abcdef"),
+			},
+		})
+	return err
+}
+
+func createClusterTemplate(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ClusterTemplates.CreateClusterTemplate(
+		ctx,
+		&cluster_templates.CreateClusterTemplateParams{
+			NewClusterTemplateParams: &models.ClusterTemplateCreateParams{
+				Name: swag.String("edge"),
+			},
+		})
+	return err
+}
+
+func listClusterTemplates(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ClusterTemplates.ListClusterTemplates(
+		ctx,
+		&cluster_templates.ListClusterTemplatesParams{})
+	return err
+}
+
+func getClusterTemplate(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ClusterTemplates.GetClusterTemplate(
+		ctx,
+		&cluster_templates.GetClusterTemplateParams{
+			ClusterTemplateID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func updateClusterTemplate(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ClusterTemplates.UpdateClusterTemplate(
+		ctx,
+		&cluster_templates.UpdateClusterTemplateParams{
+			ClusterTemplateID: strfmt.UUID(uuid.New().String()),
+			ClusterTemplateUpdateParams: &models.ClusterTemplateCreateParams{
+				Name: swag.String("edge"),
+			},
+		})
+	return err
+}
+
+func deleteClusterTemplate(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.ClusterTemplates.DeleteClusterTemplate(
+		ctx,
+		&cluster_templates.DeleteClusterTemplateParams{
+			ClusterTemplateID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func listWebhooks(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Webhooks.ListWebhooks(
+		ctx,
+		&webhooks.ListWebhooksParams{})
+	return err
+}
+
+func deregisterWebhook(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Webhooks.DeregisterWebhook(
+		ctx,
+		&webhooks.DeregisterWebhookParams{
+			WebhookID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func listWebhookDeliveries(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Webhooks.ListWebhookDeliveries(
+		ctx,
+		&webhooks.ListWebhookDeliveriesParams{
+			WebhookID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func replayWebhookDelivery(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Webhooks.ReplayWebhookDelivery(
+		ctx,
+		&webhooks.ReplayWebhookDeliveryParams{
+			WebhookID:  strfmt.UUID(uuid.New().String()),
+			DeliveryID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func listAuditRecords(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Audit.ListAuditRecords(
+		ctx,
+		&audit.ListAuditRecordsParams{})
+	return err
+}
+
+func listStateMachines(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.ListStateMachines(
+		ctx,
+		&installer.ListStateMachinesParams{})
+	return err
+}
+
 func listManagedDomains(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.ManagedDomains.ListManagedDomains(
 		ctx,
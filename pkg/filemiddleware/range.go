@@ -0,0 +1,163 @@
+package filemiddleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+)
+
+// AcceptRanges is the value of the Accept-Ranges header of the files that support range requests
+const AcceptRanges = "bytes"
+
+// RangeNotSatisfiableError is returned when the requested range doesn't overlap the file
+type RangeNotSatisfiableError struct {
+	// Size is the size of the whole file
+	Size int64
+}
+
+func (e *RangeNotSatisfiableError) Error() string {
+	return fmt.Sprintf("The requested range is not satisfiable, the file is %d bytes long", e.Size)
+}
+
+// ContentRange returns the value of the Content-Range header of the response
+func (e *RangeNotSatisfiableError) ContentRange() string {
+	return fmt.Sprintf("%s */%d", AcceptRanges, e.Size)
+}
+
+// ByteRange is a part of a file
+type ByteRange struct {
+	Offset int64
+	Length int64
+}
+
+// ParseRange returns the part of a file with the given size and entity tag that was requested by the Range and
+// If-Range headers. It returns nil when the whole file should be sent, which is the case when no range or several
+// ranges are requested, when the Range header is invalid and when the If-Range header doesn't match the entity tag.
+func ParseRange(rangeHeader, ifRange *string, size int64, etag string) (*ByteRange, error) {
+	if rangeHeader == nil || *rangeHeader == "" {
+		return nil, nil
+	}
+	// If-Range may also hold a date, which isn't supported, so anything but the current strong entity tag means
+	// that the file changed since the client started downloading it
+	if ifRange != nil && *ifRange != "" && (*ifRange != etag || strings.HasPrefix(etag, "W/")) {
+		return nil, nil
+	}
+	if !strings.HasPrefix(*rangeHeader, AcceptRanges+"=") {
+		return nil, nil
+	}
+	spec := strings.TrimSpace(strings.TrimPrefix(*rangeHeader, AcceptRanges+"="))
+	if strings.Contains(spec, ",") {
+		return nil, nil
+	}
+	parts := strings.SplitN(spec, "-", 2)
+	if len(parts) != 2 {
+		return nil, nil
+	}
+	startStr, endStr := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+	if startStr == "" {
+		// A suffix range, which requests the last bytes of the file
+		suffixLength, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil || suffixLength < 0 {
+			return nil, nil
+		}
+		if suffixLength == 0 || size == 0 {
+			return nil, &RangeNotSatisfiableError{Size: size}
+		}
+		if suffixLength > size {
+			suffixLength = size
+		}
+		return &ByteRange{Offset: size - suffixLength, Length: suffixLength}, nil
+	}
+
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start < 0 {
+		return nil, nil
+	}
+	end := size - 1
+	if endStr != "" {
+		end, err = strconv.ParseInt(endStr, 10, 64)
+		if err != nil || end < start {
+			return nil, nil
+		}
+	}
+	if start >= size {
+		return nil, &RangeNotSatisfiableError{Size: size}
+	}
+	if end >= size {
+		end = size - 1
+	}
+	return &ByteRange{Offset: start, Length: end - start + 1}, nil
+}
+
+// Download is a file, or the part of it that was requested, that is ready to be sent
+type Download struct {
+	Reader io.ReadCloser
+	// Size is the size of the whole file
+	Size int64
+	ETag string
+	// Range is the part of the file that is sent, or nil when the whole file is sent
+	Range *ByteRange
+}
+
+// IsPartial returns whether only a part of the file is sent
+func (d *Download) IsPartial() bool {
+	return d.Range != nil
+}
+
+// Length returns the number of bytes that are sent
+func (d *Download) Length() int64 {
+	if d.Range != nil {
+		return d.Range.Length
+	}
+	return d.Size
+}
+
+// ContentRange returns the value of the Content-Range header of a partial response
+func (d *Download) ContentRange() string {
+	if d.Range == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %d-%d/%d", AcceptRanges, d.Range.Offset, d.Range.Offset+d.Range.Length-1, d.Size)
+}
+
+// Open opens the part of a file with the given size and entity tag that was requested by the Range and If-Range
+// headers, or the whole file. The openRange function opens the given number of bytes of the file, starting at the
+// given offset. It returns a RangeNotSatisfiableError when the requested range doesn't overlap the file.
+func Open(size int64, etag string, rangeHeader, ifRange *string, openRange func(offset, length int64) (io.ReadCloser, error)) (*Download, error) {
+	byteRange, err := ParseRange(rangeHeader, ifRange, size, etag)
+	if err != nil {
+		return nil, err
+	}
+	download := &Download{Size: size, ETag: etag, Range: byteRange}
+	if download.Length() == 0 {
+		download.Reader = ioutil.NopCloser(bytes.NewReader(nil))
+		return download, nil
+	}
+	offset := int64(0)
+	if byteRange != nil {
+		offset = byteRange.Offset
+	}
+	if download.Reader, err = openRange(offset, download.Length()); err != nil {
+		return nil, err
+	}
+	return download, nil
+}
+
+// OpenObject opens the part of a stored object that was requested by the Range and If-Range headers, or the whole
+// object
+func OpenObject(ctx context.Context, objectHandler s3wrapper.API, objectName string, rangeHeader, ifRange *string) (*Download, error) {
+	info, err := objectHandler.GetObjectInfo(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return Open(info.Size, info.ETag, rangeHeader, ifRange, func(offset, length int64) (io.ReadCloser, error) {
+		return objectHandler.DownloadRange(ctx, objectName, offset, length)
+	})
+}
@@ -0,0 +1,97 @@
+package filemiddleware
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func TestFileMiddleware(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "File middleware tests Suite")
+}
+
+var _ = Describe("ParseRange", func() {
+	const (
+		size = int64(1000)
+		etag = `"abc"`
+	)
+
+	table.DescribeTable("ranges",
+		func(rangeHeader, ifRange *string, expected *ByteRange) {
+			byteRange, err := ParseRange(rangeHeader, ifRange, size, etag)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(byteRange).To(Equal(expected))
+		},
+		table.Entry("no range", nil, nil, nil),
+		table.Entry("empty range", swag.String(""), nil, nil),
+		table.Entry("closed range", swag.String("bytes=100-199"), nil, &ByteRange{Offset: 100, Length: 100}),
+		table.Entry("open range", swag.String("bytes=900-"), nil, &ByteRange{Offset: 900, Length: 100}),
+		table.Entry("range past the end", swag.String("bytes=900-2000"), nil, &ByteRange{Offset: 900, Length: 100}),
+		table.Entry("suffix range", swag.String("bytes=-10"), nil, &ByteRange{Offset: 990, Length: 10}),
+		table.Entry("suffix range larger than the file", swag.String("bytes=-2000"), nil, &ByteRange{Offset: 0, Length: size}),
+		table.Entry("matching If-Range", swag.String("bytes=0-9"), swag.String(etag), &ByteRange{Offset: 0, Length: 10}),
+		table.Entry("different If-Range", swag.String("bytes=0-9"), swag.String(`"def"`), nil),
+		table.Entry("date in If-Range", swag.String("bytes=0-9"), swag.String("Wed, 21 Oct 2015 07:28:00 GMT"), nil),
+		table.Entry("several ranges", swag.String("bytes=0-9,20-29"), nil, nil),
+		table.Entry("other unit", swag.String("items=0-9"), nil, nil),
+		table.Entry("reversed range", swag.String("bytes=9-0"), nil, nil),
+		table.Entry("invalid range", swag.String("bytes=a-b"), nil, nil),
+	)
+
+	table.DescribeTable("unsatisfiable ranges",
+		func(rangeHeader string) {
+			_, err := ParseRange(&rangeHeader, nil, size, etag)
+			Expect(err).To(Equal(&RangeNotSatisfiableError{Size: size}))
+		},
+		table.Entry("start at the end", "bytes=1000-"),
+		table.Entry("start past the end", "bytes=2000-3000"),
+		table.Entry("empty suffix", "bytes=-0"),
+	)
+})
+
+var _ = Describe("Open", func() {
+	contents := []byte("0123456789")
+
+	openRange := func(offset, length int64) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(contents[offset : offset+length])), nil
+	}
+
+	It("opens the whole file", func() {
+		download, err := Open(int64(len(contents)), `"abc"`, nil, nil, openRange)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(download.IsPartial()).To(BeFalse())
+		Expect(download.Length()).To(Equal(int64(len(contents))))
+		Expect(ioutil.ReadAll(download.Reader)).To(Equal(contents))
+	})
+
+	It("opens the requested range", func() {
+		download, err := Open(int64(len(contents)), `"abc"`, swag.String("bytes=2-4"), nil, openRange)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(download.IsPartial()).To(BeTrue())
+		Expect(download.Length()).To(Equal(int64(3)))
+		Expect(download.ContentRange()).To(Equal("bytes 2-4/10"))
+		Expect(ioutil.ReadAll(download.Reader)).To(Equal([]byte("234")))
+	})
+
+	It("doesn't open empty files", func() {
+		download, err := Open(0, `"abc"`, nil, nil, func(offset, length int64) (io.ReadCloser, error) {
+			Fail("empty files should not be opened")
+			return nil, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.ReadAll(download.Reader)).To(BeEmpty())
+	})
+
+	It("fails with unsatisfiable ranges", func() {
+		_, err := Open(int64(len(contents)), `"abc"`, swag.String("bytes=20-"), nil, openRange)
+		Expect(err).To(BeAssignableToTypeOf(&RangeNotSatisfiableError{}))
+		Expect(err.(*RangeNotSatisfiableError).ContentRange()).To(Equal("bytes */10"))
+	})
+})
@@ -0,0 +1,573 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/versions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	azureEndpointSuffix = ".blob.core.windows.net"
+	// azureCopyBlockSize is the size of the blocks copied from the base ISO when creating the ISO of a cluster
+	azureCopyBlockSize = 64 * 1024 * 1024
+)
+
+type AzureConfig struct {
+	AccountName string `envconfig:"AZURE_STORAGE_ACCOUNT"`
+	AccountKey  string `envconfig:"AZURE_STORAGE_KEY"`
+	// EndpointURL defaults to https://<account>.blob.core.windows.net, set it for emulators such as Azurite
+	EndpointURL string `envconfig:"AZURE_STORAGE_ENDPOINT_URL"`
+	Container   string `envconfig:"AZURE_STORAGE_CONTAINER"`
+
+	// Warning - the files stored in this container are publicly viewable and therefore
+	// should only be used for storing RHCOS image files that are readily available on the Internet
+	PublicContainer string `envconfig:"AZURE_STORAGE_CONTAINER_PUBLIC"`
+}
+
+var _ API = &AzureClient{}
+
+// AzureClient stores the objects as block blobs in Azure Blob Storage
+type AzureClient struct {
+	log              logrus.FieldLogger
+	cfg              *AzureConfig
+	credential       *azblob.SharedKeyCredential
+	container        azblob.ContainerURL
+	publicContainer  azblob.ContainerURL
+	isoAreas         isoAreaCache
+	versionsHandler  versions.Handler
+	isoEditorFactory isoeditor.Factory
+}
+
+// NewAzureClient creates a new Azure Blob Storage client using the defined env variables
+func NewAzureClient(cfg *AzureConfig, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory) *AzureClient {
+	credential, err := azblob.NewSharedKeyCredential(cfg.AccountName, cfg.AccountKey)
+	if err != nil {
+		logger.WithError(err).Error("failed to create azure storage credential")
+		return nil
+	}
+	endpointURL := cfg.EndpointURL
+	if endpointURL == "" {
+		endpointURL = fmt.Sprintf("https://%s%s", cfg.AccountName, azureEndpointSuffix)
+	}
+	serviceURL, err := url.Parse(endpointURL)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to parse azure storage endpoint %s", endpointURL)
+		return nil
+	}
+	service := azblob.NewServiceURL(*serviceURL, azblob.NewPipeline(credential, azblob.PipelineOptions{}))
+	return &AzureClient{
+		log:              logger,
+		cfg:              cfg,
+		credential:       credential,
+		container:        service.NewContainerURL(cfg.Container),
+		publicContainer:  service.NewContainerURL(cfg.PublicContainer),
+		versionsHandler:  versionsHandler,
+		isoEditorFactory: isoEditorFactory,
+	}
+}
+
+// IsAwsS3 reports whether presigned URLs are reachable by the users, which is the case for the Azure cloud but not
+// for emulators
+func (a *AzureClient) IsAwsS3() bool {
+	return a.cfg.EndpointURL == "" || strings.HasSuffix(strings.TrimSuffix(a.cfg.EndpointURL, "/"), azureEndpointSuffix)
+}
+
+func (a *AzureClient) createContainer(container azblob.ContainerURL, publicAccess azblob.PublicAccessType) error {
+	_, err := container.Create(context.Background(), azblob.Metadata{}, publicAccess)
+	if err != nil && !isAzureServiceCode(err, azblob.ServiceCodeContainerAlreadyExists) {
+		return errors.Wrapf(err, "Failed to create container %s", container.String())
+	}
+	return nil
+}
+
+func (a *AzureClient) CreateBucket() error {
+	return a.createContainer(a.container, azblob.PublicAccessNone)
+}
+
+func (a *AzureClient) CreatePublicBucket() error {
+	return a.createContainer(a.publicContainer, azblob.PublicAccessBlob)
+}
+
+func (a *AzureClient) uploadStream(ctx context.Context, reader io.Reader, objectName string, container azblob.ContainerURL) error {
+	log := logutil.FromContext(ctx, a.log)
+	_, err := azblob.UploadStreamToBlockBlob(ctx, reader, container.NewBlockBlobURL(objectName), azblob.UploadStreamToBlockBlobOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to container %s", objectName, container.String())
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to container %s", objectName, container.String())
+	return nil
+}
+
+func (a *AzureClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return a.uploadStream(ctx, reader, objectName, a.container)
+}
+
+func (a *AzureClient) UploadStreamToPublicBucket(ctx context.Context, reader io.Reader, objectName string) error {
+	return a.uploadStream(ctx, reader, objectName, a.publicContainer)
+}
+
+func (a *AzureClient) uploadFile(ctx context.Context, filePath, objectName string, container azblob.ContainerURL) error {
+	log := logutil.FromContext(ctx, a.log)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+
+	_, err = azblob.UploadFileToBlockBlob(ctx, file, container.NewBlockBlobURL(objectName), azblob.UploadToBlockBlobOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to container %s", objectName, container.String())
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to container %s", objectName, container.String())
+	return nil
+}
+
+func (a *AzureClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return a.uploadFile(ctx, filePath, objectName, a.container)
+}
+
+func (a *AzureClient) UploadFileToPublicBucket(ctx context.Context, filePath, objectName string) error {
+	return a.uploadFile(ctx, filePath, objectName, a.publicContainer)
+}
+
+func (a *AzureClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return a.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+// UploadISO creates the ISO of a cluster on the server side, by copying the blocks of the base ISO around its
+// embedded area and staging the embedded area with the ignition config
+func (a *AzureClient) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
+	log := logutil.FromContext(ctx, a.log)
+	destObjectName := fmt.Sprintf("%s.iso", destObjectPrefix)
+	srcBlob := a.publicContainer.NewBlobURL(srcObject)
+
+	props, err := srcBlob.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to get properties of base ISO %s", srcObject)
+	}
+	srcSize := props.ContentLength()
+	etag := props.ETag()
+
+	area, err := a.isoAreas.get(ctx, string(etag), srcSize, func(ctx context.Context, offset, length int64) ([]byte, error) {
+		return a.readRange(ctx, srcBlob, etag, offset, length)
+	})
+	if err != nil {
+		return err
+	}
+	areaContents, err := area.withIgnition(ignitionConfig)
+	if err != nil {
+		return err
+	}
+
+	srcURL, err := a.sasURL(srcBlob.URL(), a.cfg.PublicContainer, srcObject, "", time.Hour)
+	if err != nil {
+		return err
+	}
+
+	destBlob := a.container.NewBlockBlobURL(destObjectName)
+	var blockIDs []string
+	stageCopy := func(start, end int64) error {
+		for offset := start; offset < end; offset += azureCopyBlockSize {
+			count := end - offset
+			if count > azureCopyBlockSize {
+				count = azureCopyBlockSize
+			}
+			blockID := azureBlockID(len(blockIDs))
+			_, err := destBlob.StageBlockFromURL(ctx, blockID, *srcURL, offset, count, azblob.LeaseAccessConditions{},
+				azblob.ModifiedAccessConditions{IfMatch: etag}, azblob.ClientProvidedKeyOptions{})
+			if err != nil {
+				return errors.Wrapf(err, "Failed to copy range %d-%d of %s to %s", offset, offset+count, srcObject, destObjectName)
+			}
+			blockIDs = append(blockIDs, blockID)
+		}
+		return nil
+	}
+
+	if err = stageCopy(0, area.offset); err != nil {
+		return err
+	}
+	areaBlockID := azureBlockID(len(blockIDs))
+	_, err = destBlob.StageBlock(ctx, areaBlockID, bytes.NewReader(areaContents), azblob.LeaseAccessConditions{}, nil, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to upload the embedded area of %s", destObjectName)
+	}
+	blockIDs = append(blockIDs, areaBlockID)
+	if err = stageCopy(area.offset+area.length, srcSize); err != nil {
+		return err
+	}
+
+	_, err = destBlob.CommitBlockList(ctx, blockIDs, azblob.BlobHTTPHeaders{}, azblob.Metadata{}, azblob.BlobAccessConditions{},
+		azblob.DefaultAccessTier, nil, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to commit the blocks of %s", destObjectName)
+	}
+	log.Infof("Successfully uploaded %s to container %s", destObjectName, a.cfg.Container)
+	return nil
+}
+
+func (a *AzureClient) readRange(ctx context.Context, blob azblob.BlobURL, etag azblob.ETag, offset, length int64) ([]byte, error) {
+	resp, err := blob.Download(ctx, offset, length, azblob.BlobAccessConditions{ModifiedAccessConditions: azblob.ModifiedAccessConditions{IfMatch: etag}},
+		false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return nil, err
+	}
+	body := resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: 3})
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// azureBlockID returns the ID of the block with the given index. All the IDs of a blob must have the same length.
+func azureBlockID(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", index)))
+}
+
+func (a *AzureClient) download(ctx context.Context, objectName string, container azblob.ContainerURL) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, a.log)
+	log.Infof("Downloading %s from container %s", objectName, container.String())
+
+	resp, err := container.NewBlobURL(objectName).Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			return nil, 0, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Failed to get %s object from container %s", objectName, container.String())
+		log.Error(err)
+		return nil, 0, err
+	}
+	return resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: 3}), resp.ContentLength(), nil
+}
+
+func (a *AzureClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return a.download(ctx, objectName, a.container)
+}
+
+func (a *AzureClient) DownloadPublic(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return a.download(ctx, objectName, a.publicContainer)
+}
+
+func (a *AzureClient) getObjectInfo(ctx context.Context, objectName string, container azblob.ContainerURL, containerName string) (*ObjectInfo, error) {
+	log := logutil.FromContext(ctx, a.log)
+	props, err := container.NewBlobURL(objectName).GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			return nil, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in container %s", objectName, containerName)
+		log.Error(err)
+		return nil, err
+	}
+	metadata := props.NewMetadata()
+	return &ObjectInfo{
+		Size:     props.ContentLength(),
+		ETag:     quoteETag(string(props.ETag())),
+		SHA256:   metadata[checksumMetadataKey],
+		Metadata: metadata,
+	}, nil
+}
+
+func (a *AzureClient) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return a.getObjectInfo(ctx, objectName, a.container, a.cfg.Container)
+}
+
+func (a *AzureClient) GetPublicObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return a.getObjectInfo(ctx, objectName, a.publicContainer, a.cfg.PublicContainer)
+}
+
+func (a *AzureClient) downloadRange(ctx context.Context, objectName string, offset, length int64, container azblob.ContainerURL) (io.ReadCloser, error) {
+	log := logutil.FromContext(ctx, a.log)
+	log.Infof("Downloading %d bytes at offset %d of %s from container %s", length, offset, objectName, container.String())
+
+	resp, err := container.NewBlobURL(objectName).Download(ctx, offset, length, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			return nil, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Failed to get a range of %s object from container %s", objectName, container.String())
+		log.Error(err)
+		return nil, err
+	}
+	return resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: 3}), nil
+}
+
+func (a *AzureClient) DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return a.downloadRange(ctx, objectName, offset, length, a.container)
+}
+
+func (a *AzureClient) DownloadPublicRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return a.downloadRange(ctx, objectName, offset, length, a.publicContainer)
+}
+
+func (a *AzureClient) doesObjectExist(ctx context.Context, objectName string, container azblob.ContainerURL) (bool, error) {
+	log := logutil.FromContext(ctx, a.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, container.String())
+	_, err := container.NewBlobURL(objectName).GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from container %s", objectName, container.String())
+	}
+	return true, nil
+}
+
+func (a *AzureClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return a.doesObjectExist(ctx, objectName, a.container)
+}
+
+func (a *AzureClient) DoesPublicObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return a.doesObjectExist(ctx, objectName, a.publicContainer)
+}
+
+func (a *AzureClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, a.log)
+	log.Infof("Deleting object %s from %s", objectName, a.cfg.Container)
+
+	_, err := a.container.NewBlobURL(objectName).Delete(ctx, azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			log.Infof("Object %s does not exist in container %s", objectName, a.cfg.Container)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to delete object %s from container %s", objectName, a.cfg.Container)
+	}
+
+	log.Infof("Deleted object %s from container %s", objectName, a.cfg.Container)
+	return true, nil
+}
+
+func (a *AzureClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, a.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	return a.setMetadata(ctx, objectName, timestampTagKey, strconv.FormatInt(time.Now().Unix(), 10))
+}
+
+func (a *AzureClient) SetObjectMetadata(ctx context.Context, objectName, key, value string) error {
+	log := logutil.FromContext(ctx, a.log)
+	log.Debugf("Setting %s in the metadata of object %s", key, objectName)
+	updated, err := a.setMetadata(ctx, objectName, key, value)
+	if err == nil && !updated {
+		return common.NotFound(objectName)
+	}
+	return err
+}
+
+// setMetadata sets a value of the metadata of the blob, and returns false if the blob doesn't exist
+func (a *AzureClient) setMetadata(ctx context.Context, objectName, key, value string) (bool, error) {
+	blob := a.container.NewBlobURL(objectName)
+	// Setting metadata replaces all the metadata of the blob, so the value is merged into the existing metadata
+	props, err := blob.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to get metadata of object %s from container %s", objectName, a.cfg.Container)
+	}
+	metadata := props.NewMetadata()
+	metadata[key] = value
+	_, err = blob.SetMetadata(ctx, metadata, azblob.BlobAccessConditions{
+		ModifiedAccessConditions: azblob.ModifiedAccessConditions{IfMatch: props.ETag()},
+	}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isAzureNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to update metadata of object %s from container %s", objectName, a.cfg.Container)
+	}
+	return true, nil
+}
+
+func (a *AzureClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return a.getObjectSizeBytes(ctx, objectName, a.container, a.cfg.Container)
+}
+
+func (a *AzureClient) GetPublicObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return a.getObjectSizeBytes(ctx, objectName, a.publicContainer, a.cfg.PublicContainer)
+}
+
+func (a *AzureClient) getObjectSizeBytes(ctx context.Context, objectName string, container azblob.ContainerURL, containerName string) (int64, error) {
+	log := logutil.FromContext(ctx, a.log)
+	props, err := container.NewBlobURL(objectName).GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in container %s", objectName, containerName)
+		log.Error(err)
+		return 0, err
+	}
+	return props.ContentLength(), nil
+}
+
+func (a *AzureClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	log := logutil.FromContext(ctx, a.log)
+	blobURL := a.container.NewBlobURL(objectName).URL()
+	signedURL, err := a.sasURL(blobURL, a.cfg.Container, objectName, fmt.Sprintf("attachment;filename=%s", downloadFilename), duration)
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+	return signedURL.String(), nil
+}
+
+// sasURL returns the URL of the blob with a shared access signature that allows reading it for the given duration
+func (a *AzureClient) sasURL(blobURL url.URL, container, objectName, contentDisposition string, duration time.Duration) (*url.URL, error) {
+	sasValues := azblob.BlobSASSignatureValues{
+		Protocol:           azblob.SASProtocolHTTPSandHTTP,
+		ExpiryTime:         time.Now().UTC().Add(duration),
+		ContainerName:      container,
+		BlobName:           objectName,
+		Permissions:        azblob.BlobSASPermissions{Read: true}.String(),
+		ContentDisposition: contentDisposition,
+	}
+	if a.IsAwsS3() {
+		sasValues.Protocol = azblob.SASProtocolHTTPS
+	}
+	sasParams, err := sasValues.NewSASQueryParameters(a.credential)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create shared access signature for object %s in container %s", objectName, container)
+	}
+	parts := azblob.NewBlobURLParts(blobURL)
+	parts.SAS = sasParams
+	signedURL := parts.URL()
+	return &signedURL, nil
+}
+
+func (a *AzureClient) listBlobs(ctx context.Context, prefix string, handle func(blob azblob.BlobItemInternal)) error {
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		resp, err := a.container.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{
+			Prefix:  prefix,
+			Details: azblob.BlobListingDetails{Metadata: true},
+		})
+		if err != nil {
+			return err
+		}
+		for _, blob := range resp.Segment.BlobItems {
+			handle(blob)
+		}
+		marker = resp.NextMarker
+	}
+	return nil
+}
+
+func (a *AzureClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, a.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	err := a.listBlobs(ctx, prefix, func(blob azblob.BlobItemInternal) {
+		if now.Before(azureBlobCreationTime(blob).Add(deleteTime)) {
+			return
+		}
+		if _, err := a.DeleteObject(ctx, blob.Name); err != nil {
+			log.WithError(err).Errorf("Error deleting expired object %s", blob.Name)
+			return
+		}
+		log.Infof("Deleted expired object %s", blob.Name)
+		callback(ctx, log, blob.Name)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+	}
+}
+
+// azureBlobCreationTime returns the time of the last timestamp update of the blob, or its creation time if it was
+// never updated
+func azureBlobCreationTime(blob azblob.BlobItemInternal) time.Time {
+	if value, ok := blob.Metadata[timestampTagKey]; ok {
+		if objTime, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Unix(objTime, 0)
+		}
+	}
+	if blob.Properties.CreationTime != nil {
+		return *blob.Properties.CreationTime
+	}
+	return blob.Properties.LastModified
+}
+
+func (a *AzureClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, a.log)
+	var objects []string
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := a.listBlobs(ctx, prefix, func(blob azblob.BlobItemInternal) {
+		objects = append(objects, blob.Name)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (a *AzureClient) UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	rhcosImage, err := a.versionsHandler.GetRHCOSImage(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	baseIsoObject, err := a.GetBaseIsoObject(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	minimalIsoObject, err := a.GetMinimalIsoObjectName(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	log := logutil.FromContext(ctx, a.log)
+	return uploadISOsFromFile(ctx, log, a, a.versionsHandler, a.isoEditorFactory, baseIsoObject, minimalIsoObject, rhcosImage, openshiftVersion, haveLatestMinimalTemplate)
+}
+
+func (a *AzureClient) GetBaseIsoObject(openshiftVersion string) (string, error) {
+	rhcosVersion, err := a.versionsHandler.GetRHCOSVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(rhcosObjectTemplate, rhcosVersion), nil
+}
+
+func (a *AzureClient) GetMinimalIsoObjectName(openshiftVersion string) (string, error) {
+	rhcosVersion, err := a.versionsHandler.GetRHCOSVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(rhcosMinimalObjectTemplate, rhcosVersion), nil
+}
+
+func isAzureServiceCode(err error, code azblob.ServiceCodeType) bool {
+	var storageErr azblob.StorageError
+	return errors.As(err, &storageErr) && storageErr.ServiceCode() == code
+}
+
+func isAzureNotFound(err error) bool {
+	var storageErr azblob.StorageError
+	if !errors.As(err, &storageErr) {
+		return false
+	}
+	return storageErr.ServiceCode() == azblob.ServiceCodeBlobNotFound ||
+		(storageErr.Response() != nil && storageErr.Response().StatusCode == http.StatusNotFound)
+}
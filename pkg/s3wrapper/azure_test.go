@@ -0,0 +1,155 @@
+package s3wrapper
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("azure client", func() {
+	var (
+		ctx        = context.Background()
+		log        = logrus.New()
+		accountKey = base64.StdEncoding.EncodeToString([]byte("test-account-key"))
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+	})
+
+	newClient := func(endpointURL string) *AzureClient {
+		client := NewAzureClient(&AzureConfig{
+			AccountName:     "account",
+			AccountKey:      accountKey,
+			EndpointURL:     endpointURL,
+			Container:       "private",
+			PublicContainer: "public",
+		}, log, nil, nil)
+		Expect(client).ToNot(BeNil())
+		return client
+	}
+
+	It("fails with an invalid account key", func() {
+		Expect(NewAzureClient(&AzureConfig{AccountName: "account", AccountKey: "not base64!"}, log, nil, nil)).To(BeNil())
+	})
+
+	It("uses presigned URLs only with the Azure cloud", func() {
+		Expect(newClient("").IsAwsS3()).To(BeTrue())
+		Expect(newClient("https://account.blob.core.windows.net/").IsAwsS3()).To(BeTrue())
+		Expect(newClient("http://127.0.0.1:10000/devstoreaccount1").IsAwsS3()).To(BeFalse())
+	})
+
+	It("generates a read-only shared access signature", func() {
+		urlStr, err := newClient("").GeneratePresignedDownloadURL(ctx, "discovery-image-abc.iso", "image.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		signedURL, err := url.Parse(urlStr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signedURL.Host).To(Equal("account.blob.core.windows.net"))
+		Expect(signedURL.Path).To(Equal("/private/discovery-image-abc.iso"))
+		query := signedURL.Query()
+		Expect(query.Get("sp")).To(Equal("r"))
+		Expect(query.Get("spr")).To(Equal("https"))
+		Expect(query.Get("rscd")).To(Equal("attachment;filename=image.iso"))
+		Expect(query.Get("sig")).ToNot(BeEmpty())
+		expiry, err := time.Parse(azblob.SASTimeFormat, query.Get("se"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expiry).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+
+	It("returns not found for missing blobs", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("x-ms-error-code", string(azblob.ServiceCodeBlobNotFound))
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		client := newClient(server.URL + "/account")
+
+		_, _, err := client.Download(ctx, "missing")
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(common.NotFound("missing")))
+
+		exists, err := client.DoesObjectExist(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		deleted, err := client.DeleteObject(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+
+		Expect(client.SetObjectMetadata(ctx, "missing", checksumMetadataKey, "abcdef")).To(Equal(common.NotFound("missing")))
+	})
+
+	It("keeps the other metadata when updating the timestamp", func() {
+		var setMetadata http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodHead:
+				w.Header().Set("ETag", `"0x1"`)
+				w.Header().Set("x-ms-meta-owner", "cluster-1")
+				w.Header().Set("x-ms-meta-"+timestampTagKey, "1600000000")
+			case r.Method == http.MethodPut && r.URL.Query().Get("comp") == "metadata":
+				setMetadata = r.Header.Clone()
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		client := newClient(server.URL + "/account")
+
+		updated, err := client.UpdateObjectTimestamp(ctx, "blob")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+		Expect(setMetadata).ToNot(BeNil())
+		Expect(setMetadata.Get("If-Match")).To(Equal(`"0x1"`))
+		Expect(setMetadata.Get("x-ms-meta-owner")).To(Equal("cluster-1"))
+		timestamp, err := strconv.ParseInt(setMetadata.Get("x-ms-meta-"+timestampTagKey), 10, 64)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(time.Unix(timestamp, 0)).To(BeTemporally("~", time.Now(), time.Minute))
+	})
+
+	It("keeps the other metadata when setting a value", func() {
+		var setMetadata http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodHead:
+				w.Header().Set("ETag", `"0x1"`)
+				w.Header().Set("x-ms-meta-"+timestampTagKey, "1600000000")
+			case r.Method == http.MethodPut && r.URL.Query().Get("comp") == "metadata":
+				setMetadata = r.Header.Clone()
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		client := newClient(server.URL + "/account")
+
+		Expect(client.SetObjectMetadata(ctx, "blob", checksumMetadataKey, "abcdef")).To(Succeed())
+		Expect(setMetadata).ToNot(BeNil())
+		Expect(setMetadata.Get("x-ms-meta-" + timestampTagKey)).To(Equal("1600000000"))
+		Expect(setMetadata.Get("x-ms-meta-" + checksumMetadataKey)).To(Equal("abcdef"))
+	})
+
+	It("uses the timestamp metadata as the creation time", func() {
+		created := time.Now().Add(-time.Hour)
+		blob := azblob.BlobItemInternal{Properties: azblob.BlobProperties{CreationTime: &created}}
+		Expect(azureBlobCreationTime(blob)).To(Equal(created))
+
+		blob.Metadata = azblob.Metadata{timestampTagKey: "1600000000"}
+		Expect(azureBlobCreationTime(blob)).To(Equal(time.Unix(1600000000, 0)))
+	})
+
+	It("generates block IDs of the same length", func() {
+		Expect(azureBlockID(0)).To(HaveLen(len(azureBlockID(12345))))
+		Expect(azureBlockID(1)).ToNot(Equal(azureBlockID(2)))
+	})
+})
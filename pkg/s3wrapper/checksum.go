@@ -0,0 +1,86 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ChecksumClient computes the SHA-256 digests of the objects that are uploaded to the private bucket while they are
+// uploaded, and stores them in the metadata of the objects, from which GetObjectInfo returns them. ISOs that are
+// created from other objects on the side of the storage have no digest, since their contents don't pass through the
+// service.
+type ChecksumClient struct {
+	API
+	log logrus.FieldLogger
+}
+
+var _ API = &ChecksumClient{}
+
+// NewChecksumClient returns a client that keeps the SHA-256 digests of the objects that are stored with the given
+// client
+func NewChecksumClient(client API, log logrus.FieldLogger) *ChecksumClient {
+	return &ChecksumClient{API: client, log: log}
+}
+
+func (c *ChecksumClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	if err := c.API.Upload(ctx, data, objectName); err != nil {
+		return err
+	}
+	return c.setChecksum(ctx, objectName, Checksum(data))
+}
+
+func (c *ChecksumClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	digest := sha256.New()
+	if err := c.API.UploadStream(ctx, io.TeeReader(reader, digest), objectName); err != nil {
+		return err
+	}
+	return c.setChecksum(ctx, objectName, hex.EncodeToString(digest.Sum(nil)))
+}
+
+func (c *ChecksumClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	checksum, err := fileChecksum(filePath)
+	if err != nil {
+		return err
+	}
+	if err = c.API.UploadFile(ctx, filePath, objectName); err != nil {
+		return err
+	}
+	return c.setChecksum(ctx, objectName, checksum)
+}
+
+func (c *ChecksumClient) setChecksum(ctx context.Context, objectName, checksum string) error {
+	return errors.Wrapf(c.API.SetObjectMetadata(ctx, objectName, checksumMetadataKey, checksum), "failed to store the checksum of object %s", objectName)
+}
+
+func fileChecksum(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open file %s", filePath)
+	}
+	defer f.Close()
+	checksum, err := readerChecksum(f)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to compute the checksum of file %s", filePath)
+	}
+	return checksum, nil
+}
+
+func readerChecksum(reader io.Reader) (string, error) {
+	digest := sha256.New()
+	if _, err := io.Copy(digest, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// Checksum returns the hex-encoded SHA-256 digest of the data, as it is kept for the stored objects
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,113 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("checksums", func() {
+	const (
+		objectName = "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig"
+		// The SHA-256 digest of "hello world"
+		helloWorldSHA256 = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	)
+
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		baseDir string
+		fsCli   *FSClient
+		client  *ChecksumClient
+		data    = []byte("hello world")
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "checksums")
+		Expect(err).ToNot(HaveOccurred())
+		fsCli = &FSClient{basedir: baseDir, log: log}
+		client = NewChecksumClient(fsCli, log)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	checksumOf := func(objectName string) string {
+		info, err := client.GetObjectInfo(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		return info.SHA256
+	}
+
+	It("keeps the checksums of uploads", func() {
+		Expect(client.Upload(ctx, data, objectName)).To(Succeed())
+		Expect(checksumOf(objectName)).To(Equal(helloWorldSHA256))
+		Expect(Checksum(data)).To(Equal(helloWorldSHA256))
+	})
+
+	It("keeps the checksums of streamed uploads", func() {
+		Expect(client.UploadStream(ctx, bytes.NewReader(data), objectName)).To(Succeed())
+		Expect(checksumOf(objectName)).To(Equal(helloWorldSHA256))
+	})
+
+	It("keeps the checksums of file uploads", func() {
+		filePath := filepath.Join(baseDir, "upload")
+		Expect(ioutil.WriteFile(filePath, data, 0600)).To(Succeed())
+		Expect(client.UploadFile(ctx, filePath, objectName)).To(Succeed())
+		Expect(checksumOf(objectName)).To(Equal(helloWorldSHA256))
+	})
+
+	It("replaces the checksums of replaced objects", func() {
+		Expect(client.Upload(ctx, []byte("other"), objectName)).To(Succeed())
+		Expect(client.Upload(ctx, data, objectName)).To(Succeed())
+		Expect(checksumOf(objectName)).To(Equal(helloWorldSHA256))
+	})
+
+	It("has no checksums for objects that were stored before", func() {
+		Expect(fsCli.Upload(ctx, data, objectName)).To(Succeed())
+		Expect(checksumOf(objectName)).To(BeEmpty())
+	})
+
+	It("drops the checksums with the objects", func() {
+		Expect(client.Upload(ctx, data, objectName)).To(Succeed())
+		existed, err := client.DeleteObject(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(existed).To(BeTrue())
+		Expect(fsCli.Upload(ctx, data, objectName)).To(Succeed())
+		Expect(checksumOf(objectName)).To(BeEmpty())
+	})
+
+	It("doesn't read created ISOs back", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockAPI := NewMockAPI(ctrl)
+		client = NewChecksumClient(mockAPI, log)
+		mockAPI.EXPECT().UploadISO(ctx, "ignition", "rhcos.iso", "discovery-image-a").Return(nil)
+		Expect(client.UploadISO(ctx, "ignition", "rhcos.iso", "discovery-image-a")).To(Succeed())
+	})
+
+	It("fails when the checksum can't be stored", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockAPI := NewMockAPI(ctrl)
+		client = NewChecksumClient(mockAPI, log)
+		mockAPI.EXPECT().Upload(ctx, data, objectName).Return(nil)
+		mockAPI.EXPECT().SetObjectMetadata(ctx, objectName, checksumMetadataKey, helloWorldSHA256).Return(errors.New("failed"))
+		Expect(client.Upload(ctx, data, objectName)).ToNot(Succeed())
+	})
+
+	It("returns not found when setting the metadata of a missing file", func() {
+		Expect(fsCli.SetObjectMetadata(ctx, "missing", checksumMetadataKey, helloWorldSHA256)).To(Equal(common.NotFound("missing")))
+	})
+})
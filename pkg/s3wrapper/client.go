@@ -36,6 +36,9 @@ const (
 	rhcosObjectTemplate        = "rhcos-%s.iso"
 	rhcosMinimalObjectTemplate = "rhcos-%s-minimal.iso"
 	DiscoveryImageTemplate     = "discovery-image-%s"
+	// SharedDiscoveryImagePrefix is the prefix of the content-addressed discovery images, which may be shared by
+	// clusters that generated identical images
+	SharedDiscoveryImagePrefix = "shared-discovery-image-"
 )
 
 //go:generate mockgen -package=s3wrapper -destination=mock_s3wrapper.go . API
@@ -54,6 +57,9 @@ type API interface {
 	GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error)
 	GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error)
 	UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error)
+	// SetObjectMetadata stores a value in the metadata of the object and keeps its other values. GetObjectInfo returns
+	// the metadata of the object.
+	SetObjectMetadata(ctx context.Context, objectName, key, value string) error
 	ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string))
 	ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error)
 	UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error
@@ -65,6 +71,31 @@ type API interface {
 	UploadFileToPublicBucket(ctx context.Context, filePath, objectName string) error
 	DoesPublicObjectExist(ctx context.Context, objectName string) (bool, error)
 	DownloadPublic(ctx context.Context, objectName string) (io.ReadCloser, int64, error)
+	GetPublicObjectSizeBytes(ctx context.Context, objectName string) (int64, error)
+
+	GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error)
+	GetPublicObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error)
+	DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error)
+	DownloadPublicRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error)
+}
+
+// ObjectInfo holds the metadata that is needed to serve parts of an object
+type ObjectInfo struct {
+	Size int64
+	// ETag is a strong entity tag, including the quotes, that changes whenever the contents of the object change
+	ETag string
+	// SHA256 is the hex-encoded SHA-256 digest of the contents, or empty if it isn't known
+	SHA256 string
+	// Metadata holds the values that were stored with SetObjectMetadata, by their lower-case keys
+	Metadata map[string]string
+}
+
+// quoteETag adds the quotes of an entity tag to the ETags of storage providers that don't include them
+func quoteETag(etag string) string {
+	if strings.HasPrefix(etag, "\"") || strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return fmt.Sprintf("%q", etag)
 }
 
 var _ API = &S3Client{}
@@ -101,6 +132,9 @@ type Config struct {
 
 const timestampTagKey = "create_sec_since_epoch"
 
+// checksumMetadataKey is the key of the SHA-256 digest of an object in its metadata
+const checksumMetadataKey = "sha256"
+
 // NewS3Client creates new s3 client using default config along with defined env variables
 func NewS3Client(cfg *Config, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory) *S3Client {
 	awsSession, err := newS3Session(cfg.AwsAccessKeyID, cfg.AwsSecretAccessKey, cfg.Region, cfg.S3EndpointURL)
@@ -281,6 +315,77 @@ func (c *S3Client) DownloadPublic(ctx context.Context, objectName string) (io.Re
 	return c.download(ctx, objectName, c.cfg.PublicS3Bucket, c.client)
 }
 
+func (c *S3Client) getObjectInfo(ctx context.Context, objectName, bucket string, client s3iface.S3API) (*ObjectInfo, error) {
+	log := logutil.FromContext(ctx, c.log)
+	headResp, err := client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		if transformed, transformedError := c.transformErrorIfNeeded(err, objectName); transformed {
+			return nil, transformedError
+		}
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in bucket %s", objectName, bucket)
+		log.Error(err)
+		return nil, err
+	}
+	metadata := s3Metadata(headResp.Metadata)
+	return &ObjectInfo{
+		Size:     aws.Int64Value(headResp.ContentLength),
+		ETag:     quoteETag(aws.StringValue(headResp.ETag)),
+		SHA256:   metadata[checksumMetadataKey],
+		Metadata: metadata,
+	}, nil
+}
+
+// s3Metadata returns the metadata of an object by lower-case keys, since S3 returns the keys in the form of header
+// names
+func s3Metadata(metadata map[string]*string) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+	ret := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		ret[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	return ret
+}
+
+func (c *S3Client) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return c.getObjectInfo(ctx, objectName, c.cfg.S3Bucket, c.client)
+}
+
+func (c *S3Client) GetPublicObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return c.getObjectInfo(ctx, objectName, c.cfg.PublicS3Bucket, c.publicClient)
+}
+
+func (c *S3Client) downloadRange(ctx context.Context, objectName string, offset, length int64, bucket string, client s3iface.S3API) (io.ReadCloser, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %d bytes at offset %d of %s from bucket %s", length, offset, objectName, bucket)
+
+	getResp, err := client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(objectName),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	})
+	if err != nil {
+		if transformed, transformedError := c.transformErrorIfNeeded(err, objectName); transformed {
+			return nil, transformedError
+		}
+		log.WithError(err).Errorf("Failed to get a range of %s object from bucket %s", objectName, bucket)
+		return nil, err
+	}
+	return getResp.Body, nil
+}
+
+func (c *S3Client) DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return c.downloadRange(ctx, objectName, offset, length, c.cfg.S3Bucket, c.client)
+}
+
+func (c *S3Client) DownloadPublicRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return c.downloadRange(ctx, objectName, offset, length, c.cfg.PublicS3Bucket, c.publicClient)
+}
+
 func (c *S3Client) doesObjectExist(ctx context.Context, objectName, bucket string, client s3iface.S3API) (bool, error) {
 	log := logutil.FromContext(ctx, c.log)
 	log.Debugf("Verifying if %s exists in %s", objectName, bucket)
@@ -357,6 +462,37 @@ func (c *S3Client) UpdateObjectTimestamp(ctx context.Context, objectName string)
 	return true, nil
 }
 
+func (c *S3Client) SetObjectMetadata(ctx context.Context, objectName, key, value string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Setting %s in the metadata of object %s", key, objectName)
+	info, err := c.GetObjectInfo(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	metadata := make(map[string]*string, len(info.Metadata)+1)
+	for k, v := range info.Metadata {
+		metadata[k] = aws.String(v)
+	}
+	metadata[key] = aws.String(value)
+	// The metadata of an object can only be replaced by copying the object onto itself, which keeps its tags. The
+	// copy is made only if the object wasn't replaced since its metadata was read.
+	_, err = c.client.CopyObject(&s3.CopyObjectInput{
+		Bucket:            aws.String(c.cfg.S3Bucket),
+		Key:               aws.String(objectName),
+		CopySource:        aws.String(fmt.Sprintf("/%s/%s", c.cfg.S3Bucket, objectName)),
+		CopySourceIfMatch: aws.String(info.ETag),
+		Metadata:          metadata,
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+	})
+	if err != nil {
+		if transformed, transformedError := c.transformErrorIfNeeded(err, objectName); transformed {
+			return transformedError
+		}
+		return errors.Wrapf(err, "Failed to set the metadata of object %s in bucket %s", objectName, c.cfg.S3Bucket)
+	}
+	return nil
+}
+
 func (c *S3Client) getObjectSizeBytes(ctx context.Context, objectName, bucket string, client s3iface.S3API) (int64, error) {
 	log := logutil.FromContext(ctx, c.log)
 	headResp, err := client.HeadObject(&s3.HeadObjectInput{
@@ -375,6 +511,10 @@ func (c *S3Client) GetObjectSizeBytes(ctx context.Context, objectName string) (i
 	return c.getObjectSizeBytes(ctx, objectName, c.cfg.S3Bucket, c.client)
 }
 
+func (c *S3Client) GetPublicObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return c.getObjectSizeBytes(ctx, objectName, c.cfg.PublicS3Bucket, c.publicClient)
+}
+
 func (c *S3Client) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
 	log := logutil.FromContext(ctx, c.log)
 	req, _ := c.client.GetObjectRequest(&s3.GetObjectInput{
@@ -493,54 +633,7 @@ func (c *S3Client) UploadISOs(ctx context.Context, openshiftVersion string, have
 
 func (c *S3Client) uploadISOs(ctx context.Context, isoObjectName, minimalIsoObject, isoURL, openshiftVersion string, haveLatestMinimalTemplate bool) error {
 	log := logutil.FromContext(ctx, c.log)
-
-	baseExists, err := c.DoesPublicObjectExist(ctx, isoObjectName)
-	if err != nil {
-		return err
-	}
-
-	var minimalExists bool
-	if !haveLatestMinimalTemplate {
-		// Should update minimal ISO template
-		minimalExists = false
-	} else {
-		minimalExists, err = c.DoesPublicObjectExist(ctx, minimalIsoObject)
-		if err != nil {
-			return err
-		}
-	}
-
-	if baseExists && minimalExists {
-		return nil
-	}
-
-	log.Infof("Starting Base ISO download for %s", isoObjectName)
-	baseIsoPath, err := DownloadURLToTemporaryFile(isoURL)
-	if err != nil {
-		log.Error(err)
-		return err
-	}
-	defer os.Remove(baseIsoPath)
-
-	if !baseExists {
-		err = c.UploadFileToPublicBucket(ctx, baseIsoPath, isoObjectName)
-		if err != nil {
-			return err
-		}
-		log.Infof("Successfully uploaded object %s", isoObjectName)
-	}
-
-	if !minimalExists {
-		rootFSURL, err := c.versionsHandler.GetRHCOSRootFS(openshiftVersion)
-		if err != nil {
-			return err
-		}
-		if err = CreateAndUploadMinimalIso(ctx, log, baseIsoPath, minimalIsoObject, rootFSURL, c, c.isoEditorFactory); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return uploadISOsFromFile(ctx, log, c, c.versionsHandler, c.isoEditorFactory, isoObjectName, minimalIsoObject, isoURL, openshiftVersion, haveLatestMinimalTemplate)
 }
 
 func (c *S3Client) GetBaseIsoObject(openshiftVersion string) (string, error) {
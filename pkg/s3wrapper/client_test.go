@@ -133,6 +133,26 @@ var _ = Describe("s3client", func() {
 		client.handleObject(ctx, log, &obj, now, deleteTime, func(ctx context.Context, log logrus.FieldLogger, objectName string) { called = true })
 		Expect(called).To(Equal(false))
 	})
+	It("merges values into the metadata of the object", func() {
+		mockAPI.EXPECT().HeadObject(&s3.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String(objKey)}).
+			Return(&s3.HeadObjectOutput{ETag: aws.String("\"abc\""), ContentLength: aws.Int64(10), Metadata: map[string]*string{"Plaintext_size": aws.String("4")}}, nil)
+		mockAPI.EXPECT().CopyObject(&s3.CopyObjectInput{
+			Bucket:            aws.String(bucket),
+			Key:               aws.String(objKey),
+			CopySource:        aws.String(fmt.Sprintf("/%s/%s", bucket, objKey)),
+			CopySourceIfMatch: aws.String("\"abc\""),
+			Metadata:          map[string]*string{"plaintext_size": aws.String("4"), checksumMetadataKey: aws.String("abcdef")},
+			MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+		}).Return(&s3.CopyObjectOutput{}, nil)
+		Expect(client.SetObjectMetadata(ctx, objKey, checksumMetadataKey, "abcdef")).To(Succeed())
+	})
+	It("returns the checksum from the metadata of the object", func() {
+		mockAPI.EXPECT().HeadObject(&s3.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String(objKey)}).
+			Return(&s3.HeadObjectOutput{ETag: aws.String("\"abc\""), ContentLength: aws.Int64(10), Metadata: map[string]*string{"Sha256": aws.String("abcdef")}}, nil)
+		info, err := client.GetObjectInfo(ctx, objKey)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info).To(Equal(&ObjectInfo{Size: 10, ETag: "\"abc\"", SHA256: "abcdef", Metadata: map[string]string{checksumMetadataKey: "abcdef"}}))
+	})
 	Context("upload iso", func() {
 		success := func(hexBytes []byte, baseISOSize, areaOffset, areaLength int64, cached bool) {
 			uploadID := "12345"
@@ -0,0 +1,542 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dataKeyLength       = 32
+	encryptionAlgorithm = "AES-256-GCM"
+	// encryptionChunkSize is the size of the parts of the contents that are encrypted separately, so that ranges of
+	// the contents can be decrypted without the rest of them
+	encryptionChunkSize = 64 * 1024
+	// gcmTagSize is the size that GCM adds to every encrypted chunk
+	gcmTagSize = 16
+	// plaintextSizeMetadataKey is the key of the size of the decrypted contents of an object in its metadata
+	plaintextSizeMetadataKey = "plaintext_size"
+)
+
+// envelopeMagic starts every encrypted object, so that objects that were stored before they were encrypted can still
+// be read
+var envelopeMagic = []byte("AIENC\x00\x01\n")
+
+// ErrEncryptedObject is returned when a presigned URL is requested for an encrypted object, since the storage would
+// serve the encrypted contents
+var ErrEncryptedObject = errors.New("The object is encrypted at rest and can only be downloaded through the service")
+
+// EncryptionConfig configures the encryption of the sensitive cluster artifacts at rest
+type EncryptionConfig struct {
+	// KeyFile is the key file of the local key provider. The objects are stored in plain form when it is empty.
+	KeyFile string `envconfig:"ENCRYPTION_KEY_FILE" default:""`
+	// ObjectPrefixes are the prefixes of the encrypted objects, which are matched against the object names within
+	// the cluster directories, e.g. "kubeconfig" matches <cluster ID>/kubeconfig and <cluster ID>/kubeconfig-noingress
+	ObjectPrefixes []string `envconfig:"ENCRYPTED_OBJECT_PREFIXES" default:"kubeconfig,kubeadmin-password,install-config.yaml,bootstrap.ign,master,worker,discovery.ign"`
+}
+
+// envelopeHeader describes how an object was encrypted. It follows envelopeMagic and its length, and precedes the
+// encrypted chunks of the contents.
+type envelopeHeader struct {
+	Algorithm  string `json:"algorithm"`
+	KeyID      string `json:"key_id"`
+	WrappedKey []byte `json:"wrapped_key"`
+	// Nonce is the nonce of the first chunk, the index of every chunk is added to it
+	Nonce     []byte `json:"nonce"`
+	ChunkSize int64  `json:"chunk_size"`
+}
+
+// EncryptedClient encrypts the objects that match the configured prefixes with a data key of their own, which is
+// wrapped by the key provider and stored along with the object, and decrypts them when they are downloaded. Objects
+// that were stored in plain form are downloaded as they are until they are encrypted by Reencrypt.
+//
+// The contents are encrypted in chunks, so that ranges are downloaded and decrypted without the rest of the object,
+// and the size of the decrypted contents is kept in the metadata of the object. Uploads and whole downloads are read
+// into memory, so the prefixes should only match small artifacts.
+type EncryptedClient struct {
+	API
+	log            logrus.FieldLogger
+	keyProvider    KeyProvider
+	objectPrefixes []string
+}
+
+var _ API = &EncryptedClient{}
+
+// NewEncryptedClient returns a client that encrypts the objects that match the given prefixes and stores them with
+// the given client
+func NewEncryptedClient(client API, log logrus.FieldLogger, keyProvider KeyProvider, objectPrefixes []string) *EncryptedClient {
+	return &EncryptedClient{
+		API:            client,
+		log:            log,
+		keyProvider:    keyProvider,
+		objectPrefixes: objectPrefixes,
+	}
+}
+
+// IsEncrypted returns whether the object is encrypted at rest
+func (c *EncryptedClient) IsEncrypted(objectName string) bool {
+	name := objectName
+	if i := strings.Index(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	for _, prefix := range c.objectPrefixes {
+		if prefix != "" && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *EncryptedClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	if !c.IsEncrypted(objectName) {
+		return c.API.Upload(ctx, data, objectName)
+	}
+	encrypted, err := c.encrypt(ctx, data, objectName)
+	if err != nil {
+		return err
+	}
+	if err = c.API.Upload(ctx, encrypted, objectName); err != nil {
+		return err
+	}
+	return errors.Wrapf(c.API.SetObjectMetadata(ctx, objectName, plaintextSizeMetadataKey, strconv.Itoa(len(data))),
+		"failed to store the size of object %s", objectName)
+}
+
+func (c *EncryptedClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	if !c.IsEncrypted(objectName) {
+		return c.API.UploadStream(ctx, reader, objectName)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+	return c.Upload(ctx, data, objectName)
+}
+
+func (c *EncryptedClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	if !c.IsEncrypted(objectName) {
+		return c.API.UploadFile(ctx, filePath, objectName)
+	}
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read file %s", filePath)
+	}
+	return c.Upload(ctx, data, objectName)
+}
+
+func (c *EncryptedClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	if !c.IsEncrypted(objectName) {
+		return c.API.Download(ctx, objectName)
+	}
+	data, err := c.downloadDecrypted(ctx, objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func (c *EncryptedClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	if !c.IsEncrypted(objectName) {
+		return c.API.GetObjectSizeBytes(ctx, objectName)
+	}
+	info, err := c.GetObjectInfo(ctx, objectName)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
+}
+
+func (c *EncryptedClient) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	info, err := c.API.GetObjectInfo(ctx, objectName)
+	if err != nil || !c.IsEncrypted(objectName) {
+		return info, err
+	}
+	// The entity tag of the stored object changes whenever the object is written, since every write uses a new data
+	// key, so only the size has to be replaced with the size of the decrypted contents
+	ret := *info
+	if ret.Size, err = c.plaintextSize(ctx, objectName, info); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// plaintextSize returns the size of the decrypted contents of an object from its metadata, or from the size of the
+// encrypted chunks if the filesystem of the object keeps no metadata
+func (c *EncryptedClient) plaintextSize(ctx context.Context, objectName string, info *ObjectInfo) (int64, error) {
+	if value, ok := info.Metadata[plaintextSizeMetadataKey]; ok {
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "object %s has an invalid size in its metadata", objectName)
+		}
+		return size, nil
+	}
+	header, dataOffset, err := c.downloadHeader(ctx, objectName, info.Size)
+	if err != nil || header == nil {
+		return info.Size, err
+	}
+	storedChunkSize := header.ChunkSize + gcmTagSize
+	chunks := (info.Size - dataOffset + storedChunkSize - 1) / storedChunkSize
+	return info.Size - dataOffset - chunks*gcmTagSize, nil
+}
+
+func (c *EncryptedClient) DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	if !c.IsEncrypted(objectName) {
+		return c.API.DownloadRange(ctx, objectName, offset, length)
+	}
+	info, err := c.API.GetObjectInfo(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	header, dataOffset, err := c.downloadHeader(ctx, objectName, info.Size)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return c.API.DownloadRange(ctx, objectName, offset, length)
+	}
+	size, err := c.plaintextSize(ctx, objectName, info)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 || length < 0 || offset+length > size {
+		return nil, errors.Errorf("range %d-%d is outside of object %s", offset, offset+length, objectName)
+	}
+	if length == 0 {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	// Only the chunks that hold the range are downloaded and decrypted
+	chunks, err := c.newChunkCipher(ctx, header, objectName)
+	if err != nil {
+		return nil, err
+	}
+	first, last := offset/header.ChunkSize, (offset+length-1)/header.ChunkSize
+	storedChunkSize := header.ChunkSize + gcmTagSize
+	storedOffset := dataOffset + first*storedChunkSize
+	storedLength := (last - first + 1) * storedChunkSize
+	if storedOffset+storedLength > info.Size {
+		storedLength = info.Size - storedOffset
+	}
+	stored, err := c.downloadStoredRange(ctx, objectName, storedOffset, storedLength)
+	if err != nil {
+		return nil, err
+	}
+	data, err := chunks.open(stored, first, lastChunk(size, header.ChunkSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt object %s", objectName)
+	}
+	start := offset - first*header.ChunkSize
+	if start+length > int64(len(data)) {
+		return nil, errors.Errorf("object %s is truncated", objectName)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data[start : start+length])), nil
+}
+
+func (c *EncryptedClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	if c.IsEncrypted(objectName) {
+		return "", errors.Wrapf(ErrEncryptedObject, "failed to generate a presigned URL for object %s", objectName)
+	}
+	return c.API.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
+}
+
+// Reencrypt encrypts the stored objects that match the prefixes with the current key of the key provider: the
+// objects that were encrypted with previous keys, and the objects that were stored in plain form. It should be run
+// after a new key is added to the key provider, and the previous keys can be removed once it succeeds. It returns the
+// number of objects that were encrypted again.
+func (c *EncryptedClient) Reencrypt(ctx context.Context) (int, error) {
+	log := logutil.FromContext(ctx, c.log)
+	objectNames, err := c.API.ListObjectsByPrefix(ctx, "")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list the stored objects")
+	}
+	currentKeyID := c.keyProvider.CurrentKeyID()
+	var reencrypted, failed int
+	for _, objectName := range objectNames {
+		if !c.IsEncrypted(objectName) {
+			continue
+		}
+		stored, err := c.downloadStored(ctx, objectName)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to re-encrypt object %s", objectName)
+			failed++
+			continue
+		}
+		header, _, err := parseEnvelope(stored)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to re-encrypt object %s", objectName)
+			failed++
+			continue
+		}
+		if header != nil && header.KeyID == currentKeyID {
+			continue
+		}
+		data, err := c.decrypt(ctx, stored, objectName)
+		if err == nil {
+			err = c.Upload(ctx, data, objectName)
+		}
+		if err == nil {
+			// Replacing the object drops its metadata
+			err = c.API.SetObjectMetadata(ctx, objectName, checksumMetadataKey, Checksum(data))
+		}
+		if err != nil {
+			log.WithError(err).Errorf("Failed to re-encrypt object %s", objectName)
+			failed++
+			continue
+		}
+		log.Infof("Re-encrypted object %s with encryption key %s", objectName, currentKeyID)
+		reencrypted++
+	}
+	if failed > 0 {
+		return reencrypted, errors.Errorf("failed to re-encrypt %d of the stored objects", failed)
+	}
+	return reencrypted, nil
+}
+
+func (c *EncryptedClient) downloadStored(ctx context.Context, objectName string) ([]byte, error) {
+	reader, _, err := c.API.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	stored, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+	return stored, nil
+}
+
+// downloadStoredRange returns a range of the stored contents of an object
+func (c *EncryptedClient) downloadStoredRange(ctx context.Context, objectName string, offset, length int64) ([]byte, error) {
+	reader, err := c.API.DownloadRange(ctx, objectName, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	stored, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+	return stored, nil
+}
+
+// downloadHeader returns the encryption header of a stored object and the offset of its encrypted chunks, or a nil
+// header if the object was stored before it was encrypted
+func (c *EncryptedClient) downloadHeader(ctx context.Context, objectName string, storedSize int64) (*envelopeHeader, int64, error) {
+	prefixLength := int64(len(envelopeMagic) + 4)
+	if storedSize < prefixLength {
+		return nil, 0, nil
+	}
+	prefix, err := c.downloadStoredRange(ctx, objectName, 0, prefixLength)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !bytes.HasPrefix(prefix, envelopeMagic) {
+		return nil, 0, nil
+	}
+	headerLength := int64(binary.BigEndian.Uint32(prefix[len(envelopeMagic):]))
+	if prefixLength+headerLength > storedSize {
+		return nil, 0, errors.Errorf("failed to decrypt object %s: encryption header is truncated", objectName)
+	}
+	headerBytes, err := c.downloadStoredRange(ctx, objectName, prefixLength, headerLength)
+	if err != nil {
+		return nil, 0, err
+	}
+	header, _, err := parseEnvelope(append(prefix, headerBytes...))
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to decrypt object %s", objectName)
+	}
+	return header, prefixLength + headerLength, nil
+}
+
+func (c *EncryptedClient) downloadDecrypted(ctx context.Context, objectName string) ([]byte, error) {
+	stored, err := c.downloadStored(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return c.decrypt(ctx, stored, objectName)
+}
+
+// encrypt encrypts the contents of an object with a new data key. The object name is authenticated along with the
+// contents, so that encrypted objects can't be swapped.
+func (c *EncryptedClient) encrypt(ctx context.Context, data []byte, objectName string) ([]byte, error) {
+	dataKey := make([]byte, dataKeyLength)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data key")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	header := envelopeHeader{
+		Algorithm: encryptionAlgorithm,
+		Nonce:     make([]byte, aead.NonceSize()),
+		ChunkSize: encryptionChunkSize,
+	}
+	if _, err = io.ReadFull(rand.Reader, header.Nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	if header.KeyID, header.WrappedKey, err = c.keyProvider.WrapKey(ctx, dataKey); err != nil {
+		return nil, errors.Wrapf(err, "failed to wrap the data key of object %s", objectName)
+	}
+	headerBytes, err := json.Marshal(&header)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal encryption header")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(envelopeMagic)
+	if err = binary.Write(&buf, binary.BigEndian, uint32(len(headerBytes))); err != nil {
+		return nil, errors.Wrap(err, "failed to write encryption header")
+	}
+	buf.Write(headerBytes)
+	chunks := &chunkCipher{aead: aead, header: &header, objectName: objectName}
+	buf.Write(chunks.seal(data))
+	return buf.Bytes(), nil
+}
+
+// decrypt returns the contents of a stored object, which are stored as they are if the object was stored before it
+// was encrypted
+func (c *EncryptedClient) decrypt(ctx context.Context, stored []byte, objectName string) ([]byte, error) {
+	header, ciphertext, err := parseEnvelope(stored)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt object %s", objectName)
+	}
+	if header == nil {
+		return stored, nil
+	}
+	chunks, err := c.newChunkCipher(ctx, header, objectName)
+	if err != nil {
+		return nil, err
+	}
+	storedChunkSize := header.ChunkSize + gcmTagSize
+	count := (int64(len(ciphertext)) + storedChunkSize - 1) / storedChunkSize
+	data, err := chunks.open(ciphertext, 0, count-1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt object %s", objectName)
+	}
+	return data, nil
+}
+
+// newChunkCipher unwraps the data key of an object to decrypt its chunks
+func (c *EncryptedClient) newChunkCipher(ctx context.Context, header *envelopeHeader, objectName string) (*chunkCipher, error) {
+	if header.Algorithm != encryptionAlgorithm {
+		return nil, errors.Errorf("object %s is encrypted with unsupported algorithm %s", objectName, header.Algorithm)
+	}
+	if header.ChunkSize <= 0 {
+		return nil, errors.Errorf("object %s has an invalid chunk size %d", objectName, header.ChunkSize)
+	}
+	dataKey, err := c.keyProvider.UnwrapKey(ctx, header.KeyID, header.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt object %s", objectName)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(header.Nonce) != aead.NonceSize() {
+		return nil, errors.Errorf("object %s has an invalid nonce", objectName)
+	}
+	return &chunkCipher{aead: aead, header: header, objectName: objectName}, nil
+}
+
+// chunkCipher encrypts and decrypts the chunks of an object. Every chunk is authenticated along with the object name,
+// its index and whether it is the last one, so that chunks can't be swapped, reordered or dropped.
+type chunkCipher struct {
+	aead       cipher.AEAD
+	header     *envelopeHeader
+	objectName string
+}
+
+// lastChunk returns the index of the last chunk of contents of the given size, which has at least one chunk
+func lastChunk(size, chunkSize int64) int64 {
+	if size == 0 {
+		return 0
+	}
+	return (size - 1) / chunkSize
+}
+
+func (c *chunkCipher) seal(data []byte) []byte {
+	size := int64(len(data))
+	last := lastChunk(size, c.header.ChunkSize)
+	ret := make([]byte, 0, size+(last+1)*gcmTagSize)
+	for index := int64(0); index <= last; index++ {
+		start := index * c.header.ChunkSize
+		end := start + c.header.ChunkSize
+		if end > size {
+			end = size
+		}
+		ret = c.aead.Seal(ret, c.nonce(index), data[start:end], c.additionalData(index, index == last))
+	}
+	return ret
+}
+
+// open decrypts consecutive stored chunks, starting with the chunk of index first
+func (c *chunkCipher) open(stored []byte, first, last int64) ([]byte, error) {
+	storedChunkSize := c.header.ChunkSize + gcmTagSize
+	var ret []byte
+	for index := first; len(stored) > 0 || index == first; index++ {
+		chunk := stored
+		if int64(len(chunk)) > storedChunkSize {
+			chunk = chunk[:storedChunkSize]
+		}
+		stored = stored[len(chunk):]
+		var err error
+		if ret, err = c.aead.Open(ret, c.nonce(index), chunk, c.additionalData(index, index == last)); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (c *chunkCipher) nonce(index int64) []byte {
+	nonce := make([]byte, len(c.header.Nonce))
+	copy(nonce, c.header.Nonce)
+	counter := nonce[len(nonce)-8:]
+	binary.BigEndian.PutUint64(counter, binary.BigEndian.Uint64(counter)+uint64(index))
+	return nonce
+}
+
+func (c *chunkCipher) additionalData(index int64, last bool) []byte {
+	ret := make([]byte, 0, len(c.objectName)+9)
+	ret = append(ret, c.objectName...)
+	ret = append(ret, make([]byte, 8)...)
+	binary.BigEndian.PutUint64(ret[len(c.objectName):], uint64(index))
+	if last {
+		return append(ret, 1)
+	}
+	return append(ret, 0)
+}
+
+// parseEnvelope returns the encryption header and the encrypted contents of a stored object, or a nil header if the
+// object isn't encrypted
+func parseEnvelope(stored []byte) (*envelopeHeader, []byte, error) {
+	if !bytes.HasPrefix(stored, envelopeMagic) {
+		return nil, stored, nil
+	}
+	rest := stored[len(envelopeMagic):]
+	if len(rest) < 4 {
+		return nil, nil, errors.New("encryption header is truncated")
+	}
+	headerLength := binary.BigEndian.Uint32(rest)
+	rest = rest[4:]
+	if uint64(len(rest)) < uint64(headerLength) {
+		return nil, nil, errors.New("encryption header is truncated")
+	}
+	var header envelopeHeader
+	if err := json.Unmarshal(rest[:headerLength], &header); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse encryption header")
+	}
+	return &header, rest[headerLength:], nil
+}
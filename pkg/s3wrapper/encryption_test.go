@@ -0,0 +1,276 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("encryption at rest", func() {
+	const (
+		clusterID  = "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1"
+		kubeconfig = clusterID + "/kubeconfig"
+		logs       = clusterID + "/logs/controller/logs.tar.gz"
+	)
+
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		baseDir string
+		keyFile string
+		fsCli   *FSClient
+		client  *EncryptedClient
+		data    = []byte("apiVersion: v1\nkind: Config\n")
+	)
+
+	newKey := func(keyID string) string {
+		key := make([]byte, dataKeyLength)
+		_, err := rand.Read(key)
+		Expect(err).ToNot(HaveOccurred())
+		return fmt.Sprintf("%s:%s\n", keyID, base64.StdEncoding.EncodeToString(key))
+	}
+
+	newClient := func(keys ...string) *EncryptedClient {
+		Expect(ioutil.WriteFile(keyFile, []byte("# encryption keys\n"+strings.Join(keys, "")), 0600)).To(Succeed())
+		provider, err := NewLocalKeyProvider(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		return NewEncryptedClient(fsCli, log, provider, []string{"kubeconfig", "install-config.yaml"})
+	}
+
+	stored := func(objectName string) []byte {
+		contents, err := ioutil.ReadFile(filepath.Join(baseDir, objectName))
+		Expect(err).ToNot(HaveOccurred())
+		return contents
+	}
+
+	download := func(c API, objectName string) []byte {
+		reader, size, err := c.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		contents, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(len(contents))))
+		return contents
+	}
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "encryption")
+		Expect(err).ToNot(HaveOccurred())
+		keyFile = filepath.Join(baseDir, "..", filepath.Base(baseDir)+".keys")
+		fsCli = &FSClient{basedir: baseDir, log: log}
+		client = newClient(newKey("key1"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+		os.Remove(keyFile)
+	})
+
+	It("encrypts the objects that match the prefixes", func() {
+		Expect(client.Upload(ctx, data, kubeconfig)).To(Succeed())
+		Expect(stored(kubeconfig)).To(HavePrefix(string(envelopeMagic)))
+		Expect(bytes.Contains(stored(kubeconfig), data)).To(BeFalse())
+		Expect(download(client, kubeconfig)).To(Equal(data))
+	})
+
+	It("stores the other objects in plain form", func() {
+		Expect(client.Upload(ctx, data, logs)).To(Succeed())
+		Expect(stored(logs)).To(Equal(data))
+		Expect(download(client, logs)).To(Equal(data))
+	})
+
+	It("encrypts streamed and file uploads", func() {
+		Expect(client.UploadStream(ctx, bytes.NewReader(data), kubeconfig)).To(Succeed())
+		Expect(stored(kubeconfig)).To(HavePrefix(string(envelopeMagic)))
+		Expect(download(client, kubeconfig)).To(Equal(data))
+
+		filePath := filepath.Join(baseDir, "install-config")
+		Expect(ioutil.WriteFile(filePath, data, 0600)).To(Succeed())
+		Expect(client.UploadFile(ctx, filePath, clusterID+"/install-config.yaml")).To(Succeed())
+		Expect(stored(clusterID + "/install-config.yaml")).To(HavePrefix(string(envelopeMagic)))
+		Expect(download(client, clusterID+"/install-config.yaml")).To(Equal(data))
+	})
+
+	It("reports the size and ranges of the decrypted contents", func() {
+		Expect(client.Upload(ctx, data, kubeconfig)).To(Succeed())
+		size, err := client.GetObjectSizeBytes(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(len(data))))
+		info, err := client.GetObjectInfo(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Size).To(Equal(int64(len(data))))
+
+		reader, err := client.DownloadRange(ctx, kubeconfig, 5, 3)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.ReadAll(reader)).To(Equal(data[5:8]))
+	})
+
+	It("decrypts only the chunks of ranges that span several chunks", func() {
+		large := make([]byte, 3*encryptionChunkSize+100)
+		_, err := rand.Read(large)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.Upload(ctx, large, kubeconfig)).To(Succeed())
+		Expect(download(client, kubeconfig)).To(Equal(large))
+
+		for _, r := range [][2]int64{
+			{0, 1},
+			{encryptionChunkSize - 10, 20},
+			{encryptionChunkSize, encryptionChunkSize},
+			{10, 3 * encryptionChunkSize},
+			{3 * encryptionChunkSize, 100},
+			{int64(len(large)), 0},
+		} {
+			reader, err := client.DownloadRange(ctx, kubeconfig, r[0], r[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.ReadAll(reader)).To(Equal(large[r[0] : r[0]+r[1]]))
+		}
+		_, err = client.DownloadRange(ctx, kubeconfig, int64(len(large))-10, 20)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("stores empty objects", func() {
+		Expect(client.Upload(ctx, []byte{}, kubeconfig)).To(Succeed())
+		Expect(download(client, kubeconfig)).To(BeEmpty())
+		size, err := client.GetObjectSizeBytes(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(BeZero())
+	})
+
+	It("reads the size of the decrypted contents from the metadata of the object", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockAPI := NewMockAPI(ctrl)
+		provider, err := NewLocalKeyProvider(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		client = NewEncryptedClient(mockAPI, log, provider, []string{"kubeconfig"})
+		stored := &ObjectInfo{Size: 1234, ETag: "etag", SHA256: "abcdef", Metadata: map[string]string{plaintextSizeMetadataKey: "1000"}}
+		mockAPI.EXPECT().GetObjectInfo(ctx, kubeconfig).Return(stored, nil).Times(2)
+		info, err := client.GetObjectInfo(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*info).To(Equal(ObjectInfo{Size: 1000, ETag: "etag", SHA256: "abcdef", Metadata: stored.Metadata}))
+		size, err := client.GetObjectSizeBytes(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(Equal(int64(1000)))
+	})
+
+	It("computes the size of the decrypted contents of objects without metadata", func() {
+		large := make([]byte, 2*encryptionChunkSize+7)
+		Expect(client.Upload(ctx, large, kubeconfig)).To(Succeed())
+		info, err := fsCli.GetObjectInfo(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		info.Metadata = nil
+		Expect(client.plaintextSize(ctx, kubeconfig, info)).To(Equal(int64(len(large))))
+	})
+
+	It("keeps the checksums of encrypted objects", func() {
+		checksums := NewChecksumClient(client, log)
+		Expect(checksums.Upload(ctx, data, kubeconfig)).To(Succeed())
+		info, err := checksums.GetObjectInfo(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.SHA256).To(Equal(Checksum(data)))
+		Expect(info.Size).To(Equal(int64(len(data))))
+	})
+
+	It("reads objects that were stored before they were encrypted", func() {
+		Expect(fsCli.Upload(ctx, data, kubeconfig)).To(Succeed())
+		Expect(download(client, kubeconfig)).To(Equal(data))
+	})
+
+	It("refuses presigned URLs of encrypted objects", func() {
+		_, err := client.GeneratePresignedDownloadURL(ctx, kubeconfig, "kubeconfig", time.Minute)
+		Expect(errors.Is(err, ErrEncryptedObject)).To(BeTrue())
+	})
+
+	It("fails to decrypt objects that were swapped", func() {
+		Expect(client.Upload(ctx, data, kubeconfig)).To(Succeed())
+		Expect(fsCli.Upload(ctx, stored(kubeconfig), clusterID+"/kubeconfig-noingress")).To(Succeed())
+		_, _, err := client.Download(ctx, clusterID+"/kubeconfig-noingress")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("re-encrypts objects with the current key", func() {
+		key1 := newKey("key1")
+		client = newClient(key1)
+		Expect(client.Upload(ctx, data, kubeconfig)).To(Succeed())
+		Expect(fsCli.Upload(ctx, data, clusterID+"/install-config.yaml")).To(Succeed())
+		Expect(fsCli.Upload(ctx, data, logs)).To(Succeed())
+
+		client = newClient(newKey("key2"), key1)
+		Expect(download(client, kubeconfig)).To(Equal(data))
+		count, err := client.Reencrypt(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+		Expect(stored(logs)).To(Equal(data))
+		header, _, err := parseEnvelope(stored(kubeconfig))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.KeyID).To(Equal("key2"))
+
+		info, err := client.GetObjectInfo(ctx, kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Size).To(Equal(int64(len(data))))
+		Expect(info.SHA256).To(Equal(Checksum(data)))
+
+		count, err = client.Reencrypt(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("fails to decrypt objects whose key was removed", func() {
+		Expect(client.Upload(ctx, data, kubeconfig)).To(Succeed())
+		client = newClient(newKey("key2"))
+		_, _, err := client.Download(ctx, kubeconfig)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("wraps the data keys with the key provider", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		provider := NewMockKeyProvider(ctrl)
+		client = NewEncryptedClient(fsCli, log, provider, []string{"kubeconfig"})
+		var dataKey []byte
+		provider.EXPECT().WrapKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key []byte) (string, []byte, error) {
+			dataKey = key
+			return "kms-key", []byte("wrapped"), nil
+		})
+		provider.EXPECT().UnwrapKey(gomock.Any(), "kms-key", []byte("wrapped")).DoAndReturn(func(context.Context, string, []byte) ([]byte, error) {
+			return dataKey, nil
+		})
+		Expect(client.Upload(ctx, data, kubeconfig)).To(Succeed())
+		Expect(download(client, kubeconfig)).To(Equal(data))
+	})
+
+	Context("local key provider", func() {
+		It("fails with keys of the wrong length", func() {
+			Expect(ioutil.WriteFile(keyFile, []byte("key1:"+base64.StdEncoding.EncodeToString([]byte("short"))), 0600)).To(Succeed())
+			_, err := NewLocalKeyProvider(keyFile)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails without keys", func() {
+			Expect(ioutil.WriteFile(keyFile, []byte("# no keys\n"), 0600)).To(Succeed())
+			_, err := NewLocalKeyProvider(keyFile)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("uses the first key for new objects", func() {
+			Expect(ioutil.WriteFile(keyFile, []byte(newKey("key2")+newKey("key1")), 0600)).To(Succeed())
+			provider, err := NewLocalKeyProvider(keyFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(provider.CurrentKeyID()).To(Equal("key2"))
+		})
+	})
+})
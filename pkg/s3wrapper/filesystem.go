@@ -9,6 +9,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alecthomas/units"
@@ -19,6 +20,7 @@ import (
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/versions"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	syscall "golang.org/x/sys/unix"
@@ -31,7 +33,14 @@ type FSClient struct {
 	isoEditorFactory isoeditor.Factory
 }
 
-func NewFSClient(basedir string, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int) *FSClientDecorator {
+// NewFSClient returns a client of the filesystem storage. When fsQuotaBytes isn't zero, the stored objects are kept
+// within that many bytes by evicting the least recently used discovery images and RHCOS images, and uploads that don't
+// fit are rejected.
+func NewFSClient(basedir string, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory, metricsAPI metrics.API, fsThreshold int, fsQuotaBytes int64) *FSClientDecorator {
+	var quota *fsQuota
+	if fsQuotaBytes > 0 {
+		quota = newFSQuota(logger, basedir, fsQuotaBytes, metricsAPI)
+	}
 	return &FSClientDecorator{
 		log:        logger,
 		metricsAPI: metricsAPI,
@@ -45,6 +54,8 @@ func NewFSClient(basedir string, logger logrus.FieldLogger, versionsHandler vers
 		timeFSUsageLog:                time.Now().Add(-1 * time.Hour),
 		loggingIntervalBelowThreshold: 1 * int64(time.Hour),
 		loggingIntervalAboveThreshold: 5 * int64(time.Minute),
+		quota:                         quota,
+		restoring:                     make(map[string]bool),
 	}
 }
 
@@ -190,6 +201,110 @@ func (f *FSClient) DownloadPublic(ctx context.Context, objectName string) (io.Re
 	return f.Download(ctx, objectName)
 }
 
+func (f *FSClient) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	filePath := filepath.Join(f.basedir, objectName)
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, common.NotFound(objectName)
+		}
+		return nil, errors.Wrapf(err, "failed to get file %s", filePath)
+	}
+	metadata := storedMetadata(filePath)
+	// Files are replaced rather than modified in place, so the modification time and size identify their contents
+	return &ObjectInfo{
+		Size:     info.Size(),
+		ETag:     fmt.Sprintf("\"%x-%x\"", info.ModTime().UnixNano(), info.Size()),
+		SHA256:   metadata[checksumMetadataKey],
+		Metadata: metadata,
+	}, nil
+}
+
+// metadataXattrPrefix starts the extended attributes that hold the metadata of a file, since files have no other
+// metadata
+const metadataXattrPrefix = "user."
+
+func (f *FSClient) SetObjectMetadata(ctx context.Context, objectName, key, value string) error {
+	filePath := filepath.Join(f.basedir, objectName)
+	if err := syscall.Setxattr(filePath, metadataXattrPrefix+key, []byte(value), 0); err != nil {
+		if os.IsNotExist(err) {
+			return common.NotFound(objectName)
+		}
+		if err == syscall.ENOTSUP {
+			logutil.FromContext(ctx, f.log).Warnf("The filesystem of %s doesn't support extended attributes, so the metadata of %s isn't kept", f.basedir, objectName)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to set the metadata of file %s", filePath)
+	}
+	return nil
+}
+
+// storedMetadata returns the metadata of the file, or nil if it has none or it can't be read
+func storedMetadata(filePath string) map[string]string {
+	names, err := readXattr(func(dest []byte) (int, error) { return syscall.Listxattr(filePath, dest) })
+	if err != nil {
+		return nil
+	}
+	var metadata map[string]string
+	for _, name := range strings.Split(string(names), "\x00") {
+		if !strings.HasPrefix(name, metadataXattrPrefix) {
+			continue
+		}
+		name := name
+		value, err := readXattr(func(dest []byte) (int, error) { return syscall.Getxattr(filePath, name, dest) })
+		if err != nil {
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[strings.TrimPrefix(name, metadataXattrPrefix)] = string(value)
+	}
+	return metadata
+}
+
+// readXattr reads a value of the extended attributes by calling read first to get its length, and then to fill it
+func readXattr(read func(dest []byte) (int, error)) ([]byte, error) {
+	length, err := read(nil)
+	if err != nil || length == 0 {
+		return nil, err
+	}
+	value := make([]byte, length)
+	if length, err = read(value); err != nil {
+		return nil, err
+	}
+	return value[:length], nil
+}
+
+func (f *FSClient) GetPublicObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return f.GetObjectInfo(ctx, objectName)
+}
+
+func (f *FSClient) DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	log := logutil.FromContext(ctx, f.log)
+	filePath := filepath.Join(f.basedir, objectName)
+	fp, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Unable to open file %s", filePath)
+		log.Error(err)
+		return nil, err
+	}
+	if _, err = fp.Seek(offset, io.SeekStart); err != nil {
+		fp.Close()
+		err = errors.Wrapf(err, "Unable to seek to offset %d of file %s", offset, filePath)
+		log.Error(err)
+		return nil, err
+	}
+	return ioutils.NewReadCloserWrapper(io.LimitReader(fp, length), fp.Close), nil
+}
+
+func (f *FSClient) DownloadPublicRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return f.DownloadRange(ctx, objectName, offset, length)
+}
+
 func (f *FSClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
 	filePath := filepath.Join(f.basedir, objectName)
 	info, err := os.Stat(filePath)
@@ -209,6 +324,10 @@ func (f *FSClient) DoesPublicObjectExist(ctx context.Context, objectName string)
 	return f.DoesObjectExist(ctx, objectName)
 }
 
+func (f *FSClient) GetPublicObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return f.GetObjectSizeBytes(ctx, objectName)
+}
+
 func (f *FSClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
 	log := logutil.FromContext(ctx, f.log)
 	filePath := filepath.Join(f.basedir, objectName)
@@ -405,6 +524,65 @@ type FSClientDecorator struct {
 	timeFSUsageLog                time.Time
 	loggingIntervalBelowThreshold int64
 	loggingIntervalAboveThreshold int64
+	// quota is nil when the storage isn't limited
+	quota *fsQuota
+	// restoreLock protects restoring, which holds the OpenShift versions whose evicted RHCOS images are being
+	// downloaded again
+	restoreLock sync.Mutex
+	restoring   map[string]bool
+}
+
+// reserve makes room for an object before it is uploaded
+func (d *FSClientDecorator) reserve(ctx context.Context, objectName string, sizeBytes int64) error {
+	if d.quota == nil {
+		return nil
+	}
+	return d.quota.reserve(ctx, objectName, sizeBytes)
+}
+
+// enforce makes room after an object was uploaded, and removes it if it doesn't fit
+func (d *FSClientDecorator) enforce(ctx context.Context, objectName string) error {
+	if d.quota == nil {
+		return nil
+	}
+	return d.quota.enforce(ctx, objectName)
+}
+
+func (d *FSClientDecorator) touch(objectName string) {
+	if d.quota != nil {
+		d.quota.touch(objectName)
+	}
+}
+
+// restoreBaseISOs downloads again, in the background, the RHCOS images of the OpenShift version if the given image
+// was evicted
+func (d *FSClientDecorator) restoreBaseISOs(openshiftVersion, objectName string) {
+	if d.quota == nil {
+		return
+	}
+	d.touch(objectName)
+	if _, err := os.Stat(filepath.Join(d.fsClient.basedir, objectName)); !os.IsNotExist(err) {
+		return
+	}
+	d.restoreLock.Lock()
+	defer d.restoreLock.Unlock()
+	if d.restoring[openshiftVersion] {
+		return
+	}
+	d.restoring[openshiftVersion] = true
+	go func() {
+		defer func() {
+			d.restoreLock.Lock()
+			defer d.restoreLock.Unlock()
+			delete(d.restoring, openshiftVersion)
+		}()
+		ctx := requestid.ToContext(context.Background(), requestid.NewID())
+		log := logutil.FromContext(ctx, d.log)
+		log.Infof("Restoring the evicted RHCOS images of OpenShift version %s", openshiftVersion)
+		if err := d.UploadISOs(ctx, openshiftVersion, true); err != nil {
+			log.WithError(err).Errorf("Failed to restore the evicted RHCOS images of OpenShift version %s", openshiftVersion)
+		}
+	}()
 }
 
 func (d *FSClientDecorator) shouldLog() bool {
@@ -459,38 +637,62 @@ func (d *FSClientDecorator) CreateBucket() error {
 }
 
 func (d *FSClientDecorator) Upload(ctx context.Context, data []byte, objectName string) error {
+	if err := d.reserve(ctx, objectName, int64(len(data))); err != nil {
+		return err
+	}
 	err := d.fsClient.Upload(ctx, data, objectName)
 	if err == nil {
+		err = d.enforce(ctx, objectName)
 		d.reportFilesystemUsageMetrics()
 	}
 	return err
 }
 
 func (d *FSClientDecorator) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	if err := d.reserve(ctx, objectName, 0); err != nil {
+		return err
+	}
 	err := d.fsClient.UploadStream(ctx, reader, objectName)
 	if err == nil {
+		err = d.enforce(ctx, objectName)
 		d.reportFilesystemUsageMetrics()
 	}
 	return err
 }
 
 func (d *FSClientDecorator) UploadFile(ctx context.Context, filePath, objectName string) error {
+	if info, statErr := os.Stat(filePath); statErr == nil {
+		if err := d.reserve(ctx, objectName, info.Size()); err != nil {
+			return err
+		}
+	}
 	err := d.fsClient.UploadFile(ctx, filePath, objectName)
 	if err == nil {
+		err = d.enforce(ctx, objectName)
 		d.reportFilesystemUsageMetrics()
 	}
 	return err
 }
 
 func (d *FSClientDecorator) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
+	objectName := fmt.Sprintf("%s.iso", destObjectPrefix)
+	d.touch(srcObject)
+	// The ISO has the size of the ISO it is created from
+	if srcSize, sizeErr := d.fsClient.GetObjectSizeBytes(ctx, srcObject); sizeErr == nil {
+		if err := d.reserve(ctx, objectName, srcSize); err != nil {
+			return err
+		}
+	}
 	err := d.fsClient.UploadISO(ctx, ignitionConfig, srcObject, destObjectPrefix)
 	if err == nil {
+		err = d.enforce(ctx, objectName)
 		d.reportFilesystemUsageMetrics()
 	}
 	return err
 }
 
 func (d *FSClientDecorator) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	d.touch(objectName)
 	return d.fsClient.Download(ctx, objectName)
 }
 
@@ -500,6 +702,9 @@ func (d *FSClientDecorator) DoesObjectExist(ctx context.Context, objectName stri
 
 func (d *FSClientDecorator) DeleteObject(ctx context.Context, objectName string) (bool, error) {
 	exists, err := d.fsClient.DeleteObject(ctx, objectName)
+	if d.quota != nil {
+		d.quota.removed(objectName)
+	}
 	if exists && err == nil {
 		d.reportFilesystemUsageMetrics()
 	}
@@ -510,6 +715,10 @@ func (d *FSClientDecorator) GetObjectSizeBytes(ctx context.Context, objectName s
 	return d.fsClient.GetObjectSizeBytes(ctx, objectName)
 }
 
+func (d *FSClientDecorator) SetObjectMetadata(ctx context.Context, objectName, key, value string) error {
+	return d.fsClient.SetObjectMetadata(ctx, objectName, key, value)
+}
+
 func (d *FSClientDecorator) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
 	return d.fsClient.GeneratePresignedDownloadURL(ctx, objectName, downloadFilename, duration)
 }
@@ -519,6 +728,15 @@ func (d *FSClientDecorator) UpdateObjectTimestamp(ctx context.Context, objectNam
 }
 
 func (d *FSClientDecorator) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration, callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	if d.quota != nil {
+		expiredCallback := callback
+		callback = func(ctx context.Context, log logrus.FieldLogger, filePath string) {
+			if objectName, err := filepath.Rel(d.fsClient.basedir, filePath); err == nil {
+				d.quota.removed(objectName)
+			}
+			expiredCallback(ctx, log, filePath)
+		}
+	}
 	d.fsClient.ExpireObjects(ctx, prefix, deleteTime, callback)
 	d.reportFilesystemUsageMetrics()
 }
@@ -529,6 +747,11 @@ func (d *FSClientDecorator) ListObjectsByPrefix(ctx context.Context, prefix stri
 
 func (d *FSClientDecorator) UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error {
 	err := d.fsClient.UploadISOs(ctx, openshiftVersion, haveLatestMinimalTemplate)
+	if d.quota != nil {
+		// The RHCOS images are written without going through the quota
+		d.quota.rescan(ctx)
+		d.quota.shrink(ctx)
+	}
 	if err != nil {
 		d.reportFilesystemUsageMetrics()
 	}
@@ -536,11 +759,19 @@ func (d *FSClientDecorator) UploadISOs(ctx context.Context, openshiftVersion str
 }
 
 func (d *FSClientDecorator) GetBaseIsoObject(openshiftVersion string) (string, error) {
-	return d.fsClient.GetBaseIsoObject(openshiftVersion)
+	objectName, err := d.fsClient.GetBaseIsoObject(openshiftVersion)
+	if err == nil {
+		d.restoreBaseISOs(openshiftVersion, objectName)
+	}
+	return objectName, err
 }
 
 func (d *FSClientDecorator) GetMinimalIsoObjectName(openshiftVersion string) (string, error) {
-	return d.fsClient.GetMinimalIsoObjectName(openshiftVersion)
+	objectName, err := d.fsClient.GetMinimalIsoObjectName(openshiftVersion)
+	if err == nil {
+		d.restoreBaseISOs(openshiftVersion, objectName)
+	}
+	return objectName, err
 }
 
 func (d *FSClientDecorator) CreatePublicBucket() error {
@@ -548,16 +779,26 @@ func (d *FSClientDecorator) CreatePublicBucket() error {
 }
 
 func (d *FSClientDecorator) UploadStreamToPublicBucket(ctx context.Context, reader io.Reader, objectName string) error {
+	if err := d.reserve(ctx, objectName, 0); err != nil {
+		return err
+	}
 	err := d.fsClient.UploadStreamToPublicBucket(ctx, reader, objectName)
 	if err == nil {
+		err = d.enforce(ctx, objectName)
 		d.reportFilesystemUsageMetrics()
 	}
 	return err
 }
 
 func (d *FSClientDecorator) UploadFileToPublicBucket(ctx context.Context, filePath, objectName string) error {
+	if info, statErr := os.Stat(filePath); statErr == nil {
+		if err := d.reserve(ctx, objectName, info.Size()); err != nil {
+			return err
+		}
+	}
 	err := d.fsClient.UploadFileToPublicBucket(ctx, filePath, objectName)
 	if err == nil {
+		err = d.enforce(ctx, objectName)
 		d.reportFilesystemUsageMetrics()
 	}
 	return err
@@ -567,6 +808,29 @@ func (d *FSClientDecorator) DoesPublicObjectExist(ctx context.Context, objectNam
 	return d.fsClient.DoesPublicObjectExist(ctx, objectName)
 }
 
+func (d *FSClientDecorator) GetPublicObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return d.fsClient.GetPublicObjectSizeBytes(ctx, objectName)
+}
+
+func (d *FSClientDecorator) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return d.fsClient.GetObjectInfo(ctx, objectName)
+}
+
+func (d *FSClientDecorator) GetPublicObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return d.fsClient.GetPublicObjectInfo(ctx, objectName)
+}
+
+func (d *FSClientDecorator) DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	d.touch(objectName)
+	return d.fsClient.DownloadRange(ctx, objectName, offset, length)
+}
+
+func (d *FSClientDecorator) DownloadPublicRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	d.touch(objectName)
+	return d.fsClient.DownloadPublicRange(ctx, objectName, offset, length)
+}
+
 func (d *FSClientDecorator) DownloadPublic(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	d.touch(objectName)
 	return d.fsClient.DownloadPublic(ctx, objectName)
 }
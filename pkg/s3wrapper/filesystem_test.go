@@ -12,6 +12,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -110,6 +111,33 @@ var _ = Describe("s3filesystem", func() {
 		Expect(length).To(Equal(expLen))
 		Expect(downloadLength).To(Equal(int64(expLen)))
 	})
+	It("upload_download_range", func() {
+		err := client.Upload(ctx, []byte(dataStr), objKey)
+		Expect(err).Should(BeNil())
+
+		info, err := client.GetObjectInfo(ctx, objKey)
+		Expect(err).Should(BeNil())
+		Expect(info.Size).To(Equal(int64(len(dataStr))))
+		Expect(info.ETag).To(HavePrefix(`"`))
+
+		reader, err := client.DownloadRange(ctx, objKey, 6, 3)
+		Expect(err).Should(BeNil())
+		data, err := ioutil.ReadAll(reader)
+		Expect(err).Should(BeNil())
+		Expect(reader.Close()).To(Succeed())
+		Expect(string(data)).To(Equal("wor"))
+
+		// Changing the modification time, as rewriting the file does, changes its entity tag
+		Expect(os.Chtimes(filepath.Join(baseDir, objKey), now, now)).To(Succeed())
+		changed, err := client.GetObjectInfo(ctx, objKey)
+		Expect(err).Should(BeNil())
+		Expect(changed.ETag).ToNot(Equal(info.ETag))
+
+		_, err = client.GetObjectInfo(ctx, objKey2)
+		Expect(err).To(Equal(common.NotFound(objKey2)))
+		_, err = client.DownloadRange(ctx, objKey2, 0, 1)
+		Expect(err).To(Equal(common.NotFound(objKey2)))
+	})
 	It("doesobjectexist_delete", func() {
 		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).Times(2)
 		err := client.Upload(ctx, []byte(dataStr), objKey)
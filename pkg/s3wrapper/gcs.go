@@ -0,0 +1,546 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/versions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// gcsISOPartsPrefix is the prefix of the objects holding the parts of the base ISOs around their embedded area,
+// which are composed with the embedded area of each cluster to create its ISO
+const gcsISOPartsPrefix = "iso-parts/"
+
+type GCSConfig struct {
+	Bucket    string `envconfig:"GCS_BUCKET"`
+	ProjectID string `envconfig:"GCS_PROJECT_ID"`
+	// CredentialsFile is a service account key file, used both for authentication and for signing URLs
+	CredentialsFile string `envconfig:"GCS_CREDENTIALS_FILE"`
+	// EndpointURL is empty for Google Cloud Storage, set it for emulators
+	EndpointURL string `envconfig:"GCS_ENDPOINT_URL"`
+
+	// Warning - the files stored in this bucket are publicly viewable and therefore
+	// should only be used for storing RHCOS image files that are readily available on the Internet
+	PublicBucket string `envconfig:"GCS_BUCKET_PUBLIC"`
+}
+
+var _ API = &GCSClient{}
+
+// GCSClient stores the objects in Google Cloud Storage
+type GCSClient struct {
+	log              logrus.FieldLogger
+	cfg              *GCSConfig
+	client           *storage.Client
+	bucket           *storage.BucketHandle
+	publicBucket     *storage.BucketHandle
+	signingEmail     string
+	signingKey       []byte
+	isoAreas         isoAreaCache
+	versionsHandler  versions.Handler
+	isoEditorFactory isoeditor.Factory
+}
+
+// NewGCSClient creates a new Google Cloud Storage client using the defined env variables
+func NewGCSClient(cfg *GCSConfig, logger logrus.FieldLogger, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory) *GCSClient {
+	var opts []option.ClientOption
+	gcsClient := &GCSClient{log: logger, cfg: cfg, versionsHandler: versionsHandler, isoEditorFactory: isoEditorFactory}
+
+	if cfg.CredentialsFile != "" {
+		credentials, err := ioutil.ReadFile(cfg.CredentialsFile)
+		if err != nil {
+			logger.WithError(err).Errorf("failed to read gcs credentials file %s", cfg.CredentialsFile)
+			return nil
+		}
+		jwtConfig, err := google.JWTConfigFromJSON(credentials)
+		if err != nil {
+			logger.WithError(err).Errorf("failed to parse gcs credentials file %s", cfg.CredentialsFile)
+			return nil
+		}
+		gcsClient.signingEmail = jwtConfig.Email
+		gcsClient.signingKey = jwtConfig.PrivateKey
+		opts = append(opts, option.WithCredentialsJSON(credentials))
+	}
+	if cfg.EndpointURL != "" {
+		opts = []option.ClientOption{
+			option.WithEndpoint(cfg.EndpointURL),
+			option.WithoutAuthentication(),
+			option.WithHTTPClient(&http.Client{Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // true to enable use of emulators with ip address
+			}}),
+		}
+	}
+
+	client, err := storage.NewClient(context.Background(), opts...)
+	if err != nil {
+		logger.WithError(err).Error("failed to create gcs client")
+		return nil
+	}
+	gcsClient.client = client
+	gcsClient.bucket = client.Bucket(cfg.Bucket)
+	gcsClient.publicBucket = client.Bucket(cfg.PublicBucket)
+	return gcsClient
+}
+
+// IsAwsS3 reports whether presigned URLs are reachable by the users, which is the case for Google Cloud Storage but
+// not for emulators
+func (g *GCSClient) IsAwsS3() bool {
+	return g.cfg.EndpointURL == "" && os.Getenv("STORAGE_EMULATOR_HOST") == ""
+}
+
+func (g *GCSClient) createBucket(bucket *storage.BucketHandle, name string, attrs *storage.BucketAttrs) error {
+	err := bucket.Create(context.Background(), g.cfg.ProjectID, attrs)
+	if err != nil && !isGCSStatus(err, http.StatusConflict) {
+		return errors.Wrapf(err, "Failed to create bucket %s", name)
+	}
+	return nil
+}
+
+func (g *GCSClient) CreateBucket() error {
+	return g.createBucket(g.bucket, g.cfg.Bucket, nil)
+}
+
+func (g *GCSClient) CreatePublicBucket() error {
+	return g.createBucket(g.publicBucket, g.cfg.PublicBucket, &storage.BucketAttrs{PredefinedDefaultObjectACL: "publicRead"})
+}
+
+func (g *GCSClient) uploadStream(ctx context.Context, reader io.Reader, objectName, bucketName string, bucket *storage.BucketHandle) error {
+	log := logutil.FromContext(ctx, g.log)
+	writer := bucket.Object(objectName).NewWriter(ctx)
+	if _, err := io.Copy(writer, reader); err != nil {
+		_ = writer.Close()
+		err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, bucketName)
+		log.Error(err)
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, bucketName)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to bucket %s", objectName, bucketName)
+	return nil
+}
+
+func (g *GCSClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return g.uploadStream(ctx, reader, objectName, g.cfg.Bucket, g.bucket)
+}
+
+func (g *GCSClient) UploadStreamToPublicBucket(ctx context.Context, reader io.Reader, objectName string) error {
+	return g.uploadStream(ctx, reader, objectName, g.cfg.PublicBucket, g.publicBucket)
+}
+
+func (g *GCSClient) uploadFile(ctx context.Context, filePath, objectName, bucketName string, bucket *storage.BucketHandle) error {
+	log := logutil.FromContext(ctx, g.log)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+	return g.uploadStream(ctx, file, objectName, bucketName, bucket)
+}
+
+func (g *GCSClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return g.uploadFile(ctx, filePath, objectName, g.cfg.Bucket, g.bucket)
+}
+
+func (g *GCSClient) UploadFileToPublicBucket(ctx context.Context, filePath, objectName string) error {
+	return g.uploadFile(ctx, filePath, objectName, g.cfg.PublicBucket, g.publicBucket)
+}
+
+func (g *GCSClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return g.UploadStream(ctx, bytes.NewReader(data), objectName)
+}
+
+// UploadISO creates the ISO of a cluster on the server side, by composing the parts of the base ISO around its
+// embedded area with the embedded area holding the ignition config
+func (g *GCSClient) UploadISO(ctx context.Context, ignitionConfig, srcObject, destObjectPrefix string) error {
+	log := logutil.FromContext(ctx, g.log)
+	destObjectName := fmt.Sprintf("%s.iso", destObjectPrefix)
+
+	srcAttrs, err := g.publicBucket.Object(srcObject).Attrs(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to get attributes of base ISO %s", srcObject)
+	}
+	src := g.publicBucket.Object(srcObject).Generation(srcAttrs.Generation)
+	version := strconv.FormatInt(srcAttrs.Generation, 10)
+
+	area, err := g.isoAreas.get(ctx, version, srcAttrs.Size, func(ctx context.Context, offset, length int64) ([]byte, error) {
+		return g.readRange(ctx, src, offset, length)
+	})
+	if err != nil {
+		return err
+	}
+	areaContents, err := area.withIgnition(ignitionConfig)
+	if err != nil {
+		return err
+	}
+
+	partsPrefix := fmt.Sprintf("%s%s/%s/", gcsISOPartsPrefix, srcObject, version)
+	head, err := g.isoPart(ctx, src, partsPrefix+"head", 0, area.offset)
+	if err != nil {
+		return err
+	}
+	tail, err := g.isoPart(ctx, src, partsPrefix+"tail", area.offset+area.length, srcAttrs.Size-area.offset-area.length)
+	if err != nil {
+		return err
+	}
+
+	areaObjectName := destObjectName + ".area"
+	if err = g.Upload(ctx, areaContents, areaObjectName); err != nil {
+		return err
+	}
+	defer func() {
+		if deleteErr := g.bucket.Object(areaObjectName).Delete(context.Background()); deleteErr != nil {
+			log.WithError(deleteErr).Warnf("Failed to delete temporary object %s", areaObjectName)
+		}
+	}()
+
+	if _, err = g.bucket.Object(destObjectName).ComposerFrom(head, g.bucket.Object(areaObjectName), tail).Run(ctx); err != nil {
+		return errors.Wrapf(err, "Failed to compose %s", destObjectName)
+	}
+	log.Infof("Successfully uploaded %s to bucket %s", destObjectName, g.cfg.Bucket)
+	return nil
+}
+
+// isoPart returns the object holding a part of the base ISO, and copies that part of the base ISO to it if it
+// doesn't exist yet
+func (g *GCSClient) isoPart(ctx context.Context, src *storage.ObjectHandle, objectName string, offset, length int64) (*storage.ObjectHandle, error) {
+	part := g.bucket.Object(objectName)
+	if _, err := part.Attrs(ctx); err == nil {
+		return part, nil
+	} else if !errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, errors.Wrapf(err, "Failed to get attributes of %s", objectName)
+	}
+
+	reader, err := src.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read range %d-%d of the base ISO", offset, offset+length)
+	}
+	defer reader.Close()
+	if err = g.UploadStream(ctx, reader, objectName); err != nil {
+		return nil, err
+	}
+	return part, nil
+}
+
+func (g *GCSClient) readRange(ctx context.Context, object *storage.ObjectHandle, offset, length int64) ([]byte, error) {
+	reader, err := object.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+func (g *GCSClient) download(ctx context.Context, objectName, bucketName string, bucket *storage.BucketHandle) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, g.log)
+	log.Infof("Downloading %s from bucket %s", objectName, bucketName)
+
+	reader, err := bucket.Object(objectName).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, 0, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Failed to get %s object from bucket %s", objectName, bucketName)
+		log.Error(err)
+		return nil, 0, err
+	}
+	return reader, reader.Attrs.Size, nil
+}
+
+func (g *GCSClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return g.download(ctx, objectName, g.cfg.Bucket, g.bucket)
+}
+
+func (g *GCSClient) DownloadPublic(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	return g.download(ctx, objectName, g.cfg.PublicBucket, g.publicBucket)
+}
+
+func (g *GCSClient) getObjectInfo(ctx context.Context, objectName string, bucket *storage.BucketHandle, bucketName string) (*ObjectInfo, error) {
+	log := logutil.FromContext(ctx, g.log)
+	attrs, err := bucket.Object(objectName).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in bucket %s", objectName, bucketName)
+		log.Error(err)
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size:     attrs.Size,
+		ETag:     quoteETag(attrs.Etag),
+		SHA256:   attrs.Metadata[checksumMetadataKey],
+		Metadata: attrs.Metadata,
+	}, nil
+}
+
+func (g *GCSClient) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return g.getObjectInfo(ctx, objectName, g.bucket, g.cfg.Bucket)
+}
+
+func (g *GCSClient) GetPublicObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	return g.getObjectInfo(ctx, objectName, g.publicBucket, g.cfg.PublicBucket)
+}
+
+func (g *GCSClient) downloadRange(ctx context.Context, objectName string, offset, length int64, bucketName string, bucket *storage.BucketHandle) (io.ReadCloser, error) {
+	log := logutil.FromContext(ctx, g.log)
+	log.Infof("Downloading %d bytes at offset %d of %s from bucket %s", length, offset, objectName, bucketName)
+
+	reader, err := bucket.Object(objectName).NewRangeReader(ctx, offset, length)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, common.NotFound(objectName)
+		}
+		err = errors.Wrapf(err, "Failed to get a range of %s object from bucket %s", objectName, bucketName)
+		log.Error(err)
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (g *GCSClient) DownloadRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return g.downloadRange(ctx, objectName, offset, length, g.cfg.Bucket, g.bucket)
+}
+
+func (g *GCSClient) DownloadPublicRange(ctx context.Context, objectName string, offset, length int64) (io.ReadCloser, error) {
+	return g.downloadRange(ctx, objectName, offset, length, g.cfg.PublicBucket, g.publicBucket)
+}
+
+func (g *GCSClient) doesObjectExist(ctx context.Context, objectName, bucketName string, bucket *storage.BucketHandle) (bool, error) {
+	log := logutil.FromContext(ctx, g.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, bucketName)
+	_, err := bucket.Object(objectName).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get %s from bucket %s", objectName, bucketName)
+	}
+	return true, nil
+}
+
+func (g *GCSClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return g.doesObjectExist(ctx, objectName, g.cfg.Bucket, g.bucket)
+}
+
+func (g *GCSClient) DoesPublicObjectExist(ctx context.Context, objectName string) (bool, error) {
+	return g.doesObjectExist(ctx, objectName, g.cfg.PublicBucket, g.publicBucket)
+}
+
+func (g *GCSClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, g.log)
+	log.Infof("Deleting object %s from %s", objectName, g.cfg.Bucket)
+
+	if err := g.bucket.Object(objectName).Delete(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			log.Infof("Object %s does not exist in bucket %s", objectName, g.cfg.Bucket)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to delete object %s from bucket %s", objectName, g.cfg.Bucket)
+	}
+
+	log.Infof("Deleted object %s from bucket %s", objectName, g.cfg.Bucket)
+	return true, nil
+}
+
+func (g *GCSClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, g.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	_, err := g.bucket.Object(objectName).Update(ctx, storage.ObjectAttrsToUpdate{
+		Metadata: map[string]string{timestampTagKey: strconv.FormatInt(time.Now().Unix(), 10)},
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "Failed to update metadata of object %s from bucket %s", objectName, g.cfg.Bucket)
+	}
+	return true, nil
+}
+
+func (g *GCSClient) SetObjectMetadata(ctx context.Context, objectName, key, value string) error {
+	log := logutil.FromContext(ctx, g.log)
+	log.Debugf("Setting %s in the metadata of object %s", key, objectName)
+	// The given metadata is merged into the existing metadata of the object
+	_, err := g.bucket.Object(objectName).Update(ctx, storage.ObjectAttrsToUpdate{
+		Metadata: map[string]string{key: value},
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return common.NotFound(objectName)
+		}
+		return errors.Wrapf(err, "Failed to set the metadata of object %s in bucket %s", objectName, g.cfg.Bucket)
+	}
+	return nil
+}
+
+func (g *GCSClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return g.getObjectSizeBytes(ctx, objectName, g.bucket, g.cfg.Bucket)
+}
+
+func (g *GCSClient) GetPublicObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	return g.getObjectSizeBytes(ctx, objectName, g.publicBucket, g.cfg.PublicBucket)
+}
+
+func (g *GCSClient) getObjectSizeBytes(ctx context.Context, objectName string, bucket *storage.BucketHandle, bucketName string) (int64, error) {
+	log := logutil.FromContext(ctx, g.log)
+	attrs, err := bucket.Object(objectName).Attrs(ctx)
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in bucket %s", objectName, bucketName)
+		log.Error(err)
+		return 0, err
+	}
+	return attrs.Size, nil
+}
+
+func (g *GCSClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	log := logutil.FromContext(ctx, g.log)
+	if g.signingKey == nil {
+		err := errors.Errorf("Failed to create presigned download URL for object %s in bucket %s: no service account credentials are configured",
+			objectName, g.cfg.Bucket)
+		log.Error(err)
+		return "", err
+	}
+	urlStr, err := storage.SignedURL(g.cfg.Bucket, objectName, &storage.SignedURLOptions{
+		GoogleAccessID:  g.signingEmail,
+		PrivateKey:      g.signingKey,
+		Method:          http.MethodGet,
+		Expires:         time.Now().Add(duration),
+		Scheme:          storage.SigningSchemeV4,
+		QueryParameters: url.Values{"response-content-disposition": []string{fmt.Sprintf("attachment;filename=%s", downloadFilename)}},
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to create presigned download URL for object %s in bucket %s", objectName, g.cfg.Bucket)
+		log.Error(err)
+		return "", err
+	}
+	return urlStr, nil
+}
+
+func (g *GCSClient) listObjects(ctx context.Context, prefix string, handle func(attrs *storage.ObjectAttrs)) error {
+	it := g.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		handle(attrs)
+	}
+}
+
+func (g *GCSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, g.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	err := g.listObjects(ctx, prefix, func(attrs *storage.ObjectAttrs) {
+		if now.Before(gcsObjectCreationTime(attrs).Add(deleteTime)) {
+			return
+		}
+		if _, err := g.DeleteObject(ctx, attrs.Name); err != nil {
+			log.WithError(err).Errorf("Error deleting expired object %s", attrs.Name)
+			return
+		}
+		log.Infof("Deleted expired object %s", attrs.Name)
+		callback(ctx, log, attrs.Name)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+	}
+}
+
+// gcsObjectCreationTime returns the time of the last timestamp update of the object, or its creation time if it
+// was never updated
+func gcsObjectCreationTime(attrs *storage.ObjectAttrs) time.Time {
+	if value, ok := attrs.Metadata[timestampTagKey]; ok {
+		if objTime, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Unix(objTime, 0)
+		}
+	}
+	return attrs.Created
+}
+
+func (g *GCSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, g.log)
+	var objects []string
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := g.listObjects(ctx, prefix, func(attrs *storage.ObjectAttrs) {
+		objects = append(objects, attrs.Name)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (g *GCSClient) UploadISOs(ctx context.Context, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	rhcosImage, err := g.versionsHandler.GetRHCOSImage(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	baseIsoObject, err := g.GetBaseIsoObject(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	minimalIsoObject, err := g.GetMinimalIsoObjectName(openshiftVersion)
+	if err != nil {
+		return err
+	}
+
+	log := logutil.FromContext(ctx, g.log)
+	return uploadISOsFromFile(ctx, log, g, g.versionsHandler, g.isoEditorFactory, baseIsoObject, minimalIsoObject, rhcosImage, openshiftVersion, haveLatestMinimalTemplate)
+}
+
+func (g *GCSClient) GetBaseIsoObject(openshiftVersion string) (string, error) {
+	rhcosVersion, err := g.versionsHandler.GetRHCOSVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(rhcosObjectTemplate, rhcosVersion), nil
+}
+
+func (g *GCSClient) GetMinimalIsoObjectName(openshiftVersion string) (string, error) {
+	rhcosVersion, err := g.versionsHandler.GetRHCOSVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(rhcosMinimalObjectTemplate, rhcosVersion), nil
+}
+
+func isGCSStatus(err error, code int) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
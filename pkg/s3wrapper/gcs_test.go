@@ -0,0 +1,126 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"time"
+
+	"cloud.google.com/go/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("gcs client", func() {
+	var (
+		ctx             = context.Background()
+		log             = logrus.New()
+		server          *httptest.Server
+		credentialsFile string
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		credentials, err := json.Marshal(map[string]string{
+			"type":         "service_account",
+			"client_email": "assisted@project.iam.gserviceaccount.com",
+			"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		file, err := ioutil.TempFile("", "gcs-credentials")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.Write(credentials)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		credentialsFile = file.Name()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.Remove(credentialsFile)
+	})
+
+	newClient := func(endpointURL string) *GCSClient {
+		client := NewGCSClient(&GCSConfig{
+			Bucket:          "private",
+			PublicBucket:    "public",
+			CredentialsFile: credentialsFile,
+			EndpointURL:     endpointURL,
+		}, log, nil, nil)
+		Expect(client).ToNot(BeNil())
+		return client
+	}
+
+	It("fails with missing credentials", func() {
+		Expect(NewGCSClient(&GCSConfig{CredentialsFile: "/does/not/exist"}, log, nil, nil)).To(BeNil())
+	})
+
+	It("uses presigned URLs only with Google Cloud Storage", func() {
+		Expect(newClient("").IsAwsS3()).To(BeTrue())
+		Expect(newClient(server.URL + "/storage/v1/").IsAwsS3()).To(BeFalse())
+	})
+
+	It("generates a signed URL", func() {
+		urlStr, err := newClient("").GeneratePresignedDownloadURL(ctx, "discovery-image-abc.iso", "image.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		signedURL, err := url.Parse(urlStr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signedURL.Path).To(Equal("/private/discovery-image-abc.iso"))
+		query := signedURL.Query()
+		Expect(query.Get("X-Goog-Credential")).To(HavePrefix("assisted@project.iam.gserviceaccount.com/"))
+		Expect(query.Get("X-Goog-Expires")).To(BeElementOf("3599", "3600"))
+		Expect(query.Get("X-Goog-Signature")).ToNot(BeEmpty())
+		Expect(query.Get("response-content-disposition")).To(Equal("attachment;filename=image.iso"))
+	})
+
+	It("fails to generate a signed URL without credentials", func() {
+		client := NewGCSClient(&GCSConfig{Bucket: "private", EndpointURL: server.URL + "/storage/v1/"}, log, nil, nil)
+		Expect(client).ToNot(BeNil())
+		_, err := client.GeneratePresignedDownloadURL(ctx, "discovery-image-abc.iso", "image.iso", time.Hour)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns not found for missing objects", func() {
+		client := newClient(server.URL + "/storage/v1/")
+
+		_, _, err := client.Download(ctx, "missing")
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(common.NotFound("missing")))
+
+		exists, err := client.DoesObjectExist(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		deleted, err := client.DeleteObject(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+
+		Expect(client.SetObjectMetadata(ctx, "missing", checksumMetadataKey, "abcdef")).To(Equal(common.NotFound("missing")))
+	})
+
+	It("uses the timestamp metadata as the creation time", func() {
+		created := time.Now().Add(-time.Hour)
+		attrs := &storage.ObjectAttrs{Created: created}
+		Expect(gcsObjectCreationTime(attrs)).To(Equal(created))
+
+		attrs.Metadata = map[string]string{timestampTagKey: "1600000000"}
+		Expect(gcsObjectCreationTime(attrs)).To(Equal(time.Unix(1600000000, 0)))
+	})
+})
@@ -0,0 +1,142 @@
+package s3wrapper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// isoHeaderOffset and isoHeaderLength locate the header of the live ISO (last 24 bytes of the first 32KB),
+// which holds the location of the embedded area
+const (
+	isoHeaderOffset = 32744
+	isoHeaderLength = 24
+)
+
+// readRangeFunc returns the bytes of an object in the range [offset, offset+length)
+type readRangeFunc func(ctx context.Context, offset, length int64) ([]byte, error)
+
+// isoArea is the embedded area of a base ISO, for backends that build the ISOs of the clusters out of the parts
+// of the base ISO around that area
+type isoArea struct {
+	offset   int64
+	length   int64
+	contents []byte
+}
+
+// isoAreaCache caches the embedded areas of the base ISOs by their version (ETag or generation)
+type isoAreaCache struct {
+	mutex sync.Mutex
+	areas map[string]*isoArea
+}
+
+func (c *isoAreaCache) get(ctx context.Context, version string, baseObjectSize int64, readRange readRangeFunc) (*isoArea, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if area, ok := c.areas[version]; ok {
+		return area, nil
+	}
+	area, err := readISOArea(ctx, baseObjectSize, readRange)
+	if err != nil {
+		return nil, err
+	}
+	if c.areas == nil {
+		c.areas = make(map[string]*isoArea)
+	}
+	c.areas[version] = area
+	return area, nil
+}
+
+func readISOArea(ctx context.Context, baseObjectSize int64, readRange readRangeFunc) (*isoArea, error) {
+	header, err := readRange(ctx, isoHeaderOffset, isoHeaderLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read the header of the base ISO")
+	}
+	ignOffsetInfo, err := isoeditor.GetIgnitionArea(header)
+	if err != nil {
+		return nil, err
+	}
+	offset, length := int64(ignOffsetInfo.Offset), int64(ignOffsetInfo.Length)
+	if offset+length > baseObjectSize {
+		return nil, errors.New("Embedded area exceeds the size of the base ISO")
+	}
+	contents, err := readRange(ctx, offset, length)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read the embedded area of the base ISO")
+	}
+	return &isoArea{offset: offset, length: length, contents: contents}, nil
+}
+
+// withIgnition returns the contents of the embedded area with the ignition config embedded in it
+func (a *isoArea) withIgnition(ignitionConfig string) ([]byte, error) {
+	ignitionBytes, err := isoeditor.IgnitionImageArchive(ignitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(ignitionBytes)) > a.length {
+		return nil, errors.New(fmt.Sprintf("Ignition is too long to be embedded (%d > %d)", len(ignitionBytes), a.length))
+	}
+	contents := make([]byte, len(a.contents))
+	copy(contents, a.contents)
+	copy(contents, ignitionBytes)
+	return contents, nil
+}
+
+// uploadISOsFromFile downloads the base ISO to a temporary file and uploads it and the minimal ISO created from
+// it to the public bucket, if they don't exist there yet
+func uploadISOsFromFile(ctx context.Context, log logrus.FieldLogger, api API, versionsHandler versions.Handler, isoEditorFactory isoeditor.Factory,
+	isoObjectName, minimalIsoObject, isoURL, openshiftVersion string, haveLatestMinimalTemplate bool) error {
+	baseExists, err := api.DoesPublicObjectExist(ctx, isoObjectName)
+	if err != nil {
+		return err
+	}
+
+	var minimalExists bool
+	if !haveLatestMinimalTemplate {
+		// Should update minimal ISO template
+		minimalExists = false
+	} else {
+		minimalExists, err = api.DoesPublicObjectExist(ctx, minimalIsoObject)
+		if err != nil {
+			return err
+		}
+	}
+
+	if baseExists && minimalExists {
+		return nil
+	}
+
+	log.Infof("Starting Base ISO download for %s", isoObjectName)
+	baseIsoPath, err := DownloadURLToTemporaryFile(isoURL)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer os.Remove(baseIsoPath)
+
+	if !baseExists {
+		err = api.UploadFileToPublicBucket(ctx, baseIsoPath, isoObjectName)
+		if err != nil {
+			return err
+		}
+		log.Infof("Successfully uploaded object %s", isoObjectName)
+	}
+
+	if !minimalExists {
+		rootFSURL, err := versionsHandler.GetRHCOSRootFS(openshiftVersion)
+		if err != nil {
+			return err
+		}
+		if err = CreateAndUploadMinimalIso(ctx, log, baseIsoPath, minimalIsoObject, rootFSURL, api, isoEditorFactory); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+)
+
+var _ = Describe("isoAreaCache", func() {
+	var (
+		ctx       = context.Background()
+		baseISO   []byte
+		areaStart = int64(40000)
+		areaLen   = int64(4096)
+		reads     int
+	)
+
+	readRange := func(ctx context.Context, offset, length int64) ([]byte, error) {
+		reads++
+		return baseISO[offset : offset+length], nil
+	}
+
+	BeforeEach(func() {
+		reads = 0
+		baseISO = bytes.Repeat([]byte{0xff}, 50000)
+		header := new(bytes.Buffer)
+		Expect(binary.Write(header, binary.LittleEndian, isoeditor.OffsetInfo{
+			Key:    [8]byte{'c', 'o', 'r', 'e', 'i', 's', 'o', '+'},
+			Offset: uint64(areaStart),
+			Length: uint64(areaLen),
+		})).To(Succeed())
+		copy(baseISO[isoHeaderOffset:], header.Bytes())
+		copy(baseISO[areaStart:], bytes.Repeat([]byte{0}, int(areaLen)))
+	})
+
+	It("reads the embedded area once per version", func() {
+		cache := isoAreaCache{}
+		area, err := cache.get(ctx, "v1", int64(len(baseISO)), readRange)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(area.offset).To(Equal(areaStart))
+		Expect(area.length).To(Equal(areaLen))
+		Expect(reads).To(Equal(2))
+
+		_, err = cache.get(ctx, "v1", int64(len(baseISO)), readRange)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reads).To(Equal(2))
+
+		_, err = cache.get(ctx, "v2", int64(len(baseISO)), readRange)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reads).To(Equal(4))
+	})
+
+	It("embeds the ignition config without modifying the cached area", func() {
+		cache := isoAreaCache{}
+		area, err := cache.get(ctx, "v1", int64(len(baseISO)), readRange)
+		Expect(err).ToNot(HaveOccurred())
+
+		contents, err := area.withIgnition(`{"ignition":{"version":"3.1.0"}}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contents).To(HaveLen(int(areaLen)))
+		archive, err := isoeditor.IgnitionImageArchive(`{"ignition":{"version":"3.1.0"}}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contents[:len(archive)]).To(Equal(archive))
+		Expect(area.contents).To(Equal(bytes.Repeat([]byte{0}, int(areaLen))))
+	})
+
+	It("fails when the ignition config is too long", func() {
+		area := &isoArea{offset: areaStart, length: 10, contents: make([]byte, 10)}
+		_, err := area.withIgnition(`{"ignition":{"version":"3.1.0"}}`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the embedded area exceeds the base ISO", func() {
+		cache := isoAreaCache{}
+		_, err := cache.get(ctx, "v1", areaStart, readRange)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("doesn't cache failures", func() {
+		cache := isoAreaCache{}
+		_, err := cache.get(ctx, "v1", int64(len(baseISO)), func(ctx context.Context, offset, length int64) ([]byte, error) {
+			return nil, errors.New("read failed")
+		})
+		Expect(err).To(HaveOccurred())
+		_, err = cache.get(ctx, "v1", int64(len(baseISO)), readRange)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
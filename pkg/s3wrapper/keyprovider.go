@@ -0,0 +1,127 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -source=keyprovider.go -package=s3wrapper -destination=mock_keyprovider.go
+
+// KeyProvider wraps the data keys that encrypt the stored objects with master keys that never leave the provider,
+// such as the keys of a local key file or of a key management service
+type KeyProvider interface {
+	// CurrentKeyID returns the ID of the master key that wraps the data keys of new objects
+	CurrentKeyID() string
+	// WrapKey encrypts a data key with the current master key, and returns the ID of that key
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrappedKey []byte, err error)
+	// UnwrapKey decrypts a data key that was encrypted with the master key of the given ID
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// LocalKeyProvider wraps data keys with AES-256-GCM master keys that are read from a local key file
+type LocalKeyProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+var _ KeyProvider = &LocalKeyProvider{}
+
+// NewLocalKeyProvider reads the master keys from a key file. Each line of the file holds a key as
+// `<key ID>:<base64 of 32 random bytes>`, and empty lines and lines starting with # are ignored. The first key wraps
+// the data keys of new objects, and the other keys are kept to decrypt the objects that were encrypted before the
+// keys were rotated.
+func NewLocalKeyProvider(keyFile string) (*LocalKeyProvider, error) {
+	f, err := os.Open(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open encryption key file %s", keyFile)
+	}
+	defer f.Close()
+
+	provider := &LocalKeyProvider{keys: make(map[string]cipher.AEAD)}
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("line %d of encryption key file %s is not in the <key ID>:<key> format", lineNumber, keyFile)
+		}
+		keyID := parts[0]
+		if _, ok := provider.keys[keyID]; ok {
+			return nil, errors.Errorf("encryption key file %s holds key %s more than once", keyFile, keyID)
+		}
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode key %s of encryption key file %s", keyID, keyFile)
+		}
+		if len(key) != dataKeyLength {
+			return nil, errors.Errorf("key %s of encryption key file %s is %d bytes long instead of %d", keyID, keyFile, len(key), dataKeyLength)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		provider.keys[keyID] = aead
+		if provider.currentKeyID == "" {
+			provider.currentKeyID = keyID
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read encryption key file %s", keyFile)
+	}
+	if provider.currentKeyID == "" {
+		return nil, errors.Errorf("encryption key file %s holds no keys", keyFile)
+	}
+	return provider, nil
+}
+
+func (p *LocalKeyProvider) CurrentKeyID() string {
+	return p.currentKeyID
+}
+
+func (p *LocalKeyProvider) WrapKey(_ context.Context, dataKey []byte) (string, []byte, error) {
+	aead := p.keys[p.currentKeyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return p.currentKeyID, aead.Seal(nonce, nonce, dataKey, []byte(p.currentKeyID)), nil
+}
+
+func (p *LocalKeyProvider) UnwrapKey(_ context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("encryption key %s is not in the key file", keyID)
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.Errorf("wrapped data key is too short")
+	}
+	dataKey, err := aead.Open(nil, wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key with encryption key %s", keyID)
+	}
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCM cipher")
+	}
+	return aead, nil
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keyprovider.go
+
+// Package s3wrapper is a generated GoMock package.
+package s3wrapper
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockKeyProvider is a mock of KeyProvider interface
+type MockKeyProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyProviderMockRecorder
+}
+
+// MockKeyProviderMockRecorder is the mock recorder for MockKeyProvider
+type MockKeyProviderMockRecorder struct {
+	mock *MockKeyProvider
+}
+
+// NewMockKeyProvider creates a new mock instance
+func NewMockKeyProvider(ctrl *gomock.Controller) *MockKeyProvider {
+	mock := &MockKeyProvider{ctrl: ctrl}
+	mock.recorder = &MockKeyProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockKeyProvider) EXPECT() *MockKeyProviderMockRecorder {
+	return m.recorder
+}
+
+// CurrentKeyID mocks base method
+func (m *MockKeyProvider) CurrentKeyID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentKeyID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CurrentKeyID indicates an expected call of CurrentKeyID
+func (mr *MockKeyProviderMockRecorder) CurrentKeyID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentKeyID", reflect.TypeOf((*MockKeyProvider)(nil).CurrentKeyID))
+}
+
+// WrapKey mocks base method
+func (m *MockKeyProvider) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WrapKey", ctx, dataKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WrapKey indicates an expected call of WrapKey
+func (mr *MockKeyProviderMockRecorder) WrapKey(ctx, dataKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WrapKey", reflect.TypeOf((*MockKeyProvider)(nil).WrapKey), ctx, dataKey)
+}
+
+// UnwrapKey mocks base method
+func (m *MockKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnwrapKey", ctx, keyID, wrappedKey)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnwrapKey indicates an expected call of UnwrapKey
+func (mr *MockKeyProviderMockRecorder) UnwrapKey(ctx, keyID, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwrapKey", reflect.TypeOf((*MockKeyProvider)(nil).UnwrapKey), ctx, keyID, wrappedKey)
+}
@@ -141,6 +141,36 @@ func (mr *MockAPIMockRecorder) DownloadPublic(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPublic", reflect.TypeOf((*MockAPI)(nil).DownloadPublic), arg0, arg1)
 }
 
+// DownloadPublicRange mocks base method
+func (m *MockAPI) DownloadPublicRange(arg0 context.Context, arg1 string, arg2, arg3 int64) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadPublicRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadPublicRange indicates an expected call of DownloadPublicRange
+func (mr *MockAPIMockRecorder) DownloadPublicRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadPublicRange", reflect.TypeOf((*MockAPI)(nil).DownloadPublicRange), arg0, arg1, arg2, arg3)
+}
+
+// DownloadRange mocks base method
+func (m *MockAPI) DownloadRange(arg0 context.Context, arg1 string, arg2, arg3 int64) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadRange indicates an expected call of DownloadRange
+func (mr *MockAPIMockRecorder) DownloadRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadRange", reflect.TypeOf((*MockAPI)(nil).DownloadRange), arg0, arg1, arg2, arg3)
+}
+
 // ExpireObjects mocks base method
 func (m *MockAPI) ExpireObjects(arg0 context.Context, arg1 string, arg2 time.Duration, arg3 func(context.Context, logrus.FieldLogger, string)) {
 	m.ctrl.T.Helper()
@@ -198,6 +228,21 @@ func (mr *MockAPIMockRecorder) GetMinimalIsoObjectName(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMinimalIsoObjectName", reflect.TypeOf((*MockAPI)(nil).GetMinimalIsoObjectName), arg0)
 }
 
+// GetObjectInfo mocks base method
+func (m *MockAPI) GetObjectInfo(arg0 context.Context, arg1 string) (*ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectInfo", arg0, arg1)
+	ret0, _ := ret[0].(*ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectInfo indicates an expected call of GetObjectInfo
+func (mr *MockAPIMockRecorder) GetObjectInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectInfo", reflect.TypeOf((*MockAPI)(nil).GetObjectInfo), arg0, arg1)
+}
+
 // GetObjectSizeBytes mocks base method
 func (m *MockAPI) GetObjectSizeBytes(arg0 context.Context, arg1 string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +258,36 @@ func (mr *MockAPIMockRecorder) GetObjectSizeBytes(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectSizeBytes", reflect.TypeOf((*MockAPI)(nil).GetObjectSizeBytes), arg0, arg1)
 }
 
+// GetPublicObjectInfo mocks base method
+func (m *MockAPI) GetPublicObjectInfo(arg0 context.Context, arg1 string) (*ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicObjectInfo", arg0, arg1)
+	ret0, _ := ret[0].(*ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicObjectInfo indicates an expected call of GetPublicObjectInfo
+func (mr *MockAPIMockRecorder) GetPublicObjectInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicObjectInfo", reflect.TypeOf((*MockAPI)(nil).GetPublicObjectInfo), arg0, arg1)
+}
+
+// GetPublicObjectSizeBytes mocks base method
+func (m *MockAPI) GetPublicObjectSizeBytes(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicObjectSizeBytes", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicObjectSizeBytes indicates an expected call of GetPublicObjectSizeBytes
+func (mr *MockAPIMockRecorder) GetPublicObjectSizeBytes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicObjectSizeBytes", reflect.TypeOf((*MockAPI)(nil).GetPublicObjectSizeBytes), arg0, arg1)
+}
+
 // IsAwsS3 mocks base method
 func (m *MockAPI) IsAwsS3() bool {
 	m.ctrl.T.Helper()
@@ -242,6 +317,20 @@ func (mr *MockAPIMockRecorder) ListObjectsByPrefix(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsByPrefix", reflect.TypeOf((*MockAPI)(nil).ListObjectsByPrefix), arg0, arg1)
 }
 
+// SetObjectMetadata mocks base method
+func (m *MockAPI) SetObjectMetadata(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetObjectMetadata", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetObjectMetadata indicates an expected call of SetObjectMetadata
+func (mr *MockAPIMockRecorder) SetObjectMetadata(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetObjectMetadata", reflect.TypeOf((*MockAPI)(nil).SetObjectMetadata), arg0, arg1, arg2, arg3)
+}
+
 // UpdateObjectTimestamp mocks base method
 func (m *MockAPI) UpdateObjectTimestamp(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
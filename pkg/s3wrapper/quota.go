@@ -0,0 +1,283 @@
+package s3wrapper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/metrics"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// EvictableDiscoveryImage is the type of the evicted discovery images, which are generated again by the users
+	EvictableDiscoveryImage = "discovery-image"
+	// EvictableBaseISO is the type of the evicted RHCOS images, which the service downloads again when they are needed
+	EvictableBaseISO = "base-iso"
+)
+
+// QuotaExceededError is returned when an upload doesn't fit the filesystem storage quota, even after evicting all the
+// objects that can be created again
+type QuotaExceededError struct {
+	ObjectName string
+	QuotaBytes int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("Object %s doesn't fit the filesystem storage quota of %d bytes", e.ObjectName, e.QuotaBytes)
+}
+
+// EvictableObjectType returns the type of the object if it may be evicted to stay within the filesystem storage
+// quota, because it can be created again, or an empty string if the object must be kept
+func EvictableObjectType(objectName string) string {
+	if strings.Contains(objectName, "/") || !strings.HasSuffix(objectName, ".iso") {
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(objectName, SharedDiscoveryImagePrefix),
+		strings.HasPrefix(objectName, fmt.Sprintf(DiscoveryImageTemplate, "")):
+		return EvictableDiscoveryImage
+	case strings.HasPrefix(objectName, strings.Split(rhcosObjectTemplate, "%s")[0]):
+		return EvictableBaseISO
+	}
+	return ""
+}
+
+type storedObject struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+// fsQuota keeps the objects of the filesystem storage within a number of bytes by evicting the least recently used
+// objects that can be created again
+type fsQuota struct {
+	log        logrus.FieldLogger
+	basedir    string
+	quotaBytes int64
+	metricsAPI metrics.API
+
+	// lock serializes the enforcement of the quota, so that concurrent uploads don't evict the same objects. It also
+	// protects objects, which holds the stored objects by their names, and used, which is the number of bytes they
+	// use. They are listed from the directory once, when they are first needed, and then kept up to date as objects
+	// are written and deleted.
+	lock    sync.Mutex
+	objects map[string]storedObject
+	used    int64
+	// accessLock protects accessTimes, which holds the times the objects were last read since the service started
+	accessLock  sync.Mutex
+	accessTimes map[string]time.Time
+}
+
+func newFSQuota(log logrus.FieldLogger, basedir string, quotaBytes int64, metricsAPI metrics.API) *fsQuota {
+	return &fsQuota{
+		log:         log,
+		basedir:     basedir,
+		quotaBytes:  quotaBytes,
+		metricsAPI:  metricsAPI,
+		accessTimes: make(map[string]time.Time),
+	}
+}
+
+// touch records that the object was read, which makes it less likely to be evicted
+func (q *fsQuota) touch(objectName string) {
+	q.accessLock.Lock()
+	defer q.accessLock.Unlock()
+	q.accessTimes[objectName] = time.Now()
+}
+
+func (q *fsQuota) forget(objectName string) {
+	q.accessLock.Lock()
+	defer q.accessLock.Unlock()
+	delete(q.accessTimes, objectName)
+}
+
+// lastUsed returns when the object was last written or read
+func (q *fsQuota) lastUsed(object storedObject) time.Time {
+	q.accessLock.Lock()
+	defer q.accessLock.Unlock()
+	if accessTime, ok := q.accessTimes[object.name]; ok && accessTime.After(object.modTime) {
+		return accessTime
+	}
+	return object.modTime
+}
+
+// load lists the stored objects, unless they were already listed. The lock must be held.
+func (q *fsQuota) load() error {
+	if q.objects != nil {
+		return nil
+	}
+	objects := make(map[string]storedObject)
+	var used int64
+	err := filepath.Walk(q.basedir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Removed while walking the directory
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(q.basedir, path)
+		if err != nil {
+			return err
+		}
+		objects[name] = storedObject{name: name, size: info.Size(), modTime: info.ModTime()}
+		used += info.Size()
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to list the files in %s", q.basedir)
+	}
+	q.objects = objects
+	q.used = used
+	return nil
+}
+
+// record updates the size of an object that was written. The lock must be held.
+func (q *fsQuota) record(objectName string) {
+	q.drop(objectName)
+	if info, err := os.Stat(filepath.Join(q.basedir, objectName)); err == nil {
+		q.objects[objectName] = storedObject{name: objectName, size: info.Size(), modTime: info.ModTime()}
+		q.used += info.Size()
+	}
+}
+
+// drop stops counting an object that was removed. The lock must be held.
+func (q *fsQuota) drop(objectName string) {
+	if object, ok := q.objects[objectName]; ok {
+		delete(q.objects, objectName)
+		q.used -= object.size
+	}
+}
+
+// removed stops counting an object that was deleted
+func (q *fsQuota) removed(objectName string) {
+	q.forget(objectName)
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.drop(objectName)
+}
+
+// rescan lists the stored objects again, after they were written without going through the quota
+func (q *fsQuota) rescan(ctx context.Context) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.objects = nil
+	if err := q.load(); err != nil {
+		logutil.FromContext(ctx, q.log).WithError(err).Error("Failed to list the objects of the filesystem storage")
+	}
+}
+
+// evictionCandidates returns the objects that may be evicted, in the order they should be evicted: the least recently
+// used discovery images first, and RHCOS images, which take longer to create again, only after all discovery images
+func (q *fsQuota) evictionCandidates(keep string) []storedObject {
+	var candidates []storedObject
+	lastUsed := make(map[string]time.Time)
+	for _, object := range q.objects {
+		if object.name != keep && EvictableObjectType(object.name) != "" {
+			candidates = append(candidates, object)
+			lastUsed[object.name] = q.lastUsed(object)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		iBaseISO := EvictableObjectType(candidates[i].name) == EvictableBaseISO
+		jBaseISO := EvictableObjectType(candidates[j].name) == EvictableBaseISO
+		if iBaseISO != jBaseISO {
+			return jBaseISO
+		}
+		if !lastUsed[candidates[i].name].Equal(lastUsed[candidates[j].name]) {
+			return lastUsed[candidates[i].name].Before(lastUsed[candidates[j].name])
+		}
+		return candidates[i].name < candidates[j].name
+	})
+	return candidates
+}
+
+// makeRoom evicts objects until the stored objects, and the given number of additional bytes, fit the quota. The
+// object that is being written is never evicted. It returns whether there is enough room. The lock must be held.
+func (q *fsQuota) makeRoom(ctx context.Context, objectName string, additionalBytes int64) bool {
+	if q.used+additionalBytes <= q.quotaBytes {
+		return true
+	}
+	log := logutil.FromContext(ctx, q.log)
+	for _, object := range q.evictionCandidates(objectName) {
+		if err := os.Remove(filepath.Join(q.basedir, object.name)); err != nil {
+			if !os.IsNotExist(err) {
+				log.WithError(err).Errorf("Failed to evict %s from the filesystem storage", object.name)
+				continue
+			}
+		} else {
+			objectType := EvictableObjectType(object.name)
+			log.Infof("Evicted %s %s (%d bytes, last used at %s) to stay within the filesystem storage quota of %d bytes",
+				objectType, object.name, object.size, q.lastUsed(object).Format(time.RFC3339), q.quotaBytes)
+			q.metricsAPI.FileSystemEviction(objectType, object.size)
+		}
+		q.forget(object.name)
+		q.drop(object.name)
+		if q.used+additionalBytes <= q.quotaBytes {
+			return true
+		}
+	}
+	return false
+}
+
+// reserve makes room for an object of the given size before it is written, and fails if there isn't enough room.
+// The size of an object that is replaced doesn't count, since its file is replaced.
+func (q *fsQuota) reserve(ctx context.Context, objectName string, sizeBytes int64) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if err := q.load(); err != nil {
+		return err
+	}
+	if object, ok := q.objects[objectName]; ok {
+		sizeBytes -= object.size
+	}
+	if !q.makeRoom(ctx, objectName, sizeBytes) {
+		q.metricsAPI.FileSystemQuotaRejection()
+		return &QuotaExceededError{ObjectName: objectName, QuotaBytes: q.quotaBytes}
+	}
+	return nil
+}
+
+// enforce makes room after an object was written, whose size wasn't known in advance or which was written
+// concurrently with other objects. If there isn't enough room, the written object is removed.
+func (q *fsQuota) enforce(ctx context.Context, objectName string) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if err := q.load(); err != nil {
+		return err
+	}
+	q.record(objectName)
+	if !q.makeRoom(ctx, objectName, 0) {
+		if err := os.Remove(filepath.Join(q.basedir, objectName)); err != nil && !os.IsNotExist(err) {
+			logutil.FromContext(ctx, q.log).WithError(err).Errorf("Failed to remove %s, which exceeds the filesystem storage quota", objectName)
+		}
+		q.record(objectName)
+		q.metricsAPI.FileSystemQuotaRejection()
+		return &QuotaExceededError{ObjectName: objectName, QuotaBytes: q.quotaBytes}
+	}
+	return nil
+}
+
+// shrink evicts objects until the stored objects fit the quota, if possible
+func (q *fsQuota) shrink(ctx context.Context) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if err := q.load(); err != nil {
+		logutil.FromContext(ctx, q.log).WithError(err).Error("Failed to enforce the filesystem storage quota")
+		return
+	}
+	if !q.makeRoom(ctx, "", 0) {
+		logutil.FromContext(ctx, q.log).Warnf("The objects that can't be evicted exceed the filesystem storage quota of %d bytes", q.quotaBytes)
+	}
+}
@@ -0,0 +1,204 @@
+package s3wrapper
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/isoeditor"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("filesystem quota", func() {
+	const quotaBytes = 100
+
+	var (
+		ctx            = context.Background()
+		log            = logrus.New()
+		ctrl           *gomock.Controller
+		mockMetricsAPI *metrics.MockAPI
+		mockVersions   *versions.MockHandler
+		baseDir        string
+		client         *FSClientDecorator
+		now            = time.Now()
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(ioutil.Discard)
+		var err error
+		baseDir, err = ioutil.TempDir("", "quota")
+		Expect(err).ToNot(HaveOccurred())
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetricsAPI = metrics.NewMockAPI(ctrl)
+		mockMetricsAPI.EXPECT().FileSystemUsage(gomock.Any()).AnyTimes()
+		editorFactory := isoeditor.NewFactory(isoeditor.Config{ConcurrentEdits: 10}, nil)
+		mockVersions = versions.NewMockHandler(ctrl)
+		client = NewFSClient(baseDir, log, mockVersions, editorFactory, mockMetricsAPI, 80, quotaBytes)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	// store writes an object of the given size that was last modified the given time ago
+	store := func(objectName string, size int, age time.Duration) {
+		filePath := filepath.Join(baseDir, objectName)
+		Expect(os.MkdirAll(filepath.Dir(filePath), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filePath, []byte(strings.Repeat("a", size)), 0600)).To(Succeed())
+		Expect(os.Chtimes(filePath, now.Add(-age), now.Add(-age))).To(Succeed())
+	}
+
+	exists := func(objectName string) bool {
+		_, err := os.Stat(filepath.Join(baseDir, objectName))
+		return err == nil
+	}
+
+	It("stores objects that fit the quota without evicting", func() {
+		store("discovery-image-a.iso", 40, time.Hour)
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 60)), "discovery-image-b.iso")).To(Succeed())
+		Expect(exists("discovery-image-a.iso")).To(BeTrue())
+		Expect(exists("discovery-image-b.iso")).To(BeTrue())
+	})
+
+	It("evicts the least recently used discovery images first", func() {
+		store("discovery-image-old.iso", 30, 3*time.Hour)
+		store("shared-discovery-image-older.iso", 30, 4*time.Hour)
+		store("discovery-image-new.iso", 30, time.Hour)
+		mockMetricsAPI.EXPECT().FileSystemEviction(EvictableDiscoveryImage, int64(30)).Times(2)
+
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 60)), "discovery-image-b.iso")).To(Succeed())
+		Expect(exists("shared-discovery-image-older.iso")).To(BeFalse())
+		Expect(exists("discovery-image-old.iso")).To(BeFalse())
+		Expect(exists("discovery-image-new.iso")).To(BeTrue())
+		Expect(exists("discovery-image-b.iso")).To(BeTrue())
+	})
+
+	It("considers downloads when choosing the images to evict", func() {
+		store("discovery-image-old.iso", 40, 3*time.Hour)
+		store("discovery-image-new.iso", 40, time.Hour)
+		reader, _, err := client.Download(ctx, "discovery-image-old.iso")
+		Expect(err).ToNot(HaveOccurred())
+		reader.Close()
+		mockMetricsAPI.EXPECT().FileSystemEviction(EvictableDiscoveryImage, int64(40)).Times(1)
+
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 40)), "discovery-image-b.iso")).To(Succeed())
+		Expect(exists("discovery-image-old.iso")).To(BeTrue())
+		Expect(exists("discovery-image-new.iso")).To(BeFalse())
+	})
+
+	It("evicts RHCOS images only after all discovery images", func() {
+		store("rhcos-4.6.iso", 30, 5*time.Hour)
+		store("discovery-image-a.iso", 30, time.Hour)
+		store("discovery-image-b.iso", 30, 2*time.Hour)
+		mockMetricsAPI.EXPECT().FileSystemEviction(EvictableDiscoveryImage, int64(30)).Times(2)
+		mockMetricsAPI.EXPECT().FileSystemEviction(EvictableBaseISO, int64(30)).Times(1)
+
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 90)), "discovery-image-c.iso")).To(Succeed())
+		Expect(exists("rhcos-4.6.iso")).To(BeFalse())
+		Expect(exists("discovery-image-a.iso")).To(BeFalse())
+		Expect(exists("discovery-image-b.iso")).To(BeFalse())
+	})
+
+	It("rejects uploads that don't fit without evicting objects that can't be created again", func() {
+		store("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/logs/host/logs.tar.gz", 30, 5*time.Hour)
+		store("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig", 30, 5*time.Hour)
+		store("discovery-image-a.iso", 30, time.Hour)
+		mockMetricsAPI.EXPECT().FileSystemEviction(EvictableDiscoveryImage, int64(30)).Times(1)
+		mockMetricsAPI.EXPECT().FileSystemQuotaRejection().Times(1)
+
+		err := client.Upload(ctx, []byte(strings.Repeat("b", 50)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/manifests/openshift/a.yaml")
+		Expect(err).To(BeAssignableToTypeOf(&QuotaExceededError{}))
+		Expect(exists("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/logs/host/logs.tar.gz")).To(BeTrue())
+		Expect(exists("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig")).To(BeTrue())
+		Expect(exists("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/manifests/openshift/a.yaml")).To(BeFalse())
+	})
+
+	It("removes streamed uploads that exceed the quota", func() {
+		store("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig", 60, time.Hour)
+		mockMetricsAPI.EXPECT().FileSystemQuotaRejection().Times(1)
+
+		err := client.UploadStream(ctx, strings.NewReader(strings.Repeat("b", 50)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/logs/controller/logs.tar.gz")
+		Expect(err).To(BeAssignableToTypeOf(&QuotaExceededError{}))
+		Expect(exists("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/logs/controller/logs.tar.gz")).To(BeFalse())
+		Expect(exists("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig")).To(BeTrue())
+	})
+
+	It("doesn't count the size of a replaced object", func() {
+		store("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig", 80, time.Hour)
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 90)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig")).To(Succeed())
+	})
+
+	It("frees the room of deleted objects", func() {
+		store("discovery-image-a.iso", 40, time.Hour)
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 60)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig")).To(Succeed())
+		_, err := client.DeleteObject(ctx, "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 60)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/install-config.yaml")).To(Succeed())
+		Expect(exists("discovery-image-a.iso")).To(BeTrue())
+	})
+
+	It("lists the stored objects only once", func() {
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 60)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig")).To(Succeed())
+		// Written behind the back of the client, so it isn't counted
+		store("8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/install-config.yaml", 30, time.Hour)
+
+		Expect(client.Upload(ctx, []byte(strings.Repeat("b", 40)), "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/bootstrap.ign")).To(Succeed())
+	})
+
+	It("restores evicted RHCOS images in the background", func() {
+		restore := make(chan struct{})
+		mockVersions.EXPECT().GetRHCOSVersion("4.6").Return("46.82.202012051820-0", nil).AnyTimes()
+		mockVersions.EXPECT().GetRHCOSImage("4.6").DoAndReturn(func(string) (string, error) {
+			<-restore
+			return "", errors.New("unavailable")
+		}).Times(1)
+
+		objectName, err := client.GetBaseIsoObject("4.6")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objectName).To(Equal("rhcos-46.82.202012051820-0.iso"))
+		// Only one restore runs at a time
+		_, err = client.GetMinimalIsoObjectName("4.6")
+		Expect(err).ToNot(HaveOccurred())
+
+		close(restore)
+		Eventually(func() bool {
+			client.restoreLock.Lock()
+			defer client.restoreLock.Unlock()
+			return client.restoring["4.6"]
+		}).Should(BeFalse())
+	})
+
+	It("doesn't restore RHCOS images that are stored", func() {
+		store("rhcos-46.82.202012051820-0.iso", 30, time.Hour)
+		mockVersions.EXPECT().GetRHCOSVersion("4.6").Return("46.82.202012051820-0", nil).Times(1)
+
+		_, err := client.GetBaseIsoObject("4.6")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.restoring).To(BeEmpty())
+	})
+
+	table.DescribeTable("evictable objects",
+		func(objectName, expected string) {
+			Expect(EvictableObjectType(objectName)).To(Equal(expected))
+		},
+		table.Entry("discovery image", "discovery-image-8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1.iso", EvictableDiscoveryImage),
+		table.Entry("shared discovery image", "shared-discovery-image-0123abcd.iso", EvictableDiscoveryImage),
+		table.Entry("RHCOS image", "rhcos-46.82.202012051820-0.iso", EvictableBaseISO),
+		table.Entry("discovery image ignition", "discovery-image-8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1.ign", ""),
+		table.Entry("kubeconfig", "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/kubeconfig", ""),
+		table.Entry("logs", "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/logs/controller/logs.tar.gz", ""),
+		table.Entry("ISO in a cluster directory", "8f6ab3c6-1a8a-4b5a-9c1f-0c7c0ad8f3a1/discovery-image-a.iso", ""),
+	)
+})
@@ -6,6 +6,15 @@ import (
 	"strings"
 )
 
+// Redacted replaces the values of secret fields
+const Redacted = "<SECRET>"
+
+// IsSecret returns whether the field is tagged `secret:"true"`, and so its value should
+// not be shown
+func IsSecret(field reflect.StructField) bool {
+	return field.Tag.Get("secret") == "true"
+}
+
 // DumpSecretStruct generates a string representation of a struct with
 // `secret:"true"` tagged fields removed.
 // Does not recurse into pointers to structs (or show pointer values in general),
@@ -25,8 +34,7 @@ func dumpSecretStructInternal(obj interface{}, sb *strings.Builder, depth int) {
 	for i := 0; i < v.NumField(); i++ {
 		field := v.Field(i)
 
-		name, tag := v.Type().Field(i).Name,
-			reflect.TypeOf(obj).Field(i).Tag
+		name := v.Type().Field(i).Name
 
 		for j := 0; j < depth; j++ {
 			sb.WriteString("\t")
@@ -34,8 +42,8 @@ func dumpSecretStructInternal(obj interface{}, sb *strings.Builder, depth int) {
 
 		sb.WriteString(fmt.Sprintf("%s: ", name))
 
-		if tag.Get("secret") == "true" {
-			sb.WriteString("<SECRET>")
+		if IsSecret(v.Type().Field(i)) {
+			sb.WriteString(Redacted)
 		} else {
 			if field.CanInterface() {
 				value := field.Interface()
@@ -29,6 +29,7 @@ type StaticNetworkConfigData struct {
 type StaticNetworkConfig interface {
 	GenerateStaticNetworkConfigData(hostsYAMLS string) ([]StaticNetworkConfigData, error)
 	FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) string
+	ParseStaticNetworkConfigFromDB(staticNetworkConfigStr string) ([]*models.HostStaticNetworkConfig, error)
 	ValidateStaticConfigParams(staticNetworkConfig []*models.HostStaticNetworkConfig) error
 }
 
@@ -209,3 +210,42 @@ func (s *StaticNetworkConfigGenerator) formatMacInterfaceMap(macInterfaceMap mod
 	sort.Strings(lines)
 	return strings.Join(lines, "\n")
 }
+
+// ParseStaticNetworkConfigFromDB reverses FormatStaticNetworkConfigForDB
+func (s *StaticNetworkConfigGenerator) ParseStaticNetworkConfigFromDB(staticNetworkConfigStr string) ([]*models.HostStaticNetworkConfig, error) {
+	if staticNetworkConfigStr == "" {
+		return nil, nil
+	}
+	hostsConfig := strings.Split(staticNetworkConfigStr, staticNetworkConfigHostsDelimeter)
+	staticNetworkConfig := make([]*models.HostStaticNetworkConfig, len(hostsConfig))
+	for i, hostConfigString := range hostsConfig {
+		hostConfig := strings.Split(hostConfigString, hostStaticNetworkDelimeter)
+		if len(hostConfig) != 2 {
+			return nil, errors.Errorf("Bad format of static network config of host %d", i)
+		}
+		macInterfaceMap, err := s.parseMacInterfaceMap(hostConfig[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse static network config of host %d", i)
+		}
+		staticNetworkConfig[i] = &models.HostStaticNetworkConfig{
+			NetworkYaml:     hostConfig[0],
+			MacInterfaceMap: macInterfaceMap,
+		}
+	}
+	return staticNetworkConfig, nil
+}
+
+func (s *StaticNetworkConfigGenerator) parseMacInterfaceMap(macInterfaceMapStr string) (models.MacInterfaceMap, error) {
+	macInterfaceMap := models.MacInterfaceMap{}
+	if macInterfaceMapStr == "" {
+		return macInterfaceMap, nil
+	}
+	for _, line := range strings.Split(macInterfaceMapStr, "\n") {
+		entry := strings.SplitN(line, "=", 2)
+		if len(entry) != 2 {
+			return nil, errors.Errorf("Bad format of MAC to interface mapping %s", line)
+		}
+		macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{MacAddress: entry[0], LogicalNicName: entry[1]})
+	}
+	return macInterfaceMap, nil
+}
@@ -66,4 +66,35 @@ var _ = Describe("StaticNetworkConfig", func() {
 		formattedOutput := staticNetworkGenerator.FormatStaticNetworkConfigForDB(nil)
 		Expect(formattedOutput).To(Equal(""))
 	})
+
+	It("check parsing static network from DB", func() {
+		map1 := models.MacInterfaceMap{
+			&models.MacInterfaceMapItems0{MacAddress: "mac10", LogicalNicName: "nic10"},
+			&models.MacInterfaceMapItems0{MacAddress: "mac11", LogicalNicName: "nic11"},
+		}
+		map2 := models.MacInterfaceMap{
+			&models.MacInterfaceMapItems0{MacAddress: "mac20", LogicalNicName: "nic20"},
+		}
+		staticNetworkConfig := []*models.HostStaticNetworkConfig{
+			common.FormatStaticConfigHostYAML("nic10", "02000048ba38", "192.168.126.30", "192.168.141.30", "192.168.126.1", map1),
+			common.FormatStaticConfigHostYAML("nic20", "02000048ba48", "192.168.126.31", "192.168.141.31", "192.168.126.1", map2),
+		}
+		formattedOutput := staticNetworkGenerator.FormatStaticNetworkConfigForDB(staticNetworkConfig)
+		parsedOutput, err := staticNetworkGenerator.ParseStaticNetworkConfigFromDB(formattedOutput)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsedOutput).To(Equal(staticNetworkConfig))
+	})
+
+	It("check parsing empty static network from DB", func() {
+		parsedOutput, err := staticNetworkGenerator.ParseStaticNetworkConfigFromDB("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsedOutput).To(BeEmpty())
+	})
+
+	It("check parsing malformed static network from DB", func() {
+		_, err := staticNetworkGenerator.ParseStaticNetworkConfigFromDB("network-yaml")
+		Expect(err).To(HaveOccurred())
+		_, err = staticNetworkGenerator.ParseStaticNetworkConfigFromDB("network-yaml" + hostStaticNetworkDelimeter + "mac10")
+		Expect(err).To(HaveOccurred())
+	})
 })
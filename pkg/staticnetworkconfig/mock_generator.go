@@ -62,6 +62,21 @@ func (mr *MockStaticNetworkConfigMockRecorder) FormatStaticNetworkConfigForDB(st
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatStaticNetworkConfigForDB", reflect.TypeOf((*MockStaticNetworkConfig)(nil).FormatStaticNetworkConfigForDB), staticNetworkConfig)
 }
 
+// ParseStaticNetworkConfigFromDB mocks base method
+func (m *MockStaticNetworkConfig) ParseStaticNetworkConfigFromDB(staticNetworkConfigStr string) ([]*models.HostStaticNetworkConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseStaticNetworkConfigFromDB", staticNetworkConfigStr)
+	ret0, _ := ret[0].([]*models.HostStaticNetworkConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseStaticNetworkConfigFromDB indicates an expected call of ParseStaticNetworkConfigFromDB
+func (mr *MockStaticNetworkConfigMockRecorder) ParseStaticNetworkConfigFromDB(staticNetworkConfigStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseStaticNetworkConfigFromDB", reflect.TypeOf((*MockStaticNetworkConfig)(nil).ParseStaticNetworkConfigFromDB), staticNetworkConfigStr)
+}
+
 // ValidateStaticConfigParams mocks base method
 func (m *MockStaticNetworkConfig) ValidateStaticConfigParams(staticNetworkConfig []*models.HostStaticNetworkConfig) error {
 	m.ctrl.T.Helper()
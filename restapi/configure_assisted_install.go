@@ -16,12 +16,15 @@ import (
 
 	"github.com/openshift/assisted-service/restapi/operations"
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 type contextKey string
@@ -42,12 +45,43 @@ type AssistedServiceIsoAPI interface {
 	GetPresignedForAssistedServiceISO(ctx context.Context, params assisted_service_iso.GetPresignedForAssistedServiceISOParams) middleware.Responder
 }
 
+//go:generate mockery -name AuditAPI -inpkg
+
+/* AuditAPI  */
+type AuditAPI interface {
+	/* ListAuditRecords Lists the records of the API calls that changed clusters and hosts, from the most recent. */
+	ListAuditRecords(ctx context.Context, params audit.ListAuditRecordsParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterTemplatesAPI -inpkg
+
+/* ClusterTemplatesAPI  */
+type ClusterTemplatesAPI interface {
+	/* CreateClusterTemplate Creates the first version of a cluster template. */
+	CreateClusterTemplate(ctx context.Context, params cluster_templates.CreateClusterTemplateParams) middleware.Responder
+
+	/* DeleteClusterTemplate Deletes all the versions of a cluster template. Clusters registered from the template are not affected. */
+	DeleteClusterTemplate(ctx context.Context, params cluster_templates.DeleteClusterTemplateParams) middleware.Responder
+
+	/* GetClusterTemplate Retrieves a version of a cluster template. */
+	GetClusterTemplate(ctx context.Context, params cluster_templates.GetClusterTemplateParams) middleware.Responder
+
+	/* ListClusterTemplates Lists the latest version of the cluster templates. */
+	ListClusterTemplates(ctx context.Context, params cluster_templates.ListClusterTemplatesParams) middleware.Responder
+
+	/* UpdateClusterTemplate Creates a new version of a cluster template. Clusters registered from previous versions are not affected. */
+	UpdateClusterTemplate(ctx context.Context, params cluster_templates.UpdateClusterTemplateParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
 type EventsAPI interface {
 	/* ListEvents Lists events for a cluster. */
 	ListEvents(ctx context.Context, params events.ListEventsParams) middleware.Responder
+
+	/* StreamEvents Streams events for a cluster as server-sent events, ordered by their time. Each event is sent with an ID made of its time and its sequence number, so that reconnecting clients can resume from the last event they received. Events saved late may follow events with later times, so on resume the events of the last few seconds before the last received event are sent again, with the same IDs. */
+	StreamEvents(ctx context.Context, params events.StreamEventsParams) middleware.Responder
 }
 
 //go:generate mockery -name InstallerAPI -inpkg
@@ -93,12 +127,21 @@ type InstallerAPI interface {
 	/* EnableHost Enables a host for inclusion in the cluster. */
 	EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder
 
+	/* ExportClusterDefinition Exports the definition of the cluster, so that it can be imported to recreate the cluster. */
+	ExportClusterDefinition(ctx context.Context, params installer.ExportClusterDefinitionParams) middleware.Responder
+
 	/* GenerateClusterISO Creates a new OpenShift per-cluster Discovery ISO. */
 	GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder
 
+	/* GetChecksumsSigningKey Retrieves the public key that verifies the signatures of the cluster checksums. */
+	GetChecksumsSigningKey(ctx context.Context, params installer.GetChecksumsSigningKeyParams) middleware.Responder
+
 	/* GetCluster Retrieves the details of the OpenShift cluster. */
 	GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder
 
+	/* GetClusterChecksums Retrieves the SHA-256 digests of the discovery ISO and the files of the cluster, optionally signed by the service. */
+	GetClusterChecksums(ctx context.Context, params installer.GetClusterChecksumsParams) middleware.Responder
+
 	/* GetClusterDefaultConfig Get the default values for various cluster properties. */
 	GetClusterDefaultConfig(ctx context.Context, params installer.GetClusterDefaultConfigParams) middleware.Responder
 
@@ -108,6 +151,18 @@ type InstallerAPI interface {
 	/* GetClusterInstallConfig Get the cluster's install config YAML. */
 	GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder
 
+	/* GetClusterInstallRetryPolicy Get the policy that the failed host installations of the cluster are automatically retried by. */
+	GetClusterInstallRetryPolicy(ctx context.Context, params installer.GetClusterInstallRetryPolicyParams) middleware.Responder
+
+	/* GetClusterStageTimeouts Get the installation stage timeouts of the cluster, which override the timeouts of the service. */
+	GetClusterStageTimeouts(ctx context.Context, params installer.GetClusterStageTimeoutsParams) middleware.Responder
+
+	/* GetClusterValidationPolicy Get the validation policy of the cluster. */
+	GetClusterValidationPolicy(ctx context.Context, params installer.GetClusterValidationPolicyParams) middleware.Responder
+
+	/* GetClusterWorkerSuccessThreshold Get how many of the workers of the cluster must be installed for its installation to succeed. */
+	GetClusterWorkerSuccessThreshold(ctx context.Context, params installer.GetClusterWorkerSuccessThresholdParams) middleware.Responder
+
 	/* GetCredentials Get the cluster admin credentials. */
 	GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder
 
@@ -138,6 +193,9 @@ type InstallerAPI interface {
 	/* GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files. */
 	GetPresignedForClusterFiles(ctx context.Context, params installer.GetPresignedForClusterFilesParams) middleware.Responder
 
+	/* ImportClusterDefinition Creates a new OpenShift cluster from a cluster definition that was exported from another cluster. */
+	ImportClusterDefinition(ctx context.Context, params installer.ImportClusterDefinitionParams) middleware.Responder
+
 	/* InstallCluster Installs the OpenShift cluster. */
 	InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder
 
@@ -147,12 +205,21 @@ type InstallerAPI interface {
 	/* InstallHosts Installs the OpenShift cluster. */
 	InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder
 
+	/* ListClusterLogArtifacts Lists the uploaded host and controller log archives of the cluster and when they expire. */
+	ListClusterLogArtifacts(ctx context.Context, params installer.ListClusterLogArtifactsParams) middleware.Responder
+
 	/* ListClusters Retrieves the list of OpenShift clusters. */
 	ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder
 
 	/* ListHosts Retrieves the list of OpenShift hosts. */
 	ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder
 
+	/* ListStateMachines Describes the state machines of the hosts and the clusters, with their transitions and diagrams. */
+	ListStateMachines(ctx context.Context, params installer.ListStateMachinesParams) middleware.Responder
+
+	/* PauseInstallation Pauses an ongoing installation. The hosts that are installing are not given new installation steps and the timeouts of their installation stages are frozen until the installation is resumed. */
+	PauseInstallation(ctx context.Context, params installer.PauseInstallationParams) middleware.Responder
+
 	/* PostStepReply Posts the result of the operations from the host agent. */
 	PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder
 
@@ -174,15 +241,33 @@ type InstallerAPI interface {
 	/* ResetHostValidation Reset failed host validation. */
 	ResetHostValidation(ctx context.Context, params installer.ResetHostValidationParams) middleware.Responder
 
+	/* ResumeInstallation Resumes a paused installation. */
+	ResumeInstallation(ctx context.Context, params installer.ResumeInstallationParams) middleware.Responder
+
+	/* SearchClusterLogs Searches the lines of the uploaded host and controller logs of the cluster. */
+	SearchClusterLogs(ctx context.Context, params installer.SearchClusterLogsParams) middleware.Responder
+
 	/* UpdateCluster Updates an OpenShift cluster definition. */
 	UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder
 
 	/* UpdateClusterInstallConfig Override values in the install config. */
 	UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder
 
+	/* UpdateClusterInstallRetryPolicy Replace the policy that the failed host installations of the cluster are automatically retried by. The policy can be changed during the installation. */
+	UpdateClusterInstallRetryPolicy(ctx context.Context, params installer.UpdateClusterInstallRetryPolicyParams) middleware.Responder
+
 	/* UpdateClusterLogsProgress Update log collection state and progress. */
 	UpdateClusterLogsProgress(ctx context.Context, params installer.UpdateClusterLogsProgressParams) middleware.Responder
 
+	/* UpdateClusterStageTimeouts Replace the installation stage timeouts of the cluster. The timeouts can be changed during the installation. */
+	UpdateClusterStageTimeouts(ctx context.Context, params installer.UpdateClusterStageTimeoutsParams) middleware.Responder
+
+	/* UpdateClusterValidationPolicy Replace the validation policy of the cluster. */
+	UpdateClusterValidationPolicy(ctx context.Context, params installer.UpdateClusterValidationPolicyParams) middleware.Responder
+
+	/* UpdateClusterWorkerSuccessThreshold Replace how many of the workers of the cluster must be installed for its installation to succeed. The threshold can be changed during the installation. */
+	UpdateClusterWorkerSuccessThreshold(ctx context.Context, params installer.UpdateClusterWorkerSuccessThresholdParams) middleware.Responder
+
 	/* UpdateDiscoveryIgnition Override values in the discovery ignition config. */
 	UpdateDiscoveryIgnition(ctx context.Context, params installer.UpdateDiscoveryIgnitionParams) middleware.Responder
 
@@ -206,6 +291,9 @@ type InstallerAPI interface {
 
 	/* UploadLogs Agent API to upload logs. */
 	UploadLogs(ctx context.Context, params installer.UploadLogsParams) middleware.Responder
+
+	/* ValidateHypotheticalHost Runs the host validations on a hypothetical host, as if it was part of a cluster, without registering it. */
+	ValidateHypotheticalHost(ctx context.Context, params installer.ValidateHypotheticalHostParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -261,15 +349,38 @@ type VersionsAPI interface {
 	ListSupportedOpenshiftVersions(ctx context.Context, params versions.ListSupportedOpenshiftVersionsParams) middleware.Responder
 }
 
+//go:generate mockery -name WebhooksAPI -inpkg
+
+/* WebhooksAPI  */
+type WebhooksAPI interface {
+	/* DeregisterWebhook Deletes a webhook. Pending deliveries of the webhook are not sent. */
+	DeregisterWebhook(ctx context.Context, params webhooks.DeregisterWebhookParams) middleware.Responder
+
+	/* ListWebhookDeliveries Lists the notifications sent or to be sent to a webhook, most recent first. */
+	ListWebhookDeliveries(ctx context.Context, params webhooks.ListWebhookDeliveriesParams) middleware.Responder
+
+	/* ListWebhooks Lists the registered webhooks. */
+	ListWebhooks(ctx context.Context, params webhooks.ListWebhooksParams) middleware.Responder
+
+	/* RegisterWebhook Registers an endpoint to be notified when clusters and hosts reach notable states. Webhooks without a cluster are notified about all the clusters of the organization. */
+	RegisterWebhook(ctx context.Context, params webhooks.RegisterWebhookParams) middleware.Responder
+
+	/* ReplayWebhookDelivery Sends a notification to the webhook again, with a new set of delivery attempts. */
+	ReplayWebhookDelivery(ctx context.Context, params webhooks.ReplayWebhookDeliveryParams) middleware.Responder
+}
+
 // Config is configuration for Handler
 type Config struct {
 	AssistedServiceIsoAPI
+	AuditAPI
+	ClusterTemplatesAPI
 	EventsAPI
 	InstallerAPI
 	ManagedDomainsAPI
 	ManifestsAPI
 	OperatorsAPI
 	VersionsAPI
+	WebhooksAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
 	// The middleware executes after routing but before authentication, binding and validation
@@ -329,6 +440,7 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.MultipartformConsumer = runtime.DiscardConsumer
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ByteStreamProducer()
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -366,6 +478,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.CreateClusterManifest(ctx, params)
 	})
+	api.ClusterTemplatesCreateClusterTemplateHandler = cluster_templates.CreateClusterTemplateHandlerFunc(func(params cluster_templates.CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.CreateClusterTemplate(ctx, params)
+	})
 	api.AssistedServiceIsoCreateISOAndUploadToS3Handler = assisted_service_iso.CreateISOAndUploadToS3HandlerFunc(func(params assisted_service_iso.CreateISOAndUploadToS3Params, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -376,6 +493,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.DeleteClusterManifest(ctx, params)
 	})
+	api.ClusterTemplatesDeleteClusterTemplateHandler = cluster_templates.DeleteClusterTemplateHandlerFunc(func(params cluster_templates.DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.DeleteClusterTemplate(ctx, params)
+	})
 	api.InstallerDeregisterClusterHandler = installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -386,6 +508,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterHost(ctx, params)
 	})
+	api.WebhooksDeregisterWebhookHandler = webhooks.DeregisterWebhookHandlerFunc(func(params webhooks.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.DeregisterWebhook(ctx, params)
+	})
 	api.InstallerDisableHostHandler = installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -441,16 +568,31 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.EnableHost(ctx, params)
 	})
+	api.InstallerExportClusterDefinitionHandler = installer.ExportClusterDefinitionHandlerFunc(func(params installer.ExportClusterDefinitionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ExportClusterDefinition(ctx, params)
+	})
 	api.InstallerGenerateClusterISOHandler = installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GenerateClusterISO(ctx, params)
 	})
+	api.InstallerGetChecksumsSigningKeyHandler = installer.GetChecksumsSigningKeyHandlerFunc(func(params installer.GetChecksumsSigningKeyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetChecksumsSigningKey(ctx, params)
+	})
 	api.InstallerGetClusterHandler = installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetCluster(ctx, params)
 	})
+	api.InstallerGetClusterChecksumsHandler = installer.GetClusterChecksumsHandlerFunc(func(params installer.GetClusterChecksumsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterChecksums(ctx, params)
+	})
 	api.InstallerGetClusterDefaultConfigHandler = installer.GetClusterDefaultConfigHandlerFunc(func(params installer.GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -466,6 +608,31 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerGetClusterInstallRetryPolicyHandler = installer.GetClusterInstallRetryPolicyHandlerFunc(func(params installer.GetClusterInstallRetryPolicyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterInstallRetryPolicy(ctx, params)
+	})
+	api.InstallerGetClusterStageTimeoutsHandler = installer.GetClusterStageTimeoutsHandlerFunc(func(params installer.GetClusterStageTimeoutsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterStageTimeouts(ctx, params)
+	})
+	api.ClusterTemplatesGetClusterTemplateHandler = cluster_templates.GetClusterTemplateHandlerFunc(func(params cluster_templates.GetClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.GetClusterTemplate(ctx, params)
+	})
+	api.InstallerGetClusterValidationPolicyHandler = installer.GetClusterValidationPolicyHandlerFunc(func(params installer.GetClusterValidationPolicyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterValidationPolicy(ctx, params)
+	})
+	api.InstallerGetClusterWorkerSuccessThresholdHandler = installer.GetClusterWorkerSuccessThresholdHandlerFunc(func(params installer.GetClusterWorkerSuccessThresholdParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterWorkerSuccessThreshold(ctx, params)
+	})
 	api.InstallerGetCredentialsHandler = installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -516,6 +683,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetPresignedForClusterFiles(ctx, params)
 	})
+	api.InstallerImportClusterDefinitionHandler = installer.ImportClusterDefinitionHandlerFunc(func(params installer.ImportClusterDefinitionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ImportClusterDefinition(ctx, params)
+	})
 	api.InstallerInstallClusterHandler = installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -531,11 +703,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.InstallHosts(ctx, params)
 	})
+	api.AuditListAuditRecordsHandler = audit.ListAuditRecordsHandlerFunc(func(params audit.ListAuditRecordsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AuditAPI.ListAuditRecords(ctx, params)
+	})
+	api.InstallerListClusterLogArtifactsHandler = installer.ListClusterLogArtifactsHandlerFunc(func(params installer.ListClusterLogArtifactsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterLogArtifacts(ctx, params)
+	})
 	api.ManifestsListClusterManifestsHandler = manifests.ListClusterManifestsHandlerFunc(func(params manifests.ListClusterManifestsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.ListClusterManifests(ctx, params)
 	})
+	api.ClusterTemplatesListClusterTemplatesHandler = cluster_templates.ListClusterTemplatesHandlerFunc(func(params cluster_templates.ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.ListClusterTemplates(ctx, params)
+	})
 	api.InstallerListClustersHandler = installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -571,6 +758,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.ListOperatorProperties(ctx, params)
 	})
+	api.InstallerListStateMachinesHandler = installer.ListStateMachinesHandlerFunc(func(params installer.ListStateMachinesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListStateMachines(ctx, params)
+	})
 	api.VersionsListSupportedOpenshiftVersionsHandler = versions.ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -581,6 +773,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.ListSupportedOperators(ctx, params)
 	})
+	api.WebhooksListWebhookDeliveriesHandler = webhooks.ListWebhookDeliveriesHandlerFunc(func(params webhooks.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ListWebhookDeliveries(ctx, params)
+	})
+	api.WebhooksListWebhooksHandler = webhooks.ListWebhooksHandlerFunc(func(params webhooks.ListWebhooksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ListWebhooks(ctx, params)
+	})
+	api.InstallerPauseInstallationHandler = installer.PauseInstallationHandlerFunc(func(params installer.PauseInstallationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.PauseInstallation(ctx, params)
+	})
 	api.InstallerPostStepReplyHandler = installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -601,6 +808,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterHost(ctx, params)
 	})
+	api.WebhooksRegisterWebhookHandler = webhooks.RegisterWebhookHandlerFunc(func(params webhooks.RegisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.RegisterWebhook(ctx, params)
+	})
+	api.WebhooksReplayWebhookDeliveryHandler = webhooks.ReplayWebhookDeliveryHandlerFunc(func(params webhooks.ReplayWebhookDeliveryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.ReplayWebhookDelivery(ctx, params)
+	})
 	api.OperatorsReportMonitoredOperatorStatusHandler = operators.ReportMonitoredOperatorStatusHandlerFunc(func(params operators.ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -621,6 +838,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ResetHostValidation(ctx, params)
 	})
+	api.InstallerResumeInstallationHandler = installer.ResumeInstallationHandlerFunc(func(params installer.ResumeInstallationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ResumeInstallation(ctx, params)
+	})
+	api.InstallerSearchClusterLogsHandler = installer.SearchClusterLogsHandlerFunc(func(params installer.SearchClusterLogsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.SearchClusterLogs(ctx, params)
+	})
+	api.EventsStreamEventsHandler = events.StreamEventsHandlerFunc(func(params events.StreamEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.StreamEvents(ctx, params)
+	})
 	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -631,11 +863,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateClusterInstallConfig(ctx, params)
 	})
+	api.InstallerUpdateClusterInstallRetryPolicyHandler = installer.UpdateClusterInstallRetryPolicyHandlerFunc(func(params installer.UpdateClusterInstallRetryPolicyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateClusterInstallRetryPolicy(ctx, params)
+	})
 	api.InstallerUpdateClusterLogsProgressHandler = installer.UpdateClusterLogsProgressHandlerFunc(func(params installer.UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateClusterLogsProgress(ctx, params)
 	})
+	api.InstallerUpdateClusterStageTimeoutsHandler = installer.UpdateClusterStageTimeoutsHandlerFunc(func(params installer.UpdateClusterStageTimeoutsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateClusterStageTimeouts(ctx, params)
+	})
+	api.ClusterTemplatesUpdateClusterTemplateHandler = cluster_templates.UpdateClusterTemplateHandlerFunc(func(params cluster_templates.UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterTemplatesAPI.UpdateClusterTemplate(ctx, params)
+	})
+	api.InstallerUpdateClusterValidationPolicyHandler = installer.UpdateClusterValidationPolicyHandlerFunc(func(params installer.UpdateClusterValidationPolicyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateClusterValidationPolicy(ctx, params)
+	})
+	api.InstallerUpdateClusterWorkerSuccessThresholdHandler = installer.UpdateClusterWorkerSuccessThresholdHandlerFunc(func(params installer.UpdateClusterWorkerSuccessThresholdParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.UpdateClusterWorkerSuccessThreshold(ctx, params)
+	})
 	api.InstallerUpdateDiscoveryIgnitionHandler = installer.UpdateDiscoveryIgnitionHandlerFunc(func(params installer.UpdateDiscoveryIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -676,6 +933,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UploadLogs(ctx, params)
 	})
+	api.InstallerValidateHypotheticalHostHandler = installer.ValidateHypotheticalHostHandlerFunc(func(params installer.ValidateHypotheticalHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ValidateHypotheticalHost(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
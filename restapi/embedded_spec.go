@@ -161,12 +161,57 @@ func init() {
           "assisted-service-iso"
         ],
         "operationId": "DownloadISO",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
               "type": "string",
               "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
             }
           },
           "401": {
@@ -187,6 +232,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -246,44 +303,85 @@ func init() {
         }
       }
     },
-    "/clusters": {
+    "/audit_records": {
       "get": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
-              "user"
+              "read-only-admin"
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Lists the records of the API calls that changed clusters and hosts, from the most recent.",
         "tags": [
-          "installer"
+          "audit"
         ],
-        "operationId": "ListClusters",
+        "operationId": "ListAuditRecords",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "Only calls that targeted this cluster are returned.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
+            "description": "Only calls that targeted this host are returned.",
+            "name": "host_id",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
+            "type": "string",
+            "description": "Only calls made by this user are returned.",
+            "name": "user_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls made by users of this organization are returned.",
+            "name": "org_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls to this operation are returned.",
+            "name": "operation_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls that changed this field of the cluster or the host are returned.",
+            "name": "field",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only calls that were made at or after this time are returned.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only calls that were made before this time are returned.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of records to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of records to skip before the returned records.",
+            "name": "offset",
             "in": "query"
           }
         ],
@@ -291,7 +389,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/audit-record-list"
+            },
+            "headers": {
+              "Audit-Record-Count": {
+                "type": "integer",
+                "description": "The total number of records matching the filters, regardless of the limit and offset."
+              }
             }
           },
           "401": {
@@ -306,54 +410,36 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "RegisterCluster",
-        "parameters": [
+      }
+    },
+    "/checksums/signing-key": {
+      "get": {
+        "security": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "Retrieves the public key that verifies the signatures of the cluster checksums.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetChecksumsSigningKey",
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/checksums_signing_key"
             }
           },
           "401": {
@@ -368,8 +454,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "The service has no signing key.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -383,7 +469,7 @@ func init() {
         }
       }
     },
-    "/clusters/default-config": {
+    "/cluster_templates": {
       "get": {
         "security": [
           {
@@ -394,16 +480,66 @@ func init() {
             ]
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Lists the latest version of the cluster templates.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "GetClusterDefaultConfig",
+        "operationId": "ListClusterTemplates",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "$ref": "#/definitions/cluster-template-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates the first version of a cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "CreateClusterTemplate",
+        "parameters": [
+          {
+            "description": "The content of the template.",
+            "name": "new-cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -427,7 +563,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}": {
+    "/cluster_templates/{cluster_template_id}": {
       "get": {
         "security": [
           {
@@ -436,44 +572,35 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Retrieves a version of a cluster template.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "GetCluster",
+        "operationId": "GetClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
-            "name": "cluster_id",
+            "description": "The template to be retrieved.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "minimum": 1,
+            "type": "integer",
+            "description": "The version of the template to be retrieved, the latest version if not set.",
+            "name": "version",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-template"
             }
           },
           "401": {
@@ -494,71 +621,65 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      "put": {
+        "description": "Creates a new version of a cluster template. Clusters registered from previous versions are not affected.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "DeregisterCluster",
+        "operationId": "UpdateClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
-            "name": "cluster_id",
+            "description": "The template to be updated.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The content of the new version of the template.",
+            "name": "cluster-template-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "401": {
-            "description": "Unauthorized.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/cluster-template"
             }
           },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "409": {
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -572,43 +693,25 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      "delete": {
+        "description": "Deletes all the versions of a cluster template. Clusters registered from the template are not affected.",
         "tags": [
-          "installer"
+          "cluster_templates"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "DeleteClusterTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
-            "name": "cluster_id",
+            "description": "The template to be deleted.",
+            "name": "cluster_template_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -628,18 +731,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -649,28 +740,52 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
         "tags": [
           "installer"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ListClusters",
         "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/cluster-list"
             }
           },
           "401": {
@@ -685,77 +800,56 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/complete_installation": {
+      },
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Creates a new OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "RegisterCluster",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/completion-params"
+              "$ref": "#/definitions/cluster-create-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -768,67 +862,42 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "InstallCluster",
-        "parameters": [
+    "/clusters/default-config": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to be installed.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster_default_config"
             }
           },
           "401": {
@@ -843,24 +912,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -870,25 +921,26 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install_hosts": {
+    "/clusters/import": {
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "description": "Creates a new OpenShift cluster from a cluster definition that was exported from another cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "ImportClusterDefinition",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster definition and the pull secret of the new cluster.",
+            "name": "import-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-definition-import-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -912,25 +964,13 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -939,25 +979,50 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+    "/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "GetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster to be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -987,53 +1052,39 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "DeregisterCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster to be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/credentials"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -1072,39 +1123,43 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/discovery-ignition": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "UpdateCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster to be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -1131,6 +1186,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1138,40 +1199,30 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      }
+    },
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "202": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1198,6 +1249,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1207,71 +1264,48 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.yaml"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
+            "description": "The software version of the discovery agent that is completing the installation.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1319,81 +1353,29 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/presigned"
-            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "400": {
             "description": "Error.",
@@ -1440,44 +1422,28 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "InstallHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The existing cluster whose hosts should be added.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/cluster"
             }
           },
           "400": {
@@ -1505,7 +1471,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -1520,45 +1489,32 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/actions/pause": {
       "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+        "description": "Pauses an ongoing installation. The hosts that are installing are not given new installation steps and the timeouts of their installation stages are frozen until the installation is resumed.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "PauseInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster whose installation is to be paused.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -1596,49 +1552,30 @@ func init() {
             }
           }
         }
-      },
-      "head": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+      }
+    },
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
-            }
-          },
-          "400": {
-            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1660,7 +1597,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -1677,44 +1617,28 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the kubeconfig file for this cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/actions/resume": {
+      "post": {
+        "description": "Resumes a paused installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "ResumeInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster whose installation is to be resumed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -1756,7 +1680,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/events": {
+    "/clusters/{cluster_id}/checksums": {
       "get": {
         "security": [
           {
@@ -1765,47 +1689,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Retrieves the SHA-256 digests of the discovery ISO and the files of the cluster, optionally signed by the service.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "GetClusterChecksums",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster whose checksums should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/cluster_checksums"
             }
           },
           "401": {
@@ -1841,7 +1746,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
+    "/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
@@ -1852,49 +1757,26 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the free address list for a network.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-            "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
-            "required": true
-          },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -1921,6 +1803,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1930,7 +1818,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/host-requirements": {
+    "/clusters/{cluster_id}/definition": {
       "get": {
         "security": [
           {
@@ -1941,16 +1829,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get host requirements of a cluster.",
+        "description": "Exports the definition of the cluster, so that it can be imported to recreate the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "ExportClusterDefinition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose definition is being exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1960,7 +1848,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/cluster-definition"
             }
           },
           "401": {
@@ -1981,12 +1869,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1996,7 +1878,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/discovery-ignition": {
       "get": {
         "security": [
           {
@@ -2005,37 +1887,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "GetDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           },
           "401": {
@@ -2050,68 +1923,54 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift host.",
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "UpdateDiscoveryIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster for which the discovery ignition config should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -2143,28 +2002,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -2173,36 +2020,104 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.yaml"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
             }
           },
           "401": {
@@ -2229,41 +2144,114 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "If downloading a file related to a host, the relevant host.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
           },
           "400": {
             "description": "Error.",
@@ -2295,6 +2283,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2304,41 +2298,98 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/downloads/image": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster whose ISO should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being enabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
               "$ref": "#/definitions/infra_error"
             }
           },
@@ -2355,15 +2406,24 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "416": {
+            "description": "The requested range is not satisfiable.",
             "schema": {
               "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
             }
           },
           "500": {
@@ -2374,37 +2434,44 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      "post": {
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster whose ISO should be generated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being disabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -2442,38 +2509,53 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+      },
+      "head": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "DownloadClusterISOHeaders",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster whose ISO headers should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              },
+              "X-Checksum-Sha256": {
+                "type": "string",
+                "description": "The hex-encoded SHA-256 digest of the ISO, when it is known."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2494,6 +2576,9 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed."
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -2509,36 +2594,44 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -2559,6 +2652,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -2574,19 +2673,30 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2594,30 +2704,90 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "A host in the specified cluster to return events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "format": "date-time",
+            "description": "Only events that occurred at or after this time are returned.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only events that occurred before this time are returned.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only events whose message contains this text, ignoring case, are returned.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "The order of the returned events by their time.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of events to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of events to skip before the returned events.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "type": "integer",
+                "description": "The total number of events matching the filters, regardless of the limit and offset."
+              }
             }
           },
           "401": {
@@ -2638,8 +2808,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2653,7 +2823,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+    "/clusters/{cluster_id}/events/stream": {
       "get": {
         "security": [
           {
@@ -2664,22 +2834,22 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
+        "description": "Streams events for a cluster as server-sent events, ordered by their time. Each event is sent with an ID made of its time and its sequence number, so that reconnecting clients can resume from the last event they received. Events saved late may follow events with later times, so on resume the events of the last few seconds before the last received event are sent again, with the same IDs.",
         "produces": [
-          "application/octet-stream"
+          "text/event-stream"
         ],
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster to stream events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2687,17 +2857,53 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "A host in the specified cluster to stream events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The ID of the last event received by the client, as sent in the stream. Only events that follow it and events of the few seconds before it are streamed.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -2724,57 +2930,69 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+    "/clusters/{cluster_id}/free_addresses": {
       "get": {
-        "description": "Get the customized ignition file for this host",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster to return free addresses for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
             "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -2801,12 +3019,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2814,48 +3026,39 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/clusters/{cluster_id}/host-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -2891,51 +3094,46 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -2950,74 +3148,73 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "description": "The software version of the discovery agent that is registering the host.",
             "name": "discovery_agent_version",
             "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3044,6 +3241,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new hosts due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3057,29 +3260,29 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "operationId": "GetHost",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3087,28 +3290,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host that should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -3140,44 +3332,20 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Download host logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster of the host that should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3185,17 +3353,20 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
+            "description": "The host that should be deregistered.",
             "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3222,12 +3393,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3235,27 +3400,20 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3263,28 +3421,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "The host that is being enabled.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3304,38 +3452,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3343,24 +3490,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host that is being disabled.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3397,39 +3538,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation progress.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "InstallHost",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3437,24 +3561,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve.",
+            "description": "The host that is being installed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3474,8 +3592,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3485,47 +3603,40 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -3546,8 +3657,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3559,38 +3670,50 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
       "patch": {
-        "description": "Override values in the install config.",
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3613,8 +3736,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3628,7 +3751,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -3639,43 +3762,33 @@ func init() {
             ]
           },
           {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Download cluster logs.",
+        "description": "Downloads the customized ignition file for this host",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "description": "The host whose ignition file should be downloaded.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -3720,62 +3833,47 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
+            "description": "The host whose customized ignition file should be retrieved.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3795,55 +3893,68 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/host-ignition-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -3869,54 +3980,60 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Lists manifests for customizing cluster installation.",
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3956,48 +4073,49 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -4024,58 +4142,73 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4100,14 +4233,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4115,46 +4248,44 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
+        "description": "Download host logs.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           }
         ],
@@ -4202,43 +4333,56 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/monitored_operators": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4258,60 +4402,63 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
       "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Update log collection state and progress.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/logs-progress-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4358,38 +4505,54 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/host-progress"
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4420,55 +4583,47 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadClusterIngressCert",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string"
             }
           },
           "401": {
@@ -4500,63 +4655,66 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/component_versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
+        "operationId": "UpdateClusterInstallConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose install config is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/list-versions"
+              "type": "string"
             }
           }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
         ],
-        "operationId": "ListManagedDomains",
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -4568,7 +4726,7 @@ func init() {
         }
       }
     },
-    "/host_requirements": {
+    "/clusters/{cluster_id}/install-retry-policy": {
       "get": {
         "security": [
           {
@@ -4579,25 +4737,26 @@ func init() {
             ]
           }
         ],
-        "description": "Get minimum host requirements.",
+        "description": "Get the policy that the failed host installations of the cluster are automatically retried by.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostRequirements",
+        "operationId": "GetClusterInstallRetryPolicy",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose install retry policy is being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-requirements"
+              "$ref": "#/definitions/install-retry-policy"
             }
           },
           "401": {
@@ -4612,6 +4771,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -4625,55 +4790,43 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/openshift_versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+      },
+      "put": {
+        "description": "Replace the policy that the failed host installations of the cluster are automatically retried by. The policy can be changed during the installation.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/openshift-versions"
-            }
+        "operationId": "UpdateClusterInstallRetryPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose install retry policy is being replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Unavailable.",
+          {
+            "description": "The new install retry policy of the cluster.",
+            "name": "install-retry-policy",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/install-retry-policy"
             }
           }
-        }
-      }
-    },
-    "/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
         ],
-        "operationId": "ListSupportedOperators",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/install-retry-policy"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4688,6 +4841,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4697,7 +4862,7 @@ func init() {
         }
       }
     },
-    "/supported-operators/{operator_name}": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
@@ -4706,28 +4871,199 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "ListOperatorProperties",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The cluster whose logs should be downloaded.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be uploaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4752,2897 +5088,7930 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "add-hosts-cluster-create-params": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "api_vip_dnsname",
-        "openshift_version"
-      ],
-      "properties": {
-        "api_vip_dnsname": {
-          "description": "api vip domain.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+    },
+    "/clusters/{cluster_id}/logs/artifacts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the uploaded host and controller log archives of the cluster and when they expire.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusterLogArtifacts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose log archives should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/log-artifact-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/logs/search": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Searches the lines of the uploaded host and controller logs of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "SearchClusterLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose logs should be searched.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only lines matching this regular expression (RE2 syntax), ignoring case, are returned.",
+            "name": "pattern",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "host",
+                "controller"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of the types of logs to search.",
+            "name": "logs_types",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster whose logs should be searched.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "master",
+                "worker",
+                "bootstrap"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of the roles of the hosts whose logs should be searched.",
+            "name": "roles",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "debug",
+                "info",
+                "warning",
+                "error",
+                "fatal"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of log levels. Lines whose level is not known are only returned when no levels are given.",
+            "name": "levels",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only lines of the files whose path within the archive contains this text are returned.",
+            "name": "file",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only lines logged at or after this time are returned. Lines whose time is not known are only returned when no time range is given.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only lines logged before this time are returned.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of lines to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of matching lines to skip before the returned lines.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/log-search-match-list"
+            },
+            "headers": {
+              "Match-Count": {
+                "type": "integer",
+                "description": "The total number of lines matching the filters, regardless of the limit and offset."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose log progress is being updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "ListClusterManifests",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the manifests should be listed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-manifests"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "CreateClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which a new manifest should be created.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DeleteClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be deleted.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/manifests/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "DownloadClusterManifest",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/monitored_operators": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ListOfClusterOperators",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return operators for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "ReportMonitoredOperatorStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetPreflightRequirements",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requrements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/stage-timeouts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the installation stage timeouts of the cluster, which override the timeouts of the service.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterStageTimeouts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose stage timeouts are being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/stage-timeouts"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace the installation stage timeouts of the cluster. The timeouts can be changed during the installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterStageTimeouts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose stage timeouts are being replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new stage timeouts of the cluster.",
+            "name": "stage-timeouts",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/stage-timeouts"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/stage-timeouts"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/validation-policy": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the validation policy of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterValidationPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose validation policy is being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/validation-policy"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace the validation policy of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterValidationPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose validation policy is being replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new validation policy of the cluster.",
+            "name": "validation-policy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/validation-policy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/validation-policy"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/worker-success-threshold": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get how many of the workers of the cluster must be installed for its installation to succeed.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterWorkerSuccessThreshold",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose worker success threshold is being retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/worker-success-threshold"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace how many of the workers of the cluster must be installed for its installation to succeed. The threshold can be changed during the installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterWorkerSuccessThreshold",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose worker success threshold is being replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new worker success threshold of the cluster.",
+            "name": "worker-success-threshold",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/worker-success-threshold"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/worker-success-threshold"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host-validations": {
+      "post": {
+        "description": "Runs the host validations on a hypothetical host, as if it was part of a cluster, without registering it.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ValidateHypotheticalHost",
+        "parameters": [
+          {
+            "description": "The description of the host to validate.",
+            "name": "hypothetical-host",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/hypothetical-host"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-validation-results"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host_requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get minimum host requirements.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetHostRequirements",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/openshift_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/state-machines": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Describes the state machines of the hosts and the clusters, with their transitions and diagrams.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListStateMachines",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/state-machines"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the registered webhooks.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ListWebhooks",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the webhooks that notify about this cluster, including the organization-wide webhooks.",
+            "name": "cluster_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers an endpoint to be notified when clusters and hosts reach notable states. Webhooks without a cluster are notified about all the clusters of the organization.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "RegisterWebhook",
+        "parameters": [
+          {
+            "description": "The endpoint to notify.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhook_id}": {
+      "delete": {
+        "description": "Deletes a webhook. Pending deliveries of the webhook are not sent.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "DeregisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to delete.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhook_id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the notifications sent or to be sent to a webhook, most recent first.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to return deliveries for.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "Only return the deliveries in this status.",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-delivery-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhook_id}/deliveries/{delivery_id}/actions/replay": {
+      "post": {
+        "description": "Sends a notification to the webhook again, with a new set of delivery attempts.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "ReplayWebhookDelivery",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook the delivery was sent to.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The delivery to replay.",
+            "name": "delivery_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-delivery"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
         }
       }
     },
     "api_vip_connectivity_request": {
       "type": "object",
       "required": [
-        "url"
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "type": "object",
+      "properties": {
+        "is_success": {
+          "description": "API VIP connectivity check result.",
+          "type": "boolean"
+        }
+      }
+    },
+    "assisted-service-iso-create-params": {
+      "type": "object",
+      "properties": {
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        }
+      }
+    },
+    "audit-change": {
+      "type": "object",
+      "required": [
+        "resource",
+        "field"
+      ],
+      "properties": {
+        "after": {
+          "description": "The value of the field after the call. Secret values are redacted.",
+          "type": "string"
+        },
+        "before": {
+          "description": "The value of the field before the call. Secret values are redacted.",
+          "type": "string"
+        },
+        "field": {
+          "description": "The changed field of the object.",
+          "type": "string"
+        },
+        "resource": {
+          "description": "The kind of the object that was changed.",
+          "type": "string",
+          "enum": [
+            "cluster",
+            "host"
+          ]
+        }
+      }
+    },
+    "audit-record": {
+      "type": "object",
+      "required": [
+        "id",
+        "created_at",
+        "method",
+        "endpoint"
+      ],
+      "properties": {
+        "changes": {
+          "description": "The fields of the cluster and the host that were changed by the call.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audit-change"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "endpoint": {
+          "description": "The path of the call.",
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "method": {
+          "description": "The HTTP method of the call.",
+          "type": "string"
+        },
+        "operation_id": {
+          "description": "The API operation that was called.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that made the call.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "request_id": {
+          "description": "The ID of the request, as sent in the X-Request-ID header or generated by the service.",
+          "type": "string"
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response.",
+          "type": "integer"
+        },
+        "user_name": {
+          "description": "The user that made the call.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-record"
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "checksums_signing_key": {
+      "type": "object",
+      "required": [
+        "public_key"
+      ],
+      "properties": {
+        "public_key": {
+          "description": "The PEM of the EC public key that verifies the signatures of the cluster checksums.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "deleted_at": {
+          "description": "The time that the cluster was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "failure_reason": {
+          "description": "JSON-formatted string containing the failure-reason of the installation, which is classified when the cluster ends in error.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\" secret:\"true\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\" secret:\"true\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\" secret:\"true\"",
+          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+        },
+        "install_retry_policy": {
+          "description": "JSON-formatted string containing the policy that the failed host installations of the cluster are automatically retried by.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "stage_timeouts": {
+          "description": "JSON-formatted string containing the installation stage timeouts of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action",
+            "installing-paused"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "template_id": {
+          "description": "The template that the cluster was registered from.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "template_version": {
+          "description": "The version of the template that the cluster was registered from.",
+          "type": "integer"
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validation_policy": {
+          "description": "JSON-formatted string containing the validation policy of the cluster and of its hosts.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "worker_success_threshold": {
+          "description": "JSON-formatted string containing how many of the workers of the cluster must be installed for its installation to succeed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "template_id": {
+          "description": "A template whose defaults are used for the parameters that are not set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "template_params": {
+          "description": "Values substituted for the ${key} placeholders in the string parameters, install config overrides and manifests of the template.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "template_version": {
+          "description": "The version of the template to use, the latest version if not set.",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": true,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-definition": {
+      "description": "The definition of a cluster, as exported from one service and imported to another.",
+      "type": "object",
+      "required": [
+        "version",
+        "cluster_params"
+      ],
+      "properties": {
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API, when it isn't allocated by DHCP.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "cluster_params": {
+          "description": "The properties of the cluster. The pull secret is never exported and is set on import.",
+          "$ref": "#/definitions/cluster-create-params"
+        },
+        "hosts": {
+          "description": "The assignments of the hosts of the cluster, applied to the hosts that register with the same MAC addresses.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-definition-host"
+          }
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "static_network_config": {
+          "description": "The static network configuration of the hosts of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        },
+        "version": {
+          "description": "The version of the format of the definition.",
+          "type": "string",
+          "enum": [
+            "v1"
+          ]
+        }
+      }
+    },
+    "cluster-definition-host": {
+      "type": "object",
+      "required": [
+        "mac_addresses"
+      ],
+      "properties": {
+        "installation_disk_id": {
+          "description": "The ID of the disk that the host is installed on.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The MAC addresses of the interfaces of the host.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
+        },
+        "requested_hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        }
+      }
+    },
+    "cluster-definition-import-params": {
+      "type": "object",
+      "required": [
+        "definition",
+        "pull_secret"
+      ],
+      "properties": {
+        "definition": {
+          "$ref": "#/definitions/cluster-definition"
+        },
+        "pull_secret": {
+          "description": "The pull secret of the new cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "version",
+        "name",
+        "created_at"
+      ],
+      "properties": {
+        "cluster_params": {
+          "x-go-custom-tag": "gorm:\"-\"",
+          "$ref": "#/definitions/cluster-template-params"
+        },
+        "created_at": {
+          "description": "The time that this version of the template was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "description": "Free-text description of the template.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the overrides for the install-config.yaml file of the clusters.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "manifests": {
+          "description": "Manifests added to the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "version": {
+          "description": "The version of the template, incremented on every update.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment:false\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "cluster_params": {
+          "$ref": "#/definitions/cluster-template-params"
+        },
+        "description": {
+          "description": "Free-text description of the template.",
+          "type": "string"
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the overrides for the install-config.yaml file of the clusters.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "Manifests added to the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-template-params": {
+      "description": "The defaults of a cluster template. Values may contain ${key} placeholders that are substituted when a cluster is registered.",
+      "type": "object",
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster.",
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.",
+          "type": "string"
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "disks_config": {
+                "description": "The desired disks parameters (such as the disk's role).",
+                "type": "array",
+                "items": {
+                  "$ref": "#/definitions/disk-config-params"
+                }
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_machine_config_pool_names": {
+          "description": "The desired machine config pool for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "machine_config_pool_name": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_names": {
+          "description": "The desired hostname for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "hostname": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "$ref": "#/definitions/host-role-update-params"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "all",
+            "none"
+          ],
+          "x-nullable": true
+        },
+        "ingress_vip": {
+          "description": "The virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vip-defined",
+        "api-vip-valid",
+        "ingress-vip-defined",
+        "ingress-vip-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "dns-domain-names-resolved-consistently"
+      ]
+    },
+    "cluster_checksums": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "created_at",
+        "files"
       ],
       "properties": {
-        "url": {
-          "description": "URL address of the API.",
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "files": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/file_checksum"
+          }
+        },
+        "signature": {
+          "description": "A JSON Web Token signed with ES256 by the checksums signing key of the service, whose claims are the cluster_id, created_at and files of the checksums. It is set when the service has a signing key.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
           "type": "string"
         },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces.",
+        "is_success": {
           "type": "boolean"
         }
       }
     },
-    "api_vip_connectivity_response": {
+    "connectivity-check-host": {
       "type": "object",
       "properties": {
-        "is_success": {
-          "description": "API VIP connectivity check result.",
-          "type": "boolean"
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
         }
       }
     },
-    "assisted-service-iso-create-params": {
+    "connectivity-check-nic": {
       "type": "object",
       "properties": {
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+        "name": {
           "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
           "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
         }
       }
     },
-    "boot": {
+    "container_image_availability_request": {
       "type": "object",
+      "required": [
+        "images"
+      ],
       "properties": {
-        "current_boot_mode": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
           "type": "string"
         },
-        "pxe_interface": {
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
           "type": "string"
         }
       }
     },
-    "cluster": {
+    "create-manifest-params": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
+        "file_name",
+        "content"
       ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "content": {
+          "description": "base64 encoded manifest content.",
           "type": "string"
         },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
           "type": "string",
-          "format": "uuid"
+          "pattern": "^[^/]*\\.(yaml|yml|json)$"
         },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
         },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
+        "password": {
+          "type": "string"
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "format": "mac"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
         },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "mac"
         },
-        "controller_logs_collected_at": {
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "ipv4"
+        },
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
+          "type": "string"
         },
-        "controller_logs_started_at": {
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "ipv4"
         },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
+        }
+      }
+    },
+    "discovery-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "bootable": {
+          "type": "boolean"
         },
-        "deleted_at": {
-          "description": "The time that the cluster was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
+          "type": "string"
         },
-        "email_domain": {
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
           "type": "string"
         },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "drive_type": {
+          "type": "string"
         },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "hctl": {
+          "type": "string"
         },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
         },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
-          "x-go-custom-tag": "gorm:\"-\""
+          "x-nullable": false
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "model": {
           "type": "string"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        "name": {
+          "type": "string"
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
-          "type": "string",
-          "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ]
+        "path": {
+          "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
+        "serial": {
+          "type": "string"
         },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        "size_bytes": {
+          "type": "integer"
         },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
+        "smart": {
+          "type": "string"
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        "vendor": {
+          "type": "string"
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
         },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-role": {
+      "type": "string",
+      "enum": [
+        "none",
+        "install"
+      ]
+    },
+    "disk_info": {
+      "type": "object",
+      "properties": {
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
         },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
+        "id": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "format": "uuid"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
-          "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster"
-          ]
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
         },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
+        "speed_ms": {
+          "type": "integer"
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
+          "type": "string"
+        }
+      }
+    },
+    "disk_speed_check_response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
         },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
+        "path": {
+          "description": "The device path.",
+          "type": "string"
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
           "type": "array",
           "items": {
             "type": "object",
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
-        },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain name that should be resolved",
+                "type": "string"
+              }
+            },
+            "x-go-name": "DomainResolutionRequestDomain"
+          }
+        }
+      }
+    },
+    "domain_resolution_response": {
+      "type": "object",
+      "required": [
+        "resolutions"
+      ],
+      "properties": {
+        "resolutions": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
+            },
+            "x-go-name": "DomainResolutionResponseDomain"
+          }
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
           "type": "string"
         },
-        "org_id": {
+        "href": {
+          "description": "Self link.",
           "type": "string"
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
+        "id": {
+          "description": "Numeric identifier of the error.",
           "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "enum": [
+            "Error"
+          ]
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "reason": {
+          "description": "Human-readable description of the error.",
           "type": "string"
-        },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
           "type": "string",
           "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
-          ]
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
+        "event_time": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "user_name": {
-          "type": "string"
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
         }
       }
     },
-    "cluster-create-params": {
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "failure-category": {
+      "type": "string",
+      "enum": [
+        "disk",
+        "network",
+        "dns",
+        "image-pull",
+        "certificate",
+        "ignition",
+        "boot-order",
+        "control-plane",
+        "operators",
+        "host-installation",
+        "timeout",
+        "unknown"
+      ]
+    },
+    "failure-evidence": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
+        "source",
+        "category",
+        "message"
       ],
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
-        },
-        "high_availability_mode": {
-          "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+      "properties": {
+        "category": {
+          "$ref": "#/definitions/failure-category"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "host_id": {
+          "description": "The host that the finding is about, if any.",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
-          "type": "string",
-          "default": "all",
-          "enum": [
-            "masters",
-            "workers",
-            "none",
-            "all"
-          ]
+        "message": {
+          "description": "The finding, such as the matching line of the logs.",
+          "type": "string"
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+        "reference": {
+          "description": "The location of the finding in the logs of the cluster, in the format \u003carchive\u003e:\u003cfile\u003e:\u003cline\u003e.",
+          "type": "string"
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "source": {
+          "description": "Where the finding comes from.",
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1
+          "enum": [
+            "host-progress",
+            "event",
+            "validation",
+            "logs"
+          ]
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "time": {
+          "description": "The time of the finding, if known.",
           "type": "string",
+          "format": "date-time",
           "x-nullable": true
+        }
+      }
+    },
+    "failure-reason": {
+      "description": "The most likely root cause of a failed installation, classified from the progress of the hosts, the events, the validations and the logs of the cluster.",
+      "type": "object",
+      "required": [
+        "category",
+        "summary"
+      ],
+      "properties": {
+        "category": {
+          "$ref": "#/definitions/failure-category"
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+        "classified_at": {
+          "description": "The time the failure was classified.",
+          "type": "string",
+          "format": "date-time"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "evidence": {
+          "description": "The findings that the classification is based on, the ones of the category first.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
+            "$ref": "#/definitions/failure-evidence"
           }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "remediation": {
+          "description": "A hint of what can be done before installing the cluster again.",
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
+        "summary": {
+          "description": "A description of the failure.",
           "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "suspected_host_id": {
+          "description": "The host that most of the evidence points at, if any.",
           "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "format": "uuid"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "suspected_hostname": {
+          "description": "The name of the suspected host.",
           "type": "string"
-        },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": true,
-          "x-nullable": true
         }
       }
     },
-    "cluster-host-requirements": {
+    "file_checksum": {
       "type": "object",
+      "required": [
+        "name",
+        "size",
+        "sha256"
+      ],
       "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
-          "type": "string",
-          "format": "uuid"
+        "name": {
+          "description": "The name of the file, discovery.iso for the discovery ISO or the file_name that downloads the file from /clusters/{cluster_id}/downloads/files.",
+          "type": "string"
         },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "sha256": {
+          "description": "The hex-encoded SHA-256 digest of the file.",
+          "type": "string"
         },
-        "operators": {
-          "description": "Host requirements related to requested operators",
+        "size": {
+          "description": "The size of the file in bytes.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-host-requirements"
+            "type": "string",
+            "format": "ipv4"
           }
         },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
         }
       }
     },
-    "cluster-host-requirements-details": {
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
       "type": "object",
       "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
-        },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "type": "string"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
         }
       }
     },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
-      }
-    },
-    "cluster-update-params": {
+    "host": {
       "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "api_vip_connectivity": {
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "api_vip": {
-          "description": "The virtual IP used to reach the OpenShift cluster's API.",
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
           "type": "string",
-          "x-nullable": true
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "connectivity": {
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "created_at": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "deleted_at": {
+          "description": "The time that the host was deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-nullable": true
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
+        "discovery_agent_version": {
+          "type": "string"
+        },
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "domain_resolution": {
+          "description": "The results of resolving the domain names of the cluster on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\" secret:\"true\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        },
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "disks_config": {
-                "description": "The desired disks parameters (such as the disk's role).",
-                "type": "array",
-                "items": {
-                  "$ref": "#/definitions/disk-config-params"
-                }
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
         },
-        "hosts_machine_config_pool_names": {
-          "description": "The desired machine config pool for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "machine_config_pool_name": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": true
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
         },
-        "hosts_names": {
-          "description": "The desired hostname for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "hostname": {
-                "type": "string"
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-nullable": true
+        "installation_retries": {
+          "description": "The number of times that the installation of the host was automatically retried.",
+          "type": "integer"
         },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "$ref": "#/definitions/host-role-update-params"
-              }
-            }
-          },
-          "x-nullable": true
+        "installer_args": {
+          "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "inventory": {
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
           "enum": [
-            "masters",
-            "workers",
-            "all",
-            "none"
-          ],
-          "x-nullable": true
+            "Host",
+            "AddToExistingClusterHost"
+          ]
         },
-        "ingress_vip": {
-          "description": "The virtual IP used for cluster ingress traffic.",
+        "logs_collected_at": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-          "x-nullable": true
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
+          "$ref": "#/definitions/logs_state"
+        },
+        "logs_started_at": {
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "OpenShift cluster name.",
+        "machine_config_pool_name": {
+          "type": "string"
+        },
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
           "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "paused_installation_failure": {
+          "description": "The reason of the failure that the host reported while its installation was paused. The failure is retried or final when the installation is resumed.",
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.",
+        "progress": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
+          "$ref": "#/definitions/host-progress-info"
+        },
+        "progress_stages": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at cloud.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
+        "requested_hostname": {
+          "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "user_managed_networking": {
-          "description": "Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "installing-paused"
+          ]
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vip-defined",
-        "api-vip-valid",
-        "ingress-vip-defined",
-        "ingress-vip-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
-      ]
-    },
-    "cluster_default_config": {
-      "type": "object",
-      "properties": {
-        "cluster_network_cidr": {
+        "status_info": {
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ntp_source": {
+        "updated_at": {
           "type": "string",
-          "x-omitempty": false
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_network_cidr": {
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "completion-params": {
+    "host-create-params": {
       "type": "object",
       "required": [
-        "is_success"
+        "host_id"
       ],
       "properties": {
-        "error_info": {
+        "discovery_agent_version": {
           "type": "string"
         },
-        "is_success": {
-          "type": "boolean"
-        }
-      }
-    },
-    "connectivity-check-host": {
-      "type": "object",
-      "properties": {
         "host_id": {
           "type": "string",
           "format": "uuid"
-        },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
         }
       }
     },
-    "connectivity-check-nic": {
-      "type": "object",
+    "host-ignition-params": {
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mac": {
-          "type": "string"
-        },
-        "name": {
+        "config": {
           "type": "string"
         }
       }
     },
-    "connectivity-check-params": {
+    "host-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/connectivity-check-host"
+        "$ref": "#/definitions/host"
       }
     },
-    "connectivity-remote-host": {
+    "host-progress": {
       "type": "object",
+      "required": [
+        "current_stage"
+      ],
       "properties": {
-        "host_id": {
+        "current_stage": {
           "type": "string",
-          "format": "uuid"
-        },
-        "l2_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l2-connectivity"
-          }
+          "$ref": "#/definitions/host-stage"
         },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
-          }
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         }
       }
     },
-    "connectivity-report": {
+    "host-progress-info": {
       "type": "object",
+      "required": [
+        "current_stage"
+      ],
       "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
+        "current_stage": {
+          "type": "string",
+          "$ref": "#/definitions/host-stage"
+        },
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       }
     },
-    "container_image_availability": {
+    "host-requirements": {
       "type": "object",
       "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
+        "master": {
+          "$ref": "#/definitions/host-requirements-role"
         },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
-          "type": "string"
+        "worker": {
+          "$ref": "#/definitions/host-requirements-role"
+        }
+      }
+    },
+    "host-requirements-role": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "type": "integer"
         },
-        "result": {
-          "$ref": "#/definitions/container_image_availability_result"
+        "disk_size_gb": {
+          "type": "integer"
         },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "installation_disk_speed_threshold_ms": {
+          "type": "integer"
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
+        "ram_gib": {
+          "type": "integer"
         }
       }
     },
-    "container_image_availability_request": {
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-type-hardware-requirements": {
       "type": "object",
-      "required": [
-        "images"
-      ],
       "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
           "type": "array",
           "items": {
-            "description": "A fully qualified image name (FQIN).",
             "type": "string"
           }
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "container_image_availability_response": {
+    "host-type-hardware-requirements-wrapper": {
       "type": "object",
-      "required": [
-        "images"
-      ],
       "properties": {
-        "images": {
-          "description": "List of images that were checked.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/container_image_availability"
-          }
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
+        },
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         }
       }
     },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
+    "host-validation-id": {
       "type": "string",
       "enum": [
-        "success",
-        "failure"
+        "connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "api-vip-connected",
+        "belongs-to-majority-group",
+        "valid-platform",
+        "ntp-synced",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly"
       ]
     },
-    "cpu": {
+    "host-validation-result": {
       "type": "object",
+      "required": [
+        "id",
+        "status",
+        "message"
+      ],
       "properties": {
-        "architecture": {
+        "id": {
+          "$ref": "#/definitions/host-validation-id"
+        },
+        "message": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending",
+            "error",
+            "disabled",
+            "warning"
+          ]
+        }
+      }
+    },
+    "host-validation-results": {
+      "description": "The results of the host validations grouped by category, in the format of the validations info of a host.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/host-validation-result"
+        }
+      }
+    },
+    "host_network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
-        },
-        "flags": {
+        "host_ids": {
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "string",
+            "format": "uuid"
           }
+        }
+      }
+    },
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
         },
-        "frequency": {
-          "type": "number"
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "host_static_network_config": {
+      "type": "object",
+      "properties": {
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "model_name": {
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
           "type": "string"
         }
       }
     },
-    "create-manifest-params": {
+    "hypothetical-host": {
+      "description": "A host that is validated without being registered.",
       "type": "object",
       "required": [
-        "file_name",
-        "content"
+        "inventory"
       ],
       "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
-        },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
+        "cluster_id": {
+          "description": "The cluster that the host is validated against. Either it or the OpenShift version must be set.",
           "type": "string",
-          "pattern": "^[^/]*\\.(yaml|yml|json)$"
+          "format": "uuid",
+          "x-nullable": true
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "connectivity": {
+          "$ref": "#/definitions/connectivity-report"
+        },
+        "high_availability_mode": {
+          "description": "The availability mode of the cluster that the host is validated against, when no cluster is set.",
           "type": "string",
-          "default": "manifests",
+          "default": "Full",
           "enum": [
-            "manifests",
-            "openshift"
+            "Full",
+            "None"
           ]
+        },
+        "inventory": {
+          "$ref": "#/definitions/inventory"
+        },
+        "openshift_version": {
+          "description": "The OpenShift version that the host is validated against, when no cluster is set.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
         }
       }
     },
-    "credentials": {
+    "image-create-params": {
       "type": "object",
       "properties": {
-        "console_url": {
-          "type": "string"
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
         },
-        "password": {
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string"
         },
-        "username": {
-          "type": "string"
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
         }
       }
     },
-    "dhcp_allocation_request": {
+    "image_info": {
       "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
       "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "download_url": {
           "type": "string"
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
+        "expires_at": {
           "type": "string",
-          "format": "mac"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+        "generator_version": {
+          "description": "Image generator version.",
           "type": "string"
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
-          "type": "string",
-          "format": "mac"
+        "size_bytes": {
+          "type": "integer"
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string"
+        },
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
         }
       }
     },
-    "dhcp_allocation_response": {
+    "image_type": {
+      "type": "string",
+      "enum": [
+        "full-iso",
+        "minimal-iso"
+      ]
+    },
+    "infra_error": {
       "type": "object",
       "required": [
-        "api_vip_address",
-        "ingress_vip_address"
+        "code",
+        "message"
       ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
+        "message": {
+          "description": "Human-readable description of the error.",
           "type": "string"
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "install-retry-policy": {
+      "description": "Opt-in policy of automatically retrying the installation of hosts that failed in stages whose failures are usually transient.",
+      "type": "object",
+      "properties": {
+        "max_retries": {
+          "description": "How many times the installation of each host may be retried. Zero disables the retries.",
+          "type": "integer",
+          "maximum": 5
         },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
-          "type": "string"
+        "stages": {
+          "description": "The installation stages whose failures are retried. Failures in \"Starting installation\", \"Installing\" and \"Writing image to disk\" are retried when empty. Stages in which the host already rebooted out of the discovery image can't be retried.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          }
         }
       }
     },
-    "discovery-ignition-params": {
+    "installer-args-params": {
+      "type": "object",
       "properties": {
-        "config": {
-          "type": "string"
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
         }
       }
     },
-    "disk": {
+    "interface": {
       "type": "object",
       "properties": {
-        "bootable": {
-          "type": "boolean"
-        },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
-        },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
-        },
-        "drive_type": {
+        "biosdevname": {
           "type": "string"
         },
-        "hctl": {
+        "client_id": {
           "type": "string"
         },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "x-nullable": false
+        "has_carrier": {
+          "type": "boolean"
         },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "model": {
+        "mac_address": {
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "mtu": {
+          "type": "integer"
         },
-        "path": {
+        "name": {
           "type": "string"
         },
-        "serial": {
+        "product": {
           "type": "string"
         },
-        "size_bytes": {
+        "speed_mbps": {
           "type": "integer"
         },
-        "smart": {
-          "type": "string"
-        },
         "vendor": {
           "type": "string"
-        },
-        "wwn": {
-          "type": "string"
         }
       }
     },
-    "disk-config-params": {
+    "inventory": {
       "type": "object",
-      "required": [
-        "id"
-      ],
       "properties": {
-        "id": {
+        "bmc_address": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/disk-role"
-        }
-      }
-    },
-    "disk-role": {
-      "type": "string",
-      "enum": [
-        "none",
-        "install"
-      ]
-    },
-    "disk_info": {
-      "type": "object",
-      "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
+        "bmc_v6address": {
+          "type": "string"
         },
-        "id": {
-          "type": "string",
-          "format": "uuid"
+        "boot": {
+          "$ref": "#/definitions/boot"
         },
-        "path": {
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
+        },
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
+          }
+        },
+        "hostname": {
           "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "timestamp": {
+          "type": "integer"
         }
       }
     },
-    "disk_speed": {
+    "io_perf": {
       "type": "object",
       "properties": {
-        "exit_code": {
-          "type": "integer"
-        },
-        "speed_ms": {
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
           "type": "integer"
-        },
-        "tested": {
-          "type": "boolean"
         }
       }
     },
-    "disk_speed_check_request": {
+    "l2-connectivity": {
       "type": "object",
-      "required": [
-        "path"
-      ],
       "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
+        "outgoing_ip_address": {
+          "type": "string"
+        },
+        "outgoing_nic": {
           "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "disk_speed_check_response": {
+    "l3-connectivity": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "path": {
-          "description": "The device path.",
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
           "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "domain_resolution_request": {
-      "type": "object",
-      "required": [
-        "domains"
-      ],
-      "properties": {
-        "domains": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain name that should be resolved",
-                "type": "string"
-              }
-            },
-            "x-go-name": "DomainResolutionRequestDomain"
-          }
-        }
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
       }
     },
-    "domain_resolution_response": {
-      "type": "object",
-      "required": [
-        "resolutions"
-      ],
-      "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
-            },
-            "x-go-name": "DomainResolutionResponseDomain"
-          }
-        }
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
       }
-    },
-    "error": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
-      ],
-      "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
-        },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
-          "type": "string",
-          "enum": [
-            "Error"
-          ]
-        },
-        "reason": {
-          "description": "Human-readable description of the error.",
+    },
+    "list-versions": {
+      "type": "object",
+      "properties": {
+        "release_tag": {
           "type": "string"
+        },
+        "versions": {
+          "$ref": "#/definitions/versions"
         }
       }
     },
-    "event": {
+    "log-artifact": {
       "type": "object",
       "required": [
-        "cluster_id",
-        "severity",
-        "message",
-        "event_time"
+        "logs_type",
+        "archive",
+        "compression",
+        "size_bytes"
       ],
       "properties": {
-        "category": {
+        "archive": {
+          "description": "The path of the log archive within the files of the cluster.",
+          "type": "string"
+        },
+        "archive_href": {
+          "description": "The URL that downloads the log archive.",
+          "type": "string"
+        },
+        "collected_at": {
+          "description": "The time the logs were uploaded.",
           "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
+          "format": "date-time"
         },
-        "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+        "compression": {
+          "description": "The compression of the tarred log archive.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
+          "enum": [
+            "gzip",
+            "zstd"
+          ]
         },
-        "event_time": {
+        "expires_at": {
+          "description": "The time the garbage collector deletes the log archive, or null if it is kept until the cluster is deleted.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-nullable": true
         },
         "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+          "description": "The host that uploaded the logs, for host logs.",
           "type": "string",
           "format": "uuid"
         },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        "host_role": {
+          "$ref": "#/definitions/host-role"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "hostname": {
+          "type": "string"
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "size_bytes": {
+          "description": "The size of the stored log archive.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "log-artifact-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/log-artifact"
+      }
+    },
+    "log-search-match": {
+      "type": "object",
+      "required": [
+        "logs_type",
+        "archive",
+        "file",
+        "line",
+        "message"
+      ],
+      "properties": {
+        "archive": {
+          "description": "The path of the log archive within the files of the cluster.",
+          "type": "string"
+        },
+        "archive_href": {
+          "description": "The URL that downloads the log archive.",
+          "type": "string"
+        },
+        "file": {
+          "description": "The path of the file within the log archive.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "The host whose logs hold the line, for host logs.",
           "type": "string",
           "format": "uuid"
         },
-        "severity": {
+        "host_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "level": {
+          "description": "The level of the line, when it is known.",
           "type": "string",
           "enum": [
+            "debug",
             "info",
             "warning",
             "error",
-            "critical"
+            "fatal"
           ]
+        },
+        "line": {
+          "description": "The number of the line within the file, starting at 1.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "message": {
+          "description": "The text of the line, truncated if it is too long.",
+          "type": "string"
+        },
+        "time": {
+          "description": "The time the line was logged, when it is known.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         }
       }
     },
-    "event-list": {
+    "log-search-match-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/event"
+        "$ref": "#/definitions/log-search-match"
       }
     },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
+    "logs-progress-params": {
+      "type": "object",
+      "required": [
+        "logs_state"
+      ],
+      "properties": {
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
+        }
       }
     },
-    "free_addresses_request": {
+    "logs_state": {
+      "type": "string",
+      "enum": [
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ]
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
+      ]
+    },
+    "mac_interface_map": {
       "type": "array",
       "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
       }
     },
-    "free_network_addresses": {
+    "managed-domain": {
       "type": "object",
       "properties": {
-        "free_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
+        "domain": {
+          "type": "string"
         },
-        "network": {
+        "provider": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "enum": [
+            "route53"
+          ]
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
+    "manifest": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
+          "type": "string"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
       }
     },
-    "gpu": {
+    "memory": {
       "type": "object",
       "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
+        "physical_bytes": {
+          "type": "integer"
+        },
+        "usable_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "monitored-operator": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this operator is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
+        },
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
+        }
+      }
+    },
+    "monitored-operators-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/monitored-operator"
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
+        }
+      }
+    },
+    "ntp_synchronization_request": {
+      "type": "object",
+      "required": [
+        "ntp_source"
+      ],
+      "properties": {
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string"
         }
       }
     },
-    "host": {
+    "ntp_synchronization_response": {
+      "properties": {
+        "ntp_sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ntp_source"
+          }
+        }
+      }
+    },
+    "openshift-version": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
+        "display_name",
+        "release_image",
+        "release_version",
+        "rhcos_image",
+        "rhcos_rootfs",
+        "rhcos_version",
+        "support_level"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
+        "default": {
+          "description": "Indication that the version is the recommended one.",
           "type": "boolean"
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "deleted_at": {
-          "description": "The time that the host was deleted.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-nullable": true
-        },
-        "discovery_agent_version": {
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
         },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "href": {
-          "description": "Self link.",
+        "release_image": {
+          "description": "The installation image of the OpenShift cluster.",
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
-        },
-        "images_status": {
-          "description": "Array of image statuses.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "release_version": {
+          "description": "OCP version from the release metadata.",
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
-          "type": "string",
-          "example": "/dev/sda"
-        },
-        "installer_args": {
+        "rhcos_image": {
+          "description": "The base RHCOS image used for the discovery iso.",
           "type": "string"
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "rhcos_rootfs": {
+          "description": "The RHCOS rootfs url.",
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "rhcos_version": {
+          "description": "Build ID of the RHCOS image.",
+          "type": "string"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
           "enum": [
-            "Host",
-            "AddToExistingClusterHost"
+            "beta",
+            "production",
+            "custom"
           ]
-        },
-        "logs_collected_at": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\"",
-          "$ref": "#/definitions/logs_state"
-        },
-        "logs_started_at": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "machine_config_pool_name": {
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator-create-params": {
+      "type": "object",
+      "properties": {
+        "name": {
           "type": "string"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "progress": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
-          "$ref": "#/definitions/host-progress-info"
-        },
-        "progress_stages": {
+        }
+      }
+    },
+    "operator-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+            "type": "string"
+          }
         },
-        "requested_hostname": {
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        }
+      }
+    },
+    "operator-host-requirements": {
+      "type": "object",
+      "properties": {
+        "operator_name": {
+          "description": "Name of the operator",
+          "type": "string"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "operator-monitor-report": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique name of the operator.",
+          "type": "string"
         },
         "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled"
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
+          "type": "string"
+        }
+      }
+    },
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
+      "type": "object",
+      "properties": {
+        "data_type": {
+          "description": "Type of the property",
+          "type": "string",
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
           ]
         },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "default_value": {
+          "description": "Default value for the property",
+          "type": "string"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "description": {
+          "description": "Description of a property",
+          "type": "string"
         },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
+          "type": "boolean"
         },
-        "user_name": {
+        "name": {
+          "description": "Name of the property",
           "type": "string"
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "options": {
+          "description": "Values to select from",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "host-create-params": {
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "preflight-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        },
+        "operators": {
+          "description": "Preflight operators hardware requirements",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
+          }
+        }
+      }
+    },
+    "presigned": {
       "type": "object",
       "required": [
-        "host_id"
+        "url"
       ],
       "properties": {
-        "discovery_agent_version": {
+        "sha256": {
+          "description": "The hex-encoded SHA-256 digest of the file, when it is known.",
           "type": "string"
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "url": {
+          "type": "string"
         }
       }
     },
-    "host-ignition-params": {
+    "route": {
+      "type": "object",
       "properties": {
-        "config": {
+        "destination": {
+          "description": "The destination network or destination host",
+          "type": "string"
+        },
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
+          "type": "string"
+        },
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
           "type": "string"
         }
       }
     },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
-      }
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
     },
-    "host-progress": {
+    "stage-timeout": {
       "type": "object",
       "required": [
-        "current_stage"
+        "stage",
+        "timeout_seconds"
       ],
       "properties": {
-        "current_stage": {
-          "type": "string",
+        "stage": {
           "$ref": "#/definitions/host-stage"
         },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "timeout_seconds": {
+          "description": "How long the hosts may stay in the stage, in seconds.",
+          "type": "integer",
+          "minimum": 1
         }
       }
     },
-    "host-progress-info": {
+    "stage-timeouts": {
+      "description": "Overrides of how long the hosts of a cluster may stay in an installation stage before their installation fails.",
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
-        },
-        "progress_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "default_timeout_seconds": {
+          "description": "Overrides the timeout of the stages that have no timeout of their own, in seconds.",
+          "type": "integer",
+          "minimum": 1
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "timeouts": {
+          "description": "Overrides the timeouts of the stages.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stage-timeout"
+          }
         }
       }
     },
-    "host-requirements": {
+    "state-machine": {
       "type": "object",
+      "required": [
+        "name",
+        "states",
+        "transitions"
+      ],
       "properties": {
-        "master": {
-          "$ref": "#/definitions/host-requirements-role"
+        "graphviz": {
+          "description": "The state machine as a Graphviz diagram.",
+          "type": "string"
         },
-        "worker": {
-          "$ref": "#/definitions/host-requirements-role"
+        "mermaid": {
+          "description": "The state machine as a Mermaid diagram.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the state machine.",
+          "type": "string",
+          "enum": [
+            "host",
+            "cluster"
+          ]
+        },
+        "states": {
+          "description": "The states of the state machine, in the order they first appear in its transitions. The empty state is the state of objects that were not created yet.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "transition_types": {
+          "description": "The types of the transitions of the state machine, in the order they first appear.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "transitions": {
+          "description": "The transition rules of the state machine. The first rule of a transition type whose source states and condition match is the one that runs.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/state-machine-transition"
+          }
         }
       }
     },
-    "host-requirements-role": {
+    "state-machine-transition": {
       "type": "object",
+      "required": [
+        "transition_type",
+        "source_states",
+        "destination_state"
+      ],
       "properties": {
-        "cpu_cores": {
-          "type": "integer"
+        "condition": {
+          "description": "The named conditions that must hold for the transition to run, combined with \u0026\u0026, || and !. Empty when the transition has no condition.",
+          "type": "string"
         },
-        "disk_size_gb": {
-          "type": "integer"
+        "destination_state": {
+          "type": "string"
         },
-        "installation_disk_speed_threshold_ms": {
-          "type": "integer"
+        "post_transition": {
+          "description": "The name of the function that runs after the transition.",
+          "type": "string"
         },
-        "ram_gib": {
-          "type": "integer"
+        "source_states": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "transition_type": {
+          "type": "string"
         }
       }
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "worker"
-      ]
-    },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+    "state-machines": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/state-machine"
+      }
     },
-    "host-type-hardware-requirements": {
+    "step": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+        "args": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "command": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "error": {
+          "type": "string"
         },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "exit_code": {
+          "type": "integer"
+        },
+        "output": {
+          "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "host-validation-id": {
+    "step-type": {
       "type": "string",
       "enum": [
-        "connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "api-vip-connected",
-        "belongs-to-majority-group",
-        "valid-platform",
-        "ntp-synced",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role"
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "reset-installation",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution"
       ]
     },
-    "host_network": {
+    "steps": {
       "type": "object",
       "properties": {
-        "cidr": {
-          "type": "string"
-        },
-        "host_ids": {
+        "instructions": {
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "uuid"
+            "$ref": "#/definitions/step"
           }
+        },
+        "next_instruction_seconds": {
+          "type": "integer"
+        },
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
+          "type": "string",
+          "default": "continue",
+          "enum": [
+            "exit",
+            "continue"
+          ]
         }
       }
     },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "system_vendor": {
+      "type": "object",
+      "properties": {
+        "manufacturer": {
+          "type": "string"
         },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
-          }
+        "product_name": {
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
-      ]
+      }
     },
-    "host_static_network_config": {
+    "usage": {
       "type": "object",
       "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
         },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
+        "name": {
+          "description": "name of the feature to track",
           "type": "string"
         }
       }
     },
-    "image-create-params": {
+    "validation-policy": {
+      "description": "Overrides of the validations of a cluster and of its hosts.",
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
+        "disabled_cluster_validations": {
+          "description": "The cluster validations that are not run for the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-validation-id"
+          }
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "disabled_host_validations": {
+          "description": "The host validations that are not run for the hosts of the cluster, in addition to the ones disabled by the service configuration.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-validation-id"
+          }
         },
-        "static_network_config": {
+        "installation_disk_speed_threshold_ms": {
+          "description": "Overrides the maximal sync duration of the installation disk of the hosts, in milliseconds.",
+          "type": "integer"
+        },
+        "network_latency_threshold_ms": {
+          "description": "Overrides the maximal network latency between the hosts of the cluster, in milliseconds.",
+          "type": "number",
+          "format": "double"
+        },
+        "packet_loss_percentage": {
+          "description": "Overrides the maximal packet loss between the hosts of the cluster, in percents.",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        },
+        "warning_cluster_validations": {
+          "description": "The cluster validations whose failures are reported as warnings, which don't prevent the installation.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host_static_network_config"
+            "$ref": "#/definitions/cluster-validation-id"
+          }
+        },
+        "warning_host_validations": {
+          "description": "The host validations whose failures are reported as warnings, which don't prevent the installation.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-validation-id"
           }
         }
       }
     },
-    "image_info": {
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "download_url": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
-        },
-        "size_bytes": {
-          "type": "integer"
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso"
-      ]
+    "versions": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
     },
-    "infra_error": {
+    "webhook": {
       "type": "object",
       "required": [
-        "code",
-        "message"
+        "id",
+        "url"
       ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
+        "cluster_id": {
+          "description": "The cluster the webhook is notified about. Empty for organization-wide webhooks.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "message": {
-          "description": "Human-readable description of the error.",
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "description": "Unique identifier of the webhook.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "org_id": {
+          "description": "The organization of the user that registered the webhook.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "url": {
+          "description": "The HTTPS endpoint that notifications are posted to.",
+          "type": "string"
+        },
+        "user_name": {
+          "description": "The user that registered the webhook.",
           "type": "string"
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "installer-args-params": {
+    "webhook-create-params": {
       "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+        "cluster_id": {
+          "description": "The cluster to be notified about. When omitted, the webhook is notified about all the clusters of the organization.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "secret": {
+          "description": "Key used to sign the notifications with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.",
+          "type": "string",
+          "minLength": 16
+        },
+        "url": {
+          "description": "The HTTPS endpoint that notifications are posted to.",
+          "type": "string",
+          "pattern": "^https://"
         }
       }
     },
-    "interface": {
+    "webhook-delivery": {
       "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "event",
+        "status"
+      ],
       "properties": {
-        "biosdevname": {
-          "type": "string"
+        "attempts": {
+          "description": "Number of times the notification was posted.",
+          "type": "integer"
         },
-        "client_id": {
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster the notification is about.",
+          "type": "string",
+          "format": "uuid"
         },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "has_carrier": {
-          "type": "boolean"
+        "event": {
+          "$ref": "#/definitions/webhook-event"
         },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "host_id": {
+          "description": "The host the notification is about, if any.",
+          "type": "string",
+          "format": "uuid"
         },
-        "ipv6_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Assisted-Delivery header.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "mac_address": {
-          "type": "string"
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "mtu": {
-          "type": "integer"
+        "last_error": {
+          "description": "Reason the last attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "name": {
-          "type": "string"
+        "next_attempt_at": {
+          "description": "When the notification is going to be posted next, while it is pending.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
-        "product": {
-          "type": "string"
+        "payload": {
+          "description": "The JSON document posted to the webhook.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "speed_mbps": {
+        "response_code": {
+          "description": "HTTP status code returned by the webhook in the last attempt.",
           "type": "integer"
         },
-        "vendor": {
-          "type": "string"
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "webhook_id": {
+          "description": "The webhook the notification is sent to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "inventory": {
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
+      }
+    },
+    "webhook-event": {
+      "description": "A state change that webhooks are notified about.",
+      "type": "string",
+      "enum": [
+        "cluster-installed",
+        "cluster-error",
+        "cluster-installing-pending-user-action",
+        "host-insufficient"
+      ]
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
+    },
+    "worker-success-threshold": {
+      "description": "How many of the workers of the cluster must be installed for its installation to succeed. When both are set, the stricter one applies. One worker must be installed when neither is set.",
       "type": "object",
       "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
-          }
-        },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
-          }
-        },
-        "hostname": {
-          "type": "string"
-        },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
-          }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
+        "min_workers": {
+          "description": "The number of workers that must be installed.",
+          "type": "integer"
         },
-        "routes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/route"
+        "min_workers_percentage": {
+          "description": "The percentage of the workers of the cluster that must be installed, rounded up.",
+          "type": "integer",
+          "maximum": 100
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
+      ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
+    },
+    {
+      "description": "ISO that contains the Assisted Service.",
+      "name": "assisted-service-iso"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    },
+    {
+      "description": "Notifications of cluster and host state changes sent to user endpoints.",
+      "name": "webhooks"
+    },
+    {
+      "description": "Records of the changes made through the API.",
+      "name": "audit"
+    },
+    {
+      "description": "Reusable defaults for registering similar clusters.",
+      "name": "cluster_templates"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/add_hosts_clusters": {
+      "post": {
+        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterAddHostsCluster",
+        "parameters": [
+          {
+            "description": "Parameters for creating a new cluster for adding nodes.",
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        },
-        "timestamp": {
-          "type": "integer"
         }
       }
     },
-    "io_perf": {
-      "type": "object",
-      "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
+    "/assisted-service-iso": {
+      "post": {
+        "description": "Creates ISO for the user and uploads to S3.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "CreateISOAndUploadToS3",
+        "parameters": [
+          {
+            "description": "Parameters for creating an Assisted Service ISO.",
+            "name": "assisted-service-iso-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/assisted-service-iso-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "l2-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
+    "/assisted-service-iso/data": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Downloads the Assisted Service ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "DownloadISO",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "l3-connectivity": {
-      "type": "object",
-      "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
+    "/assisted-service-iso/presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "tags": [
+          "assisted-service-iso"
+        ],
+        "operationId": "GetPresignedForAssistedServiceISO",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
-      }
-    },
-    "list-manifests": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/manifest"
-      }
-    },
-    "list-versions": {
-      "type": "object",
-      "properties": {
-        "release_tag": {
-          "type": "string"
-        },
-        "versions": {
-          "$ref": "#/definitions/versions"
+    "/audit_records": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the records of the API calls that changed clusters and hosts, from the most recent.",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "ListAuditRecords",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only calls that targeted this cluster are returned.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only calls that targeted this host are returned.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls made by this user are returned.",
+            "name": "user_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls made by users of this organization are returned.",
+            "name": "org_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls to this operation are returned.",
+            "name": "operation_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only calls that changed this field of the cluster or the host are returned.",
+            "name": "field",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only calls that were made at or after this time are returned.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only calls that were made before this time are returned.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of records to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The number of records to skip before the returned records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-record-list"
+            },
+            "headers": {
+              "Audit-Record-Count": {
+                "type": "integer",
+                "description": "The total number of records matching the filters, regardless of the limit and offset."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "logs-progress-params": {
-      "type": "object",
-      "required": [
-        "logs_state"
-      ],
-      "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+    "/checksums/signing-key": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the public key that verifies the signatures of the cluster checksums.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetChecksumsSigningKey",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/checksums_signing_key"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "The service has no signing key.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ]
-    },
-    "logs_type": {
-      "type": "string",
-      "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
-      ]
-    },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
+    "/cluster_templates": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the latest version of the cluster templates.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "ListClusterTemplates",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates the first version of a cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "CreateClusterTemplate",
+        "parameters": [
+          {
+            "description": "The content of the template.",
+            "name": "new-cluster-template-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
-        }
-      }
-    },
-    "manifest": {
-      "type": "object",
-      "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
-          "type": "string"
-        },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
-        }
-      }
-    },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
+    "/cluster_templates/{cluster_template_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a version of a cluster template.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "GetClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The template to be retrieved.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The version of the template to be retrieved, the latest version if not set.",
+            "name": "version",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "monitored-operator": {
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
-        },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
-          "type": "string"
-        },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+      },
+      "put": {
+        "description": "Creates a new version of a cluster template. Clusters registered from previous versions are not affected.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "UpdateClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The template to be updated.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The content of the new version of the template.",
+            "name": "cluster-template-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-template-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-template"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "monitored-operators-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/monitored-operator"
-      }
-    },
-    "ntp_source": {
-      "type": "object",
-      "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
-          "type": "string"
-        },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
+      },
+      "delete": {
+        "description": "Deletes all the versions of a cluster template. Clusters registered from the template are not affected.",
+        "tags": [
+          "cluster_templates"
+        ],
+        "operationId": "DeleteClusterTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The template to be deleted.",
+            "name": "cluster_template_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
+    "/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "openshift-version": {
-      "type": "object",
-      "required": [
-        "display_name",
-        "release_image",
-        "release_version",
-        "rhcos_image",
-        "rhcos_rootfs",
-        "rhcos_version",
-        "support_level"
-      ],
-      "properties": {
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
-        },
-        "release_image": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string"
-        },
-        "release_version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        },
-        "rhcos_image": {
-          "description": "The base RHCOS image used for the discovery iso.",
-          "type": "string"
-        },
-        "rhcos_rootfs": {
-          "description": "The RHCOS rootfs url.",
-          "type": "string"
-        },
-        "rhcos_version": {
-          "description": "Build ID of the RHCOS image.",
-          "type": "string"
-        },
-        "support_level": {
-          "description": "Level of support of the version.",
-          "type": "string",
-          "enum": [
-            "beta",
-            "production",
-            "custom"
-          ]
+    "/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "openshift-versions": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
-      }
-    },
-    "operator-create-params": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+    "/clusters/import": {
+      "post": {
+        "description": "Creates a new OpenShift cluster from a cluster definition that was exported from another cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ImportClusterDefinition",
+        "parameters": [
+          {
+            "description": "The cluster definition and the pull secret of the new cluster.",
+            "name": "import-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-definition-import-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "string"
-        },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        }
-      }
-    },
-    "operator-host-requirements": {
-      "type": "object",
-      "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
-        },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "operator-monitor-report": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
-      "type": "object",
-      "properties": {
-        "data_type": {
-          "description": "Type of the property",
-          "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
-        },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
-        },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "preflight-hardware-requirements": {
-      "type": "object",
-      "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "presigned": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "url": {
-          "type": "string"
-        }
-      }
-    },
-    "route": {
-      "type": "object",
-      "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
-        },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
+    "/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The existing cluster whose hosts should be added.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/actions/pause": {
+      "post": {
+        "description": "Pauses an ongoing installation. The hosts that are installing are not given new installation steps and the timeouts of their installation stages are frozen until the installation is resumed.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "PauseInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be paused.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "reset-installation",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/clusters/{cluster_id}/actions/resume": {
+      "post": {
+        "description": "Resumes a paused installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ResumeInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be resumed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+    "/clusters/{cluster_id}/checksums": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the SHA-256 digests of the discovery ISO and the files of the cluster, optionally signed by the service.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterChecksums",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose checksums should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_checksums"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
       }
     },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/clusters/{cluster_id}/definition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Exports the definition of the cluster, so that it can be imported to recreate the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ExportClusterDefinition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose definition is being exported.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-definition"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "ISO that contains the Assisted Service.",
-      "name": "assisted-service-iso"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/add_hosts_clusters": {
-      "post": {
-        "description": "Creates a new OpenShift cluster definition for adding nodes to and existing OCP cluster.",
+    "/clusters/{cluster_id}/discovery-ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterAddHostsCluster",
+        "operationId": "GetDiscoveryIgnition",
         "parameters": [
           {
-            "description": "Parameters for creating a new cluster for adding nodes.",
-            "name": "new-add-hosts-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/add-hosts-cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           },
           "401": {
@@ -7657,6 +13026,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7664,23 +13045,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/assisted-service-iso": {
-      "post": {
-        "description": "Creates ISO for the user and uploads to S3.",
+      },
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
         "tags": [
-          "assisted-service-iso"
+          "installer"
         ],
-        "operationId": "CreateISOAndUploadToS3",
+        "operationId": "UpdateDiscoveryIgnition",
         "parameters": [
           {
-            "description": "Parameters for creating an Assisted Service ISO.",
-            "name": "assisted-service-iso-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/assisted-service-iso-create-params"
+              "$ref": "#/definitions/discovery-ignition-params"
             }
           }
         ],
@@ -7706,6 +13093,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7715,7 +13114,7 @@ func init() {
         }
       }
     },
-    "/assisted-service-iso/data": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -7724,22 +13123,104 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Downloads the Assisted Service ISO.",
+        "description": "Downloads files relating to the installed/installing cluster.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "assisted-service-iso"
+          "installer"
+        ],
+        "operationId": "DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
+          }
         ],
-        "operationId": "DownloadISO",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
             }
           },
           "401": {
@@ -7760,16 +13241,46 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/assisted-service-iso/presigned": {
+    "/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -7780,16 +13291,75 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading assisted-service ISO.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
-          "assisted-service-iso"
+          "installer"
+        ],
+        "operationId": "GetPresignedForClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
+          }
         ],
-        "operationId": "GetPresignedForAssistedServiceISO",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -7810,6 +13380,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -7819,7 +13401,7 @@ func init() {
         }
       }
     },
-    "/clusters": {
+    "/clusters/{cluster_id}/downloads/image": {
       "get": {
         "security": [
           {
@@ -7828,43 +13410,84 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusters",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -7879,20 +13502,35 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -7900,19 +13538,27 @@ func init() {
         }
       },
       "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterCluster",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be generated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/image-create-params"
             }
           }
         ],
@@ -7941,54 +13587,22 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/clusters/default-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the default values for various cluster properties.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "GetClusterDefaultConfig",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster_default_config"
-            }
           },
-          "401": {
-            "description": "Unauthorized.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -7998,10 +13612,8 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}": {
-      "get": {
+      },
+      "head": {
         "security": [
           {
             "userAuth": [
@@ -8011,101 +13623,43 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCluster",
+        "operationId": "DownloadClusterISOHeaders",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose ISO headers should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              },
+              "X-Checksum-Sha256": {
+                "type": "string",
+                "description": "The hex-encoded SHA-256 digest of the ISO, when it is known."
+              }
             }
           },
-          "500": {
+          "400": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "DeregisterCluster",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to be deregistered.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Success."
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -8126,10 +13680,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "description": "Method Not Allowed."
           },
           "409": {
             "description": "Error.",
@@ -8144,43 +13695,46 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose kubeconfig should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -8222,28 +13776,122 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only events that occurred at or after this time are returned.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only events that occurred before this time are returned.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only events whose message contains this text, ignoring case, are returned.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "The order of the returned events by their time.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of events to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The number of events to skip before the returned events.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "type": "integer",
+                "description": "The total number of events matching the filters, regardless of the limit and offset."
+              }
             }
           },
           "401": {
@@ -8270,12 +13918,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8285,48 +13927,87 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
+    "/clusters/{cluster_id}/events/stream": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Streams events for a cluster as server-sent events, ordered by their time. Each event is sent with an ID made of its time and its sequence number, so that reconnecting clients can resume from the last event they received. Events saved late may follow events with later times, so on resume the events of the last few seconds before the last received event are sent again, with the same IDs.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster to stream events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to stream events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
+            "description": "The ID of the last event received by the client, as sent in the stream. Only events that follow it and events of the few seconds before it are streamed.",
+            "name": "Last-Event-ID",
             "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8353,55 +14034,69 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The cluster to be installed.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "format": "uuid",
+            "description": "The cluster to return free addresses for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "type": "string",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "401": {
@@ -8428,12 +14123,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8443,34 +14132,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install_hosts": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/clusters/{cluster_id}/host-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "GetClusterHostRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -8497,12 +14189,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -8512,28 +14198,46 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -8548,64 +14252,73 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Registers a new OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster into which the host should be registered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The description of the host being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the host.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/host_registration_response"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -8633,7 +14346,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new hosts due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -8643,11 +14356,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/discovery-ignition": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -8658,26 +14377,34 @@ func init() {
             ]
           }
         ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -8712,33 +14439,32 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Override values in the discovery ignition config.",
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "operationId": "DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster of the host that should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
           "400": {
@@ -8780,71 +14506,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.yaml"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host that is being enabled.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -8877,101 +14568,43 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+      },
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
+            "description": "The host that is being disabled.",
             "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -9013,50 +14646,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -9077,9 +14696,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9093,43 +14709,38 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
       "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/image-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -9150,12 +14761,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9169,47 +14774,50 @@ func init() {
             }
           }
         }
-      },
-      "head": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
+            "schema": {
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9232,9 +14840,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed."
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -9250,7 +14855,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -9261,33 +14866,40 @@ func init() {
             ]
           },
           {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads the kubeconfig file for this cluster.",
+        "description": "Downloads the customized ignition file for this host",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "type": "file"
             }
           },
           "401": {
@@ -9325,34 +14937,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/clusters/{cluster_id}/events": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
           },
-          {
-            "urlAuth": []
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Lists events for a cluster.",
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Get the customized ignition file for this host",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9360,25 +14966,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "The host whose customized ignition file should be retrieved.",
             "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -9405,6 +15003,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9412,62 +15016,48 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/free_addresses": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the free address list for a network.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9503,37 +15093,51 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/host-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get host requirements of a cluster.",
+    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -9560,6 +15164,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9569,37 +15179,38 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts.",
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster of the host that is retrieving instructions.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
             "name": "discovery_agent_version",
             "in": "header"
           }
@@ -9608,7 +15219,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -9623,6 +15234,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -9649,42 +15266,46 @@ func init() {
             "agentAuth": []
           }
         ],
-        "description": "Registers a new OpenShift host.",
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterHost",
+        "operationId": "PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster into which the host should be registered.",
+            "description": "The cluster of the host that is posting results.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the host being registered.",
-            "name": "new-host-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/step-reply"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the host.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host_registration_response"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -9716,12 +15337,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new hosts due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9737,7 +15352,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
         "security": [
           {
@@ -9746,18 +15361,25 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Download host logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "DownloadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9765,7 +15387,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host whose logs should be downloaded.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -9775,7 +15397,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "401": {
@@ -9802,6 +15424,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -9810,17 +15438,26 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "UploadHostLogs",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9828,22 +15465,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -9862,14 +15506,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -9877,18 +15521,23 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9896,18 +15545,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being enabled.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -9944,20 +15599,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "DisableHost",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -9965,18 +15639,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being disabled.",
+            "description": "The ID of the host to retrieve.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10002,14 +15682,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10017,36 +15697,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHost",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string"
             }
           },
           "401": {
@@ -10067,8 +15748,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10080,38 +15761,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetHost",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10132,8 +15815,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10147,50 +15830,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+    "/clusters/{cluster_id}/install-retry-policy": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the policy that the failed host installations of the cluster are automatically retried by.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "GetClusterInstallRetryPolicy",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster whose install retry policy is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/install-retry-policy"
             }
           },
           "401": {
@@ -10211,66 +15881,56 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            }
           },
-          {
-            "agentAuth": []
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+        }
+      },
+      "put": {
+        "description": "Replace the policy that the failed host installations of the cluster are automatically retried by. The policy can be changed during the installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "UpdateClusterInstallRetryPolicy",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster whose install retry policy is being replaced.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The new install retry policy of the cluster.",
+            "name": "install-retry-policy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/install-retry-policy"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/install-retry-policy"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10297,57 +15957,112 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/ignition": {
+    "/clusters/{cluster_id}/logs": {
       "get": {
-        "description": "Get the customized ignition file for this host",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Requests a single range of bytes of the file (e.g. \"bytes=1024-\"), for resuming interrupted downloads.",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Applies the Range header only if the ETag of the file still matches the given one.",
+            "name": "If-Range",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
+            }
+          },
+          "206": {
+            "description": "Partial content, the requested range of the file.",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string",
+                "description": "The unit of the ranges that may be requested, which is \"bytes\"."
+              },
+              "Content-Range": {
+                "type": "string",
+                "description": "The range of bytes of the file that is sent, and its size."
+              },
+              "ETag": {
+                "type": "string",
+                "description": "The entity tag of the file, for resuming its download with the If-Range header."
+              }
             }
           },
           "401": {
@@ -10380,6 +16095,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "416": {
+            "description": "The requested range is not satisfiable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string",
+                "description": "The size of the file."
+              }
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10388,49 +16115,60 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
             "required": true
           },
           {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -10449,14 +16187,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -10464,51 +16202,37 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/clusters/{cluster_id}/logs/artifacts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the uploaded host and controller log archives of the cluster and when they expire.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "ListClusterLogArtifacts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster whose log archives should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/installer-args-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/log-artifact-list"
             }
           },
           "401": {
@@ -10535,12 +16259,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10550,47 +16268,141 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+    "/clusters/{cluster_id}/logs/search": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Searches the lines of the uploaded host and controller logs of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetNextSteps",
+        "operationId": "SearchClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is retrieving instructions.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster whose logs should be searched.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only lines matching this regular expression (RE2 syntax), ignoring case, are returned.",
+            "name": "pattern",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "host",
+                "controller"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of the types of logs to search.",
+            "name": "logs_types",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster whose logs should be searched.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "master",
+                "worker",
+                "bootstrap"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of the roles of the hosts whose logs should be searched.",
+            "name": "roles",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "debug",
+                "info",
+                "warning",
+                "error",
+                "fatal"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of log levels. Lines whose level is not known are only returned when no levels are given.",
+            "name": "levels",
+            "in": "query"
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Only lines of the files whose path within the archive contains this text are returned.",
+            "name": "file",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "date-time",
+            "description": "Only lines logged at or after this time are returned. Lines whose time is not known are only returned when no time range is given.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only lines logged before this time are returned.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of lines to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The number of matching lines to skip before the returned lines.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/log-search-match-list"
+            },
+            "headers": {
+              "Match-Count": {
+                "type": "integer",
+                "description": "The total number of lines matching the filters, regardless of the limit and offset."
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -10622,67 +16434,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/logs_progress": {
+      "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "PostStepReply",
+        "operationId": "UpdateClusterLogsProgress",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is posting results.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/logs-progress-params"
             }
           }
         ],
         "responses": {
           "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10708,6 +16497,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -10723,52 +16518,33 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Download host logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "DownloadHostLogs",
-        "deprecated": true,
+        "operationId": "ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -10812,52 +16588,45 @@ func init() {
       "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
+        "operationId": "CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -10877,63 +16646,63 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateHostLogsProgress",
+        "operationId": "DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -10970,64 +16739,59 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/manifests/files": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "UpdateHostInstallProgress",
+        "operationId": "DownloadClusterManifest",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to retrieve.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11053,14 +16817,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11068,37 +16832,40 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/install-config": {
+    "/clusters/{cluster_id}/monitored_operators": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
+        "operationId": "ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -11133,37 +16900,43 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Override values in the install config.",
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "UpdateClusterInstallConfig",
+        "operationId": "ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11192,16 +16965,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}/logs": {
+    "/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -11210,52 +16995,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterLogs",
+        "operationId": "GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster to return preflight requrements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -11282,12 +17043,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11295,60 +17050,110 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/stage-timeouts": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Get the installation stage timeouts of the cluster, which override the timeouts of the service.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
+        "operationId": "GetClusterStageTimeouts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster whose stage timeouts are being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/stage-timeouts"
+            }
           },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace the installation stage timeouts of the cluster. The timeouts can be changed during the installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterStageTimeouts",
+        "parameters": [
           {
-            "enum": [
-              "host",
-              "controller"
-            ],
             "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
+            "format": "uuid",
+            "description": "The cluster whose stage timeouts are being replaced.",
+            "name": "cluster_id",
+            "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The new stage timeouts of the cluster.",
+            "name": "stage-timeouts",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/stage-timeouts"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/stage-timeouts"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11368,14 +17173,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11383,40 +17188,52 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/logs_progress": {
-      "put": {
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
+        "operationId": "UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11442,12 +17259,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11463,23 +17274,27 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests": {
+    "/clusters/{cluster_id}/validation-policy": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Get the validation policy of the cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
+        "operationId": "GetClusterValidationPolicy",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster whose validation policy is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -11489,7 +17304,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/validation-policy"
             }
           },
           "401": {
@@ -11516,12 +17331,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11530,41 +17339,36 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Creates a manifest for customizing cluster installation.",
+      "put": {
+        "description": "Replace the validation policy of the cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
+        "operationId": "UpdateClusterValidationPolicy",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose validation policy is being replaced.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
+            "description": "The new validation policy of the cluster.",
+            "name": "validation-policy",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/create-manifest-params"
+              "$ref": "#/definitions/validation-policy"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "$ref": "#/definitions/validation-policy"
             }
           },
           "400": {
@@ -11610,44 +17414,40 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/clusters/{cluster_id}/worker-success-threshold": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get how many of the workers of the cluster must be installed for its installation to succeed.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
+        "operationId": "GetClusterWorkerSuccessThreshold",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose worker success threshold is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/worker-success-threshold"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -11673,12 +17473,76 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace how many of the workers of the cluster must be installed for its installation to succeed. The threshold can be changed during the installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateClusterWorkerSuccessThreshold",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose worker success threshold is being replaced.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new worker success threshold of the cluster.",
+            "name": "worker-success-threshold",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/worker-success-threshold"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/worker-success-threshold"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11688,54 +17552,93 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/manifests/files": {
+    "/component_versions": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "List of component versions.",
         "tags": [
-          "manifests"
+          "versions"
         ],
-        "operationId": "DownloadClusterManifest",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "security": [
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
           },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/host-validations": {
+      "post": {
+        "description": "Runs the host validations on a hypothetical host, as if it was part of a cluster, without registering it.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ValidateHypotheticalHost",
+        "parameters": [
           {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The description of the host to validate.",
+            "name": "hypothetical-host",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/hypothetical-host"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host-validation-results"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11756,18 +17659,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11777,40 +17668,36 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/monitored_operators": {
+    "/host_requirements": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Get minimum host requirements.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
+        "operationId": "GetHostRequirements",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return operators for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
+            "type": "boolean",
+            "default": false,
+            "description": "Get hw requirements for single node.",
+            "name": "single_node",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/host-requirements"
             }
           },
           "401": {
@@ -11825,12 +17712,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -11844,46 +17725,60 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/openshift_versions": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Retrieves the list of OpenShift supported versions.",
         "tags": [
-          "operators",
-          "installer"
+          "versions"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+        "operationId": "ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
           },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
+          "503": {
+            "description": "Unavailable.",
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/state-machines": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
         ],
+        "description": "Describes the state machines of the hosts and the clusters, with their transitions and diagrams.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListStateMachines",
         "responses": {
           "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/state-machines"
             }
           },
           "401": {
@@ -11898,32 +17793,46 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
-          "409": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -11931,7 +17840,7 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/preflight-requirements": {
+    "/supported-operators/{operator_name}": {
       "get": {
         "security": [
           {
@@ -11942,17 +17851,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Lists properties for an operator.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "GetPreflightRequirements",
+        "operationId": "ListOperatorProperties",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
-            "name": "cluster_id",
+            "description": "The operator name.",
+            "name": "operator_name",
             "in": "path",
             "required": true
           }
@@ -11961,7 +17869,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/operator-properties"
             }
           },
           "401": {
@@ -11982,12 +17890,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11997,46 +17899,81 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/webhooks": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Lists the registered webhooks.",
         "tags": [
-          "installer"
+          "webhooks"
         ],
-        "operationId": "UploadClusterIngressCert",
+        "operationId": "ListWebhooks",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "Only return the webhooks that notify about this cluster, including the organization-wide webhooks.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
           },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Registers an endpoint to be notified when clusters and hosts reach notable states. Webhooks without a cluster are notified about all the clusters of the organization.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "RegisterWebhook",
+        "parameters": [
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The endpoint to notify.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
           },
           "400": {
             "description": "Error.",
@@ -12062,76 +17999,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/component_versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12141,37 +18008,26 @@ func init() {
         }
       }
     },
-    "/host_requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get minimum host requirements.",
+    "/webhooks/{webhook_id}": {
+      "delete": {
+        "description": "Deletes a webhook. Pending deliveries of the webhook are not sent.",
         "tags": [
-          "installer"
+          "webhooks"
         ],
-        "operationId": "GetHostRequirements",
+        "operationId": "DeregisterWebhook",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Get hw requirements for single node.",
-            "name": "single_node",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to delete.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-requirements"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12185,8 +18041,8 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12200,7 +18056,7 @@ func init() {
         }
       }
     },
-    "/openshift_versions": {
+    "/webhooks/{webhook_id}/deliveries": {
       "get": {
         "security": [
           {
@@ -12211,42 +18067,37 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Lists the notifications sent or to be sent to a webhook, most recent first.",
         "tags": [
-          "versions"
+          "webhooks"
         ],
-        "operationId": "ListSupportedOpenshiftVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/openshift-versions"
-            }
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook to return deliveries for.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "Only return the deliveries in this status.",
+            "name": "status",
+            "in": "query"
           }
-        }
-      }
-    },
-    "/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
         ],
-        "operationId": "ListSupportedOperators",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/webhook-delivery-list"
             }
           },
           "401": {
@@ -12261,6 +18112,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12270,36 +18127,36 @@ func init() {
         }
       }
     },
-    "/supported-operators/{operator_name}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Lists properties for an operator.",
+    "/webhooks/{webhook_id}/deliveries/{delivery_id}/actions/replay": {
+      "post": {
+        "description": "Sends a notification to the webhook again, with a new set of delivery attempts.",
         "tags": [
-          "operators"
+          "webhooks"
         ],
-        "operationId": "ListOperatorProperties",
+        "operationId": "ReplayWebhookDelivery",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The webhook the delivery was sent to.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The delivery to replay.",
+            "name": "delivery_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/webhook-delivery"
             }
           },
           "401": {
@@ -12320,6 +18177,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12545,6 +18408,109 @@ func init() {
         }
       }
     },
+    "audit-change": {
+      "type": "object",
+      "required": [
+        "resource",
+        "field"
+      ],
+      "properties": {
+        "after": {
+          "description": "The value of the field after the call. Secret values are redacted.",
+          "type": "string"
+        },
+        "before": {
+          "description": "The value of the field before the call. Secret values are redacted.",
+          "type": "string"
+        },
+        "field": {
+          "description": "The changed field of the object.",
+          "type": "string"
+        },
+        "resource": {
+          "description": "The kind of the object that was changed.",
+          "type": "string",
+          "enum": [
+            "cluster",
+            "host"
+          ]
+        }
+      }
+    },
+    "audit-record": {
+      "type": "object",
+      "required": [
+        "id",
+        "created_at",
+        "method",
+        "endpoint"
+      ],
+      "properties": {
+        "changes": {
+          "description": "The fields of the cluster and the host that were changed by the call.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audit-change"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "endpoint": {
+          "description": "The path of the call.",
+          "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "method": {
+          "description": "The HTTP method of the call.",
+          "type": "string"
+        },
+        "operation_id": {
+          "description": "The API operation that was called.",
+          "type": "string"
+        },
+        "org_id": {
+          "description": "The organization of the user that made the call.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "request_id": {
+          "description": "The ID of the request, as sent in the X-Request-ID header or generated by the service.",
+          "type": "string"
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response.",
+          "type": "integer"
+        },
+        "user_name": {
+          "description": "The user that made the call.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-record"
+      }
+    },
     "boot": {
       "type": "object",
       "properties": {
@@ -12556,6 +18522,18 @@ func init() {
         }
       }
     },
+    "checksums_signing_key": {
+      "type": "object",
+      "required": [
+        "public_key"
+      ],
+      "properties": {
+        "public_key": {
+          "description": "The PEM of the EC public key that verifies the signatures of the cluster checksums.",
+          "type": "string"
+        }
+      }
+    },
     "cluster": {
       "type": "object",
       "required": [
@@ -12638,6 +18616,11 @@ func init() {
           "format": "int64",
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "failure_reason": {
+          "description": "JSON-formatted string containing the failure-reason of the installation, which is classified when the cluster ends in error.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "feature_usage": {
           "description": "JSON-formatted string containing the usage information by feature name",
           "type": "string",
@@ -12675,12 +18658,13 @@ func init() {
         },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "secret:\"true\""
         },
         "https_proxy": {
           "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+          "x-go-custom-tag": "gorm:\"column:https_proxy\" secret:\"true\""
         },
         "hyperthreading": {
           "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes",
@@ -12701,7 +18685,7 @@ func init() {
         "ignition_config_overrides": {
           "description": "Json formatted string containing the user overrides for the initial ignition config",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-go-custom-tag": "gorm:\"type:text\" secret:\"true\"",
           "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
         "image_info": {
@@ -12722,9 +18706,14 @@ func init() {
         "install_config_overrides": {
           "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-go-custom-tag": "gorm:\"type:text\" secret:\"true\"",
           "example": "{\"networking\":{\"networkType\": \"OVN-Kubernetes\"},\"fips\":true}"
         },
+        "install_retry_policy": {
+          "description": "JSON-formatted string containing the policy that the failed host installations of the cluster are automatically retried by.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "install_started_at": {
           "description": "The time that this cluster started installation.",
           "type": "string",
@@ -12801,6 +18790,11 @@ func init() {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
         },
+        "stage_timeouts": {
+          "description": "JSON-formatted string containing the installation stage timeouts of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "status": {
           "description": "Status of the OpenShift cluster.",
           "type": "string",
@@ -12815,7 +18809,8 @@ func init() {
             "installed",
             "adding-hosts",
             "cancelled",
-            "installing-pending-user-action"
+            "installing-pending-user-action",
+            "installing-paused"
           ]
         },
         "status_info": {
@@ -12829,6 +18824,15 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "template_id": {
+          "description": "The template that the cluster was registered from.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "template_version": {
+          "description": "The version of the template that the cluster was registered from.",
+          "type": "integer"
+        },
         "total_host_count": {
           "description": "All hosts associated to this cluster.",
           "type": "integer",
@@ -12849,6 +18853,11 @@ func init() {
         "user_name": {
           "type": "string"
         },
+        "validation_policy": {
+          "description": "JSON-formatted string containing the validation policy of the cluster and of its hosts.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "validations_info": {
           "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
           "type": "string",
@@ -12858,6 +18867,11 @@ func init() {
           "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
           "type": "boolean",
           "x-nullable": true
+        },
+        "worker_success_threshold": {
+          "description": "JSON-formatted string containing how many of the workers of the cluster must be installed for its installation to succeed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
@@ -12966,6 +18980,25 @@ func init() {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
         },
+        "template_id": {
+          "description": "A template whose defaults are used for the parameters that are not set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "template_params": {
+          "description": "Values substituted for the ${key} placeholders in the string parameters, install config overrides and manifests of the template.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "template_version": {
+          "description": "The version of the template to use, the latest version if not set.",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
         "user_managed_networking": {
           "description": "Indicate if the networking is managed by the user.",
           "type": "boolean",
@@ -12980,6 +19013,99 @@ func init() {
         }
       }
     },
+    "cluster-definition": {
+      "description": "The definition of a cluster, as exported from one service and imported to another.",
+      "type": "object",
+      "required": [
+        "version",
+        "cluster_params"
+      ],
+      "properties": {
+        "api_vip": {
+          "description": "The virtual IP used to reach the OpenShift cluster's API, when it isn't allocated by DHCP.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$"
+        },
+        "cluster_params": {
+          "description": "The properties of the cluster. The pull secret is never exported and is set on import.",
+          "$ref": "#/definitions/cluster-create-params"
+        },
+        "hosts": {
+          "description": "The assignments of the hosts of the cluster, applied to the hosts that register with the same MAC addresses.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-definition-host"
+          }
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "The custom manifests of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "static_network_config": {
+          "description": "The static network configuration of the hosts of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        },
+        "version": {
+          "description": "The version of the format of the definition.",
+          "type": "string",
+          "enum": [
+            "v1"
+          ]
+        }
+      }
+    },
+    "cluster-definition-host": {
+      "type": "object",
+      "required": [
+        "mac_addresses"
+      ],
+      "properties": {
+        "installation_disk_id": {
+          "description": "The ID of the disk that the host is installed on.",
+          "type": "string"
+        },
+        "mac_addresses": {
+          "description": "The MAC addresses of the interfaces of the host.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
+        },
+        "requested_hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role-update-params"
+        }
+      }
+    },
+    "cluster-definition-import-params": {
+      "type": "object",
+      "required": [
+        "definition",
+        "pull_secret"
+      ],
+      "properties": {
+        "definition": {
+          "$ref": "#/definitions/cluster-definition"
+        },
+        "pull_secret": {
+          "description": "The pull secret of the new cluster.",
+          "type": "string"
+        }
+      }
+    },
     "cluster-host-requirements": {
       "type": "object",
       "properties": {
@@ -13050,6 +19176,183 @@ func init() {
         "$ref": "#/definitions/cluster"
       }
     },
+    "cluster-template": {
+      "type": "object",
+      "required": [
+        "id",
+        "version",
+        "name",
+        "created_at"
+      ],
+      "properties": {
+        "cluster_params": {
+          "x-go-custom-tag": "gorm:\"-\"",
+          "$ref": "#/definitions/cluster-template-params"
+        },
+        "created_at": {
+          "description": "The time that this version of the template was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "description": {
+          "description": "Free-text description of the template.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the overrides for the install-config.yaml file of the clusters.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "manifests": {
+          "description": "Manifests added to the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "version": {
+          "description": "The version of the template, incremented on every update.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment:false\""
+        }
+      }
+    },
+    "cluster-template-create-params": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "cluster_params": {
+          "$ref": "#/definitions/cluster-template-params"
+        },
+        "description": {
+          "description": "Free-text description of the template.",
+          "type": "string"
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the overrides for the install-config.yaml file of the clusters.",
+          "type": "string"
+        },
+        "manifests": {
+          "description": "Manifests added to the clusters.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/create-manifest-params"
+          }
+        },
+        "name": {
+          "description": "Name of the template.",
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        }
+      }
+    },
+    "cluster-template-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-template"
+      }
+    },
+    "cluster-template-params": {
+      "description": "The defaults of a cluster template. Values may contain ${key} placeholders that are substituted when a cluster is registered.",
+      "type": "object",
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated.",
+          "type": "string"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "high_availability_mode": {
+          "description": "Guaranteed availability of the installed cluster.",
+          "type": "string",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.",
+          "type": "string"
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, worker nodes, or all nodes.",
+          "type": "string",
+          "enum": [
+            "masters",
+            "workers",
+            "none",
+            "all"
+          ]
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses.",
+          "type": "string"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "user_managed_networking": {
+          "description": "Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
     "cluster-update-params": {
       "type": "object",
       "properties": {
@@ -13218,9 +19521,38 @@ func init() {
         "ntp-server-configured",
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
-        "cnv-requirements-satisfied"
+        "cnv-requirements-satisfied",
+        "dns-domain-names-resolved-consistently"
       ]
     },
+    "cluster_checksums": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "created_at",
+        "files"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "files": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/file_checksum"
+          }
+        },
+        "signature": {
+          "description": "A JSON Web Token signed with ES256 by the checksums signing key of the service, whose claims are the cluster_id, created_at and files of the checksums. It is set when the service has a signing key.",
+          "type": "string"
+        }
+      }
+    },
     "cluster_default_config": {
       "type": "object",
       "properties": {
@@ -13803,6 +20135,130 @@ func init() {
         "$ref": "#/definitions/event"
       }
     },
+    "failure-category": {
+      "type": "string",
+      "enum": [
+        "disk",
+        "network",
+        "dns",
+        "image-pull",
+        "certificate",
+        "ignition",
+        "boot-order",
+        "control-plane",
+        "operators",
+        "host-installation",
+        "timeout",
+        "unknown"
+      ]
+    },
+    "failure-evidence": {
+      "type": "object",
+      "required": [
+        "source",
+        "category",
+        "message"
+      ],
+      "properties": {
+        "category": {
+          "$ref": "#/definitions/failure-category"
+        },
+        "host_id": {
+          "description": "The host that the finding is about, if any.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "message": {
+          "description": "The finding, such as the matching line of the logs.",
+          "type": "string"
+        },
+        "reference": {
+          "description": "The location of the finding in the logs of the cluster, in the format \u003carchive\u003e:\u003cfile\u003e:\u003cline\u003e.",
+          "type": "string"
+        },
+        "source": {
+          "description": "Where the finding comes from.",
+          "type": "string",
+          "enum": [
+            "host-progress",
+            "event",
+            "validation",
+            "logs"
+          ]
+        },
+        "time": {
+          "description": "The time of the finding, if known.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "failure-reason": {
+      "description": "The most likely root cause of a failed installation, classified from the progress of the hosts, the events, the validations and the logs of the cluster.",
+      "type": "object",
+      "required": [
+        "category",
+        "summary"
+      ],
+      "properties": {
+        "category": {
+          "$ref": "#/definitions/failure-category"
+        },
+        "classified_at": {
+          "description": "The time the failure was classified.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "evidence": {
+          "description": "The findings that the classification is based on, the ones of the category first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/failure-evidence"
+          }
+        },
+        "remediation": {
+          "description": "A hint of what can be done before installing the cluster again.",
+          "type": "string"
+        },
+        "summary": {
+          "description": "A description of the failure.",
+          "type": "string"
+        },
+        "suspected_host_id": {
+          "description": "The host that most of the evidence points at, if any.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "suspected_hostname": {
+          "description": "The name of the suspected host.",
+          "type": "string"
+        }
+      }
+    },
+    "file_checksum": {
+      "type": "object",
+      "required": [
+        "name",
+        "size",
+        "sha256"
+      ],
+      "properties": {
+        "name": {
+          "description": "The name of the file, discovery.iso for the discovery ISO or the file_name that downloads the file from /clusters/{cluster_id}/downloads/files.",
+          "type": "string"
+        },
+        "sha256": {
+          "description": "The hex-encoded SHA-256 digest of the file.",
+          "type": "string"
+        },
+        "size": {
+          "description": "The size of the file in bytes.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "free-addresses-list": {
       "type": "array",
       "items": {
@@ -13917,6 +20373,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "domain_resolution": {
+          "description": "The results of resolving the domain names of the cluster on the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -13934,7 +20395,7 @@ func init() {
         "ignition_config_overrides": {
           "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-go-custom-tag": "gorm:\"type:text\" secret:\"true\"",
           "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
         "images_status": {
@@ -13951,6 +20412,10 @@ func init() {
           "type": "string",
           "example": "/dev/sda"
         },
+        "installation_retries": {
+          "description": "The number of times that the installation of the host was automatically retried.",
+          "type": "integer"
+        },
         "installer_args": {
           "type": "string"
         },
@@ -13993,6 +20458,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "paused_installation_failure": {
+          "description": "The reason of the failure that the host reported while its installation was paused. The failure is retried or final when the installation is resumed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "progress": {
           "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
           "$ref": "#/definitions/host-progress-info"
@@ -14041,7 +20511,8 @@ func init() {
             "error",
             "resetting",
             "added-to-existing-cluster",
-            "cancelled"
+            "cancelled",
+            "installing-paused"
           ]
         },
         "status_info": {
@@ -14256,9 +20727,49 @@ func init() {
         "sufficient-installation-disk-speed",
         "cnv-requirements-satisfied",
         "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role"
+        "sufficient-packet-loss-requirement-for-role",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly"
       ]
     },
+    "host-validation-result": {
+      "type": "object",
+      "required": [
+        "id",
+        "status",
+        "message"
+      ],
+      "properties": {
+        "id": {
+          "$ref": "#/definitions/host-validation-id"
+        },
+        "message": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending",
+            "error",
+            "disabled",
+            "warning"
+          ]
+        }
+      }
+    },
+    "host-validation-results": {
+      "description": "The results of the host validations grouped by category, in the format of the validations info of a host.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/host-validation-result"
+        }
+      }
+    },
     "host_network": {
       "type": "object",
       "properties": {
@@ -14317,6 +20828,43 @@ func init() {
         }
       }
     },
+    "hypothetical-host": {
+      "description": "A host that is validated without being registered.",
+      "type": "object",
+      "required": [
+        "inventory"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that the host is validated against. Either it or the OpenShift version must be set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "connectivity": {
+          "$ref": "#/definitions/connectivity-report"
+        },
+        "high_availability_mode": {
+          "description": "The availability mode of the cluster that the host is validated against, when no cluster is set.",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "inventory": {
+          "$ref": "#/definitions/inventory"
+        },
+        "openshift_version": {
+          "description": "The OpenShift version that the host is validated against, when no cluster is set.",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        }
+      }
+    },
     "image-create-params": {
       "type": "object",
       "properties": {
@@ -14403,6 +20951,25 @@ func init() {
     "ingress-cert-params": {
       "type": "string"
     },
+    "install-retry-policy": {
+      "description": "Opt-in policy of automatically retrying the installation of hosts that failed in stages whose failures are usually transient.",
+      "type": "object",
+      "properties": {
+        "max_retries": {
+          "description": "How many times the installation of each host may be retried. Zero disables the retries.",
+          "type": "integer",
+          "maximum": 5,
+          "minimum": 0
+        },
+        "stages": {
+          "description": "The installation stages whose failures are retried. Failures in \"Starting installation\", \"Installing\" and \"Writing image to disk\" are retried when empty. Stages in which the host already rebooted out of the discovery image can't be retried.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          }
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -14585,21 +21152,154 @@ func init() {
         "$ref": "#/definitions/managed-domain"
       }
     },
-    "list-manifests": {
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
+      "type": "object",
+      "properties": {
+        "release_tag": {
+          "type": "string"
+        },
+        "versions": {
+          "$ref": "#/definitions/versions"
+        }
+      }
+    },
+    "log-artifact": {
+      "type": "object",
+      "required": [
+        "logs_type",
+        "archive",
+        "compression",
+        "size_bytes"
+      ],
+      "properties": {
+        "archive": {
+          "description": "The path of the log archive within the files of the cluster.",
+          "type": "string"
+        },
+        "archive_href": {
+          "description": "The URL that downloads the log archive.",
+          "type": "string"
+        },
+        "collected_at": {
+          "description": "The time the logs were uploaded.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "compression": {
+          "description": "The compression of the tarred log archive.",
+          "type": "string",
+          "enum": [
+            "gzip",
+            "zstd"
+          ]
+        },
+        "expires_at": {
+          "description": "The time the garbage collector deletes the log archive, or null if it is kept until the cluster is deleted.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "host_id": {
+          "description": "The host that uploaded the logs, for host logs.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "size_bytes": {
+          "description": "The size of the stored log archive.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "log-artifact-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/log-artifact"
+      }
+    },
+    "log-search-match": {
+      "type": "object",
+      "required": [
+        "logs_type",
+        "archive",
+        "file",
+        "line",
+        "message"
+      ],
+      "properties": {
+        "archive": {
+          "description": "The path of the log archive within the files of the cluster.",
+          "type": "string"
+        },
+        "archive_href": {
+          "description": "The URL that downloads the log archive.",
+          "type": "string"
+        },
+        "file": {
+          "description": "The path of the file within the log archive.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "The host whose logs hold the line, for host logs.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "host_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "level": {
+          "description": "The level of the line, when it is known.",
+          "type": "string",
+          "enum": [
+            "debug",
+            "info",
+            "warning",
+            "error",
+            "fatal"
+          ]
+        },
+        "line": {
+          "description": "The number of the line within the file, starting at 1.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "logs_type": {
+          "$ref": "#/definitions/logs_type"
+        },
+        "message": {
+          "description": "The text of the line, truncated if it is too long.",
+          "type": "string"
+        },
+        "time": {
+          "description": "The time the line was logged, when it is known.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "log-search-match-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/manifest"
-      }
-    },
-    "list-versions": {
-      "type": "object",
-      "properties": {
-        "release_tag": {
-          "type": "string"
-        },
-        "versions": {
-          "$ref": "#/definitions/versions"
-        }
+        "$ref": "#/definitions/log-search-match"
       }
     },
     "logs-progress-params": {
@@ -14972,6 +21672,10 @@ func init() {
         "url"
       ],
       "properties": {
+        "sha256": {
+          "description": "The hex-encoded SHA-256 digest of the file, when it is known.",
+          "type": "string"
+        },
         "url": {
           "type": "string"
         }
@@ -15010,6 +21714,124 @@ func init() {
         "unreachable"
       ]
     },
+    "stage-timeout": {
+      "type": "object",
+      "required": [
+        "stage",
+        "timeout_seconds"
+      ],
+      "properties": {
+        "stage": {
+          "$ref": "#/definitions/host-stage"
+        },
+        "timeout_seconds": {
+          "description": "How long the hosts may stay in the stage, in seconds.",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "stage-timeouts": {
+      "description": "Overrides of how long the hosts of a cluster may stay in an installation stage before their installation fails.",
+      "type": "object",
+      "properties": {
+        "default_timeout_seconds": {
+          "description": "Overrides the timeout of the stages that have no timeout of their own, in seconds.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "timeouts": {
+          "description": "Overrides the timeouts of the stages.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stage-timeout"
+          }
+        }
+      }
+    },
+    "state-machine": {
+      "type": "object",
+      "required": [
+        "name",
+        "states",
+        "transitions"
+      ],
+      "properties": {
+        "graphviz": {
+          "description": "The state machine as a Graphviz diagram.",
+          "type": "string"
+        },
+        "mermaid": {
+          "description": "The state machine as a Mermaid diagram.",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the state machine.",
+          "type": "string",
+          "enum": [
+            "host",
+            "cluster"
+          ]
+        },
+        "states": {
+          "description": "The states of the state machine, in the order they first appear in its transitions. The empty state is the state of objects that were not created yet.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "transition_types": {
+          "description": "The types of the transitions of the state machine, in the order they first appear.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "transitions": {
+          "description": "The transition rules of the state machine. The first rule of a transition type whose source states and condition match is the one that runs.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/state-machine-transition"
+          }
+        }
+      }
+    },
+    "state-machine-transition": {
+      "type": "object",
+      "required": [
+        "transition_type",
+        "source_states",
+        "destination_state"
+      ],
+      "properties": {
+        "condition": {
+          "description": "The named conditions that must hold for the transition to run, combined with \u0026\u0026, || and !. Empty when the transition has no condition.",
+          "type": "string"
+        },
+        "destination_state": {
+          "type": "string"
+        },
+        "post_transition": {
+          "description": "The name of the function that runs after the transition.",
+          "type": "string"
+        },
+        "source_states": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "transition_type": {
+          "type": "string"
+        }
+      }
+    },
+    "state-machines": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/state-machine"
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -15130,6 +21952,58 @@ func init() {
         }
       }
     },
+    "validation-policy": {
+      "description": "Overrides of the validations of a cluster and of its hosts.",
+      "type": "object",
+      "properties": {
+        "disabled_cluster_validations": {
+          "description": "The cluster validations that are not run for the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-validation-id"
+          }
+        },
+        "disabled_host_validations": {
+          "description": "The host validations that are not run for the hosts of the cluster, in addition to the ones disabled by the service configuration.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-validation-id"
+          }
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Overrides the maximal sync duration of the installation disk of the hosts, in milliseconds.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "network_latency_threshold_ms": {
+          "description": "Overrides the maximal network latency between the hosts of the cluster, in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "minimum": 0
+        },
+        "packet_loss_percentage": {
+          "description": "Overrides the maximal packet loss between the hosts of the cluster, in percents.",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "warning_cluster_validations": {
+          "description": "The cluster validations whose failures are reported as warnings, which don't prevent the installation.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-validation-id"
+          }
+        },
+        "warning_host_validations": {
+          "description": "The host validations whose failures are reported as warnings, which don't prevent the installation.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-validation-id"
+          }
+        }
+      }
+    },
     "versioned-host-requirements": {
       "type": "object",
       "properties": {
@@ -15159,6 +22033,187 @@ func init() {
       "additionalProperties": {
         "type": "string"
       }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the webhook is notified about. Empty for organization-wide webhooks.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "description": "Unique identifier of the webhook.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "org_id": {
+          "description": "The organization of the user that registered the webhook.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "url": {
+          "description": "The HTTPS endpoint that notifications are posted to.",
+          "type": "string"
+        },
+        "user_name": {
+          "description": "The user that registered the webhook.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster to be notified about. When omitted, the webhook is notified about all the clusters of the organization.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "secret": {
+          "description": "Key used to sign the notifications with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.",
+          "type": "string",
+          "minLength": 16
+        },
+        "url": {
+          "description": "The HTTPS endpoint that notifications are posted to.",
+          "type": "string",
+          "pattern": "^https://"
+        }
+      }
+    },
+    "webhook-delivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "event",
+        "status"
+      ],
+      "properties": {
+        "attempts": {
+          "description": "Number of times the notification was posted.",
+          "type": "integer"
+        },
+        "cluster_id": {
+          "description": "The cluster the notification is about.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event": {
+          "$ref": "#/definitions/webhook-event"
+        },
+        "host_id": {
+          "description": "The host the notification is about, if any.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Assisted-Delivery header.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "last_error": {
+          "description": "Reason the last attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "next_attempt_at": {
+          "description": "When the notification is going to be posted next, while it is pending.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "payload": {
+          "description": "The JSON document posted to the webhook.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "response_code": {
+          "description": "HTTP status code returned by the webhook in the last attempt.",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "webhook_id": {
+          "description": "The webhook the notification is sent to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
+      }
+    },
+    "webhook-event": {
+      "description": "A state change that webhooks are notified about.",
+      "type": "string",
+      "enum": [
+        "cluster-installed",
+        "cluster-error",
+        "cluster-installing-pending-user-action",
+        "host-insufficient"
+      ]
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
+    },
+    "worker-success-threshold": {
+      "description": "How many of the workers of the cluster must be installed for its installation to succeed. When both are set, the stricter one applies. One worker must be installed when neither is set.",
+      "type": "object",
+      "properties": {
+        "min_workers": {
+          "description": "The number of workers that must be installed.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "min_workers_percentage": {
+          "description": "The percentage of the workers of the cluster that must be installed, rounded up.",
+          "type": "integer",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -15218,6 +22273,18 @@ func init() {
     {
       "description": "Information regarding versions.",
       "name": "versions"
+    },
+    {
+      "description": "Notifications of cluster and host state changes sent to user endpoints.",
+      "name": "webhooks"
+    },
+    {
+      "description": "Records of the changes made through the API.",
+      "name": "audit"
+    },
+    {
+      "description": "Reusable defaults for registering similar clusters.",
+      "name": "cluster_templates"
     }
   ]
 }`))
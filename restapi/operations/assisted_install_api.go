@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -20,12 +21,15 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/restapi/operations/assisted_service_iso"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_templates"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 // NewAssistedInstallAPI creates a new AssistedInstall instance
@@ -51,6 +55,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		InstallerCancelInstallationHandler: installer.CancelInstallationHandlerFunc(func(params installer.CancelInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.CancelInstallation has not yet been implemented")
@@ -61,18 +68,27 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		ManifestsCreateClusterManifestHandler: manifests.CreateClusterManifestHandlerFunc(func(params manifests.CreateClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.CreateClusterManifest has not yet been implemented")
 		}),
+		ClusterTemplatesCreateClusterTemplateHandler: cluster_templates.CreateClusterTemplateHandlerFunc(func(params cluster_templates.CreateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.CreateClusterTemplate has not yet been implemented")
+		}),
 		AssistedServiceIsoCreateISOAndUploadToS3Handler: assisted_service_iso.CreateISOAndUploadToS3HandlerFunc(func(params assisted_service_iso.CreateISOAndUploadToS3Params, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation assisted_service_iso.CreateISOAndUploadToS3 has not yet been implemented")
 		}),
 		ManifestsDeleteClusterManifestHandler: manifests.DeleteClusterManifestHandlerFunc(func(params manifests.DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.DeleteClusterManifest has not yet been implemented")
 		}),
+		ClusterTemplatesDeleteClusterTemplateHandler: cluster_templates.DeleteClusterTemplateHandlerFunc(func(params cluster_templates.DeleteClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.DeleteClusterTemplate has not yet been implemented")
+		}),
 		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterCluster has not yet been implemented")
 		}),
 		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterHost has not yet been implemented")
 		}),
+		WebhooksDeregisterWebhookHandler: webhooks.DeregisterWebhookHandlerFunc(func(params webhooks.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.DeregisterWebhook has not yet been implemented")
+		}),
 		InstallerDisableHostHandler: installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DisableHost has not yet been implemented")
 		}),
@@ -106,12 +122,21 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.EnableHost has not yet been implemented")
 		}),
+		InstallerExportClusterDefinitionHandler: installer.ExportClusterDefinitionHandlerFunc(func(params installer.ExportClusterDefinitionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ExportClusterDefinition has not yet been implemented")
+		}),
 		InstallerGenerateClusterISOHandler: installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GenerateClusterISO has not yet been implemented")
 		}),
+		InstallerGetChecksumsSigningKeyHandler: installer.GetChecksumsSigningKeyHandlerFunc(func(params installer.GetChecksumsSigningKeyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetChecksumsSigningKey has not yet been implemented")
+		}),
 		InstallerGetClusterHandler: installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCluster has not yet been implemented")
 		}),
+		InstallerGetClusterChecksumsHandler: installer.GetClusterChecksumsHandlerFunc(func(params installer.GetClusterChecksumsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterChecksums has not yet been implemented")
+		}),
 		InstallerGetClusterDefaultConfigHandler: installer.GetClusterDefaultConfigHandlerFunc(func(params installer.GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterDefaultConfig has not yet been implemented")
 		}),
@@ -121,6 +146,21 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterInstallConfigHandler: installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerGetClusterInstallRetryPolicyHandler: installer.GetClusterInstallRetryPolicyHandlerFunc(func(params installer.GetClusterInstallRetryPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterInstallRetryPolicy has not yet been implemented")
+		}),
+		InstallerGetClusterStageTimeoutsHandler: installer.GetClusterStageTimeoutsHandlerFunc(func(params installer.GetClusterStageTimeoutsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterStageTimeouts has not yet been implemented")
+		}),
+		ClusterTemplatesGetClusterTemplateHandler: cluster_templates.GetClusterTemplateHandlerFunc(func(params cluster_templates.GetClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.GetClusterTemplate has not yet been implemented")
+		}),
+		InstallerGetClusterValidationPolicyHandler: installer.GetClusterValidationPolicyHandlerFunc(func(params installer.GetClusterValidationPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterValidationPolicy has not yet been implemented")
+		}),
+		InstallerGetClusterWorkerSuccessThresholdHandler: installer.GetClusterWorkerSuccessThresholdHandlerFunc(func(params installer.GetClusterWorkerSuccessThresholdParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterWorkerSuccessThreshold has not yet been implemented")
+		}),
 		InstallerGetCredentialsHandler: installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCredentials has not yet been implemented")
 		}),
@@ -151,6 +191,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetPresignedForClusterFilesHandler: installer.GetPresignedForClusterFilesHandlerFunc(func(params installer.GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		InstallerImportClusterDefinitionHandler: installer.ImportClusterDefinitionHandlerFunc(func(params installer.ImportClusterDefinitionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ImportClusterDefinition has not yet been implemented")
+		}),
 		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallCluster has not yet been implemented")
 		}),
@@ -160,9 +203,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerInstallHostsHandler: installer.InstallHostsHandlerFunc(func(params installer.InstallHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallHosts has not yet been implemented")
 		}),
+		AuditListAuditRecordsHandler: audit.ListAuditRecordsHandlerFunc(func(params audit.ListAuditRecordsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation audit.ListAuditRecords has not yet been implemented")
+		}),
+		InstallerListClusterLogArtifactsHandler: installer.ListClusterLogArtifactsHandlerFunc(func(params installer.ListClusterLogArtifactsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterLogArtifacts has not yet been implemented")
+		}),
 		ManifestsListClusterManifestsHandler: manifests.ListClusterManifestsHandlerFunc(func(params manifests.ListClusterManifestsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.ListClusterManifests has not yet been implemented")
 		}),
+		ClusterTemplatesListClusterTemplatesHandler: cluster_templates.ListClusterTemplatesHandlerFunc(func(params cluster_templates.ListClusterTemplatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.ListClusterTemplates has not yet been implemented")
+		}),
 		InstallerListClustersHandler: installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListClusters has not yet been implemented")
 		}),
@@ -184,12 +236,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		OperatorsListOperatorPropertiesHandler: operators.ListOperatorPropertiesHandlerFunc(func(params operators.ListOperatorPropertiesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ListOperatorProperties has not yet been implemented")
 		}),
+		InstallerListStateMachinesHandler: installer.ListStateMachinesHandlerFunc(func(params installer.ListStateMachinesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListStateMachines has not yet been implemented")
+		}),
 		VersionsListSupportedOpenshiftVersionsHandler: versions.ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.ListSupportedOpenshiftVersions has not yet been implemented")
 		}),
 		OperatorsListSupportedOperatorsHandler: operators.ListSupportedOperatorsHandlerFunc(func(params operators.ListSupportedOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ListSupportedOperators has not yet been implemented")
 		}),
+		WebhooksListWebhookDeliveriesHandler: webhooks.ListWebhookDeliveriesHandlerFunc(func(params webhooks.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListWebhookDeliveries has not yet been implemented")
+		}),
+		WebhooksListWebhooksHandler: webhooks.ListWebhooksHandlerFunc(func(params webhooks.ListWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ListWebhooks has not yet been implemented")
+		}),
+		InstallerPauseInstallationHandler: installer.PauseInstallationHandlerFunc(func(params installer.PauseInstallationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.PauseInstallation has not yet been implemented")
+		}),
 		InstallerPostStepReplyHandler: installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.PostStepReply has not yet been implemented")
 		}),
@@ -202,6 +266,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerRegisterHostHandler: installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterHost has not yet been implemented")
 		}),
+		WebhooksRegisterWebhookHandler: webhooks.RegisterWebhookHandlerFunc(func(params webhooks.RegisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.RegisterWebhook has not yet been implemented")
+		}),
+		WebhooksReplayWebhookDeliveryHandler: webhooks.ReplayWebhookDeliveryHandlerFunc(func(params webhooks.ReplayWebhookDeliveryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.ReplayWebhookDelivery has not yet been implemented")
+		}),
 		OperatorsReportMonitoredOperatorStatusHandler: operators.ReportMonitoredOperatorStatusHandlerFunc(func(params operators.ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -214,15 +284,39 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerResetHostValidationHandler: installer.ResetHostValidationHandlerFunc(func(params installer.ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetHostValidation has not yet been implemented")
 		}),
+		InstallerResumeInstallationHandler: installer.ResumeInstallationHandlerFunc(func(params installer.ResumeInstallationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ResumeInstallation has not yet been implemented")
+		}),
+		InstallerSearchClusterLogsHandler: installer.SearchClusterLogsHandlerFunc(func(params installer.SearchClusterLogsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.SearchClusterLogs has not yet been implemented")
+		}),
+		EventsStreamEventsHandler: events.StreamEventsHandlerFunc(func(params events.StreamEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.StreamEvents has not yet been implemented")
+		}),
 		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
 		InstallerUpdateClusterInstallConfigHandler: installer.UpdateClusterInstallConfigHandlerFunc(func(params installer.UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerUpdateClusterInstallRetryPolicyHandler: installer.UpdateClusterInstallRetryPolicyHandlerFunc(func(params installer.UpdateClusterInstallRetryPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterInstallRetryPolicy has not yet been implemented")
+		}),
 		InstallerUpdateClusterLogsProgressHandler: installer.UpdateClusterLogsProgressHandlerFunc(func(params installer.UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateClusterLogsProgress has not yet been implemented")
 		}),
+		InstallerUpdateClusterStageTimeoutsHandler: installer.UpdateClusterStageTimeoutsHandlerFunc(func(params installer.UpdateClusterStageTimeoutsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterStageTimeouts has not yet been implemented")
+		}),
+		ClusterTemplatesUpdateClusterTemplateHandler: cluster_templates.UpdateClusterTemplateHandlerFunc(func(params cluster_templates.UpdateClusterTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_templates.UpdateClusterTemplate has not yet been implemented")
+		}),
+		InstallerUpdateClusterValidationPolicyHandler: installer.UpdateClusterValidationPolicyHandlerFunc(func(params installer.UpdateClusterValidationPolicyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterValidationPolicy has not yet been implemented")
+		}),
+		InstallerUpdateClusterWorkerSuccessThresholdHandler: installer.UpdateClusterWorkerSuccessThresholdHandlerFunc(func(params installer.UpdateClusterWorkerSuccessThresholdParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterWorkerSuccessThreshold has not yet been implemented")
+		}),
 		InstallerUpdateDiscoveryIgnitionHandler: installer.UpdateDiscoveryIgnitionHandlerFunc(func(params installer.UpdateDiscoveryIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateDiscoveryIgnition has not yet been implemented")
 		}),
@@ -247,6 +341,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUploadLogsHandler: installer.UploadLogsHandlerFunc(func(params installer.UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadLogs has not yet been implemented")
 		}),
+		InstallerValidateHypotheticalHostHandler: installer.ValidateHypotheticalHostHandlerFunc(func(params installer.ValidateHypotheticalHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ValidateHypotheticalHost has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -301,6 +398,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -323,14 +423,20 @@ type AssistedInstallAPI struct {
 	InstallerCompleteInstallationHandler installer.CompleteInstallationHandler
 	// ManifestsCreateClusterManifestHandler sets the operation handler for the create cluster manifest operation
 	ManifestsCreateClusterManifestHandler manifests.CreateClusterManifestHandler
+	// ClusterTemplatesCreateClusterTemplateHandler sets the operation handler for the create cluster template operation
+	ClusterTemplatesCreateClusterTemplateHandler cluster_templates.CreateClusterTemplateHandler
 	// AssistedServiceIsoCreateISOAndUploadToS3Handler sets the operation handler for the create i s o and upload to s3 operation
 	AssistedServiceIsoCreateISOAndUploadToS3Handler assisted_service_iso.CreateISOAndUploadToS3Handler
 	// ManifestsDeleteClusterManifestHandler sets the operation handler for the delete cluster manifest operation
 	ManifestsDeleteClusterManifestHandler manifests.DeleteClusterManifestHandler
+	// ClusterTemplatesDeleteClusterTemplateHandler sets the operation handler for the delete cluster template operation
+	ClusterTemplatesDeleteClusterTemplateHandler cluster_templates.DeleteClusterTemplateHandler
 	// InstallerDeregisterClusterHandler sets the operation handler for the deregister cluster operation
 	InstallerDeregisterClusterHandler installer.DeregisterClusterHandler
 	// InstallerDeregisterHostHandler sets the operation handler for the deregister host operation
 	InstallerDeregisterHostHandler installer.DeregisterHostHandler
+	// WebhooksDeregisterWebhookHandler sets the operation handler for the deregister webhook operation
+	WebhooksDeregisterWebhookHandler webhooks.DeregisterWebhookHandler
 	// InstallerDisableHostHandler sets the operation handler for the disable host operation
 	InstallerDisableHostHandler installer.DisableHostHandler
 	// InstallerDownloadClusterFilesHandler sets the operation handler for the download cluster files operation
@@ -353,16 +459,32 @@ type AssistedInstallAPI struct {
 	AssistedServiceIsoDownloadISOHandler assisted_service_iso.DownloadISOHandler
 	// InstallerEnableHostHandler sets the operation handler for the enable host operation
 	InstallerEnableHostHandler installer.EnableHostHandler
+	// InstallerExportClusterDefinitionHandler sets the operation handler for the export cluster definition operation
+	InstallerExportClusterDefinitionHandler installer.ExportClusterDefinitionHandler
 	// InstallerGenerateClusterISOHandler sets the operation handler for the generate cluster i s o operation
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
+	// InstallerGetChecksumsSigningKeyHandler sets the operation handler for the get checksums signing key operation
+	InstallerGetChecksumsSigningKeyHandler installer.GetChecksumsSigningKeyHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
 	InstallerGetClusterHandler installer.GetClusterHandler
+	// InstallerGetClusterChecksumsHandler sets the operation handler for the get cluster checksums operation
+	InstallerGetClusterChecksumsHandler installer.GetClusterChecksumsHandler
 	// InstallerGetClusterDefaultConfigHandler sets the operation handler for the get cluster default config operation
 	InstallerGetClusterDefaultConfigHandler installer.GetClusterDefaultConfigHandler
 	// InstallerGetClusterHostRequirementsHandler sets the operation handler for the get cluster host requirements operation
 	InstallerGetClusterHostRequirementsHandler installer.GetClusterHostRequirementsHandler
 	// InstallerGetClusterInstallConfigHandler sets the operation handler for the get cluster install config operation
 	InstallerGetClusterInstallConfigHandler installer.GetClusterInstallConfigHandler
+	// InstallerGetClusterInstallRetryPolicyHandler sets the operation handler for the get cluster install retry policy operation
+	InstallerGetClusterInstallRetryPolicyHandler installer.GetClusterInstallRetryPolicyHandler
+	// InstallerGetClusterStageTimeoutsHandler sets the operation handler for the get cluster stage timeouts operation
+	InstallerGetClusterStageTimeoutsHandler installer.GetClusterStageTimeoutsHandler
+	// ClusterTemplatesGetClusterTemplateHandler sets the operation handler for the get cluster template operation
+	ClusterTemplatesGetClusterTemplateHandler cluster_templates.GetClusterTemplateHandler
+	// InstallerGetClusterValidationPolicyHandler sets the operation handler for the get cluster validation policy operation
+	InstallerGetClusterValidationPolicyHandler installer.GetClusterValidationPolicyHandler
+	// InstallerGetClusterWorkerSuccessThresholdHandler sets the operation handler for the get cluster worker success threshold operation
+	InstallerGetClusterWorkerSuccessThresholdHandler installer.GetClusterWorkerSuccessThresholdHandler
 	// InstallerGetCredentialsHandler sets the operation handler for the get credentials operation
 	InstallerGetCredentialsHandler installer.GetCredentialsHandler
 	// InstallerGetDiscoveryIgnitionHandler sets the operation handler for the get discovery ignition operation
@@ -383,14 +505,22 @@ type AssistedInstallAPI struct {
 	AssistedServiceIsoGetPresignedForAssistedServiceISOHandler assisted_service_iso.GetPresignedForAssistedServiceISOHandler
 	// InstallerGetPresignedForClusterFilesHandler sets the operation handler for the get presigned for cluster files operation
 	InstallerGetPresignedForClusterFilesHandler installer.GetPresignedForClusterFilesHandler
+	// InstallerImportClusterDefinitionHandler sets the operation handler for the import cluster definition operation
+	InstallerImportClusterDefinitionHandler installer.ImportClusterDefinitionHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
 	InstallerInstallClusterHandler installer.InstallClusterHandler
 	// InstallerInstallHostHandler sets the operation handler for the install host operation
 	InstallerInstallHostHandler installer.InstallHostHandler
 	// InstallerInstallHostsHandler sets the operation handler for the install hosts operation
 	InstallerInstallHostsHandler installer.InstallHostsHandler
+	// AuditListAuditRecordsHandler sets the operation handler for the list audit records operation
+	AuditListAuditRecordsHandler audit.ListAuditRecordsHandler
+	// InstallerListClusterLogArtifactsHandler sets the operation handler for the list cluster log artifacts operation
+	InstallerListClusterLogArtifactsHandler installer.ListClusterLogArtifactsHandler
 	// ManifestsListClusterManifestsHandler sets the operation handler for the list cluster manifests operation
 	ManifestsListClusterManifestsHandler manifests.ListClusterManifestsHandler
+	// ClusterTemplatesListClusterTemplatesHandler sets the operation handler for the list cluster templates operation
+	ClusterTemplatesListClusterTemplatesHandler cluster_templates.ListClusterTemplatesHandler
 	// InstallerListClustersHandler sets the operation handler for the list clusters operation
 	InstallerListClustersHandler installer.ListClustersHandler
 	// VersionsListComponentVersionsHandler sets the operation handler for the list component versions operation
@@ -405,10 +535,18 @@ type AssistedInstallAPI struct {
 	OperatorsListOfClusterOperatorsHandler operators.ListOfClusterOperatorsHandler
 	// OperatorsListOperatorPropertiesHandler sets the operation handler for the list operator properties operation
 	OperatorsListOperatorPropertiesHandler operators.ListOperatorPropertiesHandler
+	// InstallerListStateMachinesHandler sets the operation handler for the list state machines operation
+	InstallerListStateMachinesHandler installer.ListStateMachinesHandler
 	// VersionsListSupportedOpenshiftVersionsHandler sets the operation handler for the list supported openshift versions operation
 	VersionsListSupportedOpenshiftVersionsHandler versions.ListSupportedOpenshiftVersionsHandler
 	// OperatorsListSupportedOperatorsHandler sets the operation handler for the list supported operators operation
 	OperatorsListSupportedOperatorsHandler operators.ListSupportedOperatorsHandler
+	// WebhooksListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	WebhooksListWebhookDeliveriesHandler webhooks.ListWebhookDeliveriesHandler
+	// WebhooksListWebhooksHandler sets the operation handler for the list webhooks operation
+	WebhooksListWebhooksHandler webhooks.ListWebhooksHandler
+	// InstallerPauseInstallationHandler sets the operation handler for the pause installation operation
+	InstallerPauseInstallationHandler installer.PauseInstallationHandler
 	// InstallerPostStepReplyHandler sets the operation handler for the post step reply operation
 	InstallerPostStepReplyHandler installer.PostStepReplyHandler
 	// InstallerRegisterAddHostsClusterHandler sets the operation handler for the register add hosts cluster operation
@@ -417,6 +555,10 @@ type AssistedInstallAPI struct {
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
+	// WebhooksRegisterWebhookHandler sets the operation handler for the register webhook operation
+	WebhooksRegisterWebhookHandler webhooks.RegisterWebhookHandler
+	// WebhooksReplayWebhookDeliveryHandler sets the operation handler for the replay webhook delivery operation
+	WebhooksReplayWebhookDeliveryHandler webhooks.ReplayWebhookDeliveryHandler
 	// OperatorsReportMonitoredOperatorStatusHandler sets the operation handler for the report monitored operator status operation
 	OperatorsReportMonitoredOperatorStatusHandler operators.ReportMonitoredOperatorStatusHandler
 	// InstallerResetClusterHandler sets the operation handler for the reset cluster operation
@@ -425,12 +567,28 @@ type AssistedInstallAPI struct {
 	InstallerResetHostHandler installer.ResetHostHandler
 	// InstallerResetHostValidationHandler sets the operation handler for the reset host validation operation
 	InstallerResetHostValidationHandler installer.ResetHostValidationHandler
+	// InstallerResumeInstallationHandler sets the operation handler for the resume installation operation
+	InstallerResumeInstallationHandler installer.ResumeInstallationHandler
+	// InstallerSearchClusterLogsHandler sets the operation handler for the search cluster logs operation
+	InstallerSearchClusterLogsHandler installer.SearchClusterLogsHandler
+	// EventsStreamEventsHandler sets the operation handler for the stream events operation
+	EventsStreamEventsHandler events.StreamEventsHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
 	// InstallerUpdateClusterInstallConfigHandler sets the operation handler for the update cluster install config operation
 	InstallerUpdateClusterInstallConfigHandler installer.UpdateClusterInstallConfigHandler
+	// InstallerUpdateClusterInstallRetryPolicyHandler sets the operation handler for the update cluster install retry policy operation
+	InstallerUpdateClusterInstallRetryPolicyHandler installer.UpdateClusterInstallRetryPolicyHandler
 	// InstallerUpdateClusterLogsProgressHandler sets the operation handler for the update cluster logs progress operation
 	InstallerUpdateClusterLogsProgressHandler installer.UpdateClusterLogsProgressHandler
+	// InstallerUpdateClusterStageTimeoutsHandler sets the operation handler for the update cluster stage timeouts operation
+	InstallerUpdateClusterStageTimeoutsHandler installer.UpdateClusterStageTimeoutsHandler
+	// ClusterTemplatesUpdateClusterTemplateHandler sets the operation handler for the update cluster template operation
+	ClusterTemplatesUpdateClusterTemplateHandler cluster_templates.UpdateClusterTemplateHandler
+	// InstallerUpdateClusterValidationPolicyHandler sets the operation handler for the update cluster validation policy operation
+	InstallerUpdateClusterValidationPolicyHandler installer.UpdateClusterValidationPolicyHandler
+	// InstallerUpdateClusterWorkerSuccessThresholdHandler sets the operation handler for the update cluster worker success threshold operation
+	InstallerUpdateClusterWorkerSuccessThresholdHandler installer.UpdateClusterWorkerSuccessThresholdHandler
 	// InstallerUpdateDiscoveryIgnitionHandler sets the operation handler for the update discovery ignition operation
 	InstallerUpdateDiscoveryIgnitionHandler installer.UpdateDiscoveryIgnitionHandler
 	// InstallerUpdateHostIgnitionHandler sets the operation handler for the update host ignition operation
@@ -447,6 +605,8 @@ type AssistedInstallAPI struct {
 	InstallerUploadHostLogsHandler installer.UploadHostLogsHandler
 	// InstallerUploadLogsHandler sets the operation handler for the upload logs operation
 	InstallerUploadLogsHandler installer.UploadLogsHandler
+	// InstallerValidateHypotheticalHostHandler sets the operation handler for the validate hypothetical host operation
+	InstallerValidateHypotheticalHostHandler installer.ValidateHypotheticalHostHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -528,6 +688,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -548,18 +711,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.ManifestsCreateClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.CreateClusterManifestHandler")
 	}
+	if o.ClusterTemplatesCreateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.CreateClusterTemplateHandler")
+	}
 	if o.AssistedServiceIsoCreateISOAndUploadToS3Handler == nil {
 		unregistered = append(unregistered, "assisted_service_iso.CreateISOAndUploadToS3Handler")
 	}
 	if o.ManifestsDeleteClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.DeleteClusterManifestHandler")
 	}
+	if o.ClusterTemplatesDeleteClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.DeleteClusterTemplateHandler")
+	}
 	if o.InstallerDeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterClusterHandler")
 	}
 	if o.InstallerDeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterHostHandler")
 	}
+	if o.WebhooksDeregisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.DeregisterWebhookHandler")
+	}
 	if o.InstallerDisableHostHandler == nil {
 		unregistered = append(unregistered, "installer.DisableHostHandler")
 	}
@@ -593,12 +765,21 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerEnableHostHandler == nil {
 		unregistered = append(unregistered, "installer.EnableHostHandler")
 	}
+	if o.InstallerExportClusterDefinitionHandler == nil {
+		unregistered = append(unregistered, "installer.ExportClusterDefinitionHandler")
+	}
 	if o.InstallerGenerateClusterISOHandler == nil {
 		unregistered = append(unregistered, "installer.GenerateClusterISOHandler")
 	}
+	if o.InstallerGetChecksumsSigningKeyHandler == nil {
+		unregistered = append(unregistered, "installer.GetChecksumsSigningKeyHandler")
+	}
 	if o.InstallerGetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterHandler")
 	}
+	if o.InstallerGetClusterChecksumsHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterChecksumsHandler")
+	}
 	if o.InstallerGetClusterDefaultConfigHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterDefaultConfigHandler")
 	}
@@ -608,6 +789,21 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterInstallConfigHandler")
 	}
+	if o.InstallerGetClusterInstallRetryPolicyHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterInstallRetryPolicyHandler")
+	}
+	if o.InstallerGetClusterStageTimeoutsHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterStageTimeoutsHandler")
+	}
+	if o.ClusterTemplatesGetClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.GetClusterTemplateHandler")
+	}
+	if o.InstallerGetClusterValidationPolicyHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterValidationPolicyHandler")
+	}
+	if o.InstallerGetClusterWorkerSuccessThresholdHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterWorkerSuccessThresholdHandler")
+	}
 	if o.InstallerGetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.GetCredentialsHandler")
 	}
@@ -638,6 +834,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.GetPresignedForClusterFilesHandler")
 	}
+	if o.InstallerImportClusterDefinitionHandler == nil {
+		unregistered = append(unregistered, "installer.ImportClusterDefinitionHandler")
+	}
 	if o.InstallerInstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.InstallClusterHandler")
 	}
@@ -647,9 +846,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerInstallHostsHandler == nil {
 		unregistered = append(unregistered, "installer.InstallHostsHandler")
 	}
+	if o.AuditListAuditRecordsHandler == nil {
+		unregistered = append(unregistered, "audit.ListAuditRecordsHandler")
+	}
+	if o.InstallerListClusterLogArtifactsHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterLogArtifactsHandler")
+	}
 	if o.ManifestsListClusterManifestsHandler == nil {
 		unregistered = append(unregistered, "manifests.ListClusterManifestsHandler")
 	}
+	if o.ClusterTemplatesListClusterTemplatesHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.ListClusterTemplatesHandler")
+	}
 	if o.InstallerListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.ListClustersHandler")
 	}
@@ -671,12 +879,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.OperatorsListOperatorPropertiesHandler == nil {
 		unregistered = append(unregistered, "operators.ListOperatorPropertiesHandler")
 	}
+	if o.InstallerListStateMachinesHandler == nil {
+		unregistered = append(unregistered, "installer.ListStateMachinesHandler")
+	}
 	if o.VersionsListSupportedOpenshiftVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.ListSupportedOpenshiftVersionsHandler")
 	}
 	if o.OperatorsListSupportedOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.ListSupportedOperatorsHandler")
 	}
+	if o.WebhooksListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListWebhookDeliveriesHandler")
+	}
+	if o.WebhooksListWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.ListWebhooksHandler")
+	}
+	if o.InstallerPauseInstallationHandler == nil {
+		unregistered = append(unregistered, "installer.PauseInstallationHandler")
+	}
 	if o.InstallerPostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.PostStepReplyHandler")
 	}
@@ -689,6 +909,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerRegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterHostHandler")
 	}
+	if o.WebhooksRegisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.RegisterWebhookHandler")
+	}
+	if o.WebhooksReplayWebhookDeliveryHandler == nil {
+		unregistered = append(unregistered, "webhooks.ReplayWebhookDeliveryHandler")
+	}
 	if o.OperatorsReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.ReportMonitoredOperatorStatusHandler")
 	}
@@ -701,15 +927,39 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.ResetHostValidationHandler")
 	}
+	if o.InstallerResumeInstallationHandler == nil {
+		unregistered = append(unregistered, "installer.ResumeInstallationHandler")
+	}
+	if o.InstallerSearchClusterLogsHandler == nil {
+		unregistered = append(unregistered, "installer.SearchClusterLogsHandler")
+	}
+	if o.EventsStreamEventsHandler == nil {
+		unregistered = append(unregistered, "events.StreamEventsHandler")
+	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
 	if o.InstallerUpdateClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterInstallConfigHandler")
 	}
+	if o.InstallerUpdateClusterInstallRetryPolicyHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterInstallRetryPolicyHandler")
+	}
 	if o.InstallerUpdateClusterLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterLogsProgressHandler")
 	}
+	if o.InstallerUpdateClusterStageTimeoutsHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterStageTimeoutsHandler")
+	}
+	if o.ClusterTemplatesUpdateClusterTemplateHandler == nil {
+		unregistered = append(unregistered, "cluster_templates.UpdateClusterTemplateHandler")
+	}
+	if o.InstallerUpdateClusterValidationPolicyHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterValidationPolicyHandler")
+	}
+	if o.InstallerUpdateClusterWorkerSuccessThresholdHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterWorkerSuccessThresholdHandler")
+	}
 	if o.InstallerUpdateDiscoveryIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateDiscoveryIgnitionHandler")
 	}
@@ -734,6 +984,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.UploadLogsHandler")
 	}
+	if o.InstallerValidateHypotheticalHostHandler == nil {
+		unregistered = append(unregistered, "installer.ValidateHypotheticalHostHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -803,6 +1056,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -858,6 +1113,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster_templates"] = cluster_templates.NewCreateClusterTemplate(o.context, o.ClusterTemplatesCreateClusterTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/assisted-service-iso"] = assisted_service_iso.NewCreateISOAndUploadToS3(o.context, o.AssistedServiceIsoCreateISOAndUploadToS3Handler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -866,6 +1125,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/cluster_templates/{cluster_template_id}"] = cluster_templates.NewDeleteClusterTemplate(o.context, o.ClusterTemplatesDeleteClusterTemplateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}"] = installer.NewDeregisterCluster(o.context, o.InstallerDeregisterClusterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -874,6 +1137,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/webhooks/{webhook_id}"] = webhooks.NewDeregisterWebhook(o.context, o.WebhooksDeregisterWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewDisableHost(o.context, o.InstallerDisableHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -915,6 +1182,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewEnableHost(o.context, o.InstallerEnableHostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/definition"] = installer.NewExportClusterDefinition(o.context, o.InstallerExportClusterDefinitionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -922,10 +1193,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/checksums/signing-key"] = installer.NewGetChecksumsSigningKey(o.context, o.InstallerGetChecksumsSigningKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}"] = installer.NewGetCluster(o.context, o.InstallerGetClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/checksums"] = installer.NewGetClusterChecksums(o.context, o.InstallerGetClusterChecksumsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/default-config"] = installer.NewGetClusterDefaultConfig(o.context, o.InstallerGetClusterDefaultConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -938,6 +1217,26 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/install-retry-policy"] = installer.NewGetClusterInstallRetryPolicy(o.context, o.InstallerGetClusterInstallRetryPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/stage-timeouts"] = installer.NewGetClusterStageTimeouts(o.context, o.InstallerGetClusterStageTimeoutsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster_templates/{cluster_template_id}"] = cluster_templates.NewGetClusterTemplate(o.context, o.ClusterTemplatesGetClusterTemplateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/validation-policy"] = installer.NewGetClusterValidationPolicy(o.context, o.InstallerGetClusterValidationPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/worker-success-threshold"] = installer.NewGetClusterWorkerSuccessThreshold(o.context, o.InstallerGetClusterWorkerSuccessThresholdHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/credentials"] = installer.NewGetCredentials(o.context, o.InstallerGetCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -978,6 +1277,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/import"] = installer.NewImportClusterDefinition(o.context, o.InstallerImportClusterDefinitionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/install"] = installer.NewInstallCluster(o.context, o.InstallerInstallClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -990,10 +1293,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audit_records"] = audit.NewListAuditRecords(o.context, o.AuditListAuditRecordsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/artifacts"] = installer.NewListClusterLogArtifacts(o.context, o.InstallerListClusterLogArtifactsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/manifests"] = manifests.NewListClusterManifests(o.context, o.ManifestsListClusterManifestsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/cluster_templates"] = cluster_templates.NewListClusterTemplates(o.context, o.ClusterTemplatesListClusterTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters"] = installer.NewListClusters(o.context, o.InstallerListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1022,11 +1337,27 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/state-machines"] = installer.NewListStateMachines(o.context, o.InstallerListStateMachinesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/openshift_versions"] = versions.NewListSupportedOpenshiftVersions(o.context, o.VersionsListSupportedOpenshiftVersionsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/supported-operators"] = operators.NewListSupportedOperators(o.context, o.OperatorsListSupportedOperatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{webhook_id}/deliveries"] = webhooks.NewListWebhookDeliveries(o.context, o.WebhooksListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = webhooks.NewListWebhooks(o.context, o.WebhooksListWebhooksHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/pause"] = installer.NewPauseInstallation(o.context, o.InstallerPauseInstallationHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1043,6 +1374,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts"] = installer.NewRegisterHost(o.context, o.InstallerRegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = webhooks.NewRegisterWebhook(o.context, o.WebhooksRegisterWebhookHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/{webhook_id}/deliveries/{delivery_id}/actions/replay"] = webhooks.NewReplayWebhookDelivery(o.context, o.WebhooksReplayWebhookDeliveryHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1059,6 +1398,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewResetHostValidation(o.context, o.InstallerResetHostValidationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/actions/resume"] = installer.NewResumeInstallation(o.context, o.InstallerResumeInstallationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/logs/search"] = installer.NewSearchClusterLogs(o.context, o.InstallerSearchClusterLogsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/events/stream"] = events.NewStreamEvents(o.context, o.EventsStreamEventsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1070,7 +1421,27 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/install-retry-policy"] = installer.NewUpdateClusterInstallRetryPolicy(o.context, o.InstallerUpdateClusterInstallRetryPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/clusters/{cluster_id}/logs_progress"] = installer.NewUpdateClusterLogsProgress(o.context, o.InstallerUpdateClusterLogsProgressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/stage-timeouts"] = installer.NewUpdateClusterStageTimeouts(o.context, o.InstallerUpdateClusterStageTimeoutsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cluster_templates/{cluster_template_id}"] = cluster_templates.NewUpdateClusterTemplate(o.context, o.ClusterTemplatesUpdateClusterTemplateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/validation-policy"] = installer.NewUpdateClusterValidationPolicy(o.context, o.InstallerUpdateClusterValidationPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/worker-success-threshold"] = installer.NewUpdateClusterWorkerSuccessThreshold(o.context, o.InstallerUpdateClusterWorkerSuccessThresholdHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1103,6 +1474,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/logs"] = installer.NewUploadLogs(o.context, o.InstallerUploadLogsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/host-validations"] = installer.NewValidateHypotheticalHost(o.context, o.InstallerValidateHypotheticalHostHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
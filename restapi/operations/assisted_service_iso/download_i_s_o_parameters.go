@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 )
 
 // NewDownloadISOParams creates a new DownloadISOParams object
@@ -27,6 +28,15 @@ type DownloadISOParams struct {
 
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
+
+	/*Applies the Range header only if the ETag of the file still matches the given one.
+	  In: header
+	*/
+	IfRange *string
+	/*Requests a single range of bytes of the file (e.g. "bytes=1024-"), for resuming interrupted downloads.
+	  In: header
+	*/
+	Range *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -38,8 +48,52 @@ func (o *DownloadISOParams) BindRequest(r *http.Request, route *middleware.Match
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfRange(r.Header[http.CanonicalHeaderKey("If-Range")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindRange(r.Header[http.CanonicalHeaderKey("Range")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindIfRange binds and validates parameter IfRange from header.
+func (o *DownloadISOParams) bindIfRange(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfRange = &raw
+
+	return nil
+}
+
+// bindRange binds and validates parameter Range from header.
+func (o *DownloadISOParams) bindRange(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Range = &raw
+
+	return nil
+}
@@ -22,6 +22,14 @@ const DownloadISOOKCode int = 200
 swagger:response downloadISOOK
 */
 type DownloadISOOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +43,28 @@ func NewDownloadISOOK() *DownloadISOOK {
 	return &DownloadISOOK{}
 }
 
+// WithAcceptRanges adds the acceptRanges to the download i s o o k response
+func (o *DownloadISOOK) WithAcceptRanges(acceptRanges string) *DownloadISOOK {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download i s o o k response
+func (o *DownloadISOOK) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithETag adds the eTag to the download i s o o k response
+func (o *DownloadISOOK) WithETag(eTag string) *DownloadISOOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download i s o o k response
+func (o *DownloadISOOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the download i s o o k response
 func (o *DownloadISOOK) WithPayload(payload io.ReadCloser) *DownloadISOOK {
 	o.Payload = payload
@@ -49,6 +79,20 @@ func (o *DownloadISOOK) SetPayload(payload io.ReadCloser) {
 // WriteResponse to the client
 func (o *DownloadISOOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -56,6 +100,114 @@ func (o *DownloadISOOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// DownloadISOPartialContentCode is the HTTP code returned for type DownloadISOPartialContent
+const DownloadISOPartialContentCode int = 206
+
+/*DownloadISOPartialContent Partial content, the requested range of the file.
+
+swagger:response downloadISOPartialContent
+*/
+type DownloadISOPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The range of bytes of the file that is sent, and its size.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewDownloadISOPartialContent creates DownloadISOPartialContent with default headers values
+func NewDownloadISOPartialContent() *DownloadISOPartialContent {
+
+	return &DownloadISOPartialContent{}
+}
+
+// WithAcceptRanges adds the acceptRanges to the download i s o partial content response
+func (o *DownloadISOPartialContent) WithAcceptRanges(acceptRanges string) *DownloadISOPartialContent {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download i s o partial content response
+func (o *DownloadISOPartialContent) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithContentRange adds the contentRange to the download i s o partial content response
+func (o *DownloadISOPartialContent) WithContentRange(contentRange string) *DownloadISOPartialContent {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download i s o partial content response
+func (o *DownloadISOPartialContent) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithETag adds the eTag to the download i s o partial content response
+func (o *DownloadISOPartialContent) WithETag(eTag string) *DownloadISOPartialContent {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download i s o partial content response
+func (o *DownloadISOPartialContent) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the download i s o partial content response
+func (o *DownloadISOPartialContent) WithPayload(payload io.ReadCloser) *DownloadISOPartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download i s o partial content response
+func (o *DownloadISOPartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadISOPartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // DownloadISOUnauthorizedCode is the HTTP code returned for type DownloadISOUnauthorized
 const DownloadISOUnauthorizedCode int = 401
 
@@ -188,6 +340,72 @@ func (o *DownloadISONotFound) WriteResponse(rw http.ResponseWriter, producer run
 	}
 }
 
+// DownloadISORequestedRangeNotSatisfiableCode is the HTTP code returned for type DownloadISORequestedRangeNotSatisfiable
+const DownloadISORequestedRangeNotSatisfiableCode int = 416
+
+/*DownloadISORequestedRangeNotSatisfiable The requested range is not satisfiable.
+
+swagger:response downloadISORequestedRangeNotSatisfiable
+*/
+type DownloadISORequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadISORequestedRangeNotSatisfiable creates DownloadISORequestedRangeNotSatisfiable with default headers values
+func NewDownloadISORequestedRangeNotSatisfiable() *DownloadISORequestedRangeNotSatisfiable {
+
+	return &DownloadISORequestedRangeNotSatisfiable{}
+}
+
+// WithContentRange adds the contentRange to the download i s o requested range not satisfiable response
+func (o *DownloadISORequestedRangeNotSatisfiable) WithContentRange(contentRange string) *DownloadISORequestedRangeNotSatisfiable {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download i s o requested range not satisfiable response
+func (o *DownloadISORequestedRangeNotSatisfiable) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithPayload adds the payload to the download i s o requested range not satisfiable response
+func (o *DownloadISORequestedRangeNotSatisfiable) WithPayload(payload *models.Error) *DownloadISORequestedRangeNotSatisfiable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download i s o requested range not satisfiable response
+func (o *DownloadISORequestedRangeNotSatisfiable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadISORequestedRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	rw.WriteHeader(416)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadISOInternalServerErrorCode is the HTTP code returned for type DownloadISOInternalServerError
 const DownloadISOInternalServerErrorCode int = 500
 
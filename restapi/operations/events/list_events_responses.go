@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -21,6 +22,10 @@ const ListEventsOKCode int = 200
 swagger:response listEventsOK
 */
 type ListEventsOK struct {
+	/*The total number of events matching the filters, regardless of the limit and offset.
+
+	 */
+	EventCount int64 `json:"Event-Count"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewListEventsOK() *ListEventsOK {
 	return &ListEventsOK{}
 }
 
+// WithEventCount adds the eventCount to the list events o k response
+func (o *ListEventsOK) WithEventCount(eventCount int64) *ListEventsOK {
+	o.EventCount = eventCount
+	return o
+}
+
+// SetEventCount sets the eventCount to the list events o k response
+func (o *ListEventsOK) SetEventCount(eventCount int64) {
+	o.EventCount = eventCount
+}
+
 // WithPayload adds the payload to the list events o k response
 func (o *ListEventsOK) WithPayload(payload models.EventList) *ListEventsOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *ListEventsOK) SetPayload(payload models.EventList) {
 // WriteResponse to the client
 func (o *ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Event-Count
+
+	eventCount := swag.FormatInt64(o.EventCount)
+	if eventCount != "" {
+		rw.Header().Set("Event-Count", eventCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -21,6 +21,13 @@ type ListEventsURL struct {
 
 	Categories []string
 	HostID     *strfmt.UUID
+	Limit      *int64
+	Message    *string
+	Offset     *int64
+	Order      *string
+	Severities []string
+	Since      *strfmt.DateTime
+	Until      *strfmt.DateTime
 
 	_basePath string
 	// avoid unkeyed usage
@@ -88,6 +95,71 @@ func (o *ListEventsURL) Build() (*url.URL, error) {
 		qs.Set("host_id", hostIDQ)
 	}
 
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var messageQ string
+	if o.Message != nil {
+		messageQ = *o.Message
+	}
+	if messageQ != "" {
+		qs.Set("message", messageQ)
+	}
+
+	var offsetQ string
+	if o.Offset != nil {
+		offsetQ = swag.FormatInt64(*o.Offset)
+	}
+	if offsetQ != "" {
+		qs.Set("offset", offsetQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var severitiesIR []string
+	for _, severitiesI := range o.Severities {
+		severitiesIS := severitiesI
+		if severitiesIS != "" {
+			severitiesIR = append(severitiesIR, severitiesIS)
+		}
+	}
+
+	severities := swag.JoinByFormat(severitiesIR, "")
+
+	if len(severities) > 0 {
+		qsv := severities[0]
+		if qsv != "" {
+			qs.Set("severities", qsv)
+		}
+	}
+
+	var sinceQ string
+	if o.Since != nil {
+		sinceQ = o.Since.String()
+	}
+	if sinceQ != "" {
+		qs.Set("since", sinceQ)
+	}
+
+	var untilQ string
+	if o.Until != nil {
+		untilQ = o.Until.String()
+	}
+	if untilQ != "" {
+		qs.Set("until", untilQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -22,6 +22,14 @@ const DownloadClusterFilesOKCode int = 200
 swagger:response downloadClusterFilesOK
 */
 type DownloadClusterFilesOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +43,28 @@ func NewDownloadClusterFilesOK() *DownloadClusterFilesOK {
 	return &DownloadClusterFilesOK{}
 }
 
+// WithAcceptRanges adds the acceptRanges to the download cluster files o k response
+func (o *DownloadClusterFilesOK) WithAcceptRanges(acceptRanges string) *DownloadClusterFilesOK {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download cluster files o k response
+func (o *DownloadClusterFilesOK) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithETag adds the eTag to the download cluster files o k response
+func (o *DownloadClusterFilesOK) WithETag(eTag string) *DownloadClusterFilesOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download cluster files o k response
+func (o *DownloadClusterFilesOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the download cluster files o k response
 func (o *DownloadClusterFilesOK) WithPayload(payload io.ReadCloser) *DownloadClusterFilesOK {
 	o.Payload = payload
@@ -49,6 +79,20 @@ func (o *DownloadClusterFilesOK) SetPayload(payload io.ReadCloser) {
 // WriteResponse to the client
 func (o *DownloadClusterFilesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -56,6 +100,114 @@ func (o *DownloadClusterFilesOK) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// DownloadClusterFilesPartialContentCode is the HTTP code returned for type DownloadClusterFilesPartialContent
+const DownloadClusterFilesPartialContentCode int = 206
+
+/*DownloadClusterFilesPartialContent Partial content, the requested range of the file.
+
+swagger:response downloadClusterFilesPartialContent
+*/
+type DownloadClusterFilesPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The range of bytes of the file that is sent, and its size.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewDownloadClusterFilesPartialContent creates DownloadClusterFilesPartialContent with default headers values
+func NewDownloadClusterFilesPartialContent() *DownloadClusterFilesPartialContent {
+
+	return &DownloadClusterFilesPartialContent{}
+}
+
+// WithAcceptRanges adds the acceptRanges to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) WithAcceptRanges(acceptRanges string) *DownloadClusterFilesPartialContent {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithContentRange adds the contentRange to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) WithContentRange(contentRange string) *DownloadClusterFilesPartialContent {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithETag adds the eTag to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) WithETag(eTag string) *DownloadClusterFilesPartialContent {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) WithPayload(payload io.ReadCloser) *DownloadClusterFilesPartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster files partial content response
+func (o *DownloadClusterFilesPartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterFilesPartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // DownloadClusterFilesUnauthorizedCode is the HTTP code returned for type DownloadClusterFilesUnauthorized
 const DownloadClusterFilesUnauthorizedCode int = 401
 
@@ -276,6 +428,72 @@ func (o *DownloadClusterFilesConflict) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// DownloadClusterFilesRequestedRangeNotSatisfiableCode is the HTTP code returned for type DownloadClusterFilesRequestedRangeNotSatisfiable
+const DownloadClusterFilesRequestedRangeNotSatisfiableCode int = 416
+
+/*DownloadClusterFilesRequestedRangeNotSatisfiable The requested range is not satisfiable.
+
+swagger:response downloadClusterFilesRequestedRangeNotSatisfiable
+*/
+type DownloadClusterFilesRequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadClusterFilesRequestedRangeNotSatisfiable creates DownloadClusterFilesRequestedRangeNotSatisfiable with default headers values
+func NewDownloadClusterFilesRequestedRangeNotSatisfiable() *DownloadClusterFilesRequestedRangeNotSatisfiable {
+
+	return &DownloadClusterFilesRequestedRangeNotSatisfiable{}
+}
+
+// WithContentRange adds the contentRange to the download cluster files requested range not satisfiable response
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) WithContentRange(contentRange string) *DownloadClusterFilesRequestedRangeNotSatisfiable {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download cluster files requested range not satisfiable response
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithPayload adds the payload to the download cluster files requested range not satisfiable response
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) WithPayload(payload *models.Error) *DownloadClusterFilesRequestedRangeNotSatisfiable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster files requested range not satisfiable response
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterFilesRequestedRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	rw.WriteHeader(416)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadClusterFilesInternalServerErrorCode is the HTTP code returned for type DownloadClusterFilesInternalServerError
 const DownloadClusterFilesInternalServerErrorCode int = 500
 
@@ -26,6 +26,10 @@ type DownloadClusterISOHeadersOK struct {
 
 	 */
 	ContentLength int64 `json:"Content-Length"`
+	/*The hex-encoded SHA-256 digest of the ISO, when it is known.
+
+	 */
+	XChecksumSha256 string `json:"X-Checksum-Sha256"`
 }
 
 // NewDownloadClusterISOHeadersOK creates DownloadClusterISOHeadersOK with default headers values
@@ -45,6 +49,17 @@ func (o *DownloadClusterISOHeadersOK) SetContentLength(contentLength int64) {
 	o.ContentLength = contentLength
 }
 
+// WithXChecksumSha256 adds the xChecksumSha256 to the download cluster i s o headers o k response
+func (o *DownloadClusterISOHeadersOK) WithXChecksumSha256(xChecksumSha256 string) *DownloadClusterISOHeadersOK {
+	o.XChecksumSha256 = xChecksumSha256
+	return o
+}
+
+// SetXChecksumSha256 sets the xChecksumSha256 to the download cluster i s o headers o k response
+func (o *DownloadClusterISOHeadersOK) SetXChecksumSha256(xChecksumSha256 string) {
+	o.XChecksumSha256 = xChecksumSha256
+}
+
 // WriteResponse to the client
 func (o *DownloadClusterISOHeadersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
@@ -55,6 +70,13 @@ func (o *DownloadClusterISOHeadersOK) WriteResponse(rw http.ResponseWriter, prod
 		rw.Header().Set("Content-Length", contentLength)
 	}
 
+	// response header X-Checksum-Sha256
+
+	xChecksumSha256 := o.XChecksumSha256
+	if xChecksumSha256 != "" {
+		rw.Header().Set("X-Checksum-Sha256", xChecksumSha256)
+	}
+
 	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
 
 	rw.WriteHeader(200)
@@ -30,6 +30,14 @@ type DownloadClusterISOParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Applies the Range header only if the ETag of the file still matches the given one.
+	  In: header
+	*/
+	IfRange *string
+	/*Requests a single range of bytes of the file (e.g. "bytes=1024-"), for resuming interrupted downloads.
+	  In: header
+	*/
+	Range *string
 	/*The cluster whose ISO should be downloaded.
 	  Required: true
 	  In: path
@@ -46,6 +54,14 @@ func (o *DownloadClusterISOParams) BindRequest(r *http.Request, route *middlewar
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfRange(r.Header[http.CanonicalHeaderKey("If-Range")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindRange(r.Header[http.CanonicalHeaderKey("Range")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -57,6 +73,42 @@ func (o *DownloadClusterISOParams) BindRequest(r *http.Request, route *middlewar
 	return nil
 }
 
+// bindIfRange binds and validates parameter IfRange from header.
+func (o *DownloadClusterISOParams) bindIfRange(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfRange = &raw
+
+	return nil
+}
+
+// bindRange binds and validates parameter Range from header.
+func (o *DownloadClusterISOParams) bindRange(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Range = &raw
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *DownloadClusterISOParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -22,6 +22,14 @@ const DownloadClusterISOOKCode int = 200
 swagger:response downloadClusterISOOK
 */
 type DownloadClusterISOOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +43,28 @@ func NewDownloadClusterISOOK() *DownloadClusterISOOK {
 	return &DownloadClusterISOOK{}
 }
 
+// WithAcceptRanges adds the acceptRanges to the download cluster i s o o k response
+func (o *DownloadClusterISOOK) WithAcceptRanges(acceptRanges string) *DownloadClusterISOOK {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download cluster i s o o k response
+func (o *DownloadClusterISOOK) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithETag adds the eTag to the download cluster i s o o k response
+func (o *DownloadClusterISOOK) WithETag(eTag string) *DownloadClusterISOOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download cluster i s o o k response
+func (o *DownloadClusterISOOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the download cluster i s o o k response
 func (o *DownloadClusterISOOK) WithPayload(payload io.ReadCloser) *DownloadClusterISOOK {
 	o.Payload = payload
@@ -49,6 +79,20 @@ func (o *DownloadClusterISOOK) SetPayload(payload io.ReadCloser) {
 // WriteResponse to the client
 func (o *DownloadClusterISOOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -56,6 +100,114 @@ func (o *DownloadClusterISOOK) WriteResponse(rw http.ResponseWriter, producer ru
 	}
 }
 
+// DownloadClusterISOPartialContentCode is the HTTP code returned for type DownloadClusterISOPartialContent
+const DownloadClusterISOPartialContentCode int = 206
+
+/*DownloadClusterISOPartialContent Partial content, the requested range of the file.
+
+swagger:response downloadClusterISOPartialContent
+*/
+type DownloadClusterISOPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The range of bytes of the file that is sent, and its size.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewDownloadClusterISOPartialContent creates DownloadClusterISOPartialContent with default headers values
+func NewDownloadClusterISOPartialContent() *DownloadClusterISOPartialContent {
+
+	return &DownloadClusterISOPartialContent{}
+}
+
+// WithAcceptRanges adds the acceptRanges to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) WithAcceptRanges(acceptRanges string) *DownloadClusterISOPartialContent {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithContentRange adds the contentRange to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) WithContentRange(contentRange string) *DownloadClusterISOPartialContent {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithETag adds the eTag to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) WithETag(eTag string) *DownloadClusterISOPartialContent {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) WithPayload(payload io.ReadCloser) *DownloadClusterISOPartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster i s o partial content response
+func (o *DownloadClusterISOPartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterISOPartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // DownloadClusterISOBadRequestCode is the HTTP code returned for type DownloadClusterISOBadRequest
 const DownloadClusterISOBadRequestCode int = 400
 
@@ -300,6 +452,72 @@ func (o *DownloadClusterISOConflict) WriteResponse(rw http.ResponseWriter, produ
 	}
 }
 
+// DownloadClusterISORequestedRangeNotSatisfiableCode is the HTTP code returned for type DownloadClusterISORequestedRangeNotSatisfiable
+const DownloadClusterISORequestedRangeNotSatisfiableCode int = 416
+
+/*DownloadClusterISORequestedRangeNotSatisfiable The requested range is not satisfiable.
+
+swagger:response downloadClusterISORequestedRangeNotSatisfiable
+*/
+type DownloadClusterISORequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadClusterISORequestedRangeNotSatisfiable creates DownloadClusterISORequestedRangeNotSatisfiable with default headers values
+func NewDownloadClusterISORequestedRangeNotSatisfiable() *DownloadClusterISORequestedRangeNotSatisfiable {
+
+	return &DownloadClusterISORequestedRangeNotSatisfiable{}
+}
+
+// WithContentRange adds the contentRange to the download cluster i s o requested range not satisfiable response
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) WithContentRange(contentRange string) *DownloadClusterISORequestedRangeNotSatisfiable {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download cluster i s o requested range not satisfiable response
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithPayload adds the payload to the download cluster i s o requested range not satisfiable response
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) WithPayload(payload *models.Error) *DownloadClusterISORequestedRangeNotSatisfiable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster i s o requested range not satisfiable response
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterISORequestedRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	rw.WriteHeader(416)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadClusterISOInternalServerErrorCode is the HTTP code returned for type DownloadClusterISOInternalServerError
 const DownloadClusterISOInternalServerErrorCode int = 500
 
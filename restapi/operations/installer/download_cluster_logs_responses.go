@@ -22,6 +22,14 @@ const DownloadClusterLogsOKCode int = 200
 swagger:response downloadClusterLogsOK
 */
 type DownloadClusterLogsOK struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +43,28 @@ func NewDownloadClusterLogsOK() *DownloadClusterLogsOK {
 	return &DownloadClusterLogsOK{}
 }
 
+// WithAcceptRanges adds the acceptRanges to the download cluster logs o k response
+func (o *DownloadClusterLogsOK) WithAcceptRanges(acceptRanges string) *DownloadClusterLogsOK {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download cluster logs o k response
+func (o *DownloadClusterLogsOK) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithETag adds the eTag to the download cluster logs o k response
+func (o *DownloadClusterLogsOK) WithETag(eTag string) *DownloadClusterLogsOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download cluster logs o k response
+func (o *DownloadClusterLogsOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the download cluster logs o k response
 func (o *DownloadClusterLogsOK) WithPayload(payload io.ReadCloser) *DownloadClusterLogsOK {
 	o.Payload = payload
@@ -49,6 +79,20 @@ func (o *DownloadClusterLogsOK) SetPayload(payload io.ReadCloser) {
 // WriteResponse to the client
 func (o *DownloadClusterLogsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -56,6 +100,114 @@ func (o *DownloadClusterLogsOK) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+// DownloadClusterLogsPartialContentCode is the HTTP code returned for type DownloadClusterLogsPartialContent
+const DownloadClusterLogsPartialContentCode int = 206
+
+/*DownloadClusterLogsPartialContent Partial content, the requested range of the file.
+
+swagger:response downloadClusterLogsPartialContent
+*/
+type DownloadClusterLogsPartialContent struct {
+	/*The unit of the ranges that may be requested, which is "bytes".
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*The range of bytes of the file that is sent, and its size.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+	/*The entity tag of the file, for resuming its download with the If-Range header.
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewDownloadClusterLogsPartialContent creates DownloadClusterLogsPartialContent with default headers values
+func NewDownloadClusterLogsPartialContent() *DownloadClusterLogsPartialContent {
+
+	return &DownloadClusterLogsPartialContent{}
+}
+
+// WithAcceptRanges adds the acceptRanges to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) WithAcceptRanges(acceptRanges string) *DownloadClusterLogsPartialContent {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithContentRange adds the contentRange to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) WithContentRange(contentRange string) *DownloadClusterLogsPartialContent {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithETag adds the eTag to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) WithETag(eTag string) *DownloadClusterLogsPartialContent {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) WithPayload(payload io.ReadCloser) *DownloadClusterLogsPartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster logs partial content response
+func (o *DownloadClusterLogsPartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterLogsPartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
 // DownloadClusterLogsUnauthorizedCode is the HTTP code returned for type DownloadClusterLogsUnauthorized
 const DownloadClusterLogsUnauthorizedCode int = 401
 
@@ -276,6 +428,72 @@ func (o *DownloadClusterLogsConflict) WriteResponse(rw http.ResponseWriter, prod
 	}
 }
 
+// DownloadClusterLogsRequestedRangeNotSatisfiableCode is the HTTP code returned for type DownloadClusterLogsRequestedRangeNotSatisfiable
+const DownloadClusterLogsRequestedRangeNotSatisfiableCode int = 416
+
+/*DownloadClusterLogsRequestedRangeNotSatisfiable The requested range is not satisfiable.
+
+swagger:response downloadClusterLogsRequestedRangeNotSatisfiable
+*/
+type DownloadClusterLogsRequestedRangeNotSatisfiable struct {
+	/*The size of the file.
+
+	 */
+	ContentRange string `json:"Content-Range"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadClusterLogsRequestedRangeNotSatisfiable creates DownloadClusterLogsRequestedRangeNotSatisfiable with default headers values
+func NewDownloadClusterLogsRequestedRangeNotSatisfiable() *DownloadClusterLogsRequestedRangeNotSatisfiable {
+
+	return &DownloadClusterLogsRequestedRangeNotSatisfiable{}
+}
+
+// WithContentRange adds the contentRange to the download cluster logs requested range not satisfiable response
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) WithContentRange(contentRange string) *DownloadClusterLogsRequestedRangeNotSatisfiable {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the download cluster logs requested range not satisfiable response
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithPayload adds the payload to the download cluster logs requested range not satisfiable response
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) WithPayload(payload *models.Error) *DownloadClusterLogsRequestedRangeNotSatisfiable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster logs requested range not satisfiable response
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterLogsRequestedRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	rw.WriteHeader(416)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadClusterLogsInternalServerErrorCode is the HTTP code returned for type DownloadClusterLogsInternalServerError
 const DownloadClusterLogsInternalServerErrorCode int = 500
 
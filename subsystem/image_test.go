@@ -112,7 +112,7 @@ var _ = Describe("system-test image tests", func() {
 				}
 				defer os.Remove(file.Name())
 
-				_, err = userBMClient.AssistedServiceIso.DownloadISO(ctx, &assisted_service_iso.DownloadISOParams{}, file)
+				_, _, err = userBMClient.AssistedServiceIso.DownloadISO(ctx, &assisted_service_iso.DownloadISOParams{}, file)
 				Expect(err).NotTo(HaveOccurred())
 				verifyFileNotEmpty(file)
 			}
@@ -167,7 +167,7 @@ var _ = Describe("image tests", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		// test that the iso is no-longer available
-		_, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{ClusterID: clusterID}, file)
+		_, _, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{ClusterID: clusterID}, file)
 		Expect(err).To(BeAssignableToTypeOf(installer.NewDownloadClusterISONotFound()))
 
 		_, err = userBMClient.Installer.DownloadClusterISOHeaders(ctx, &installer.DownloadClusterISOHeadersParams{ClusterID: clusterID})
@@ -179,7 +179,7 @@ var _ = Describe("image tests", func() {
 	})
 
 	It("download_non_existing_cluster", func() {
-		_, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{ClusterID: *strToUUID(uuid.New().String())}, file)
+		_, _, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{ClusterID: *strToUUID(uuid.New().String())}, file)
 		Expect(err).Should(HaveOccurred())
 	})
 
@@ -197,7 +197,7 @@ var _ = Describe("image tests", func() {
 			},
 		})
 		Expect(err).NotTo(HaveOccurred())
-		_, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{
+		_, _, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{
 			ClusterID: *cluster.GetPayload().ID,
 		}, file)
 		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewDownloadClusterISONotFound())))
@@ -309,7 +309,7 @@ func downloadClusterIso(ctx context.Context, clusterID strfmt.UUID) {
 	}
 	defer os.Remove(file.Name())
 
-	_, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{
+	_, _, err = userBMClient.Installer.DownloadClusterISO(ctx, &installer.DownloadClusterISOParams{
 		ClusterID: clusterID,
 	}, file)
 	Expect(err).NotTo(HaveOccurred())